@@ -57,6 +57,7 @@ func benchPreInit() {
 	benchCheckers = append(benchCheckers,
 		benchChecker{"msgpack", fnMsgpackEncodeFn, fnMsgpackDecodeFn},
 		benchChecker{"json", fnJsonEncodeFn, fnJsonDecodeFn},
+		benchChecker{"cbor", fnCborEncodeFn, fnCborDecodeFn},
 		benchChecker{"std-json", fnStdJsonEncodeFn, fnStdJsonDecodeFn},
 		benchChecker{"gob", fnGobEncodeFn, fnGobDecodeFn},
 		benchChecker{"std-xml", fnStdXmlEncodeFn, fnStdXmlDecodeFn},
@@ -235,6 +236,14 @@ func fnJsonDecodeFn(buf []byte, ts interface{}) error {
 	return sTestCodecDecode(buf, ts, testJsonH, &testJsonH.BasicHandle)
 }
 
+func fnCborEncodeFn(ts interface{}, bsIn []byte) (bs []byte, err error) {
+	return sTestCodecEncode(ts, bsIn, fnBenchmarkByteBuf, testCborH, &testCborH.BasicHandle)
+}
+
+func fnCborDecodeFn(buf []byte, ts interface{}) error {
+	return sTestCodecDecode(buf, ts, testCborH, &testCborH.BasicHandle)
+}
+
 func fnGobEncodeFn(ts interface{}, bsIn []byte) ([]byte, error) {
 	buf := fnBenchmarkByteBuf(bsIn)
 	err := gob.NewEncoder(buf).Encode(ts)
@@ -281,6 +290,10 @@ func Benchmark__Json_______Encode(b *testing.B) {
 	fnBenchmarkEncode(b, "json", benchTs, fnJsonEncodeFn)
 }
 
+func Benchmark__Cbor_______Encode(b *testing.B) {
+	fnBenchmarkEncode(b, "cbor", benchTs, fnCborEncodeFn)
+}
+
 func Benchmark__Std_Json___Encode(b *testing.B) {
 	fnBenchmarkEncode(b, "std-json", benchTs, fnStdJsonEncodeFn)
 }
@@ -303,6 +316,10 @@ func Benchmark__Json_______Decode(b *testing.B) {
 	fnBenchmarkDecode(b, "json", benchTs, fnJsonEncodeFn, fnJsonDecodeFn, fnBenchNewTs)
 }
 
+func Benchmark__Cbor_______Decode(b *testing.B) {
+	fnBenchmarkDecode(b, "cbor", benchTs, fnCborEncodeFn, fnCborDecodeFn, fnBenchNewTs)
+}
+
 func Benchmark__Std_Json___Decode(b *testing.B) {
 	fnBenchmarkDecode(b, "std-json", benchTs, fnStdJsonEncodeFn, fnStdJsonDecodeFn, fnBenchNewTs)
 }
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"strconv"
+	"testing"
+)
+
+// Benchmarks for the fast-path, comparing each fast-path type against the same data
+// in a type with a named element (or key) type, which is encoded via reflection instead.
+//
+// Sample way to run:
+// go test -benchmem -bench='__(Fastpath|Reflect)'
+
+type benchFpString string
+
+const benchFpLen = 256
+
+var (
+	benchFpSliceString  = make([]string, benchFpLen)
+	benchFpSliceStringR = make([]benchFpString, benchFpLen)
+
+	benchFpMapStringString  = make(map[string]string, benchFpLen)
+	benchFpMapStringStringR = make(map[benchFpString]benchFpString, benchFpLen)
+
+	benchFpMapStringIntf  = make(map[string]interface{}, benchFpLen)
+	benchFpMapStringIntfR = make(map[benchFpString]interface{}, benchFpLen)
+)
+
+func init() {
+	for i := 0; i < benchFpLen; i++ {
+		s := "value-" + strconv.Itoa(i)
+		k := "key-" + strconv.Itoa(i)
+		benchFpSliceString[i] = s
+		benchFpSliceStringR[i] = benchFpString(s)
+		benchFpMapStringString[k] = s
+		benchFpMapStringStringR[benchFpString(k)] = benchFpString(s)
+		benchFpMapStringIntf[k] = s
+		benchFpMapStringIntfR[benchFpString(k)] = s
+	}
+}
+
+func Benchmark__Fastpath_SliceString_____Encode(b *testing.B) {
+	fnBenchmarkEncode(b, "msgpack", benchFpSliceString, fnMsgpackEncodeFn)
+}
+
+func Benchmark__Reflect__SliceString_____Encode(b *testing.B) {
+	fnBenchmarkEncode(b, "msgpack", benchFpSliceStringR, fnMsgpackEncodeFn)
+}
+
+func Benchmark__Fastpath_MapStringString_Encode(b *testing.B) {
+	fnBenchmarkEncode(b, "msgpack", benchFpMapStringString, fnMsgpackEncodeFn)
+}
+
+func Benchmark__Reflect__MapStringString_Encode(b *testing.B) {
+	fnBenchmarkEncode(b, "msgpack", benchFpMapStringStringR, fnMsgpackEncodeFn)
+}
+
+func Benchmark__Fastpath_MapStringIntf___Encode(b *testing.B) {
+	fnBenchmarkEncode(b, "msgpack", benchFpMapStringIntf, fnMsgpackEncodeFn)
+}
+
+func Benchmark__Reflect__MapStringIntf___Encode(b *testing.B) {
+	fnBenchmarkEncode(b, "msgpack", benchFpMapStringIntfR, fnMsgpackEncodeFn)
+}
+
+func Benchmark__Fastpath_SliceString_____Decode(b *testing.B) {
+	fnBenchmarkDecode(b, "msgpack", benchFpSliceString, fnMsgpackEncodeFn, fnMsgpackDecodeFn,
+		func() interface{} { return new([]string) })
+}
+
+func Benchmark__Reflect__SliceString_____Decode(b *testing.B) {
+	fnBenchmarkDecode(b, "msgpack", benchFpSliceStringR, fnMsgpackEncodeFn, fnMsgpackDecodeFn,
+		func() interface{} { return new([]benchFpString) })
+}
+
+func Benchmark__Fastpath_MapStringString_Decode(b *testing.B) {
+	fnBenchmarkDecode(b, "msgpack", benchFpMapStringString, fnMsgpackEncodeFn, fnMsgpackDecodeFn,
+		func() interface{} { return new(map[string]string) })
+}
+
+func Benchmark__Reflect__MapStringString_Decode(b *testing.B) {
+	fnBenchmarkDecode(b, "msgpack", benchFpMapStringStringR, fnMsgpackEncodeFn, fnMsgpackDecodeFn,
+		func() interface{} { return new(map[benchFpString]benchFpString) })
+}
+
+func Benchmark__Fastpath_MapStringIntf___Decode(b *testing.B) {
+	fnBenchmarkDecode(b, "msgpack", benchFpMapStringIntf, fnMsgpackEncodeFn, fnMsgpackDecodeFn,
+		func() interface{} { return new(map[string]interface{}) })
+}
+
+func Benchmark__Reflect__MapStringIntf___Decode(b *testing.B) {
+	fnBenchmarkDecode(b, "msgpack", benchFpMapStringIntfR, fnMsgpackEncodeFn, fnMsgpackDecodeFn,
+		func() interface{} { return new(map[benchFpString]interface{}) })
+}
@@ -78,6 +78,7 @@ var (
 	// testNoopH    = NoopHandle(8)
 	testMsgpackH = &MsgpackHandle{}
 	testJsonH    = &JsonHandle{}
+	testCborH    = &CborHandle{}
 
 	testHandles     []Handle
 	testPreInitFns  []func()
@@ -135,10 +136,11 @@ func init() {
 	testHEDs = make([]testHED, 0, 32)
 	testHandles = append(testHandles,
 		// testNoopH,
-		testMsgpackH, testJsonH)
+		testMsgpackH, testJsonH, testCborH)
 	// set ExplicitRelease on each handle
 	testMsgpackH.ExplicitRelease = true
 	testJsonH.ExplicitRelease = true
+	testCborH.ExplicitRelease = true
 
 	testInitFlags()
 	benchInitFlags()
@@ -0,0 +1,976 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// major types, as defined in RFC 8949 section 3.1
+const (
+	cborMajorUint byte = iota
+	cborMajorNegInt
+	cborMajorBytes
+	cborMajorString
+	cborMajorArray
+	cborMajorMap
+	cborMajorTag
+	cborMajorSimpleOrFloat
+)
+
+const (
+	cborBdFalse byte = 0xf4 + iota
+	cborBdTrue
+	cborBdNil
+	cborBdUndefined
+	cborBdExt
+	cborBdFloat16
+	cborBdFloat32
+	cborBdFloat64
+)
+
+const (
+	cborBdIndefiniteBytes  byte = 0x5f
+	cborBdIndefiniteString byte = 0x7f
+	cborBdIndefiniteArray  byte = 0x9f
+	cborBdIndefiniteMap    byte = 0xbf
+	cborBdBreak            byte = 0xff
+)
+
+const (
+	cborBaseUint   byte = 0x00
+	cborBaseNegInt byte = 0x20
+	cborBaseBytes  byte = 0x40
+	cborBaseString byte = 0x60
+	cborBaseArray  byte = 0x80
+	cborBaseMap    byte = 0xa0
+	cborBaseTag    byte = 0xc0
+	cborBaseSimple byte = 0xe0
+)
+
+// tags used for time.Time, as defined in RFC 8949 section 3.4
+const (
+	cborTagTimeRFC3339 uint64 = 0
+	cborTagTimeEpoch   uint64 = 1
+)
+
+// cborNaN is the half-precision quiet NaN, which is the deterministic encoding of NaN.
+const cborNaN uint16 = 0x7e00
+
+func cbordesc(bd byte) string {
+	switch bd >> 5 {
+	case cborMajorUint:
+		return "(u)int"
+	case cborMajorNegInt:
+		return "int"
+	case cborMajorBytes:
+		return "bytes"
+	case cborMajorString:
+		return "string"
+	case cborMajorArray:
+		return "array"
+	case cborMajorMap:
+		return "map"
+	case cborMajorTag:
+		return "tag"
+	}
+	switch bd {
+	case cborBdNil:
+		return "nil"
+	case cborBdUndefined:
+		return "undefined"
+	case cborBdFalse, cborBdTrue:
+		return "bool"
+	case cborBdFloat16, cborBdFloat32, cborBdFloat64:
+		return "float"
+	case cborBdBreak:
+		return "break"
+	}
+	return "simple"
+}
+
+// cborFloat16 returns the half-precision bits of f,
+// and whether f can be represented as a half-precision float without loss.
+func cborFloat16(f float32) (h uint16, ok bool) {
+	b := math.Float32bits(f)
+	sign := uint16(b>>16) & 0x8000
+	exp := int(b>>23) & 0xff
+	mant := b & 0x7fffff
+	if exp == 0xff {
+		if mant != 0 {
+			return cborNaN, true
+		}
+		return sign | 0x7c00, true // infinity
+	}
+	if exp == 0 && mant == 0 {
+		return sign, true // +/- zero
+	}
+	switch exp -= 127; {
+	case exp >= -14 && exp <= 15:
+		// normal: the low 13 bits of the mantissa are dropped
+		if mant&0x1fff != 0 {
+			return
+		}
+		return sign | uint16(exp+15)<<10 | uint16(mant>>13), true
+	case exp >= -24 && exp < -14:
+		// subnormal: value is m * 2^-24, where m is in [1, 1023]
+		mant |= 0x800000
+		shift := uint(-exp - 1)
+		if mant&(1<<shift-1) != 0 {
+			return
+		}
+		return sign | uint16(mant>>shift), true
+	}
+	return
+}
+
+// cborHalfFloatToFloat32 converts the half-precision bits h to a float32.
+func cborHalfFloatToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+	switch exp {
+	case 0: // zero or subnormal
+		return math.Float32frombits(math.Float32bits(float32(mant)/(1<<24)) | sign)
+	case 0x1f: // infinity or NaN
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+//---------------------------------------------
+
+type cborEncDriver struct {
+	noBuiltInTypes
+	encDriverNoopContainerWriter
+	e *Encoder
+	w *encWriterSwitch
+	h *CborHandle
+	x [8]byte
+}
+
+// indefinite returns true if containers should be written with indefinite length.
+//
+// Canonical encoding requires definite lengths, so it takes precedence.
+func (e *cborEncDriver) indefinite() bool {
+	return e.h.IndefiniteLength && !e.h.Canonical
+}
+
+func (e *cborEncDriver) EncodeNil() {
+	e.w.writen1(cborBdNil)
+}
+
+func (e *cborEncDriver) EncodeBool(b bool) {
+	if b {
+		e.w.writen1(cborBdTrue)
+	} else {
+		e.w.writen1(cborBdFalse)
+	}
+}
+
+func (e *cborEncDriver) EncodeFloat32(f float32) {
+	if e.h.Canonical {
+		if h, ok := cborFloat16(f); ok {
+			e.w.writen1(cborBdFloat16)
+			bigenHelper{e.x[:2], e.w}.writeUint16(h)
+			return
+		}
+	}
+	e.w.writen1(cborBdFloat32)
+	bigenHelper{e.x[:4], e.w}.writeUint32(math.Float32bits(f))
+}
+
+func (e *cborEncDriver) EncodeFloat64(f float64) {
+	// canonical encoding uses the shortest float which preserves the value.
+	if e.h.Canonical && (math.IsNaN(f) || float64(float32(f)) == f) {
+		e.EncodeFloat32(float32(f))
+		return
+	}
+	e.w.writen1(cborBdFloat64)
+	bigenHelper{e.x[:8], e.w}.writeUint64(math.Float64bits(f))
+}
+
+func (e *cborEncDriver) encUint(v uint64, bd byte) {
+	if v <= 0x17 {
+		e.w.writen1(byte(v) + bd)
+	} else if v <= math.MaxUint8 {
+		e.w.writen2(bd+0x18, uint8(v))
+	} else if v <= math.MaxUint16 {
+		e.w.writen1(bd + 0x19)
+		bigenHelper{e.x[:2], e.w}.writeUint16(uint16(v))
+	} else if v <= math.MaxUint32 {
+		e.w.writen1(bd + 0x1a)
+		bigenHelper{e.x[:4], e.w}.writeUint32(uint32(v))
+	} else {
+		e.w.writen1(bd + 0x1b)
+		bigenHelper{e.x[:8], e.w}.writeUint64(v)
+	}
+}
+
+func (e *cborEncDriver) EncodeInt(v int64) {
+	if v < 0 {
+		e.encUint(uint64(-1-v), cborBaseNegInt)
+	} else {
+		e.encUint(uint64(v), cborBaseUint)
+	}
+}
+
+func (e *cborEncDriver) EncodeUint(v uint64) {
+	e.encUint(v, cborBaseUint)
+}
+
+func (e *cborEncDriver) encLen(bd byte, length int) {
+	e.encUint(uint64(length), bd)
+}
+
+func (e *cborEncDriver) EncodeTime(t time.Time) {
+	if t.IsZero() {
+		e.EncodeNil()
+	} else if e.h.TimeRFC3339 {
+		e.encUint(cborTagTimeRFC3339, cborBaseTag)
+		e.EncodeStringEnc(cUTF8, t.Format(time.RFC3339Nano))
+	} else {
+		e.encUint(cborTagTimeEpoch, cborBaseTag)
+		// a float64 cannot hold nanosecond precision for current times,
+		// so round to the microsecond.
+		t = t.UTC().Round(time.Microsecond)
+		sec, nsec := t.Unix(), uint64(t.Nanosecond())
+		if nsec == 0 {
+			e.EncodeInt(sec)
+		} else {
+			e.EncodeFloat64(float64(sec) + float64(nsec)/1e9)
+		}
+	}
+}
+
+func (e *cborEncDriver) EncodeExt(rv interface{}, xtag uint64, ext Ext, en *Encoder) {
+	e.encUint(xtag, cborBaseTag)
+	if v := ext.ConvertExt(rv); v == nil {
+		e.EncodeNil()
+	} else {
+		en.encode(v)
+	}
+}
+
+func (e *cborEncDriver) EncodeRawExt(re *RawExt, en *Encoder) {
+	e.encUint(re.Tag, cborBaseTag)
+	// only encodes re.Value (never re.Data), as the tag content is a cbor value
+	if re.Value != nil {
+		en.encode(re.Value)
+	} else {
+		e.EncodeNil()
+	}
+}
+
+func (e *cborEncDriver) WriteArrayStart(length int) {
+	if e.indefinite() {
+		e.w.writen1(cborBdIndefiniteArray)
+	} else {
+		e.encLen(cborBaseArray, length)
+	}
+}
+
+func (e *cborEncDriver) WriteMapStart(length int) {
+	if e.indefinite() {
+		e.w.writen1(cborBdIndefiniteMap)
+	} else {
+		e.encLen(cborBaseMap, length)
+	}
+}
+
+func (e *cborEncDriver) WriteArrayEnd() {
+	if e.indefinite() {
+		e.w.writen1(cborBdBreak)
+	}
+}
+
+func (e *cborEncDriver) WriteMapEnd() {
+	if e.indefinite() {
+		e.w.writen1(cborBdBreak)
+	}
+}
+
+func (e *cborEncDriver) EncodeString(c charEncoding, v string) {
+	if c == cRAW {
+		e.encStringBytesS(cborBaseBytes, v)
+	} else {
+		e.encStringBytesS(cborBaseString, v)
+	}
+}
+
+func (e *cborEncDriver) EncodeStringEnc(c charEncoding, v string) {
+	e.encStringBytesS(cborBaseString, v)
+}
+
+func (e *cborEncDriver) EncodeStringBytes(c charEncoding, v []byte) {
+	if v == nil {
+		e.EncodeNil()
+	} else if c == cRAW {
+		e.encStringBytesS(cborBaseBytes, stringView(v))
+	} else {
+		e.encStringBytesS(cborBaseString, stringView(v))
+	}
+}
+
+func (e *cborEncDriver) EncodeStringBytesRaw(v []byte) {
+	if v == nil {
+		e.EncodeNil()
+	} else {
+		e.encStringBytesS(cborBaseBytes, stringView(v))
+	}
+}
+
+func (e *cborEncDriver) encStringBytesS(bb byte, v string) {
+	e.encLen(bb, len(v))
+	if len(v) > 0 {
+		e.w.writestr(v)
+	}
+}
+
+// ----------------------
+
+type cborDecDriver struct {
+	d *Decoder
+	h *CborHandle
+	r *decReaderSwitch
+	// b      [scratchByteArrayLen]byte
+	bd     byte
+	bdRead bool
+	br     bool // bytes reader
+	st     bool // skip tags
+	noBuiltInTypes
+	decDriverNoopContainerReader
+}
+
+func (d *cborDecDriver) readNextBd() {
+	d.bd = d.r.readn1()
+	d.bdRead = true
+}
+
+func (d *cborDecDriver) uncacheRead() {
+	if d.bdRead {
+		d.r.unreadn1()
+		d.bdRead = false
+	}
+}
+
+func (d *cborDecDriver) ContainerType() (vt valueType) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.st {
+		d.skipTags()
+	}
+	if d.bd == cborBdNil || d.bd == cborBdUndefined {
+		return valueTypeNil
+	}
+	switch d.bd >> 5 {
+	case cborMajorBytes:
+		return valueTypeBytes
+	case cborMajorString:
+		return valueTypeString
+	case cborMajorArray:
+		return valueTypeArray
+	case cborMajorMap:
+		return valueTypeMap
+	}
+	return valueTypeUnset
+}
+
+func (d *cborDecDriver) nextValueType() (vt valueType) {
+	if vt = d.ContainerType(); vt != valueTypeUnset {
+		return
+	}
+	switch d.bd >> 5 {
+	case cborMajorUint:
+		if d.h.SignedInteger {
+			return valueTypeInt
+		}
+		return valueTypeUint
+	case cborMajorNegInt:
+		return valueTypeInt
+	case cborMajorTag:
+		// only the first byte is examined, so the time tags are recognised
+		// only when in their (usual) single-byte encoding.
+		if d.bd == cborBaseTag|byte(cborTagTimeRFC3339) || d.bd == cborBaseTag|byte(cborTagTimeEpoch) {
+			return valueTypeTime
+		}
+		return valueTypeExt
+	}
+	switch d.bd {
+	case cborBdFalse, cborBdTrue:
+		return valueTypeBool
+	case cborBdFloat16, cborBdFloat32, cborBdFloat64:
+		return valueTypeFloat
+	}
+	return valueTypeUnset
+}
+
+func (d *cborDecDriver) TryDecodeAsNil() bool {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	// treat Nil and Undefined as nil values
+	if d.bd == cborBdNil || d.bd == cborBdUndefined {
+		d.bdRead = false
+		return true
+	}
+	return false
+}
+
+func (d *cborDecDriver) CheckBreak() (v bool) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.bd == cborBdBreak {
+		d.bdRead = false
+		v = true
+	}
+	return
+}
+
+// skipTags skips over any tags preceding the current value.
+func (d *cborDecDriver) skipTags() {
+	for d.bd>>5 == cborMajorTag {
+		d.decUint()
+		d.bd = d.r.readn1()
+	}
+}
+
+func (d *cborDecDriver) decUint() (ui uint64) {
+	v := d.bd & 0x1f
+	if v <= 0x17 {
+		ui = uint64(v)
+	} else if v == 0x18 {
+		ui = uint64(d.r.readn1())
+	} else if v == 0x19 {
+		ui = uint64(bigen.Uint16(d.r.readx(2)))
+	} else if v == 0x1a {
+		ui = uint64(bigen.Uint32(d.r.readx(4)))
+	} else if v == 0x1b {
+		ui = bigen.Uint64(d.r.readx(8))
+	} else {
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "invalid descriptor decoding uint: %x/%s", d.bd, cbordesc(d.bd))
+	}
+	return
+}
+
+func (d *cborDecDriver) decCheckInteger() (neg bool) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.st {
+		d.skipTags()
+	}
+	major := d.bd >> 5
+	if major == cborMajorUint {
+	} else if major == cborMajorNegInt {
+		neg = true
+	} else {
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "invalid integer: %s: %x/%s", msgBadDesc, d.bd, cbordesc(d.bd))
+	}
+	return
+}
+
+func (d *cborDecDriver) DecodeInt64() (i int64) {
+	neg := d.decCheckInteger()
+	ui := d.decUint()
+	// a negative integer is encoded as -1 - ui
+	if neg {
+		i = -1 - chkOvf.SignedIntV(ui)
+	} else {
+		i = chkOvf.SignedIntV(ui)
+	}
+	d.bdRead = false
+	return
+}
+
+func (d *cborDecDriver) DecodeUint64() (ui uint64) {
+	if d.decCheckInteger() {
+		d.d.errorIs(ErrOverflow, valueTypeInt, "assigning negative signed value to unsigned type")
+		return
+	}
+	ui = d.decUint()
+	d.bdRead = false
+	return
+}
+
+func (d *cborDecDriver) DecodeFloat64() (f float64) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.st {
+		d.skipTags()
+	}
+	switch d.bd {
+	case cborBdFloat16:
+		f = float64(cborHalfFloatToFloat32(bigen.Uint16(d.r.readx(2))))
+	case cborBdFloat32:
+		f = float64(math.Float32frombits(bigen.Uint32(d.r.readx(4))))
+	case cborBdFloat64:
+		f = math.Float64frombits(bigen.Uint64(d.r.readx(8)))
+	default:
+		if major := d.bd >> 5; major == cborMajorUint || major == cborMajorNegInt {
+			f = float64(d.DecodeInt64())
+		} else {
+			d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot decode float: %s: %x/%s", msgBadDesc, d.bd, cbordesc(d.bd))
+			return
+		}
+	}
+	d.bdRead = false
+	return
+}
+
+// bool can be decoded from bool only (not from 0 or 1).
+func (d *cborDecDriver) DecodeBool() (b bool) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.st {
+		d.skipTags()
+	}
+	if d.bd == cborBdTrue {
+		b = true
+	} else if d.bd == cborBdFalse {
+	} else {
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot decode bool: %s: %x/%s", msgBadDesc, d.bd, cbordesc(d.bd))
+		return
+	}
+	d.bdRead = false
+	return
+}
+
+func (d *cborDecDriver) ReadMapStart() (length int) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.st {
+		d.skipTags()
+	}
+	if d.bd == cborBdIndefiniteMap {
+		d.bdRead = false
+		return -1
+	}
+	if d.bd>>5 != cborMajorMap {
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot read map length: %s: %x/%s", msgBadDesc, d.bd, cbordesc(d.bd))
+		return
+	}
+	d.bdRead = false
+	length = d.decLen()
+	if d.d.limits {
+		d.d.limitContainerLen(length, true)
+	}
+	return
+}
+
+func (d *cborDecDriver) ReadArrayStart() (length int) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.st {
+		d.skipTags()
+	}
+	if d.bd == cborBdIndefiniteArray {
+		d.bdRead = false
+		return -1
+	}
+	if d.bd>>5 != cborMajorArray {
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot read array length: %s: %x/%s", msgBadDesc, d.bd, cbordesc(d.bd))
+		return
+	}
+	d.bdRead = false
+	length = d.decLen()
+	if d.d.limits {
+		d.d.limitContainerLen(length, false)
+	}
+	return
+}
+
+func (d *cborDecDriver) decLen() int {
+	return int(chkOvf.SignedIntV(d.decUint()))
+}
+
+// decAppendIndefiniteBytes appends the definite-length chunks of an
+// indefinite-length byte or text string to bs, until the break is seen.
+func (d *cborDecDriver) decAppendIndefiniteBytes(bs []byte) []byte {
+	major := d.bd >> 5
+	d.bdRead = false
+	for !d.CheckBreak() {
+		if d.bd>>5 != major {
+			d.d.errorf("invalid indefinite string/bytes chunk: %s: %x/%s", msgBadDesc, d.bd, cbordesc(d.bd))
+			return nil
+		}
+		n := uint(d.decLen())
+		if d.d.limits {
+			d.d.limit("MaxBytesLen", d.h.MaxBytesLen, len(bs)+int(n))
+			d.d.limitAlloc(int(n))
+		}
+		// grow bs in steps (as decByteSlice does), so a chunk length far larger
+		// than the input does not allocate all of it up front.
+		for n > 0 {
+			step := uint(decInferLen(int(n), d.h.MaxInitLen, 1))
+			if d.d.bytes {
+				if rem := uint(len(d.d.rb.b)) - d.d.rb.c; step > rem {
+					step = max(rem, 1) // so reading past the end fails
+				}
+			}
+			oldLen := uint(len(bs))
+			newLen := oldLen + step
+			if newLen > uint(cap(bs)) {
+				bs2 := make([]byte, newLen, 2*uint(cap(bs))+step)
+				copy(bs2, bs)
+				bs = bs2
+			} else {
+				bs = bs[:newLen]
+			}
+			d.r.readb(bs[oldLen:newLen])
+			n -= step
+		}
+		d.bdRead = false
+	}
+	return bs
+}
+
+func (d *cborDecDriver) DecodeBytes(bs []byte, zerocopy bool) (bsOut []byte) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.st {
+		d.skipTags()
+	}
+	if d.bd == cborBdNil || d.bd == cborBdUndefined {
+		d.bdRead = false
+		return nil
+	}
+	if d.bd == cborBdIndefiniteBytes || d.bd == cborBdIndefiniteString {
+		if bs == nil {
+			if zerocopy {
+				return d.decAppendIndefiniteBytes(d.d.b[:0])
+			}
+			return d.decAppendIndefiniteBytes(zeroByteSlice)
+		}
+		return d.decAppendIndefiniteBytes(bs[:0])
+	}
+	// check if an "array" of uint8's
+	if d.bd == cborBdIndefiniteArray || d.bd>>5 == cborMajorArray {
+		if zerocopy && len(bs) == 0 {
+			bs = d.d.b[:]
+		}
+		bsOut, _ = fastpathTV.DecSliceUint8V(bs, true, d.d)
+		return
+	}
+	if major := d.bd >> 5; major != cborMajorBytes && major != cborMajorString {
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "invalid byte descriptor for decoding bytes, got: %x/%s", d.bd, cbordesc(d.bd))
+		return
+	}
+	clen := d.decLen()
+	d.bdRead = false
+	if d.d.limits {
+		d.d.limitBytesLen(clen)
+	}
+	if zerocopy {
+		if d.br {
+			return d.r.readx(uint(clen))
+		} else if len(bs) == 0 {
+			bs = d.d.b[:]
+		}
+	}
+	return decByteSlice(d.r, clen, d.h.MaxInitLen, bs)
+}
+
+func (d *cborDecDriver) DecodeString() (s string) {
+	return string(d.DecodeBytes(d.d.b[:], true))
+}
+
+func (d *cborDecDriver) DecodeStringAsBytes() (s []byte) {
+	return d.DecodeBytes(d.d.b[:], true)
+}
+
+func (d *cborDecDriver) DecodeTime() (t time.Time) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.bd == cborBdNil || d.bd == cborBdUndefined {
+		d.bdRead = false
+		return
+	}
+	if d.bd>>5 != cborMajorTag {
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot decode time: expected tag: %s: %x/%s", msgBadDesc, d.bd, cbordesc(d.bd))
+		return
+	}
+	xtag := d.decUint()
+	d.bdRead = false
+	return d.decodeTime(xtag)
+}
+
+func (d *cborDecDriver) decodeTime(xtag uint64) (t time.Time) {
+	switch xtag {
+	case cborTagTimeRFC3339:
+		var err error
+		if t, err = time.Parse(time.RFC3339, stringView(d.DecodeStringAsBytes())); err != nil {
+			d.d.errorv(err)
+		}
+	case cborTagTimeEpoch:
+		if !d.bdRead {
+			d.readNextBd()
+		}
+		if major := d.bd >> 5; major == cborMajorUint || major == cborMajorNegInt {
+			t = time.Unix(d.DecodeInt64(), 0)
+		} else {
+			f1, f2 := math.Modf(d.DecodeFloat64())
+			t = time.Unix(int64(f1), int64(f2*1e9)).Round(time.Microsecond)
+		}
+	default:
+		d.d.errorf("invalid tag for time.Time - expecting 0 or 1, got %d", xtag)
+	}
+	return t.UTC()
+}
+
+func (d *cborDecDriver) DecodeExt(rv interface{}, xtag uint64, ext Ext) (realxtag uint64) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	if d.bd>>5 != cborMajorTag {
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot decode ext: expected tag: %s: %x/%s", msgBadDesc, d.bd, cbordesc(d.bd))
+		return
+	}
+	realxtag = d.decUint()
+	d.bdRead = false
+	if ext == nil {
+		re := rv.(*RawExt)
+		re.Tag = realxtag
+		d.d.decode(&re.Value)
+	} else if xtag != realxtag {
+		d.d.errorf("wrong extension tag - got %d, expecting %d", realxtag, xtag)
+		return
+	} else {
+		var v interface{}
+		d.d.decode(&v)
+		ext.UpdateExt(rv, v)
+	}
+	return
+}
+
+// Note: This returns either a primitive (int, bool, etc) for non-containers,
+// or a containerType, or a specific type denoting nil or extension.
+// It is called when a nil interface{} is passed, leaving it up to the DecDriver
+// to introspect the stream and decide how best to decode.
+// It deciphers the value by looking at the stream first.
+func (d *cborDecDriver) DecodeNaked() {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	n := d.d.naked()
+	var decodeFurther bool
+
+	switch d.bd >> 5 {
+	case cborMajorUint:
+		if d.h.SignedInteger {
+			n.v = valueTypeInt
+			n.i = d.DecodeInt64()
+		} else {
+			n.v = valueTypeUint
+			n.u = d.DecodeUint64()
+		}
+	case cborMajorNegInt:
+		n.v = valueTypeInt
+		n.i = d.DecodeInt64()
+	case cborMajorBytes:
+		decNakedReadRawBytes(d, d.d, n, d.h.RawToString)
+	case cborMajorString:
+		n.v = valueTypeString
+		n.s = d.DecodeString()
+	case cborMajorArray:
+		n.v = valueTypeArray
+		decodeFurther = true
+	case cborMajorMap:
+		n.v = valueTypeMap
+		decodeFurther = true
+	case cborMajorTag:
+		n.v = valueTypeExt
+		n.u = d.decUint()
+		n.l = nil
+		if n.u == cborTagTimeRFC3339 || n.u == cborTagTimeEpoch {
+			d.bdRead = false
+			n.v = valueTypeTime
+			n.t = d.decodeTime(n.u)
+		} else if d.st && d.h.getExtForTag(n.u) == nil {
+			// skip the tag, and decode the tagged value in its place
+			d.bdRead = false
+			d.DecodeNaked()
+			return
+		}
+	case cborMajorSimpleOrFloat:
+		switch d.bd {
+		case cborBdNil, cborBdUndefined:
+			n.v = valueTypeNil
+		case cborBdFalse:
+			n.v = valueTypeBool
+			n.b = false
+		case cborBdTrue:
+			n.v = valueTypeBool
+			n.b = true
+		case cborBdFloat16, cborBdFloat32, cborBdFloat64:
+			n.v = valueTypeFloat
+			n.f = d.DecodeFloat64()
+		default:
+			d.d.errorf("cannot infer value: %s: %x/%s", msgBadDesc, d.bd, cbordesc(d.bd))
+		}
+	}
+	if !decodeFurther {
+		d.bdRead = false
+	}
+}
+
+// -------------------------
+
+// CborHandle is a Handle for the CBOR encoding format,
+// defined at https://tools.ietf.org/html/rfc8949 and documented further at http://cbor.io .
+//
+// CBOR is comprehensively supported, including support for:
+//   - indefinite-length arrays/maps/bytes/strings
+//   - (extension) tags, mapped to types registered via SetExt or SetInterfaceExt
+//   - half, single and double-precision floats
+//   - all numbers (1, 2, 4 and 8-byte signed and unsigned integers)
+//   - nil, undefined, true, false
+//   - time.Time, via tag 0 (RFC 3339 string) or tag 1 (seconds since epoch)
+//
+// With Canonical=true, we follow the core deterministic encoding requirements
+// of RFC 8949 section 4.2.1: containers always have definite lengths,
+// floats use the shortest form which preserves their value,
+// and the keys of maps and structs are sorted by the bytewise lexicographic order
+// of their encoding (so a shorter string key comes first e.g. "b" before "aa").
+type CborHandle struct {
+	BasicHandle
+
+	// IndefiniteLength=true, means that we encode arrays and maps using indefinite length.
+	//
+	// It is ignored when Canonical=true.
+	IndefiniteLength bool
+
+	// TimeRFC3339 says to encode time.Time using the RFC3339 format (tag 0).
+	// If unset, we encode time.Time as seconds past epoch (tag 1),
+	// rounded to the microsecond.
+	TimeRFC3339 bool
+
+	// SkipUnexpectedTags says to skip over any tags for which extensions are
+	// not defined. This is in keeping with the cbor spec on "Optional Tagging of Items".
+	//
+	// Furthermore, this allows the skipping over of the Self Described CBOR tag 55799.
+	SkipUnexpectedTags bool
+
+	binaryEncodingType
+	noElemSeparators
+}
+
+// Name returns the name of the handle: cbor
+func (h *CborHandle) Name() string { return "cbor" }
+
+func (h *CborHandle) canonicalByEncoding() bool { return true }
+
+// SetInterfaceExt sets an extension
+func (h *CborHandle) SetInterfaceExt(rt reflect.Type, tag uint64, ext InterfaceExt) (err error) {
+	return h.SetExt(rt, tag, &extWrapper{bytesExtFailer{}, ext})
+}
+
+func (h *CborHandle) newEncDriver(e *Encoder) encDriver {
+	return &cborEncDriver{e: e, w: e.w, h: h}
+}
+
+func (h *CborHandle) newDecDriver(d *Decoder) decDriver {
+	return &cborDecDriver{d: d, h: h, r: d.r, br: d.bytes, st: h.SkipUnexpectedTags}
+}
+
+func (e *cborEncDriver) reset() {
+	e.w = e.e.w
+}
+
+func (d *cborDecDriver) reset() {
+	d.r, d.br = d.d.r, d.d.bytes
+	d.bd, d.bdRead = 0, false
+	d.st = d.h.SkipUnexpectedTags
+}
+
+var _ decDriver = (*cborDecDriver)(nil)
+var _ encDriver = (*cborEncDriver)(nil)
+
+// cborSortSFI returns the fields of a struct sorted by the canonical encoding of their keys
+// (per RFC 8949 section 4.2.1), given them sorted by name. If that is the same order,
+// it returns sfis.
+func cborSortSFI(sfis []*structFieldInfo, keyType valueType) []*structFieldInfo {
+	keys := make([][]byte, len(sfis))
+	sorted := true
+	for i, si := range sfis {
+		keys[i] = cborAppendKey(nil, keyType, si.encName)
+		if i > 0 && bytes.Compare(keys[i-1], keys[i]) > 0 {
+			sorted = false
+		}
+	}
+	if sorted {
+		return sfis
+	}
+	idx := make([]int, len(sfis))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return bytes.Compare(keys[idx[i]], keys[idx[j]]) < 0 })
+	z := make([]*structFieldInfo, len(sfis))
+	for i, k := range idx {
+		z[i] = sfis[k]
+	}
+	return z
+}
+
+// cborAppendKey appends the canonical encoding of a struct field key with the name encName,
+// as encStructFieldKey writes it.
+func cborAppendKey(b []byte, keyType valueType, encName string) []byte {
+	switch keyType {
+	case valueTypeInt:
+		v, _ := strconv.ParseInt(encName, 10, 64)
+		if v < 0 {
+			return cborAppendUint(b, uint64(-1-v), cborBaseNegInt)
+		}
+		return cborAppendUint(b, uint64(v), cborBaseUint)
+	case valueTypeUint:
+		v, _ := strconv.ParseUint(encName, 10, 64)
+		return cborAppendUint(b, v, cborBaseUint)
+	case valueTypeFloat:
+		f, _ := strconv.ParseFloat(encName, 64)
+		if math.IsNaN(f) || float64(float32(f)) == f {
+			if h, ok := cborFloat16(float32(f)); ok {
+				return append(b, cborBdFloat16, byte(h>>8), byte(h))
+			}
+			v := math.Float32bits(float32(f))
+			return append(b, cborBdFloat32, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+		}
+		b = append(b, cborBdFloat64)
+		v := math.Float64bits(f)
+		for i := 56; i >= 0; i -= 8 {
+			b = append(b, byte(v>>uint(i)))
+		}
+		return b
+	}
+	return append(cborAppendUint(b, uint64(len(encName)), cborBaseString), encName...)
+}
+
+// cborAppendUint appends the head of a data item with the major type bd and argument v.
+func cborAppendUint(b []byte, v uint64, bd byte) []byte {
+	switch {
+	case v <= 0x17:
+		return append(b, byte(v)+bd)
+	case v <= math.MaxUint8:
+		return append(b, bd+0x18, byte(v))
+	case v <= math.MaxUint16:
+		return append(b, bd+0x19, byte(v>>8), byte(v))
+	case v <= math.MaxUint32:
+		return append(b, bd+0x1a, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
+	return append(b, bd+0x1b, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32),
+		byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
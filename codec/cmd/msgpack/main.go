@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+// msgpack inspects and converts msgpack files e.g. Raft snapshots or state store dumps.
+//
+// The command line format is:
+//
+//	msgpack command [options] [path] [file]
+//
+// where the input is read from file, or from stdin if file is omitted or "-".
+// The input may contain a stream of values, which are processed in turn.
+//
+// The commands are:
+//
+//   - dump: write an annotated tree of the values, showing offsets, formats and types
+//   - tojson: convert the values to json, one per line
+//   - fromjson: convert a stream of json values to msgpack
+//   - validate: check that the values are well-formed per the spec, reporting the offset of a violation
+//   - get path: write the value at path (e.g. Spec.Tasks[3].Name) within each value, as json,
+//     failing if no value has one
+//
+// By default, values which json cannot represent (binary, extensions, timestamps,
+// and maps with non-string keys) are converted lossily. With -tagged, they are
+// written as tagged single-entry maps (e.g. {"$bin": "AQID"}), which fromjson -tagged reads back,
+// so that values round-trip.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+
+	"github.com/hashicorp/go-msgpack/v2/codec"
+)
+
+const usage = `usage: msgpack command [options] [path] [file]
+
+Commands:
+  dump       write an annotated tree of the values
+  tojson     convert the values to json, one per line
+  fromjson   convert json values to msgpack
+  validate   check that the values are well-formed
+  get path   write the value at path (e.g. Spec.Tasks[3].Name), as json
+
+Run 'msgpack command -h' for the options of a command.
+`
+
+func main() {
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	err := run(flag.Arg(0), flag.Args()[1:], os.Stdin, os.Stdout, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(0)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "msgpack %s: %v\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
+
+// run executes the named command with the given arguments.
+// Usage and errors parsing the options are written to stderr.
+func run(cmd string, args []string, stdin io.Reader, stdout, stderr io.Writer) (err error) {
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	tagged := fs.Bool("tagged", false, "write values which json cannot represent as tagged maps (tojson, fromjson, get)")
+	indent := fs.Int("indent", 0, "indent json output by this many spaces, or tabs if negative, from -128 to 127 (tojson, get)")
+	raw := fs.Bool("raw", false, "write the value found as msgpack, not json (get)")
+	utf8 := fs.Bool("utf8", false, "check that strings are valid UTF-8 (validate)")
+
+	switch cmd {
+	case "dump", "tojson", "fromjson", "validate", "get":
+	default:
+		fmt.Fprint(stderr, usage)
+		return errors.New("unknown command")
+	}
+	if err = fs.Parse(args); err != nil {
+		return err
+	}
+	if *indent < math.MinInt8 || *indent > math.MaxInt8 {
+		return fmt.Errorf("-indent %d out of range [%d, %d]", *indent, math.MinInt8, math.MaxInt8)
+	}
+	args = fs.Args()
+	var path string
+	if cmd == "get" {
+		if len(args) == 0 || args[0] == "" {
+			return errors.New("missing path")
+		}
+		path, args = args[0], args[1:]
+	}
+	if len(args) > 1 {
+		return errors.New("too many arguments")
+	}
+
+	var name string
+	if len(args) == 1 {
+		name = args[0]
+	}
+	b, err := readInput(name, stdin)
+	if err != nil {
+		return err
+	}
+
+	var mh codec.MsgpackHandle
+	mh.WriteExt = true
+	var jh codec.JsonHandle
+	jh.Indent = int8(*indent)
+	jh.HTMLCharsAsIs = true
+	jh.MapKeyAsString = true
+	var tc codec.Transcoder
+	if *tagged {
+		tc = codec.Transcoder{
+			Bytes:   codec.TranscodeTagged,
+			Ext:     codec.TranscodeTagged,
+			Time:    codec.TranscodeTagged,
+			MapKeys: codec.TranscodeTagged,
+		}
+	}
+
+	w := bufio.NewWriter(stdout)
+	defer func() {
+		if ferr := w.Flush(); err == nil {
+			err = ferr
+		}
+	}()
+
+	switch cmd {
+	case "dump":
+		return codec.MsgpackDump(w, b)
+	case "validate":
+		return codec.MsgpackValidateBytes(b, &codec.MsgpackValidateOptions{ValidateUTF8: *utf8, Multiple: true})
+	case "tojson":
+		d := codec.NewDecoderBytes(b, &mh)
+		for d.NumBytesRead() < len(b) {
+			if err = transcodeJSON(w, &tc, d, &jh); err != nil {
+				return err
+			}
+		}
+	case "fromjson":
+		d := codec.NewDecoderBytes(b, &jh)
+		e := codec.NewEncoder(w, &mh)
+		for len(bytes.TrimSpace(b[d.NumBytesRead():])) != 0 {
+			if err = tc.Transcode(e, d); err != nil {
+				return err
+			}
+		}
+	case "get":
+		d := codec.NewDecoderBytes(nil, &mh)
+		var found bool
+		for n := 0; n < len(b); {
+			d.ResetBytes(b[n:])
+			// Lookup leaves the stream at the value found, so find the
+			// end of the current value before looking it up.
+			var v codec.Raw
+			if err = d.Decode(&v); err != nil {
+				return err
+			}
+			n += len(v)
+			d.ResetBytes(v)
+			bs, err := d.Lookup(path)
+			if err != nil {
+				return err
+			}
+			if bs == nil {
+				continue
+			}
+			found = true
+			if *raw {
+				_, err = w.Write(bs)
+			} else {
+				err = transcodeJSON(w, &tc, codec.NewDecoderBytes(bs, &mh), &jh)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: not found", path)
+		}
+	}
+	return nil
+}
+
+// readInput returns the contents of the named file, or of stdin if name is "" or "-".
+func readInput(name string, stdin io.Reader) ([]byte, error) {
+	if name == "" || name == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(name)
+}
+
+// transcodeJSON writes the next value from d to w as json, followed by a newline.
+func transcodeJSON(w io.Writer, tc *codec.Transcoder, d *codec.Decoder, jh *codec.JsonHandle) error {
+	if err := tc.Transcode(codec.NewEncoder(w, jh), d); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
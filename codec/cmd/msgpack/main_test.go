@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-msgpack/v2/codec"
+)
+
+// testMsgpack returns the values encoded one after the other, as msgpack.
+func testMsgpack(t *testing.T, vs ...interface{}) []byte {
+	var h codec.MsgpackHandle
+	h.WriteExt = true
+	h.Canonical = true
+	var b []byte
+	e := codec.NewEncoderBytes(&b, &h)
+	for _, v := range vs {
+		if err := e.Encode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return b
+}
+
+func TestRun(t *testing.T) {
+	in := testMsgpack(t,
+		map[string]interface{}{"a": 1, "b": []byte{1, 2, 3}},
+		map[string]interface{}{"a": []interface{}{"x", time.Unix(1, 0).UTC()}},
+	)
+	for _, v := range []struct {
+		name string
+		args []string
+		in   []byte
+		out  string
+		err  string // a substring of the error, if any
+	}{
+		{name: "tojson", args: []string{"tojson"}, in: in,
+			out: `{"a":1,"b":"AQID"}` + "\n" + `{"a":["x","1970-01-01T00:00:01Z"]}` + "\n"},
+		{name: "tojson-tagged", args: []string{"tojson", "-tagged"}, in: in,
+			out: `{"a":1,"b":{"$bin":"AQID"}}` + "\n" + `{"a":["x",{"$time":"1970-01-01T00:00:01Z"}]}` + "\n"},
+		{name: "tojson-indent", args: []string{"tojson", "-indent", "1"}, in: testMsgpack(t, []int{1}),
+			out: "[\n 1\n]\n"},
+		{name: "tojson-indent-range", args: []string{"tojson", "-indent", "128"}, in: in, err: "-indent 128 out of range [-128, 127]"},
+		{name: "fromjson", args: []string{"fromjson"}, in: []byte(`{"a":1} ["x"]`),
+			out: string(testMsgpack(t, map[string]int{"a": 1}, []string{"x"}))},
+		{name: "fromjson-tagged", args: []string{"fromjson", "-tagged"}, in: []byte(`{"$bin":"AQID"}`),
+			out: "\xc4\x03\x01\x02\x03"},
+		{name: "validate", args: []string{"validate"}, in: in},
+		{name: "validate-invalid", args: []string{"validate"}, in: []byte{0x92, 0x01, 0xc1}, err: "offset 2"},
+		{name: "validate-utf8", args: []string{"validate", "-utf8"}, in: []byte{0xa1, 0xff}, err: "UTF-8"},
+		{name: "get", args: []string{"get", "a"}, in: in,
+			out: "1\n" + `["x","1970-01-01T00:00:01Z"]` + "\n"},
+		{name: "get-nested", args: []string{"get", "a[1]", "-"}, in: in, out: `"1970-01-01T00:00:01Z"` + "\n"},
+		{name: "get-missing", args: []string{"get", "c"}, in: in, err: "c: not found"},
+		{name: "get-raw", args: []string{"get", "-raw", "a"}, in: in, out: "\x01\x92\xa1x\xd6\xff\x00\x00\x00\x01"},
+		{name: "get-no-path", args: []string{"get"}, in: in, err: "missing path"},
+		{name: "dump", args: []string{"dump"}, in: testMsgpack(t, []byte{1, 2, 3}),
+			out: "     0  c4 03                        bin8       bytes        len=3 010203\n"},
+		{name: "unknown", args: []string{"nope"}, err: "unknown command"},
+		{name: "bad-flag", args: []string{"tojson", "-nope"}, err: "-nope"},
+		{name: "too-many", args: []string{"tojson", "a", "b"}, err: "too many arguments"},
+	} {
+		t.Run(v.name, func(t *testing.T) {
+			var out, stderr bytes.Buffer
+			err := run(v.args[0], v.args[1:], bytes.NewReader(v.in), &out, &stderr)
+			if v.err == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if v.err != "" && (err == nil || !strings.Contains(err.Error(), v.err)) {
+				t.Fatalf("expected error containing %q, got: %v", v.err, err)
+			}
+			if out.String() != v.out {
+				t.Fatalf("expected output %q, got %q", v.out, out.String())
+			}
+		})
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	var out, stderr bytes.Buffer
+	if err := run("nope", nil, nil, &out, &stderr); err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.HasPrefix(stderr.String(), "usage: msgpack") || out.Len() != 0 {
+		t.Fatalf("expected usage on stderr only, got: %q, %q", out.String(), stderr.String())
+	}
+}
+
+func TestRunFile(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "in.msgpack")
+	if err := os.WriteFile(name, testMsgpack(t, "x"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	var out, stderr bytes.Buffer
+	if err := run("tojson", []string{name}, nil, &out, &stderr); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != `"x"`+"\n" {
+		t.Fatalf("unexpected output: %q", out.String())
+	}
+}
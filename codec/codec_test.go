@@ -7,6 +7,7 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/gob"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -19,10 +20,13 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/hashicorp/go-msgpack/v2/codec/internal"
@@ -206,6 +210,73 @@ func (r *TestRpcInt) Echo123(args []string, res *string) error {
 	return nil
 }
 
+type TestRpcNotify struct {
+	logged chan string
+}
+
+func (r *TestRpcNotify) Log(s string, _ *struct{}) error  { r.logged <- s; return nil }
+func (r *TestRpcNotify) Echo(s string, res *string) error { *res = s; return nil }
+
+type TestRpcMultiArgs struct {
+	Name  string
+	Count int
+}
+
+type TestRpcMulti struct{}
+
+func (TestRpcMulti) Repeat(a TestRpcMultiArgs, res *string) error {
+	*res = strings.Repeat(a.Name, a.Count)
+	return nil
+}
+func (TestRpcMulti) Echo(s string, res *string) error { *res = s; return nil }
+
+type TestRpcAppError struct {
+	Code  int
+	Retry bool
+	Cause *TestRpcAppError
+}
+
+func (e *TestRpcAppError) Error() string { return fmt.Sprintf("app error %d", e.Code) }
+
+type TestRpcErrReply struct {
+	Value string
+	Err   interface{} `codec:"-"`
+}
+
+func (r *TestRpcErrReply) MsgpackSpecRpcError() interface{} { return r.Err }
+func (r *TestRpcErrReply) JsonRpc2Error() *JsonRpc2Error {
+	e, _ := r.Err.(*JsonRpc2Error)
+	return e
+}
+
+type TestRpcErrs struct{}
+
+func (TestRpcErrs) Get(arg string, reply *TestRpcErrReply) error {
+	// errors with the same message, but different values
+	if n, ok := strings.CutPrefix(arg, "typed "); ok {
+		code, _ := strconv.Atoi(n)
+		reply.Err = &TestRpcAppError{Code: 503, Cause: &TestRpcAppError{Code: code}}
+		return nil
+	}
+	if n, ok := strings.CutPrefix(arg, "coded "); ok {
+		reply.Err = &JsonRpc2Error{Code: 42, Message: "coded error", Data: n}
+		return nil
+	}
+	switch arg {
+	case "typed":
+		reply.Err = &TestRpcAppError{Code: 503, Retry: true, Cause: &TestRpcAppError{Code: 1}}
+	case "map":
+		reply.Err = map[string]interface{}{"code": 7}
+	case "coded":
+		reply.Err = &JsonRpc2Error{Code: 42, Message: "coded error", Data: "d"}
+	case "string":
+		return errors.New("plain error")
+	default:
+		reply.Value = arg
+	}
+	return nil
+}
+
 type TestRawValue struct {
 	R Raw
 	I int
@@ -563,10 +634,12 @@ func testInit() {
 
 	chkErr(testMsgpackH.SetBytesExt(wrapBytesTyp, 32, &tBytesExt))
 	chkErr(testJsonH.SetInterfaceExt(wrapBytesTyp, 32, &tBytesExt))
+	chkErr(testCborH.SetInterfaceExt(wrapBytesTyp, 32, &tBytesExt))
 
 	// chkErr(testSimpleH.SetBytesExt(wrapInt64Typ, 16, &tI64Ext))
 	chkErr(testMsgpackH.SetBytesExt(wrapInt64Typ, 16, &tI64Ext))
 	chkErr(testJsonH.SetInterfaceExt(wrapInt64Typ, 16, &tI64Ext))
+	chkErr(testCborH.SetInterfaceExt(wrapInt64Typ, 16, &tI64Ext))
 
 	// primitives MUST be an even number, so it can be used as a mapBySlice also.
 	primitives := []interface{}{
@@ -957,6 +1030,13 @@ func testCodecTableOne(t *testing.T, h Handle) {
 		v.WriteExt = true
 		doTestCodecTableOne(t, false, h, table, tableVerify)
 		v.WriteExt = oldWriteExt
+	case *CborHandle:
+		// time.Time is encoded as seconds since epoch (rounded to the microsecond) by default,
+		// so use RFC3339 strings to compare the nanoseconds in the table.
+		oldTimeRFC3339 := v.TimeRFC3339
+		v.TimeRFC3339 = true
+		doTestCodecTableOne(t, false, h, table, tableVerify)
+		v.TimeRFC3339 = oldTimeRFC3339
 	case *JsonHandle:
 		//skip []interface{} containing time.Time, as it encodes as a number, but cannot decode back to time.Time.
 		//As there is no real support for extension tags in json, this must be skipped.
@@ -1549,8 +1629,8 @@ func doTestErrWriter(t *testing.T, name string, h Handle) {
 	enc := NewEncoder(w, h)
 	for i := 0; i < 4; i++ {
 		err := enc.Encode("ugorji")
-		if ev, ok := err.(encodeError); ok {
-			err = ev.Cause()
+		if ev, ok := err.(*EncodeError); ok {
+			err = ev.Err
 		}
 		if err != testErrWriterErr {
 			logT(t, "%s: expecting err: %v, received: %v", name, testErrWriterErr, err)
@@ -1801,6 +1881,301 @@ func doTestMsgpackRpcSpecGoClientToPythonSvc(t *testing.T) {
 	}
 }
 
+func doTestMsgpackRpcSpecNotifications(t *testing.T) {
+	if testSkipRPCTests {
+		return
+	}
+	testOnce.Do(testInitAll)
+	h := testMsgpackH
+	rcvr := &TestRpcNotify{logged: make(chan string, 4)}
+	srv := rpc.NewServer()
+	checkErrT(t, srv.RegisterName("N", rcvr))
+
+	// a server dispatches a notification, but writes no response for it
+	var in, out bytes.Buffer
+	e := NewEncoder(&in, h)
+	e.MustEncode([]interface{}{2, "N.Log", []string{"a"}})
+	e.MustEncode([]interface{}{2, "N.Unknown", []string{"b"}})
+	e.MustEncode([]interface{}{0, 1, "N.Echo", []string{"c"}})
+	srv.ServeCodec(MsgpackSpecRpc.ServerCodec(struct {
+		io.Reader
+		io.Writer
+		io.Closer
+	}{&in, &out, io.NopCloser(nil)}, h))
+	checkEqualT(t, <-rcvr.logged, "a", "logged")
+	var resp []interface{}
+	d := NewDecoder(&out, h)
+	checkErrT(t, d.Decode(&resp))
+	checkEqualT(t, fmt.Sprintf("%v", resp), "[1 1 <nil> c]", "response")
+	if err := d.Decode(&resp); err != io.EOF {
+		t.Fatalf("expected only one response, got: %v, %v", resp, err)
+	}
+
+	// both client and server can notify the other
+	c1, c2 := net.Pipe()
+	sc := MsgpackSpecRpc.ServerCodec(c1, h)
+	go srv.ServeCodec(sc)
+	notified := make(chan string, 1)
+	cc := MsgpackSpecRpcOptions{OnNotify: func(method string, params Raw) {
+		var args []string
+		checkErrT(t, NewDecoderBytes(params, h).Decode(&args))
+		notified <- method + ":" + strings.Join(args, ",")
+	}}.ClientCodec(c2, h)
+	cl := rpc.NewClientWithCodec(cc)
+	defer cl.Close()
+	checkErrT(t, cc.(MsgpackSpecRpcNotifier).Notify("N.Log", "x"))
+	checkEqualT(t, <-rcvr.logged, "x", "logged")
+	checkErrT(t, sc.(MsgpackSpecRpcNotifier).Notify("event", MsgpackSpecRpcMultiArgs{"p", "q"}))
+	checkEqualT(t, <-notified, "event:p,q", "notified")
+	var rstr string
+	checkErrT(t, cl.Call("N.Echo", "z", &rstr))
+	checkEqualT(t, rstr, "z", "rstr=")
+}
+
+func doTestMsgpackRpcSpecMultiArgs(t *testing.T) {
+	if testSkipRPCTests {
+		return
+	}
+	testOnce.Do(testInitAll)
+	h := testMsgpackH
+	srv := rpc.NewServer()
+	checkErrT(t, srv.RegisterName("M", TestRpcMulti{}))
+	c1, c2 := net.Pipe()
+	go srv.ServeCodec(MsgpackSpecRpcOptions{MultiArgs: true}.ServerCodec(c1, h))
+	cl := rpc.NewClientWithCodec(MsgpackSpecRpc.ClientCodec(c2, h))
+	defer cl.Close()
+	var rstr string
+	checkErrT(t, cl.Call("M.Repeat", MsgpackSpecRpcMultiArgs{"ab", 3}, &rstr))
+	checkEqualT(t, rstr, "ababab", "rstr=")
+	checkErrT(t, cl.Call("M.Echo", "x", &rstr))
+	checkEqualT(t, rstr, "x", "rstr=")
+	for _, args := range []interface{}{
+		MsgpackSpecRpcMultiArgs{"ab"},
+		MsgpackSpecRpcMultiArgs{"ab", 3, 4},
+		TestRpcMultiArgs{"ab", 3},
+	} {
+		// the error is in the response, and the connection can be used again
+		if err := cl.Call("M.Repeat", args, &rstr); err == nil || !strings.Contains(err.Error(), "wrong number of params") {
+			t.Fatalf("expected error for %v, got: %v", args, err)
+		}
+		checkErrT(t, cl.Call("M.Echo", "y", &rstr))
+		checkEqualT(t, rstr, "y", "rstr=")
+	}
+	if err := cl.Call("M.Echo", MsgpackSpecRpcMultiArgs{"x", "y"}, &rstr); err == nil || !strings.Contains(err.Error(), "wrong number of params") {
+		t.Fatalf("expected error, got: %v", err)
+	}
+	if err := cl.Call("M.Repeat", MsgpackSpecRpcMultiArgs{3, "ab"}, &rstr); err == nil {
+		t.Fatalf("expected error, got: %v", rstr)
+	}
+	checkErrT(t, cl.Call("M.Echo", "z", &rstr))
+	checkEqualT(t, rstr, "z", "rstr=")
+}
+
+func doTestMsgpackRpcSpecErrors(t *testing.T) {
+	if testSkipRPCTests {
+		return
+	}
+	testOnce.Do(testInitAll)
+	var h MsgpackHandle
+	checkErrT(t, h.RegisterType(reflect.TypeOf((*error)(nil)).Elem(), "app", reflect.TypeOf(&TestRpcAppError{})))
+	srv := rpc.NewServer()
+	checkErrT(t, srv.RegisterName("E", TestRpcErrs{}))
+	c1, c2 := net.Pipe()
+	go srv.ServeCodec(MsgpackSpecRpc.ServerCodec(c1, &h))
+	cc := MsgpackSpecRpc.ClientCodec(c2, &h)
+	cl := rpc.NewClientWithCodec(cc)
+	defer cl.Close()
+	se := cc.(MsgpackSpecRpcServerErrors)
+
+	var reply TestRpcErrReply
+	checkErrT(t, cl.Call("E.Get", "ok", &reply))
+	checkEqualT(t, reply.Value, "ok", "reply")
+
+	err := cl.Call("E.Get", "typed", &reply)
+	checkEqualT(t, err, error(rpc.ServerError("app error 503")), "fallback")
+	var ae *TestRpcAppError
+	if err = se.Call(cl, "E.Get", "typed", &reply); !errors.As(err, &ae) {
+		t.Fatalf("expected a *TestRpcAppError, got: %T: %v", err, err)
+	}
+	testDeepEqualErr(&TestRpcAppError{Code: 503, Retry: true, Cause: &TestRpcAppError{Code: 1}}, ae, t, "typed")
+
+	err = se.Call(cl, "E.Get", "map", &reply)
+	var me *MsgpackSpecRpcError
+	if !errors.As(err, &me) || !strings.Contains(fmt.Sprint(me.Value), "7") {
+		t.Fatalf("expected a *MsgpackSpecRpcError, got: %T: %v", err, err)
+	}
+
+	err = se.Call(cl, "E.Get", "string", &reply)
+	checkEqualT(t, err, error(rpc.ServerError("plain error")), "string")
+
+	// Call gets the error of each call, though many fail concurrently with the same message
+	var wg sync.WaitGroup
+	errs := make([]error, 32)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var reply TestRpcErrReply
+			errs[i] = se.Call(cl, "E.Get", "typed "+strconv.Itoa(i), &reply)
+		}()
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if !errors.As(err, &ae) || ae.Cause == nil || ae.Cause.Code != i {
+			t.Fatalf("%d: expected a *TestRpcAppError with cause %d, got: %T: %v", i, i, err, err)
+		}
+	}
+	checkErrT(t, se.Call(cl, "E.Get", "ok", &reply))
+}
+
+func doTestJsonRpc2(t *testing.T) {
+	if testSkipRPCTests {
+		return
+	}
+	testOnce.Do(testInitAll)
+	var h JsonHandle
+	h.TermWhitespace = true
+	h.Canonical = true
+	rcvr := &TestRpcNotify{logged: make(chan string, 4)}
+	srv := rpc.NewServer()
+	checkErrT(t, srv.RegisterName("N", rcvr))
+	checkErrT(t, srv.RegisterName("M", TestRpcMulti{}))
+	checkErrT(t, srv.RegisterName("E", TestRpcErrs{}))
+
+	// a server answers each request (and each batch) of a client not written in Go
+	in := bytes.NewBufferString(`{"jsonrpc":"2.0","method":"M.Echo","params":["a"],"id":1}
+{"jsonrpc":"2.0","method":"N.Log","params":["b"]}
+{"jsonrpc":"2.0","method":"M.Repeat","params":{"Name":"ab","Count":2},"id":"x"}
+{"jsonrpc":"2.0","method":"M.Repeat","params":["ab",3],"id":3}
+{"jsonrpc":"2.0","method":"M.Repeat","params":["ab"],"id":4}
+{"jsonrpc":"2.0","method":"M.Nope","id":5}
+{"method":"M.Echo","params":["a"],"id":6}
+{"jsonrpc":"2.0","method":"E.Get","params":["coded"],"id":7}
+{"jsonrpc":"2.0","method":"M.Echo","params":["n"],"id":null}
+[]
+[{"jsonrpc":"2.0","method":"M.Echo","params":["p"],"id":8},{"jsonrpc":"2.0","method":"N.Log","params":["q"]},1]
+[{"jsonrpc":"2.0","method":"N.Log","params":["r"]}]
+{"jsonrpc":"2.0","method":"M.Echo","params":["s"],"id":9}
+{"jsonrpc":"2.0","method":1,"id":10}
+{"jsonrpc":2.0,"method":"M.Echo","params":["t"],"id":11}
+{"jsonrpc":"2.0",`)
+	var out bytes.Buffer
+	srv.ServeCodec(JsonRpc2.ServerCodec(struct {
+		io.Reader
+		io.Writer
+		io.Closer
+	}{in, &out, io.NopCloser(nil)}, &h))
+	logged := []string{<-rcvr.logged, <-rcvr.logged, <-rcvr.logged}
+	sort.Strings(logged) // notifications are served concurrently, as requests are
+	testDeepEqualErr(logged, []string{"b", "q", "r"}, t, "logged")
+	// the responses may be in any order, as may those of a batch
+	testDeepEqualErr(testJsonRpc2Msgs(t, &h, out.Bytes()), testJsonRpc2Msgs(t, &h, []byte(`
+{"jsonrpc":"2.0","result":"a","id":1}
+{"jsonrpc":"2.0","result":"abab","id":"x"}
+{"jsonrpc":"2.0","result":"ababab","id":3}
+{"jsonrpc":"2.0","error":{"code":-32602,"message":"rpc: wrong number of params for codec.TestRpcMultiArgs: expecting 2, got 1"},"id":4}
+{"jsonrpc":"2.0","error":{"code":-32601,"message":"rpc: can't find method M.Nope"},"id":5}
+{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"},"id":6}
+{"jsonrpc":"2.0","error":{"code":42,"message":"coded error","data":"d"},"id":7}
+{"jsonrpc":"2.0","result":"n","id":null}
+{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"},"id":null}
+[{"jsonrpc":"2.0","result":"p","id":8},{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"},"id":null}]
+{"jsonrpc":"2.0","result":"s","id":9}
+{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"},"id":10}
+{"jsonrpc":"2.0","error":{"code":-32600,"message":"Invalid Request"},"id":11}
+{"jsonrpc":"2.0","error":{"code":-32700,"message":"Parse error"},"id":null}
+`)), t, "responses")
+
+	// a Go client can call, notify, and get the error objects of its calls
+	c1, c2 := net.Pipe()
+	go srv.ServeCodec(JsonRpc2.ServerCodec(c1, &h))
+	cc := JsonRpc2.ClientCodec(c2, &h)
+	cl := rpc.NewClientWithCodec(cc)
+	defer cl.Close()
+	var rstr string
+	checkErrT(t, cl.Call("M.Echo", "x", &rstr))
+	checkEqualT(t, rstr, "x", "rstr=")
+	checkErrT(t, cl.Call("M.Repeat", TestRpcMultiArgs{"ab", 2}, &rstr))
+	checkEqualT(t, rstr, "abab", "rstr=")
+	checkErrT(t, cl.Call("M.Repeat", MsgpackSpecRpcMultiArgs{"ab", 3}, &rstr))
+	checkEqualT(t, rstr, "ababab", "rstr=")
+	checkErrT(t, cc.(MsgpackSpecRpcNotifier).Notify("N.Log", "y"))
+	checkEqualT(t, <-rcvr.logged, "y", "logged")
+
+	se := cc.(MsgpackSpecRpcServerErrors)
+	var reply TestRpcErrReply
+	for _, v := range []struct {
+		method, arg string
+		code        int
+		msg         string
+	}{
+		{"E.Get", "coded", 42, "coded error"},
+		{"E.Get", "string", JsonRpc2ServerError, "plain error"},
+		{"M.Nope", "", JsonRpc2MethodNotFound, "rpc: can't find method M.Nope"},
+		{"M.Repeat", "ab", JsonRpc2InvalidParams, "rpc: wrong number of params for codec.TestRpcMultiArgs: expecting 2, got 1"},
+	} {
+		err := cl.Call(v.method, v.arg, &reply)
+		checkEqualT(t, err, error(rpc.ServerError(v.msg)), v.method)
+		var je *JsonRpc2Error
+		if err = se.Call(cl, v.method, v.arg, &reply); !errors.As(err, &je) {
+			t.Fatalf("expected a *JsonRpc2Error, got: %T: %v", err, err)
+		}
+		checkEqualT(t, je.Code, v.code, v.method)
+		checkEqualT(t, je.Message, v.msg, v.method)
+	}
+
+	// Call gets the error object of each call, though many fail concurrently with the same message
+	var wg sync.WaitGroup
+	errs := make([]error, 32)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var reply TestRpcErrReply
+			errs[i] = se.Call(cl, "E.Get", "coded "+strconv.Itoa(i), &reply)
+		}()
+	}
+	wg.Wait()
+	for i, err := range errs {
+		var je *JsonRpc2Error
+		if !errors.As(err, &je) || je.Code != 42 || je.Data != strconv.Itoa(i) {
+			t.Fatalf("%d: expected a *JsonRpc2Error with data %d, got: %T: %v", i, i, err, err)
+		}
+	}
+	checkErrT(t, cl.Call("M.Echo", "z", &rstr))
+	checkEqualT(t, rstr, "z", "rstr=")
+}
+
+// testJsonRpc2Msgs returns the messages in bs, each re-encoded canonically, in sorted order.
+// The messages of a batch are sorted within it.
+func testJsonRpc2Msgs(t *testing.T, h Handle, bs []byte) (msgs []string) {
+	enc := func(v interface{}) string {
+		var bs []byte
+		checkErrT(t, NewEncoderBytes(&bs, h).Encode(v))
+		return strings.TrimSpace(string(bs))
+	}
+	d := NewDecoderBytes(bs, h)
+	for {
+		var v interface{}
+		if err := d.Decode(&v); err == io.EOF {
+			break
+		} else {
+			checkErrT(t, err)
+		}
+		if a, ok := v.([]interface{}); ok {
+			var batch []string
+			for _, v := range a {
+				batch = append(batch, enc(v))
+			}
+			sort.Strings(batch)
+			v = batch
+		}
+		msgs = append(msgs, enc(v))
+	}
+	sort.Strings(msgs)
+	return
+}
+
 func doTestMsgpackRpcSpecPythonClientToGoSvc(t *testing.T) {
 	if testSkipRPCTests {
 		return
@@ -1842,6 +2217,7 @@ func doTestRawExt(t *testing.T, h Handle) {
 	var b []byte
 	var v RawExt // interface{}
 	_, isJson := h.(*JsonHandle)
+	_, isCbor := h.(*CborHandle)
 	bh := basicHandle(h)
 	// isValuer := isJson || isCbor
 	// _ = isValuer
@@ -1858,6 +2234,8 @@ func doTestRawExt(t *testing.T, h Handle) {
 		case isJson:
 			r2.Tag = 0
 			r2.Data = nil
+		case isCbor:
+			r2.Data = nil
 		default:
 			r2.Value = nil
 		}
@@ -2466,6 +2844,248 @@ func doTestMissingFields(t *testing.T, name string, h Handle) {
 	testDeepEqualErr(v1, v3, t, name+"-missing-cmp-2")
 }
 
+func doTestUnknownFields(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	if basicHandle(h).StructToArray {
+		t.Skipf("Skipping Unknown Fields test when StructToArray=true")
+	}
+	type T1 = unknownFieldsT1
+	type T2 = unknownFieldsT2
+	// encode T2, decode into T1, encode it out again: the unknown fields are kept as is
+	v1 := T2{A: "true seven eight", U: 7, F: 1.5, L: []string{"a", "b"}, M: map[string]uint16{"m": 9}}
+	b1 := testMarshalErr(v1, h, t, name+"-unknown-enc-2")
+	var v2 T1
+	testUnmarshalErr(&v2, b1, h, t, name+"-unknown-dec-1")
+	testDeepEqualErr(4, v2.Unknown.Len(), t, name+"-unknown-len")
+	testDeepEqualErr(v1.A, v2.A, t, name+"-unknown-known-field")
+	b2 := testMarshalErr(&v2, h, t, name+"-unknown-enc-1")
+	if !basicHandle(h).Canonical {
+		testDeepEqualErr(b1, b2, t, name+"-unknown-cmp-bytes")
+	}
+	var v3 T2
+	testUnmarshalErr(&v3, b2, h, t, name+"-unknown-dec-2")
+	testDeepEqualErr(v1, v3, t, name+"-unknown-cmp-2")
+
+	// decoding again replaces the unknown fields, and from an io.Reader too
+	NewDecoder(bytes.NewReader(testMarshalErr(T2{A: "x"}, h, t, name+"-unknown-enc-3")), h).MustDecode(&v2)
+	testDeepEqualErr(4, v2.Unknown.Len(), t, name+"-unknown-len-reader")
+	testUnmarshalErr(&v2, testMarshalErr(map[string]string{"A": "y"}, h, t, name+"-unknown-enc-4"), h, t, name+"-unknown-dec-3")
+	testDeepEqualErr(0, v2.Unknown.Len(), t, name+"-unknown-len-reset")
+
+	// unknown fields are converted when encoding in a different format
+	for _, h2 := range []Handle{testMsgpackH, testJsonH, testCborH} {
+		if h2 == h || basicHandle(h2).StructToArray {
+			continue
+		}
+		testUnmarshalErr(&v2, b1, h, t, name+"-unknown-dec-4")
+		var v4 T2
+		testUnmarshalErr(&v4, testMarshalErr(&v2, h2, t, name+"-unknown-enc-"+h2.Name()), h2, t, name+"-unknown-dec-"+h2.Name())
+		testDeepEqualErr(v1, v4, t, name+"-unknown-cmp-"+h2.Name())
+	}
+}
+
+func doTestFieldAliases(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	if basicHandle(h).StructToArray {
+		t.Skipf("Skipping Field Aliases test when StructToArray=true")
+	}
+	type T = fieldAliasT
+	for i, m := range []map[string]interface{}{
+		{"a": "x", "D": 1, "f": 2},
+		{"b": "x", "E": 1, "f": 2},
+		{"c": "x", "D": 1, "f": 2}, // first field with the alias, sorted by name
+	} {
+		var v T
+		testUnmarshalErr(&v, testMarshalErr(m, h, t, name+"-alias-enc"), h, t, name+"-alias-dec")
+		testDeepEqualErr(T{A: "x", D: 1, F: 2}, v, t, fmt.Sprintf("%s-alias-cmp-%d", name, i))
+	}
+	// the name is always used when encoding
+	var m map[string]interface{}
+	testUnmarshalErr(&m, testMarshalErr(T{A: "x"}, h, t, name+"-alias-enc-name"), h, t, name+"-alias-dec-name")
+	if len(m) != 2 || m["a"] == nil || m["D"] == nil {
+		t.Fatalf("%s: expected keys a and D, got %v", name, m)
+	}
+}
+
+func doTestRequiredFields(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	if basicHandle(h).StructToArray {
+		t.Skipf("Skipping Required Fields test when StructToArray=true")
+	}
+	type TT = requiredFieldsTT
+	for i, v := range []struct {
+		m       map[string]interface{}
+		missing []string
+	}{
+		{map[string]interface{}{"a": "x", "B": 1, "c": nil}, nil},
+		{map[string]interface{}{"a": "x", "b": 1, "c": []int{1}}, nil},
+		{map[string]interface{}{"a": "x", "D": 1}, []string{"B", "c"}},
+		{map[string]interface{}{}, []string{"B", "a", "c"}},
+	} {
+		var v2 TT
+		bs := testMarshalErr(map[string]interface{}{"Items": []interface{}{v.m}}, h, t, name+"-required-enc")
+		err := NewDecoderBytes(bs, h).Decode(&v2)
+		var me *MissingFieldsError
+		var de *DecodeError
+		if v.missing == nil {
+			if err != nil {
+				t.Fatalf("%s-required-%d: unexpected error: %v", name, i, err)
+			}
+		} else if !errors.Is(err, ErrMissingField) || !errors.As(err, &me) || !errors.As(err, &de) {
+			t.Fatalf("%s-required-%d: expected MissingFieldsError, got: %v", name, i, err)
+		} else {
+			// all the missing fields are reported, with the path to the struct
+			// (which codecgen-generated code does not track)
+			testDeepEqualErr(v.missing, me.Fields, t, fmt.Sprintf("%s-required-%d", name, i))
+			if !codecgen {
+				testDeepEqualErr(".Items[0]", de.Path, t, fmt.Sprintf("%s-required-path-%d", name, i))
+			}
+		}
+	}
+}
+
+func doTestFieldDefaults(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	dflt := defaulterT{S: "seven", I: -7, U: 16, D: 90 * time.Second, B: true, F: 9.5, L: []string{"nine"}}
+	for i, v := range []struct {
+		in  interface{}
+		out defaulterT
+	}{
+		{map[string]interface{}{}, dflt},
+		{[]interface{}{}, dflt},
+		// fields in the stream are decoded as is, even if zero
+		{map[string]interface{}{"s": "x", "F": 0, "B": false}, defaulterT{S: "x", I: -7, U: 16, D: 90 * time.Second, L: []string{"nine"}}},
+		{[]interface{}{"x", 1, 2, nil}, defaulterT{S: "x", I: 1, U: 2, B: true, F: 9.5, L: []string{"nine"}}},
+		// a short array, as written by an older version
+		{defaulterOldT{S: "x", I: 1}, defaulterT{S: "x", I: 1, U: 16, D: 90 * time.Second, B: true, F: 9.5, L: []string{"nine"}}},
+	} {
+		var v2 defaulterT
+		testUnmarshalErr(&v2, testMarshalErr(v.in, h, t, name+"-defaults-enc"), h, t, name+"-defaults-dec")
+		testDeepEqualErr(v.out, v2, t, fmt.Sprintf("%s-defaults-%d", name, i))
+	}
+
+	// without a Defaulter, only fields with a default= option are set
+	v2 := defaulterTagT{A: 1, B: 2}
+	testUnmarshalErr(&v2, testMarshalErr(map[string]interface{}{}, h, t, name+"-defaults-enc"), h, t, name+"-defaults-dec")
+	testDeepEqualErr(defaulterTagT{A: 3, B: 2}, v2, t, name+"-defaults-tag")
+
+	type TE struct {
+		A int `codec:",default=three"`
+	}
+	if err := NewDecoderBytes(testMarshalErr(map[string]interface{}{}, h, t, name+"-defaults-enc"), h).Decode(&TE{}); err == nil ||
+		!strings.Contains(err.Error(), "invalid default value") {
+		t.Fatalf("%s-defaults-invalid: expected error, got: %v", name, err)
+	}
+}
+
+func doTestFieldSet(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	type T = fieldSetT
+	for i, v := range []struct {
+		in    interface{}
+		names []string
+	}{
+		{map[string]interface{}{}, nil},
+		{map[string]interface{}{"aa": "x", "B": nil, "C": 0, "X": 1}, []string{"A", "B", "C"}},
+		{[]interface{}{"x", nil}, []string{"A", "B"}},
+		{[]interface{}{"x", 1, 2, 3, 4}, []string{"A", "B", "C", "D"}},
+	} {
+		if _, ok := v.in.([]interface{}); ok != basicHandle(h).StructToArray {
+			continue
+		}
+		v2 := T{D: 9}
+		v2.FS.Add("D")
+		testUnmarshalErr(&v2, testMarshalErr(v.in, h, t, name+"-fieldset-enc"), h, t, name+"-fieldset-dec")
+		names := append([]string(nil), v2.FS.Names()...)
+		sort.Strings(names)
+		testDeepEqualErr(v.names, names, t, fmt.Sprintf("%s-fieldset-%d", name, i))
+		if v2.FS.Len() != len(v.names) || v2.FS.Has("D") != (len(v.names) == 4) {
+			t.Fatalf("%s-fieldset-%d: unexpected set: %v", name, i, v2.FS.Names())
+		}
+	}
+
+	// the FieldSet is not encoded
+	v := T{A: "x"}
+	v.FS.Add("A")
+	var m map[string]interface{}
+	var l []interface{}
+	if basicHandle(h).StructToArray {
+		testUnmarshalErr(&l, testMarshalErr(&v, h, t, name+"-fieldset-enc"), h, t, name+"-fieldset-dec")
+		testDeepEqualErr(4, len(l), t, name+"-fieldset-not-encoded")
+	} else {
+		testUnmarshalErr(&m, testMarshalErr(&v, h, t, name+"-fieldset-enc"), h, t, name+"-fieldset-dec")
+		if _, ok := m["FS"]; ok || len(m) != 4 {
+			t.Fatalf("%s-fieldset-not-encoded: got %v", name, m)
+		}
+	}
+}
+
+func doTestFieldMask(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	type Task = fieldMaskTask
+	type Spec = fieldMaskSpec
+	task := Task{Name: "web", Driver: "docker", Env: map[string]string{"A": "1"}}
+	var anyEnv interface{} = map[string]interface{}{"Env": task.Env}
+	if basicHandle(h).StructToArray {
+		anyEnv = []interface{}{nil, nil, task.Env} // unselected fields are nil
+	}
+	v := Spec{Name: "job", Count: 3, Tasks: []Task{task, task}, ByName: map[string]*Task{"web": &task},
+		Meta: map[string]string{"k": "v"}, Any: &task}
+	for i, x := range []struct {
+		paths []string
+		out   Spec
+	}{
+		{[]string{"Name", "Tasks.driver", "ByName.Name", "Meta"}, Spec{Name: "job",
+			Tasks: []Task{{Driver: "docker"}, {Driver: "docker"}}, ByName: map[string]*Task{"web": {Name: "web"}},
+			Meta: map[string]string{"k": "v"}}},
+		// a path selects all of the field, whatever else is selected in it
+		{[]string{"Tasks.Name", "Tasks", "Tasks.driver"}, Spec{Tasks: []Task{task, task}}},
+		{[]string{"Count", "Any.Env"}, Spec{Count: 3, Any: anyEnv}},
+		{nil, Spec{}},
+	} {
+		m, err := NewFieldMask(x.paths...)
+		if err != nil {
+			t.Fatalf("%s-fieldmask-%d: %v", name, i, err)
+		}
+		var bs []byte
+		if err = NewEncoderBytes(&bs, h).EncodeMasked(&v, m); err != nil {
+			t.Fatalf("%s-fieldmask-%d: %v", name, i, err)
+		}
+		var v2 Spec
+		testUnmarshalErr(&v2, bs, h, t, name+"-fieldmask-dec")
+		if x.out.Any != nil {
+			// as decoded into an interface{}
+			var a interface{}
+			testUnmarshalErr(&a, testMarshalErr(x.out.Any, h, t, name+"-fieldmask-enc"), h, t, name+"-fieldmask-dec")
+			x.out.Any = a
+		}
+		testDeepEqualErr(x.out, v2, t, fmt.Sprintf("%s-fieldmask-%d", name, i))
+	}
+
+	// without a mask, everything is encoded, as by Encode
+	var bs, bs2 []byte
+	if err := NewEncoderBytes(&bs, h).EncodeMasked(&v.Tasks, nil); err != nil {
+		t.Fatalf("%s-fieldmask-nil: %v", name, err)
+	}
+	testDeepEqualErr(testMarshalErr(&v.Tasks, h, t, name+"-fieldmask-enc"), bs, t, name+"-fieldmask-nil")
+
+	// the mask applies to the one call
+	m, _ := NewFieldMask("Name")
+	e := NewEncoderBytes(&bs, h)
+	if err := e.EncodeMasked(&task, m); err != nil {
+		t.Fatalf("%s-fieldmask-once: %v", name, err)
+	}
+	e.ResetBytes(&bs2)
+	e.MustEncode(&task)
+	testDeepEqualErr(testMarshalErr(&task, h, t, name+"-fieldmask-enc"), bs2, t, name+"-fieldmask-once")
+
+	for _, p := range []string{"", "Tasks.", ".Name", "Tasks..Name"} {
+		if _, err := NewFieldMask("Name", p); err == nil {
+			t.Fatalf("%s-fieldmask-invalid: expected error for %q", name, p)
+		}
+	}
+}
+
 func doTestMaxDepth(t *testing.T, name string, h Handle) {
 	testOnce.Do(testInitAll)
 	type T struct {
@@ -2515,9 +3135,9 @@ func doTestMaxDepth(t *testing.T, name string, h Handle) {
 
 	table = append(table, T{s, 0, false, nil})
 	table = append(table, T{s, 256, false, nil})
-	table = append(table, T{s, 7, false, errMaxDepthExceeded})
+	table = append(table, T{s, 7, false, ErrMaxDepthExceeded})
 	table = append(table, T{s, 15, false, nil})
-	table = append(table, T{m99, 15, true, errMaxDepthExceeded})
+	table = append(table, T{m99, 15, true, ErrMaxDepthExceeded})
 	table = append(table, T{m99, 215, true, nil})
 
 	defer func(n int16, b bool) {
@@ -2538,12 +3158,9 @@ func doTestMaxDepth(t *testing.T, name string, h Handle) {
 			var v2 interface{}
 			err = testUnmarshal(&v2, b1, h)
 		}
-		if err1, ok := err.(decodeError); ok {
-			err = err1.codecError
-		}
-		var err0 interface{} = err
-		if err1, ok := err.(codecError); ok {
-			err0 = err1.err
+		var err0 error = err
+		if err1, ok := err.(*DecodeError); ok {
+			err0 = err1.Err
 		}
 		if err0 != v.E {
 			failT(t, "Unexpected error testing max depth for depth %d: expected %v, received %v", v.M, v.E, err)
@@ -2553,86 +3170,840 @@ func doTestMaxDepth(t *testing.T, name string, h Handle) {
 	}
 }
 
-func doTestMultipleEncDec(t *testing.T, name string, h Handle) {
-	testOnce.Do(testInitAll)
-	// encode a string multiple times.
-	// decode it multiple times.
-	// ensure we get the value each time
-	var s1 = "ugorji"
-	var s2 = "nwoke"
-	var s11, s21 string
-	var buf bytes.Buffer
-	e := NewEncoder(&buf, h)
-	e.MustEncode(s1)
-	e.MustEncode(s2)
-	d := NewDecoder(&buf, h)
-	d.MustDecode(&s11)
-	d.MustDecode(&s21)
-	testDeepEqualErr(s1, s11, t, name+"-multiple-encode")
-	testDeepEqualErr(s2, s21, t, name+"-multiple-encode")
-}
-
-// -----------------
-
-func TestJsonDecodeNonStringScalarInStringContext(t *testing.T) {
+func doTestDecodeLimits(t *testing.T, name string, h Handle) {
 	testOnce.Do(testInitAll)
-	var b = `{"s.true": "true", "b.true": true, "s.false": "false", "b.false": false, "s.10": "10", "i.10": 10, "i.-10": -10}`
-	var golden = map[string]string{"s.true": "true", "b.true": "true", "s.false": "false", "b.false": "false", "s.10": "10", "i.10": "10", "i.-10": "-10"}
+	type T struct {
+		S string
+		A []int
+		M map[string]int
+	}
+	v := T{
+		S: strings.Repeat("x", 100),
+		A: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		M: map[string]int{"k1": 1, "k2": 2, "k3": 3, "k4": 4, "k5": 5},
+	}
+	bs := testMarshalErr(v, h, t, name+"-limits")
 
-	var m map[string]string
-	d := NewDecoderBytes([]byte(b), testJsonH)
-	d.MustDecode(&m)
-	if err := internal.DeepEqual(golden, m); err == nil {
-		logT(t, "++++ match: decoded: %#v", m)
-	} else {
-		logT(t, "---- mismatch: %v ==> golden: %#v, decoded: %#v", err, golden, m)
-		failT(t)
+	bh := basicHandle(h)
+	defer func(o DecodeOptions) { bh.DecodeOptions = o }(bh.DecodeOptions)
+	for i, l := range []struct {
+		limit string // the limit expected to be exceeded, if any
+		opts  DecodeOptions
+	}{
+		{"", DecodeOptions{MaxBytesLen: 100, MaxArrayLen: 10, MaxMapLen: 5, MaxBytesRead: len(bs)}},
+		{"MaxBytesLen", DecodeOptions{MaxBytesLen: 99}},
+		{"MaxArrayLen", DecodeOptions{MaxArrayLen: 9}},
+		{"MaxMapLen", DecodeOptions{MaxMapLen: 4}},
+		{"MaxAlloc", DecodeOptions{MaxAlloc: 100}},
+		{"MaxBytesRead", DecodeOptions{MaxBytesRead: len(bs) - 1}},
+		{"MaxBytesRead", DecodeOptions{MaxBytesRead: 10}},
+	} {
+		bh.DecodeOptions = l.opts
+		for _, r := range []bool{false, true} {
+			var v2 T
+			var err error
+			if r {
+				err = NewDecoder(bytes.NewReader(bs), h).Decode(&v2)
+			} else {
+				err = NewDecoderBytes(bs, h).Decode(&v2)
+			}
+			if l.limit == "" {
+				if err != nil {
+					t.Fatalf("%s: %d (reader: %v): unexpected error: %v", name, i, r, err)
+				}
+				testDeepEqualErr(v2, v, t, name+"-limits")
+				continue
+			}
+			var lerr *DecodeLimitError
+			if !errors.As(err, &lerr) || lerr.Limit != l.limit {
+				t.Fatalf("%s: %d (reader: %v): expected %s to be exceeded, got: %v", name, i, r, l.limit, err)
+			}
+		}
 	}
 }
 
-func TestJsonEncodeIndent(t *testing.T) {
+func doTestStructuredErrors(t *testing.T, name string, h Handle) {
 	testOnce.Do(testInitAll)
-	v := TestSimplish{
-		Ii: -794,
-		Ss: `A Man is
-after the new line
-	after new line and tab
-`,
+	type E struct {
+		Data int8
 	}
-	v2 := v
-	v.Mm = make(map[string]*TestSimplish)
-	for i := 0; i < len(v.Ar); i++ {
-		v3 := v2
-		v3.Ii += (i * 4)
-		v3.Ss = fmt.Sprintf("%d - %s", v3.Ii, v3.Ss)
-		if i%2 == 0 {
-			v.Ar[i] = &v3
+	type T struct {
+		Entries []E
+		Tags    map[string]uint8
+		Arr     [1]int
+		Ptrs    map[string]*E
+	}
+	type M = map[string]interface{}
+	entries := func(data ...interface{}) M {
+		var v []interface{}
+		for _, d := range data {
+			v = append(v, M{"Data": d})
 		}
-		// v3 = v2
-		v.Sl = append(v.Sl, &v3)
-		v.Mm[strconv.FormatInt(int64(i), 10)] = &v3
+		return M{"Entries": v}
 	}
-	oldcan := testJsonH.Canonical
-	oldIndent := testJsonH.Indent
-	oldS2A := testJsonH.StructToArray
-	defer func() {
-		testJsonH.Canonical = oldcan
-		testJsonH.Indent = oldIndent
-		testJsonH.StructToArray = oldS2A
-	}()
-	testJsonH.Canonical = true
-	testJsonH.Indent = -1
-	testJsonH.StructToArray = false
-	var bs []byte
-	NewEncoderBytes(&bs, testJsonH).MustEncode(&v)
-	txt1Tab := string(bs)
-	bs = nil
-	testJsonH.Indent = 120
-	NewEncoderBytes(&bs, testJsonH).MustEncode(&v)
-	txtSpaces := string(bs)
-	// fmt.Printf("\n-----------\n%s\n------------\n%s\n-------------\n", txt1Tab, txtSpaces)
 
-	goldenResultTab := `{
+	bh := basicHandle(h)
+	defer func(o DecodeOptions) { bh.DecodeOptions = o }(bh.DecodeOptions)
+	for i, x := range []struct {
+		v         interface{} // value to encode, then decode into a T
+		opts      DecodeOptions
+		is        error
+		path      string
+		typ       reflect.Type
+		valueType string // "?" if it depends on the format
+	}{
+		{entries(1, 300), DecodeOptions{}, ErrOverflow, ".Entries[1].Data", reflect.TypeOf(int8(0)), "Int"},
+		{M{"Tags": M{"env": -1}}, DecodeOptions{}, ErrOverflow, `.Tags["env"]`, reflect.TypeOf(uint8(0)), "Int"},
+		{M{"Tags": 1}, DecodeOptions{}, ErrTypeMismatch, ".Tags", reflect.TypeOf(map[string]uint8(nil)), "?"},
+		{M{"Entries": []interface{}{5}}, DecodeOptions{}, ErrTypeMismatch, ".Entries[0]", reflect.TypeOf(E{}), ""},
+		{M{"Entries": []interface{}{M{"Data": 1, "Extra": "x"}}}, DecodeOptions{ErrorIfNoField: true},
+			ErrUnknownField, ".Entries[0]", reflect.TypeOf(E{}), "?"},
+		{M{"Arr": []int{1, 2}}, DecodeOptions{ErrorIfNoArrayExpand: true},
+			ErrArrayCannotExpand, ".Arr[1]", reflect.TypeOf(0), "Array"},
+		{entries(1), DecodeOptions{MaxDepth: 2}, ErrMaxDepthExceeded, ".Entries", reflect.TypeOf([]E(nil)), ""},
+	} {
+		bs := testMarshalErr(x.v, h, t, name+"-structured-errors")
+		bh.DecodeOptions = x.opts
+		var v T
+		err := NewDecoderBytes(bs, h).Decode(&v)
+		if !errors.Is(err, x.is) {
+			t.Fatalf("%s: %d: expected error matching %v, got: %v", name, i, x.is, err)
+		}
+		var derr *DecodeError
+		if !errors.As(err, &derr) {
+			t.Fatalf("%s: %d: expected a *DecodeError, got: %T", name, i, err)
+		}
+		if derr.Name != name || derr.Offset <= 0 || derr.Offset > len(bs) {
+			t.Fatalf("%s: %d: unexpected name %q or offset %d", name, i, derr.Name, derr.Offset)
+		}
+		if derr.Path != x.path || derr.Type != x.typ || (derr.ValueType != x.valueType && x.valueType != "?") {
+			t.Fatalf("%s: %d: expected path %q, type %v, value type %q; got %q, %v, %q",
+				name, i, x.path, x.typ, x.valueType, derr.Path, derr.Type, derr.ValueType)
+		}
+	}
+
+	// the key of an entry decoded into an existing value is in the path
+	ptrs := func(n, data int) map[string]interface{} {
+		m := make(M)
+		for j := 0; j < n; j++ {
+			m["key"+strconv.Itoa(j)] = M{"Data": data}
+		}
+		return m
+	}
+	bh.DecodeOptions = DecodeOptions{}
+	bs := testMarshalErr(M{"Ptrs": ptrs(1, 300)}, h, t, name+"-structured-errors")
+	v := T{Ptrs: map[string]*E{"key0": {}}}
+	err := NewDecoderBytes(bs, h).Decode(&v)
+	var derr *DecodeError
+	if !errors.As(err, &derr) || !errors.Is(err, ErrOverflow) || derr.Path != `.Ptrs["key0"].Data` {
+		t.Fatalf("%s: unexpected error decoding into an existing map value: %v", name, err)
+	}
+	if !codecgen {
+		allocs := func(n int) float64 {
+			bs := testMarshalErr(ptrs(n, 1), h, t, name+"-structured-errors")
+			v := make(map[string]*E)
+			testUnmarshalErr(&v, bs, h, t, name+"-structured-errors")
+			d := NewDecoderBytes(nil, h)
+			return testing.AllocsPerRun(10, func() {
+				d.ResetBytes(bs)
+				if err := d.Decode(&v); err != nil {
+					t.Fatalf("%s: %v", name, err)
+				}
+			})
+		}
+		// the key is read into a string, but not copied again for the path
+		if n1, n8 := allocs(1), allocs(8); n8-n1 > 7 {
+			t.Fatalf("%s: expected 1 allocation per existing map value, got %v for 1, %v for 8", name, n1, n8)
+		}
+	}
+
+	// a scalar at the top-level has no path
+	bs = testMarshalErr(300, h, t, name+"-structured-errors")
+	var i8 int8
+	err = NewDecoderBytes(bs, h).Decode(&i8)
+	if !errors.As(err, &derr) || !errors.Is(err, ErrOverflow) || derr.Path != "" || derr.Type != reflect.TypeOf(i8) {
+		t.Fatalf("%s: unexpected error decoding overflowing top-level value: %v", name, err)
+	}
+	// the cause is also available as per github.com/pkg/errors
+	type causer interface{ Cause() error }
+	if c, ok := err.(causer); !ok || c.Cause() != derr.Err {
+		t.Fatalf("%s: expected Cause to return the underlying error, got: %v", name, err)
+	}
+
+	var eerr *EncodeError
+	err = NewEncoderBytes(&bs, h).Encode(make(chan<- int))
+	if !errors.As(err, &eerr) || eerr.Name != name {
+		t.Fatalf("%s: expected an *EncodeError, got: %v", name, err)
+	}
+	if c, ok := err.(causer); !ok || c.Cause() != eerr.Err {
+		t.Fatalf("%s: expected Cause to return the underlying error, got: %v", name, err)
+	}
+}
+
+func doTestMarshalGeneric(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	type T struct {
+		S  string
+		N  int
+		Ss []string
+	}
+	v := T{S: "hello", N: 42, Ss: []string{"a", "b"}}
+
+	bs := testMarshalErr(v, h, t, name+"-marshal-generic")
+	bs2, err := Marshal(h, v)
+	if err != nil || !bytes.Equal(bs, bs2) {
+		t.Fatalf("%s: Marshal: expected %x, got %x (err: %v)", name, bs, bs2, err)
+	}
+	if cap(bs2) > 2*len(bs2) {
+		t.Fatalf("%s: Marshal: expected the result sized to its length %d, got capacity %d", name, len(bs2), cap(bs2))
+	}
+
+	prefix := []byte("prefix")
+	bs2, err = AppendMarshal(prefix[:len(prefix):len(prefix)], h, v)
+	if err != nil || !bytes.Equal(bs2, append(prefix, bs...)) {
+		t.Fatalf("%s: AppendMarshal: expected %x, got %x (err: %v)", name, append(prefix, bs...), bs2, err)
+	}
+
+	v2, err := Unmarshal[T](h, bs)
+	if err != nil {
+		t.Fatalf("%s: Unmarshal: %v", name, err)
+	}
+	testDeepEqualErr(v, v2, t, name+"-unmarshal-generic")
+
+	var v3 = T{N: 7}
+	if err = UnmarshalInto(h, bs, &v3); err != nil {
+		t.Fatalf("%s: UnmarshalInto: %v", name, err)
+	}
+	testDeepEqualErr(v, v3, t, name+"-unmarshal-into-generic")
+
+	// errors are reported as from Decode, and do not poison the pooled Decoder
+	if _, err = Unmarshal[T](h, nil); err == nil {
+		t.Fatalf("%s: Unmarshal: expected an error decoding an empty input", name)
+	}
+	if _, err = Unmarshal[T](h, bs[:len(bs)-1]); err == nil {
+		t.Fatalf("%s: Unmarshal: expected an error decoding a truncated input", name)
+	}
+	if _, err = Unmarshal[int8](h, testMarshalErr(300, h, t, name)); !errors.Is(err, ErrOverflow) {
+		t.Fatalf("%s: Unmarshal: expected an overflow, got: %v", name, err)
+	}
+	if _, err = Marshal(h, make(chan<- int)); err == nil {
+		t.Fatalf("%s: Marshal: expected an error encoding a send-only chan", name)
+	}
+
+	bh := basicHandle(h)
+	defer func(b bool) { bh.ExplicitRelease = b }(bh.ExplicitRelease)
+	bh.ExplicitRelease = true
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 16; j++ {
+				w := T{S: strconv.Itoa(i), N: j}
+				b, err := Marshal(h, &w)
+				if err != nil {
+					t.Errorf("%s: Marshal: %v", name, err)
+					return
+				}
+				if w2, err := Unmarshal[*T](h, b); err != nil || w2.S != w.S || w2.N != w.N {
+					t.Errorf("%s: Unmarshal: expected %v, got %v (err: %v)", name, w, w2, err)
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func doTestDecodeSeq(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	type T struct {
+		N int
+		S string
+	}
+	var bs []byte
+	e := NewEncoderBytes(&bs, h)
+	for i := 0; i < 3; i++ {
+		if err := e.Encode(T{N: i, S: strconv.Itoa(i)}); err != nil {
+			t.Fatalf("%s: error encoding: %v", name, err)
+		}
+	}
+	// the end of the input is found alike, whether it is read from a []byte or an io.Reader
+	newDecs := map[string]func([]byte) *Decoder{
+		"bytes":  func(b []byte) *Decoder { return NewDecoderBytes(b, h) },
+		"reader": func(b []byte) *Decoder { return NewDecoder(bytes.NewReader(b), h) },
+	}
+	var n int
+	for dname, newDec := range newDecs {
+		n = 0
+		for v, err := range DecodeValues[T](newDec(bs)) {
+			if err != nil || v.N != n || v.S != strconv.Itoa(n) {
+				t.Fatalf("%s: %s: DecodeValues: %d: unexpected value %v (err: %v)", name, dname, n, v, err)
+			}
+			n++
+		}
+		if n != 3 {
+			t.Fatalf("%s: %s: DecodeValues: expected 3 values, got %d", name, dname, n)
+		}
+		// iteration stops at the first error
+		n = 0
+		for _, err := range DecodeValues[T](newDec(bs[:len(bs)-1])) {
+			var de *DecodeError
+			if n++; (n < 3 && err != nil) || (n == 3 && (!errors.Is(err, io.ErrUnexpectedEOF) || !errors.As(err, &de))) {
+				t.Fatalf("%s: %s: DecodeValues: %d: unexpected error: %v", name, dname, n, err)
+			}
+			if n == 3 && de.Offset != len(bs)-1 {
+				t.Fatalf("%s: %s: DecodeValues: unexpected offset %d", name, dname, de.Offset)
+			}
+		}
+		if n != 3 {
+			t.Fatalf("%s: %s: DecodeValues: expected 3 results decoding truncated input, got %d", name, dname, n)
+		}
+		// scalars, which json separates by whitespace, and may end the input with
+		_, isJson := h.(*JsonHandle)
+		var is []byte
+		for i := 1; i <= 2; i++ {
+			if i > 1 && isJson {
+				is = append(is, ' ')
+			}
+			is = append(is, testMarshalErr(i, h, t, name)...)
+		}
+		ins := [][]byte{is}
+		if isJson {
+			ins = append(ins, append(is[:len(is):len(is)], '\n'))
+		}
+		for _, in := range ins {
+			var vs []int
+			for v, err := range DecodeValues[int](newDec(in)) {
+				if err != nil || len(vs) == 2 {
+					t.Fatalf("%s: %s: DecodeValues: %q: unexpected value %v after %v (err: %v)", name, dname, in, v, vs, err)
+				}
+				vs = append(vs, v)
+			}
+			testDeepEqualErr(vs, []int{1, 2}, t, name+"-decode-values-"+dname)
+		}
+	}
+
+	type M = map[string]interface{}
+	bs = testMarshalErr([]interface{}{M{"a": 1, "b": 2, "c": 3}, []int{1, 2, 3}, "after"}, h, t, name+"-decode-seq")
+
+	d := NewDecoderBytes(bs, h)
+	if tok, err := d.Token(); err != nil || tok.Kind != TokenArrayStart {
+		t.Fatalf("%s: expected an array start, got: %v (err: %v)", name, tok, err)
+	}
+	m := make(map[string]int)
+	for x, err := range DecodeEntries[string, int](d) {
+		if err != nil {
+			t.Fatalf("%s: DecodeEntries: %v", name, err)
+		}
+		m[x.Key] = x.Value
+	}
+	testDeepEqualErr(m, map[string]int{"a": 1, "b": 2, "c": 3}, t, name+"-decode-entries")
+	// exiting early skips the rest of the array
+	for v, err := range DecodeElems[int](d) {
+		if err != nil || v != 1 {
+			t.Fatalf("%s: DecodeElems: unexpected value %v (err: %v)", name, v, err)
+		}
+		break
+	}
+	var str string
+	if err := d.Decode(&str); err != nil || str != "after" {
+		t.Fatalf("%s: expected to decode %q after the array, got %q (err: %v)", name, "after", str, err)
+	}
+	if tok, err := d.Token(); err != nil || tok.Kind != TokenEnd {
+		t.Fatalf("%s: expected an end token, got: %v (err: %v)", name, tok, err)
+	}
+
+	// a large top-level array
+	var elems []T
+	for i := 0; i < 1000; i++ {
+		elems = append(elems, T{N: i})
+	}
+	n = 0
+	for v, err := range DecodeElems[T](NewDecoderBytes(testMarshalErr(elems, h, t, name), h)) {
+		if err != nil || v.N != n {
+			t.Fatalf("%s: DecodeElems: %d: unexpected value %v (err: %v)", name, n, v, err)
+		}
+		n++
+	}
+	if n != len(elems) {
+		t.Fatalf("%s: DecodeElems: expected %d elements, got %d", name, len(elems), n)
+	}
+
+	// a nil is empty, and anything else is an error
+	for range DecodeElems[T](NewDecoderBytes(testMarshalErr(nil, h, t, name), h)) {
+		t.Fatalf("%s: DecodeElems: expected no elements decoding a nil", name)
+	}
+	for _, err := range DecodeElems[T](NewDecoderBytes(testMarshalErr("x", h, t, name), h)) {
+		if !errors.Is(err, ErrTypeMismatch) {
+			t.Fatalf("%s: DecodeElems: expected a type mismatch, got: %v", name, err)
+		}
+	}
+}
+
+func doTestFastpath(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	// named element types are never on the fast-path, so are encoded via reflection
+	type S string
+	type I16 int16
+	type I interface{}
+	var (
+		ss  = []string{"a", "", "ccc"}
+		ss2 = []S{"a", "", "ccc"}
+		is  = []int16{-1, 0, 300}
+		is2 = []I16{-1, 0, 300}
+		ms  = map[string]string{"a": "1"}
+		ms2 = map[S]S{"a": "1"}
+		mi  = map[string]interface{}{"a": true}
+		mi2 = map[S]I{"a": true}
+	)
+	for i, x := range [][2]interface{}{{ss, ss2}, {is, is2}, {ms, ms2}, {mi, mi2}} {
+		bs := testMarshalErr(x[0], h, t, name)
+		if bs2 := testMarshalErr(x[1], h, t, name); !bytes.Equal(bs, bs2) {
+			t.Fatalf("%s: %d: fast-path encoded %x, but reflection encoded %x", name, i, bs, bs2)
+		}
+		v := reflect.New(reflect.TypeOf(x[0]))
+		testUnmarshalErr(v.Interface(), bs, h, t, name)
+		testDeepEqualErr(x[0], v.Elem().Interface(), t, name+"-fastpath")
+	}
+
+	bh := basicHandle(h)
+	defer func(o DecodeOptions) { bh.DecodeOptions = o }(bh.DecodeOptions)
+
+	// decode into existing slices and maps
+	bs := testMarshalErr([]interface{}{"x", nil}, h, t, name)
+	ss3 := []string{"a", "b", "c"}
+	testUnmarshalErr(&ss3, bs, h, t, name)
+	testDeepEqualErr([]string{"x", ""}, ss3, t, name+"-fastpath-existing-slice")
+	bs = testMarshalErr(map[string]interface{}{"a": nil, "b": "2"}, h, t, name)
+	ms3 := map[string]string{"a": "1", "c": "3"}
+	testUnmarshalErr(&ms3, bs, h, t, name)
+	testDeepEqualErr(map[string]string{"a": "", "b": "2", "c": "3"}, ms3, t, name+"-fastpath-existing-map")
+	bh.DeleteOnNilMapValue = true
+	testUnmarshalErr(&ms3, bs, h, t, name)
+	testDeepEqualErr(map[string]string{"b": "2", "c": "3"}, ms3, t, name+"-fastpath-delete-on-nil")
+
+	// errors have the same path as via reflection
+	bh.DecodeOptions = DecodeOptions{}
+	bs = testMarshalErr(map[string]interface{}{"a": []int{1, 2, 40000}}, h, t, name)
+	var mis map[string][]int16
+	err := NewDecoderBytes(bs, h).Decode(&mis)
+	var derr *DecodeError
+	if !errors.As(err, &derr) || !errors.Is(err, ErrOverflow) || derr.Path != `["a"][2]` {
+		t.Fatalf("%s: expected an overflow at [\"a\"][2], got: %v", name, err)
+	}
+	bs = testMarshalErr(map[string]interface{}{"a": 1, "b": "x"}, h, t, name)
+	var msi map[string]int
+	if err = NewDecoderBytes(bs, h).Decode(&msi); !errors.As(err, &derr) || derr.Path != `["b"]` {
+		t.Fatalf("%s: expected an error at [\"b\"], got: %v", name, err)
+	}
+
+	// limits apply
+	bh.MaxArrayLen = 2
+	var is3 []int16
+	if err = NewDecoderBytes(testMarshalErr(is, h, t, name), h).Decode(&is3); !errors.As(err, new(*DecodeLimitError)) {
+		t.Fatalf("%s: expected a *DecodeLimitError, got: %v", name, err)
+	}
+}
+
+func doTestMultipleEncDec(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	// encode a string multiple times.
+	// decode it multiple times.
+	// ensure we get the value each time
+	var s1 = "ugorji"
+	var s2 = "nwoke"
+	var s11, s21 string
+	var buf bytes.Buffer
+	e := NewEncoder(&buf, h)
+	e.MustEncode(s1)
+	e.MustEncode(s2)
+	d := NewDecoder(&buf, h)
+	d.MustDecode(&s11)
+	d.MustDecode(&s21)
+	testDeepEqualErr(s1, s11, t, name+"-multiple-encode")
+	testDeepEqualErr(s2, s21, t, name+"-multiple-encode")
+}
+
+func doTestToken(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	type T struct {
+		A string
+		B []interface{}
+		C map[string]int64
+		D []string
+		E int
+	}
+	v := T{
+		A: "a",
+		B: []interface{}{int64(-3), uint64(7), 1.5, true, nil},
+		C: map[string]int64{"k": 1},
+		D: []string{"d1", "d2"},
+		E: 5,
+	}
+	var tokstr = func(tk Token) string {
+		switch tk.Kind {
+		case TokenInt:
+			return strconv.FormatInt(tk.Int, 10)
+		case TokenUint:
+			return strconv.FormatUint(tk.Uint, 10)
+		case TokenFloat:
+			return strconv.FormatFloat(tk.Float, 'g', -1, 64)
+		case TokenBool:
+			return strconv.FormatBool(tk.Bool)
+		case TokenString:
+			return strconv.Quote(string(tk.Bytes))
+		case TokenNil:
+			return "nil"
+		case TokenMapStart:
+			return "{"
+		case TokenArrayStart:
+			return "["
+		case TokenEnd:
+			return "$"
+		}
+		return tk.Kind.String()
+	}
+	bs := testMarshalErr(v, h, t, name+"-token")
+	bs = append(bs, testMarshalErr("next", h, t, name+"-token")...)
+
+	d := NewDecoderBytes(bs, h)
+	var toks []string
+	for i := 0; i < 13; i++ {
+		tk, err := d.Token()
+		if err != nil {
+			t.Fatalf("%s: token %d: unexpected error: %v", name, i, err)
+		}
+		if i == 0 && tk.Len != 5 && tk.Len != -1 {
+			t.Fatalf("%s: expected map of length 5 (or -1), got %d", name, tk.Len)
+		}
+		toks = append(toks, tokstr(tk))
+	}
+	testDeepEqualErr(strings.Join(toks, " "), `{ "A" "a" "B" [ -3 7 1.5 true nil $ "C" {`, t, name+"-token")
+
+	// interleave Decode with Token: decode the key and value of C's only entry
+	var m map[string]int64
+	var k string
+	var ss []string
+	var e int
+	decodeNext := func(v interface{}) {
+		if err := d.Decode(v); err != nil {
+			t.Fatalf("%s: unexpected error decoding %T: %v", name, v, err)
+		}
+	}
+	decodeNext(&k)
+	decodeNext(&e)
+	testDeepEqualErr(k, "k", t, name+"-token-key")
+	testDeepEqualErr(e, 1, t, name+"-token-value")
+	if err := d.Decode(&e); err == nil {
+		t.Fatalf("%s: expected error decoding past the end of the map", name)
+	}
+
+	d = NewDecoderBytes(bs, h)
+	for i := 0; i < 12; i++ {
+		if _, err := d.Token(); err != nil {
+			t.Fatalf("%s: token %d: unexpected error: %v", name, i, err)
+		}
+	}
+	decodeNext(&m)
+	testDeepEqualErr(m, v.C, t, name+"-token-map")
+	decodeNext(&k)
+	testDeepEqualErr(k, "D", t, name+"-token-key")
+	decodeNext(&ss)
+	testDeepEqualErr(ss, v.D, t, name+"-token-slice")
+	tk, _ := d.Token()
+	testDeepEqualErr(tokstr(tk), `"E"`, t, name+"-token-key")
+	if kind, err := d.PeekKind(); err != nil || (kind != TokenInt && kind != TokenUint && kind != TokenNumber) {
+		t.Fatalf("%s: expected a number kind, got %v (err: %v)", name, kind, err)
+	}
+	decodeNext(&e)
+	testDeepEqualErr(e, v.E, t, name+"-token-int")
+	if kind, err := d.PeekKind(); err != nil || kind != TokenEnd {
+		t.Fatalf("%s: expected %v, got %v (err: %v)", name, TokenEnd, kind, err)
+	}
+	tk, _ = d.Token()
+	testDeepEqualErr(tk.Kind, TokenEnd, t, name+"-token-end")
+	decodeNext(&k)
+	testDeepEqualErr(k, "next", t, name+"-token-next")
+
+	// at the end of the input, Token returns io.EOF, whether it is read from a []byte or an io.Reader,
+	// including after a json number (which only the end of the input ends)
+	bs = append(bs, testMarshalErr(6, h, t, name+"-token")...)
+	for _, d = range []*Decoder{NewDecoderBytes(bs, h), NewDecoder(bytes.NewReader(bs), h)} {
+		toks = toks[:0]
+		for {
+			tk, err := d.Token()
+			if err == io.EOF {
+				break
+			}
+			if err != nil || len(toks) > 26 {
+				t.Fatalf("%s: token %d: unexpected token %v (err: %v)", name, len(toks), tokstr(tk), err)
+			}
+			toks = append(toks, tokstr(tk))
+		}
+		testDeepEqualErr(len(toks), 26, t, name+"-token-count")
+		testDeepEqualErr(strings.Join(toks[24:], " "), `"next" 6`, t, name+"-token-eof")
+	}
+}
+
+func doTestEncodeTokens(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	type T struct {
+		A string
+		B []interface{}
+		C map[string]int64
+		D []string
+		E int
+	}
+	v := T{
+		A: "a",
+		B: []interface{}{int64(-3), uint64(7), 1.5, true, nil},
+		C: map[string]int64{"k": 1},
+		D: []string{"d1", "d2"},
+		E: 5,
+	}
+	var bs []byte
+	e := NewEncoderBytes(&bs, h)
+	for i, fn := range []func() error{
+		func() error { return e.WriteMapStart(5) },
+		func() error { return e.WriteKey("A") },
+		func() error { return e.WriteString("a") },
+		func() error { return e.WriteKey("B") },
+		func() error { return e.WriteArrayStart(5) },
+		func() error { return e.WriteInt(-3) },
+		func() error { return e.WriteUint(7) },
+		func() error { return e.WriteFloat(1.5) },
+		func() error { return e.WriteBool(true) },
+		func() error { return e.WriteNil() },
+		e.WriteEnd,
+		func() error { return e.WriteKey("C") },
+		func() error { return e.Encode(v.C) },
+		func() error { return e.WriteKey("D") },
+		func() error { return e.Encode(v.D) },
+		func() error { return e.WriteKey("E") },
+		func() error { return e.WriteInt(5) },
+		e.WriteEnd,
+	} {
+		if err := fn(); err != nil {
+			t.Fatalf("%s: write %d: unexpected error: %v", name, i, err)
+		}
+	}
+	testDeepEqualErr(bs, testMarshalErr(v, h, t, name+"-write"), t, name+"-write")
+	var v2 T
+	testUnmarshalErr(&v2, bs, h, t, name+"-write")
+	testDeepEqualErr(v2.D, v.D, t, name+"-write")
+
+	// output to an io.Writer is flushed once the top-level value is complete
+	var buf bytes.Buffer
+	e = NewEncoder(&buf, h)
+	if err := e.WriteArrayStart(1); err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+	if err := e.WriteString("a"); err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+	if err := e.WriteEnd(); err != nil {
+		t.Fatalf("%s: unexpected error: %v", name, err)
+	}
+	testDeepEqualErr(buf.Bytes(), testMarshalErr([]string{"a"}, h, t, name+"-write"), t, name+"-write-writer")
+
+	var testWriteErr = func(prefix, errstr string, fns ...func(e *Encoder) error) {
+		var err error
+		e := NewEncoderBytes(&bs, h)
+		for _, fn := range fns {
+			if err = fn(e); err != nil {
+				break
+			}
+		}
+		if err == nil || !strings.Contains(err.Error(), errstr) {
+			t.Fatalf("%s: %s: expected error containing %q, got %v", name, prefix, errstr, err)
+		}
+		if err2 := e.WriteNil(); err2 != err {
+			t.Fatalf("%s: %s: expected error to be retained, got %v", name, prefix, err2)
+		}
+	}
+	testWriteErr("too-few", "started with 2 elements, but 1 were written",
+		func(e *Encoder) error { return e.WriteArrayStart(2) },
+		func(e *Encoder) error { return e.WriteInt(1) },
+		(*Encoder).WriteEnd)
+	testWriteErr("too-many", "started with 1 entries, which were all written",
+		func(e *Encoder) error { return e.WriteMapStart(1) },
+		func(e *Encoder) error { return e.WriteKey("a") },
+		func(e *Encoder) error { return e.WriteInt(1) },
+		func(e *Encoder) error { return e.WriteKey("b") })
+	testWriteErr("key-in-array", "an array element is expected",
+		func(e *Encoder) error { return e.WriteArrayStart(1) },
+		func(e *Encoder) error { return e.WriteKey("a") })
+	testWriteErr("missing-value", "a map value is expected",
+		func(e *Encoder) error { return e.WriteMapStart(1) },
+		func(e *Encoder) error { return e.WriteKey("a") },
+		(*Encoder).WriteEnd)
+	testWriteErr("unstarted", "no map or array was started",
+		(*Encoder).WriteEnd)
+}
+
+func doTestLookup(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	type Task struct {
+		Name  string
+		Count int
+	}
+	type Spec struct {
+		Tasks []Task
+		Ints  map[int]string
+	}
+	v := struct {
+		ID   string
+		Spec Spec
+	}{
+		ID: "id",
+		Spec: Spec{
+			Tasks: []Task{{"t0", 0}, {"t1", 1}, {"t2", 2}},
+			Ints:  map[int]string{1: "one", 2: "two"},
+		},
+	}
+	bs := testMarshalErr(v, h, t, name+"-lookup")
+	d := NewDecoderBytes(nil, h)
+
+	var s string
+	for path, expect := range map[string]string{
+		"ID":                 "id",
+		"Spec.Tasks[1].Name": "t1",
+		"Spec.Tasks[2].Name": "t2",
+		"Spec.Ints[2]":       "two",
+	} {
+		s = ""
+		d.ResetBytes(bs)
+		if found, err := d.LookupDecode(path, &s); !found || err != nil {
+			t.Fatalf("%s: lookup %s: found: %v, err: %v", name, path, found, err)
+		}
+		testDeepEqualErr(s, expect, t, name+"-lookup-"+path)
+	}
+
+	d.ResetBytes(bs)
+	raw, err := d.Lookup("Spec.Tasks[1]")
+	if err != nil {
+		t.Fatalf("%s: lookup: unexpected error: %v", name, err)
+	}
+	var task Task
+	testUnmarshalErr(&task, raw, h, t, name+"-lookup-raw")
+	testDeepEqualErr(task, v.Spec.Tasks[1], t, name+"-lookup-raw")
+
+	for _, path := range []string{"Spec.Tasks[3].Name", "Spec.Missing", "ID.Name", "[0]", "Spec.Ints[3]", "Spec.Tasks.Name"} {
+		d.ResetBytes(bs)
+		if raw, err = d.Lookup(path); raw != nil || err != nil {
+			t.Fatalf("%s: lookup %s: expected not found, got %v, err: %v", name, path, raw, err)
+		}
+	}
+	for _, path := range []string{"Spec..Tasks", "Spec.Tasks[x]", "Spec.Tasks[1", "Spec.[1]", ".Spec", "Spec.Tasks[1]Name"} {
+		d.ResetBytes(bs)
+		if _, err = d.Lookup(path); err == nil || !strings.Contains(err.Error(), "invalid path") {
+			t.Fatalf("%s: lookup %s: expected invalid path error, got %v", name, path, err)
+		}
+	}
+
+	if _, ok := h.(*JsonHandle); !ok {
+		if n := testing.AllocsPerRun(10, func() {
+			d.ResetBytes(bs)
+			if raw, _ = d.Lookup("Spec.Tasks[2].Name"); raw == nil {
+				t.Fatalf("%s: lookup: not found", name)
+			}
+		}); n != 0 {
+			t.Fatalf("%s: lookup: expected no allocations, got %v", name, n)
+		}
+	}
+}
+
+func doTestTokenTopLevel(t *testing.T, name string, h Handle) {
+	testOnce.Do(testInitAll)
+	bs := testMarshalErr([]int{1}, h, t, name+"-token")
+	bs = append(bs, testMarshalErr("next", h, t, name+"-token")...)
+	d := NewDecoderBytes(bs, h)
+	var kinds []TokenKind
+	for {
+		k, err := d.PeekKind()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		tk, err := d.Token()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+		if k != tk.Kind && !(k == TokenNumber && (tk.Kind == TokenInt || tk.Kind == TokenUint)) {
+			t.Fatalf("%s: PeekKind returned %v, but Token returned %v", name, k, tk.Kind)
+		}
+		kinds = append(kinds, tk.Kind)
+	}
+	testDeepEqualErr(len(kinds), 4, t, name+"-token-top-level")
+	testDeepEqualErr(kinds[3], TokenString, t, name+"-token-top-level")
+	if _, err := d.Token(); err != io.EOF {
+		t.Fatalf("%s: expected io.EOF, got %v", name, err)
+	}
+}
+
+// -----------------
+
+func TestJsonDecodeNonStringScalarInStringContext(t *testing.T) {
+	testOnce.Do(testInitAll)
+	var b = `{"s.true": "true", "b.true": true, "s.false": "false", "b.false": false, "s.10": "10", "i.10": 10, "i.-10": -10}`
+	var golden = map[string]string{"s.true": "true", "b.true": "true", "s.false": "false", "b.false": "false", "s.10": "10", "i.10": "10", "i.-10": "-10"}
+
+	var m map[string]string
+	d := NewDecoderBytes([]byte(b), testJsonH)
+	d.MustDecode(&m)
+	if err := internal.DeepEqual(golden, m); err == nil {
+		logT(t, "++++ match: decoded: %#v", m)
+	} else {
+		logT(t, "---- mismatch: %v ==> golden: %#v, decoded: %#v", err, golden, m)
+		failT(t)
+	}
+}
+
+func TestJsonEncodeIndent(t *testing.T) {
+	testOnce.Do(testInitAll)
+	v := TestSimplish{
+		Ii: -794,
+		Ss: `A Man is
+after the new line
+	after new line and tab
+`,
+	}
+	v2 := v
+	v.Mm = make(map[string]*TestSimplish)
+	for i := 0; i < len(v.Ar); i++ {
+		v3 := v2
+		v3.Ii += (i * 4)
+		v3.Ss = fmt.Sprintf("%d - %s", v3.Ii, v3.Ss)
+		if i%2 == 0 {
+			v.Ar[i] = &v3
+		}
+		// v3 = v2
+		v.Sl = append(v.Sl, &v3)
+		v.Mm[strconv.FormatInt(int64(i), 10)] = &v3
+	}
+	oldcan := testJsonH.Canonical
+	oldIndent := testJsonH.Indent
+	oldS2A := testJsonH.StructToArray
+	defer func() {
+		testJsonH.Canonical = oldcan
+		testJsonH.Indent = oldIndent
+		testJsonH.StructToArray = oldS2A
+	}()
+	testJsonH.Canonical = true
+	testJsonH.Indent = -1
+	testJsonH.StructToArray = false
+	var bs []byte
+	NewEncoderBytes(&bs, testJsonH).MustEncode(&v)
+	txt1Tab := string(bs)
+	bs = nil
+	testJsonH.Indent = 120
+	NewEncoderBytes(&bs, testJsonH).MustEncode(&v)
+	txtSpaces := string(bs)
+	// fmt.Printf("\n-----------\n%s\n------------\n%s\n-------------\n", txt1Tab, txtSpaces)
+
+	goldenResultTab := `{
 	"Ar": [
 		{
 			"Ar": [
@@ -2885,6 +4256,221 @@ func TestMsgpackDecodeMapAndExtSizeMismatch(t *testing.T) {
 	// fn(t, b, &s)
 }
 
+func TestCborGoldens(t *testing.T) {
+	testOnce.Do(testInitAll)
+	// examples from RFC 8949 Appendix A, encoded using the deterministic encoding.
+	var h CborHandle
+	h.Canonical = true
+	for _, v := range []struct {
+		hex string
+		v   interface{}
+	}{
+		{"00", uint64(0)},
+		{"17", uint64(23)},
+		{"1818", uint64(24)},
+		{"1903e8", uint64(1000)},
+		{"1a000f4240", uint64(1000000)},
+		{"1b000000e8d4a51000", uint64(1000000000000)},
+		{"1bffffffffffffffff", uint64(math.MaxUint64)},
+		{"20", int64(-1)},
+		{"3863", int64(-100)},
+		{"3903e7", int64(-1000)},
+		{"f93e00", float64(1.5)},
+		{"f97bff", float64(65504.0)},
+		{"f90001", float64(5.960464477539063e-8)},
+		{"f9c400", float64(-4.0)},
+		{"fa47c35000", float64(100000.0)},
+		{"fb3ff199999999999a", float64(1.1)},
+		{"f97c00", math.Inf(1)},
+		{"f9fc00", math.Inf(-1)},
+		{"f4", false},
+		{"f5", true},
+		{"f6", nil},
+		{"60", ""},
+		{"6449455446", "IETF"},
+		{"4401020304", []byte{1, 2, 3, 4}},
+		{"83010203", []interface{}{uint64(1), uint64(2), uint64(3)}},
+		{"a26161016162820203", map[string]interface{}{"a": uint64(1), "b": []interface{}{uint64(2), uint64(3)}}},
+		{"c11a514b67b0", time.Unix(1363896240, 0).UTC()},
+	} {
+		bs, err := hex.DecodeString(v.hex)
+		checkErrT(t, err)
+		var out []byte
+		NewEncoderBytes(&out, &h).MustEncode(v.v)
+		if !bytes.Equal(bs, out) {
+			failT(t, "cbor encode %v: expected %x, got %x", v.v, bs, out)
+		}
+		var v2 interface{}
+		h.MapType = testMapStrIntfTyp
+		NewDecoderBytes(bs, &h).MustDecode(&v2)
+		testDeepEqualErr(v.v, v2, t, "cbor-golden-"+v.hex)
+	}
+
+	// NaN cannot be compared with DeepEqual
+	var out []byte
+	NewEncoderBytes(&out, &h).MustEncode(math.NaN())
+	if hex.EncodeToString(out) != "f97e00" {
+		failT(t, "cbor encode NaN: expected f97e00, got %x", out)
+	}
+	var f float64
+	NewDecoderBytes(out, &h).MustDecode(&f)
+	if !math.IsNaN(f) {
+		failT(t, "cbor decode NaN: got %v", f)
+	}
+}
+
+func TestCborIndefiniteLength(t *testing.T) {
+	testOnce.Do(testInitAll)
+	var h CborHandle
+	h.MapType = testMapStrIntfTyp
+	for _, v := range []struct {
+		hex string
+		v   interface{}
+	}{
+		{"5f42010243030405ff", []byte{1, 2, 3, 4, 5}},
+		{"7f657374726561646d696e67ff", "streaming"},
+		{"9fff", []interface{}{}},
+		{"9f018202039f0405ffff", []interface{}{uint64(1), []interface{}{uint64(2), uint64(3)}, []interface{}{uint64(4), uint64(5)}}},
+		{"bf61610161629f0203ffff", map[string]interface{}{"a": uint64(1), "b": []interface{}{uint64(2), uint64(3)}}},
+	} {
+		bs, err := hex.DecodeString(v.hex)
+		checkErrT(t, err)
+		var v2 interface{}
+		NewDecoderBytes(bs, &h).MustDecode(&v2)
+		testDeepEqualErr(v.v, v2, t, "cbor-indefinite-"+v.hex)
+	}
+
+	// a chunk length far larger than the input fails, without allocating it first
+	for _, x := range []string{"5f5b000000100000000000", "7f7b000000100000000000", "5f5b0000001000000000ffff"} {
+		bs, err := hex.DecodeString(x)
+		checkErrT(t, err)
+		var v2 interface{}
+		if err = NewDecoderBytes(bs, &h).Decode(&v2); err == nil {
+			t.Fatalf("cbor-indefinite-huge-chunk %s: expected an error", x)
+		}
+		if err = NewDecoder(bytes.NewReader(bs), &h).Decode(&v2); err == nil {
+			t.Fatalf("cbor-indefinite-huge-chunk-reader %s: expected an error", x)
+		}
+		if _, err = NewDecoderBytes(bs, &h).Token(); err == nil {
+			t.Fatalf("cbor-indefinite-huge-chunk-token %s: expected an error", x)
+		}
+		var out []byte
+		if err = new(Transcoder).Transcode(NewEncoderBytes(&out, testMsgpackH), NewDecoderBytes(bs, &h)); err == nil {
+			t.Fatalf("cbor-indefinite-huge-chunk-transcode %s: expected an error", x)
+		}
+	}
+
+	// round-trip a struct through indefinite-length containers
+	h.IndefiniteLength = true
+	v := TestSimplish{Ii: 9, Ss: "ss", Ar: [2]*TestSimplish{{Ii: 1}}, Sl: []*TestSimplish{{Ss: "sl"}}, Mm: map[string]*TestSimplish{"m": {Ii: 2}}}
+	var bs []byte
+	NewEncoderBytes(&bs, &h).MustEncode(v)
+	if bs[0] != cborBdIndefiniteMap || bs[len(bs)-1] != cborBdBreak {
+		failT(t, "expected indefinite-length map, got %x", bs)
+	}
+	var v2 TestSimplish
+	NewDecoderBytes(bs, &h).MustDecode(&v2)
+	testDeepEqualErr(v, v2, t, "cbor-indefinite-struct")
+}
+
+func TestCborCanonical(t *testing.T) {
+	testOnce.Do(testInitAll)
+	var h CborHandle
+	h.Canonical = true
+	h.IndefiniteLength = true // ignored, as canonical requires definite lengths
+	for _, v := range []struct {
+		hex string
+		v   interface{}
+	}{
+		// keys sorted by encoded bytes: shorter strings first
+		{"a361610361620162616102", map[string]int{"b": 1, "aa": 2, "a": 3}},
+		// keys sorted by encoded bytes: positive integers before negative ones
+		{"a400010a0220003403", map[int]int{-21: 3, 10: 2, -1: 0, 0: 1}},
+		// struct fields are sorted as map keys are, not by name
+		{"a361610361620162616102", struct {
+			AA int `codec:"aa"`
+			A  int `codec:"a"`
+			B  int `codec:"b"`
+		}{2, 3, 1}},
+		{"a400010a0220003403", struct {
+			_struct struct{} `codec:",int"`
+			M21     int      `codec:"-21"`
+			P10     int      `codec:"10"`
+			M1      int      `codec:"-1"`
+			Z       int      `codec:"0"`
+		}{M21: 3, P10: 2, M1: 0, Z: 1}},
+	} {
+		var out []byte
+		NewEncoderBytes(&out, &h).MustEncode(v.v)
+		if hex.EncodeToString(out) != v.hex {
+			failT(t, "cbor canonical %v: expected %s, got %x", v.v, v.hex, out)
+		}
+	}
+}
+
+func TestCborHalfFloat(t *testing.T) {
+	testOnce.Do(testInitAll)
+	// every half-precision value must round-trip through float32
+	for i := 0; i <= math.MaxUint16; i++ {
+		h := uint16(i)
+		f := cborHalfFloatToFloat32(h)
+		if math.IsNaN(float64(f)) {
+			continue
+		}
+		h2, ok := cborFloat16(f)
+		if !ok || h2 != h {
+			failT(t, "half float 0x%04x: decoded to %v, re-encoded to 0x%04x (%v)", h, f, h2, ok)
+		}
+	}
+	for _, f := range []float32{1.0 / 3, 65520, 1e-8, math.MaxFloat32} {
+		if _, ok := cborFloat16(f); ok {
+			failT(t, "%v should not be representable as a half float", f)
+		}
+	}
+}
+
+func TestCborTags(t *testing.T) {
+	testOnce.Do(testInitAll)
+	var h CborHandle
+	// time via tag 0 and tag 1 (with fractional seconds)
+	for _, v := range []struct {
+		hex string
+		t   time.Time
+	}{
+		{"c074323031332d30332d32315432303a30343a30305a", time.Unix(1363896240, 0).UTC()},
+		{"c1fb41d452d9ec200000", time.Unix(1363896240, 5e8).UTC()},
+	} {
+		bs, err := hex.DecodeString(v.hex)
+		checkErrT(t, err)
+		var tt time.Time
+		NewDecoderBytes(bs, &h).MustDecode(&tt)
+		testDeepEqualErr(v.t, tt, t, "cbor-time-"+v.hex)
+	}
+
+	// unregistered tags are decoded as a RawExt, unless they are skipped
+	bs, _ := hex.DecodeString("d9d9f7d82063666f6f") // 55799(32("foo"))
+	var v interface{}
+	NewDecoderBytes(bs, &h).MustDecode(&v)
+	testDeepEqualErr(RawExt{Tag: 55799, Value: RawExt{Tag: 32, Value: "foo"}}, v, t, "cbor-rawext")
+	h.SkipUnexpectedTags = true
+	v = nil
+	NewDecoderBytes(bs, &h).MustDecode(&v)
+	testDeepEqualErr("foo", v, t, "cbor-skip-tags")
+
+	// registered tags go through the extension
+	h.SignedInteger = true // wrapInt64Ext expects an int64
+	var tI64Ext wrapInt64Ext
+	checkErrT(t, h.SetInterfaceExt(wrapInt64Typ, 16, &tI64Ext))
+	var out []byte
+	NewEncoderBytes(&out, &h).MustEncode(wrapInt64(7))
+	if hex.EncodeToString(out) != "d007" {
+		failT(t, "cbor ext: expected d007, got %x", out)
+	}
+	var w wrapInt64
+	NewDecoderBytes(out, &h).MustDecode(&w)
+	testDeepEqualErr(wrapInt64(7), w, t, "cbor-ext")
+}
+
 // ----------
 
 func TestMsgpackCodecsTable(t *testing.T) {
@@ -2907,6 +4493,34 @@ func TestMsgpackMammoth(t *testing.T) {
 	testMammoth(t, "msgpack", testMsgpackH)
 }
 
+func TestCborCodecsTable(t *testing.T) {
+	testCodecTableOne(t, testCborH)
+}
+
+func TestCborCodecsMisc(t *testing.T) {
+	testCodecMiscOne(t, testCborH)
+}
+
+func TestCborCodecsEmbeddedPointer(t *testing.T) {
+	testCodecEmbeddedPointer(t, testCborH)
+}
+
+func TestCborCodecChan(t *testing.T) {
+	testCodecChan(t, testCborH)
+}
+
+func TestCborStdEncIntf(t *testing.T) {
+	doTestStdEncIntf(t, "cbor", testCborH)
+}
+
+func TestCborMammoth(t *testing.T) {
+	testMammoth(t, "cbor", testCborH)
+}
+
+func TestCborMapEncodeForCanonical(t *testing.T) {
+	doTestMapEncodeForCanonical(t, "cbor", testCborH)
+}
+
 func TestJsonCodecsTable(t *testing.T) {
 	testCodecTableOne(t, testJsonH)
 }
@@ -2939,6 +4553,10 @@ func TestMsgpackRaw(t *testing.T) {
 	doTestRawValue(t, "msgpack", testMsgpackH)
 }
 
+func TestCborRaw(t *testing.T) {
+	doTestRawValue(t, "cbor", testCborH)
+}
+
 // ----- ALL (framework based) -----
 
 func TestAllErrWriter(t *testing.T) {
@@ -2959,6 +4577,30 @@ func TestMsgpackRpcSpec(t *testing.T) {
 	testCodecRpcOne(t, MsgpackSpecRpc, testMsgpackH, true, 0)
 }
 
+func TestMsgpackRpcSpecNotifications(t *testing.T) {
+	doTestMsgpackRpcSpecNotifications(t)
+}
+
+func TestMsgpackRpcSpecMultiArgs(t *testing.T) {
+	doTestMsgpackRpcSpecMultiArgs(t)
+}
+
+func TestMsgpackRpcSpecErrors(t *testing.T) {
+	doTestMsgpackRpcSpecErrors(t)
+}
+
+func TestJsonRpc2(t *testing.T) {
+	testCodecRpcOne(t, JsonRpc2, testJsonH, true, 0)
+}
+
+func TestJsonRpc2Protocol(t *testing.T) {
+	doTestJsonRpc2(t)
+}
+
+func TestCborRpcGo(t *testing.T) {
+	testCodecRpcOne(t, GoRpc, testCborH, true, 0)
+}
+
 func TestJsonSwallowAndZero(t *testing.T) {
 	doTestSwallowAndZero(t, testJsonH)
 }
@@ -2967,6 +4609,10 @@ func TestMsgpackSwallowAndZero(t *testing.T) {
 	doTestSwallowAndZero(t, testMsgpackH)
 }
 
+func TestCborSwallowAndZero(t *testing.T) {
+	doTestSwallowAndZero(t, testCborH)
+}
+
 func TestJsonRawExt(t *testing.T) {
 	doTestRawExt(t, testJsonH)
 }
@@ -2975,6 +4621,10 @@ func TestMsgpackRawExt(t *testing.T) {
 	doTestRawExt(t, testMsgpackH)
 }
 
+func TestCborRawExt(t *testing.T) {
+	doTestRawExt(t, testCborH)
+}
+
 func TestJsonMapStructKey(t *testing.T) {
 	doTestMapStructKey(t, testJsonH)
 }
@@ -2983,6 +4633,10 @@ func TestMsgpackMapStructKey(t *testing.T) {
 	doTestMapStructKey(t, testMsgpackH)
 }
 
+func TestCborMapStructKey(t *testing.T) {
+	doTestMapStructKey(t, testCborH)
+}
+
 func TestJsonDecodeNilMapValue(t *testing.T) {
 	doTestDecodeNilMapValue(t, testJsonH)
 }
@@ -2991,6 +4645,10 @@ func TestMsgpackDecodeNilMapValue(t *testing.T) {
 	doTestDecodeNilMapValue(t, testMsgpackH)
 }
 
+func TestCborDecodeNilMapValue(t *testing.T) {
+	doTestDecodeNilMapValue(t, testCborH)
+}
+
 func TestJsonEmbeddedFieldPrecedence(t *testing.T) {
 	doTestEmbeddedFieldPrecedence(t, testJsonH)
 }
@@ -2999,6 +4657,10 @@ func TestMsgpackEmbeddedFieldPrecedence(t *testing.T) {
 	doTestEmbeddedFieldPrecedence(t, testMsgpackH)
 }
 
+func TestCborEmbeddedFieldPrecedence(t *testing.T) {
+	doTestEmbeddedFieldPrecedence(t, testCborH)
+}
+
 func TestJsonLargeContainerLen(t *testing.T) {
 	doTestLargeContainerLen(t, testJsonH)
 }
@@ -3007,6 +4669,10 @@ func TestMsgpackLargeContainerLen(t *testing.T) {
 	doTestLargeContainerLen(t, testMsgpackH)
 }
 
+func TestCborLargeContainerLen(t *testing.T) {
+	doTestLargeContainerLen(t, testCborH)
+}
+
 func TestJsonMammothMapsAndSlices(t *testing.T) {
 	doTestMammothMapsAndSlices(t, testJsonH)
 }
@@ -3019,6 +4685,10 @@ func TestMsgpackMammothMapsAndSlices(t *testing.T) {
 	doTestMammothMapsAndSlices(t, testMsgpackH)
 }
 
+func TestCborMammothMapsAndSlices(t *testing.T) {
+	doTestMammothMapsAndSlices(t, testCborH)
+}
+
 func TestJsonTime(t *testing.T) {
 	testTime(t, "json", testJsonH)
 }
@@ -3027,6 +4697,10 @@ func TestMsgpackTime(t *testing.T) {
 	testTime(t, "msgpack", testMsgpackH)
 }
 
+func TestCborTime(t *testing.T) {
+	testTime(t, "cbor", testCborH)
+}
+
 func TestJsonUintToInt(t *testing.T) {
 	testUintToInt(t, "json", testJsonH)
 }
@@ -3035,6 +4709,10 @@ func TestMsgpackUintToInt(t *testing.T) {
 	testUintToInt(t, "msgpack", testMsgpackH)
 }
 
+func TestCborUintToInt(t *testing.T) {
+	testUintToInt(t, "cbor", testCborH)
+}
+
 func TestJsonDifferentMapOrSliceType(t *testing.T) {
 	doTestDifferentMapOrSliceType(t, "json", testJsonH)
 }
@@ -3043,6 +4721,10 @@ func TestMsgpackDifferentMapOrSliceType(t *testing.T) {
 	doTestDifferentMapOrSliceType(t, "msgpack", testMsgpackH)
 }
 
+func TestCborDifferentMapOrSliceType(t *testing.T) {
+	doTestDifferentMapOrSliceType(t, "cbor", testCborH)
+}
+
 func TestJsonScalars(t *testing.T) {
 	doTestScalars(t, "json", testJsonH)
 }
@@ -3051,6 +4733,10 @@ func TestMsgpackScalars(t *testing.T) {
 	doTestScalars(t, "msgpack", testMsgpackH)
 }
 
+func TestCborScalars(t *testing.T) {
+	doTestScalars(t, "cbor", testCborH)
+}
+
 func TestJsonOmitempty(t *testing.T) {
 	doTestOmitempty(t, "json", testJsonH)
 }
@@ -3059,6 +4745,10 @@ func TestMsgpackOmitempty(t *testing.T) {
 	doTestOmitempty(t, "msgpack", testMsgpackH)
 }
 
+func TestCborOmitempty(t *testing.T) {
+	doTestOmitempty(t, "cbor", testCborH)
+}
+
 func TestJsonIntfMapping(t *testing.T) {
 	doTestIntfMapping(t, "json", testJsonH)
 }
@@ -3067,6 +4757,10 @@ func TestMsgpackIntfMapping(t *testing.T) {
 	doTestIntfMapping(t, "msgpack", testMsgpackH)
 }
 
+func TestCborIntfMapping(t *testing.T) {
+	doTestIntfMapping(t, "cbor", testCborH)
+}
+
 func TestJsonMissingFields(t *testing.T) {
 	doTestMissingFields(t, "json", testJsonH)
 }
@@ -3075,6 +4769,82 @@ func TestMsgpackMissingFields(t *testing.T) {
 	doTestMissingFields(t, "msgpack", testMsgpackH)
 }
 
+func TestCborMissingFields(t *testing.T) {
+	doTestMissingFields(t, "cbor", testCborH)
+}
+
+func TestJsonUnknownFields(t *testing.T) {
+	doTestUnknownFields(t, "json", testJsonH)
+}
+
+func TestMsgpackUnknownFields(t *testing.T) {
+	doTestUnknownFields(t, "msgpack", testMsgpackH)
+}
+
+func TestCborUnknownFields(t *testing.T) {
+	doTestUnknownFields(t, "cbor", testCborH)
+}
+
+func TestJsonFieldAliases(t *testing.T) {
+	doTestFieldAliases(t, "json", testJsonH)
+}
+
+func TestMsgpackFieldAliases(t *testing.T) {
+	doTestFieldAliases(t, "msgpack", testMsgpackH)
+}
+
+func TestCborFieldAliases(t *testing.T) {
+	doTestFieldAliases(t, "cbor", testCborH)
+}
+
+func TestJsonRequiredFields(t *testing.T) {
+	doTestRequiredFields(t, "json", testJsonH)
+}
+
+func TestMsgpackRequiredFields(t *testing.T) {
+	doTestRequiredFields(t, "msgpack", testMsgpackH)
+}
+
+func TestCborRequiredFields(t *testing.T) {
+	doTestRequiredFields(t, "cbor", testCborH)
+}
+
+func TestJsonFieldDefaults(t *testing.T) {
+	doTestFieldDefaults(t, "json", testJsonH)
+}
+
+func TestMsgpackFieldDefaults(t *testing.T) {
+	doTestFieldDefaults(t, "msgpack", testMsgpackH)
+}
+
+func TestCborFieldDefaults(t *testing.T) {
+	doTestFieldDefaults(t, "cbor", testCborH)
+}
+
+func TestJsonFieldSet(t *testing.T) {
+	doTestFieldSet(t, "json", testJsonH)
+}
+
+func TestMsgpackFieldSet(t *testing.T) {
+	doTestFieldSet(t, "msgpack", testMsgpackH)
+}
+
+func TestCborFieldSet(t *testing.T) {
+	doTestFieldSet(t, "cbor", testCborH)
+}
+
+func TestJsonFieldMask(t *testing.T) {
+	doTestFieldMask(t, "json", testJsonH)
+}
+
+func TestMsgpackFieldMask(t *testing.T) {
+	doTestFieldMask(t, "msgpack", testMsgpackH)
+}
+
+func TestCborFieldMask(t *testing.T) {
+	doTestFieldMask(t, "cbor", testCborH)
+}
+
 func TestJsonMaxDepth(t *testing.T) {
 	doTestMaxDepth(t, "json", testJsonH)
 }
@@ -3083,6 +4853,558 @@ func TestMsgpackMaxDepth(t *testing.T) {
 	doTestMaxDepth(t, "msgpack", testMsgpackH)
 }
 
+func TestCborMaxDepth(t *testing.T) {
+	doTestMaxDepth(t, "cbor", testCborH)
+}
+
+func TestJsonStructuredErrors(t *testing.T) {
+	doTestStructuredErrors(t, "json", testJsonH)
+}
+
+func TestMsgpackStructuredErrors(t *testing.T) {
+	doTestStructuredErrors(t, "msgpack", testMsgpackH)
+}
+
+func TestCborStructuredErrors(t *testing.T) {
+	doTestStructuredErrors(t, "cbor", testCborH)
+}
+
+func TestJsonMarshalGeneric(t *testing.T) {
+	doTestMarshalGeneric(t, "json", testJsonH)
+}
+
+func TestMsgpackMarshalGeneric(t *testing.T) {
+	doTestMarshalGeneric(t, "msgpack", testMsgpackH)
+}
+
+func TestCborMarshalGeneric(t *testing.T) {
+	doTestMarshalGeneric(t, "cbor", testCborH)
+}
+
+func TestJsonDecodeSeq(t *testing.T) {
+	doTestDecodeSeq(t, "json", testJsonH)
+}
+
+func TestMsgpackDecodeSeq(t *testing.T) {
+	doTestDecodeSeq(t, "msgpack", testMsgpackH)
+}
+
+func TestCborDecodeSeq(t *testing.T) {
+	doTestDecodeSeq(t, "cbor", testCborH)
+}
+
+func TestJsonFastpath(t *testing.T) {
+	doTestFastpath(t, "json", testJsonH)
+}
+
+func TestMsgpackFastpath(t *testing.T) {
+	doTestFastpath(t, "msgpack", testMsgpackH)
+}
+
+func TestCborFastpath(t *testing.T) {
+	doTestFastpath(t, "cbor", testCborH)
+}
+
+func TestJsonDecodeLimits(t *testing.T) {
+	doTestDecodeLimits(t, "json", testJsonH)
+}
+
+func TestMsgpackDecodeLimits(t *testing.T) {
+	doTestDecodeLimits(t, "msgpack", testMsgpackH)
+}
+
+func TestCborDecodeLimits(t *testing.T) {
+	doTestDecodeLimits(t, "cbor", testCborH)
+}
+
+func TestJsonLookup(t *testing.T) {
+	doTestLookup(t, "json", testJsonH)
+}
+
+func TestMsgpackLookup(t *testing.T) {
+	doTestLookup(t, "msgpack", testMsgpackH)
+}
+
+func TestCborLookup(t *testing.T) {
+	doTestLookup(t, "cbor", testCborH)
+}
+
+func TestJsonToken(t *testing.T) {
+	doTestToken(t, "json", testJsonH)
+	doTestTokenTopLevel(t, "json", testJsonH)
+	doTestEncodeTokens(t, "json", testJsonH)
+
+	var bs []byte
+	e := NewEncoderBytes(&bs, testJsonH)
+	for _, err := range []error{e.WriteMapStart(-1), e.WriteKey("a"), e.WriteInt(1), e.WriteEnd()} {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	testDeepEqualErr(string(bs), `{"a":1}`, t, "json-write-unknown-length")
+}
+
+func TestMsgpackToken(t *testing.T) {
+	doTestToken(t, "msgpack", testMsgpackH)
+	doTestTokenTopLevel(t, "msgpack", testMsgpackH)
+	doTestEncodeTokens(t, "msgpack", testMsgpackH)
+
+	var bs []byte
+	if err := NewEncoderBytes(&bs, testMsgpackH).WriteMapStart(-1); err == nil {
+		t.Fatalf("expected error starting a msgpack map without a length")
+	}
+}
+
+func TestCborToken(t *testing.T) {
+	doTestToken(t, "cbor", testCborH)
+	doTestTokenTopLevel(t, "cbor", testCborH)
+	doTestEncodeTokens(t, "cbor", testCborH)
+
+	// indefinite-length array containing a time and a tagged value
+	bs, _ := hex.DecodeString("9fc101d82a01ff")
+	d := NewDecoderBytes(bs, &CborHandle{})
+	var toks []Token
+	for {
+		tk, err := d.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		toks = append(toks, tk)
+	}
+	testDeepEqualErr(len(toks), 5, t, "cbor-token-indefinite")
+	testDeepEqualErr(toks[0].Len, -1, t, "cbor-token-indefinite")
+	testDeepEqualErr(toks[1].Kind, TokenTime, t, "cbor-token-time")
+	testDeepEqualErr(toks[1].Time.Unix(), int64(1), t, "cbor-token-time")
+	testDeepEqualErr(toks[2].Kind, TokenExt, t, "cbor-token-tag")
+	testDeepEqualErr(toks[2].Tag, uint64(42), t, "cbor-token-tag")
+	testDeepEqualErr(toks[3].Uint, uint64(1), t, "cbor-token-tagged-value")
+	testDeepEqualErr(toks[4].Kind, TokenEnd, t, "cbor-token-indefinite")
+}
+
+func TestTranscode(t *testing.T) {
+	testOnce.Do(testInitAll)
+	var mh MsgpackHandle
+	mh.WriteExt = true
+	mh.Canonical = true
+	var jh JsonHandle
+	var ch CborHandle
+	ch.Canonical = true
+
+	tm := time.Date(2020, 1, 2, 3, 4, 5, 6000, time.UTC)
+	v := map[string]interface{}{
+		"a": int64(-1),
+		"b": uint64(1 << 60),
+		"c": 1.5,
+		"d": []byte{1, 2, 3},
+		"e": tm,
+		"f": []interface{}{"x", true, nil, 2.0},
+		"g": map[int]string{1: "one", 2: "two"},
+		"h": &RawExt{Tag: 5, Data: []byte("ext")},
+	}
+	var transcode = func(tc *Transcoder, bs []byte, from, to Handle) (out []byte, err error) {
+		err = tc.Transcode(NewEncoderBytes(&out, to), NewDecoderBytes(bs, from))
+		return
+	}
+	var mustTranscode = func(tc *Transcoder, bs []byte, from, to Handle) []byte {
+		out, err := transcode(tc, bs, from, to)
+		if err != nil {
+			t.Fatalf("transcode %s to %s: unexpected error: %v", from.Name(), to.Name(), err)
+		}
+		return out
+	}
+	mbs := testMarshalErr(v, &mh, t, "transcode")
+
+	// native: bytes as base64, time as a string, ext as its data, int keys as is
+	var tc Transcoder
+	testDeepEqualErr(string(mustTranscode(&tc, mbs, &mh, &jh)),
+		`{"a":-1,"b":1152921504606846976,"c":1.5,"d":"AQID","e":"2020-01-02T03:04:05.000006Z",`+
+			`"f":["x",true,null,2.0],"g":{1:"one",2:"two"},"h":"ZXh0"}`, t, "transcode-native")
+
+	// tagged: all values round-trip, back to identical bytes
+	tc = Transcoder{Bytes: TranscodeTagged, Ext: TranscodeTagged, Time: TranscodeTagged, MapKeys: TranscodeTagged}
+	jbs := mustTranscode(&tc, mbs, &mh, &jh)
+	testDeepEqualErr(string(jbs),
+		`{"a":-1,"b":1152921504606846976,"c":1.5,"d":{"$bin":"AQID"},"e":{"$time":"2020-01-02T03:04:05.000006Z"},`+
+			`"f":["x",true,null,2.0],"g":{"$map":[1,"one",2,"two"]},"h":{"$ext":[5,"ZXh0"]}}`, t, "transcode-tagged")
+	testDeepEqualErr(mustTranscode(&tc, jbs, &jh, &mh), mbs, t, "transcode-tagged-roundtrip")
+
+	// between binary formats, all values are transcoded natively
+	cbs := mustTranscode(&tc, mbs, &mh, &ch)
+	testDeepEqualErr(mustTranscode(&tc, cbs, &ch, &mh), mbs, t, "transcode-cbor-roundtrip")
+	testDeepEqualErr(mustTranscode(&tc, mustTranscode(&tc, cbs, &ch, &jh), &jh, &ch), cbs, t, "transcode-cbor-json-roundtrip")
+
+	// json containers have no length, so are buffered when writing msgpack
+	jbs = []byte(`{"a":[1,[2,{"b":[]}],-3],"c":{}}`)
+	testDeepEqualErr(string(mustTranscode(&tc, mustTranscode(&tc, jbs, &jh, &mh), &mh, &jh)), string(jbs), t, "transcode-json-roundtrip")
+
+	for _, tc = range []Transcoder{{Bytes: TranscodeFail}, {Ext: TranscodeFail}, {Time: TranscodeFail}, {MapKeys: TranscodeFail}} {
+		if _, err := transcode(&tc, mbs, &mh, &jh); err == nil || !strings.Contains(err.Error(), "cannot write") {
+			t.Fatalf("transcode %+v: expected error, got %v", tc, err)
+		}
+	}
+}
+
+func TestMsgpackDump(t *testing.T) {
+	testOnce.Do(testInitAll)
+	var h MsgpackHandle
+	h.WriteExt = true
+	var bs []byte
+	NewEncoderBytes(&bs, &h).MustEncode([]interface{}{
+		map[string]interface{}{"a": -2},
+		300, 1.5, []byte{1, 2}, nil, time.Unix(5, 6).UTC(), &RawExt{Tag: 3, Data: []byte("x")},
+	})
+	var buf bytes.Buffer
+	if err := MsgpackDump(&buf, bs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testDeepEqualErr(strings.Split(buf.String(), "\n"), []string{
+		"     0  97                           fixarray   array        len=7",
+		"     1    81                         fixmap     map          len=1",
+		`     2      a1                       fixstr     string|bytes len=1 "a"`,
+		"     4      fe                       negfixint  int          -2",
+		"     5    d1 012c                    int16      int          300",
+		"     8    cb 3ff8000000000000        float64    float        1.5",
+		"    17    c4 02                      bin8       bytes        len=2 0102",
+		"    21    c0                         nil        nil          nil",
+		"    22    d7 ff                      fixext8    ext          len=8 tag=-1 time=1970-01-01T00:00:05.000000006Z",
+		"    32    d4 03                      fixext1    ext          len=1 tag=3 78",
+		"",
+	}, t, "msgpack-dump")
+
+	for _, v := range []struct {
+		b   []byte
+		err string
+	}{
+		{bs[:len(bs)-3], "offset 32: truncated fixarray at offset 0: have 6 of 7 items"},
+		{bs[:20], "offset 17: truncated bin8: need 2 bytes, have 1"},
+		{bs[:7], "offset 5: truncated int16: need 2 header bytes, have 1"},
+		{[]byte{0x92, 0x01, 0xc1}, "offset 2: invalid descriptor 0xc1"},
+		// lengths which do not fit in a 32-bit int
+		{[]byte{0xdb, 0xff, 0xff, 0xff, 0xff, 0x61}, "offset 0: truncated str32: need 4294967295 bytes, have 1"},
+		{[]byte{0xdf, 0xff, 0xff, 0xff, 0xff}, "offset 5: truncated map32 at offset 0: have 0 of 8589934590 items"},
+	} {
+		buf.Reset()
+		err := MsgpackDump(&buf, v.b)
+		if err == nil || !strings.Contains(err.Error(), v.err) {
+			t.Fatalf("expected error containing %q, got: %v", v.err, err)
+		}
+		if !strings.Contains(buf.String(), "!! "+v.err[strings.Index(v.err, ": ")+2:]) {
+			t.Fatalf("expected dump to mark the error, got:\n%s", buf.String())
+		}
+	}
+}
+
+func TestMsgpackValidate(t *testing.T) {
+	testOnce.Do(testInitAll)
+	var h MsgpackHandle
+	h.WriteExt = true
+	var bs []byte
+	NewEncoderBytes(&bs, &h).MustEncode([]interface{}{
+		map[string]interface{}{"a": -2},
+		300, 1.5, []byte{1, 2}, nil, time.Unix(5, 6).UTC(), &RawExt{Tag: 3, Data: []byte("x")},
+	})
+	// a str whose multi-byte runes straddle the chunks read from an io.Reader
+	var long []byte
+	NewEncoderBytes(&long, &h).MustEncode("a" + strings.Repeat("\u00e9", 2500))
+	longBad := append([]byte(nil), long...)
+	longBad[len(longBad)-1] = 0xff
+
+	for i, v := range []struct {
+		b    []byte
+		opts MsgpackValidateOptions
+		err  string // expected error, if any
+		rerr string // expected error when reading from an io.Reader, if different
+	}{
+		{b: bs},
+		{b: long, opts: MsgpackValidateOptions{ValidateUTF8: true}},
+		{b: append(bs[:len(bs):len(bs)], 0x01, 0x02), opts: MsgpackValidateOptions{Multiple: true}},
+		{b: nil, opts: MsgpackValidateOptions{Multiple: true}},
+		{b: nil, err: "offset 0: unexpected end of input: expected a value"},
+		{b: append(bs[:len(bs):len(bs)], 0x01), err: "offset 35: unexpected trailing bytes after value"},
+		{b: []byte{0x92, 0x01, 0xc1}, err: "offset 2: reserved descriptor 0xc1"},
+		{b: bs[:len(bs)-3], err: "offset 32: truncated fixarray at offset 0: have 6 of 7 items"},
+		{b: []byte{0xdd, 0xff, 0xff, 0xff, 0xff}, err: "offset 0: truncated array32: 4294967295 items declared, but only 0 bytes remain",
+			rerr: "offset 5: truncated array32 at offset 0: have 0 of 4294967295 items"},
+		{b: []byte{0xdf, 0xff, 0xff, 0xff, 0xff}, err: "offset 0: truncated map32: 8589934590 items declared, but only 0 bytes remain",
+			rerr: "offset 5: truncated map32 at offset 0: have 0 of 8589934590 items"},
+		{b: []byte{0xdb, 0xff, 0xff, 0xff, 0xff, 0x61}, err: "offset 0: truncated str32: need 4294967295 bytes, have 1"},
+		{b: []byte{0xdb, 0xff, 0xff, 0xff, 0xff, 0x61}, opts: MsgpackValidateOptions{ValidateUTF8: true},
+			err: "offset 0: truncated str32: need 4294967295 bytes, have 1"},
+		{b: bs[:20], err: "offset 17: truncated bin8: need 2 bytes, have 1"},
+		{b: bs[:7], err: "offset 0: truncated fixarray: 7 items declared, but only 6 bytes remain",
+			rerr: "offset 5: truncated int16: need 2 header bytes, have 1"},
+		{b: bs[5:7], err: "offset 0: truncated int16: need 2 header bytes, have 1"},
+		{b: bs, opts: MsgpackValidateOptions{MaxDepth: 1}, err: "offset 1: maximum depth of 1 exceeded"},
+		{b: bs, opts: MsgpackValidateOptions{MaxContainerLen: 6}, err: "offset 0: fixarray length 7 exceeds the limit of 6"},
+		{b: bs, opts: MsgpackValidateOptions{MaxBytesLen: 1}, err: "offset 17: bin8 length 2 exceeds the limit of 1"},
+		{b: []byte{0xa2, 0xc3, 0x28}, err: ""},
+		{b: []byte{0xa2, 0xc3, 0x28}, opts: MsgpackValidateOptions{ValidateUTF8: true}, err: "offset 0: invalid UTF-8 in fixstr"},
+		{b: longBad, opts: MsgpackValidateOptions{ValidateUTF8: true}, err: "offset 0: invalid UTF-8 in str16"},
+		{b: []byte{0xd5, 0xff, 0x00, 0x01}, err: "offset 0: malformed timestamp: fixext2 data has length 2, not 4, 8 or 12"},
+		{b: []byte{0xd7, 0xff, 0xff, 0xff, 0xff, 0xfc, 0x00, 0x00, 0x00, 0x00},
+			err: "offset 0: malformed timestamp: nanoseconds 1073741823 exceed 999999999"},
+		{b: []byte{0xc7, 0x0c, 0xff, 0x3b, 0x9a, 0xca, 0x00, 0, 0, 0, 0, 0, 0, 0, 0},
+			err: "offset 0: malformed timestamp: nanoseconds 1000000000 exceed 999999999"},
+	} {
+		if v.rerr == "" {
+			v.rerr = v.err
+		}
+		for _, r := range []bool{false, true} {
+			var err error
+			expected := v.err
+			if r {
+				err = MsgpackValidate(bytes.NewReader(v.b), &v.opts)
+				expected = v.rerr
+			} else {
+				err = MsgpackValidateBytes(v.b, &v.opts)
+			}
+			if expected == "" {
+				if err != nil {
+					t.Fatalf("%d (reader: %v): unexpected error: %v", i, r, err)
+				}
+				continue
+			}
+			verr, ok := err.(*MsgpackValidateError)
+			if !ok || err.Error() != "msgpack: "+expected {
+				t.Fatalf("%d (reader: %v): expected error %q, got: %v", i, r, expected, err)
+			}
+			if !strings.HasPrefix(expected, fmt.Sprintf("offset %d: ", verr.Offset)) {
+				t.Fatalf("%d (reader: %v): unexpected offset %d", i, r, verr.Offset)
+			}
+		}
+	}
+
+	// errors reading are returned as is
+	if err := MsgpackValidate(iotest.TimeoutReader(bytes.NewReader(long)), nil); err != iotest.ErrTimeout {
+		t.Fatalf("expected read error, got: %v", err)
+	}
+}
+
+func TestPolymorphicTypes(t *testing.T) {
+	testOnce.Do(testInitAll)
+	intf := reflect.TypeOf((*testPolyEvent)(nil)).Elem()
+	register := func(h Handle) Handle {
+		bh := basicHandle(h)
+		for _, v := range []struct {
+			name string
+			impl reflect.Type
+		}{
+			{"created", reflect.TypeOf(testPolyCreated{})},
+			{"deleted", reflect.TypeOf((*testPolyDeleted)(nil))},
+			{"note", reflect.TypeOf(testPolyNote(""))},
+		} {
+			if err := bh.RegisterType(intf, v.name, v.impl); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+		return h
+	}
+	log := testPolyLog{
+		Events: []testPolyEvent{testPolyCreated{1, "a"}, &testPolyDeleted{2}, nil},
+		Last:   testPolyCreated{3, "c"},
+		ByName: map[string]testPolyEvent{"x": &testPolyDeleted{4}},
+	}
+	for _, key := range []string{"", "type"} {
+		var hs MsgpackHandle
+		hs.WriteExt, hs.AsSymbols = true, AsSymbolAll
+		for _, h := range []Handle{&MsgpackHandle{}, &hs, &JsonHandle{}, &CborHandle{}} {
+			register(h)
+			basicHandle(h).InterfaceTypeKey = key
+			name := fmt.Sprintf("%s-%q", h.Name(), key)
+			var log2 testPolyLog
+			testUnmarshalErr(&log2, testMarshalErr(&log, h, t, name), h, t, name)
+			testDeepEqualErr(log, log2, t, name)
+
+			// a non-map type is only supported with no InterfaceTypeKey
+			var ev testPolyEvent = testPolyNote("n")
+			var bs []byte
+			err := NewEncoderBytes(&bs, h).Encode(&ev)
+			if key == "" {
+				var ev2 testPolyEvent
+				testUnmarshalErr(&ev2, bs, h, t, name+"-note")
+				testDeepEqualErr(ev, ev2, t, name+"-note")
+			} else if err == nil {
+				t.Fatalf("%s: expected error encoding a string with InterfaceTypeKey", name)
+			}
+		}
+	}
+
+	// the discriminator, as seen in json
+	h := register(&JsonHandle{}).(*JsonHandle)
+	var ev testPolyEvent = testPolyCreated{1, "a"}
+	testDeepEqualErr(`{"created":{"ID":1,"Name":"a"}}`, string(testMarshalErr(&ev, h, t, "json-wrapper")), t, "json-wrapper")
+	h.InterfaceTypeKey = "type"
+	testDeepEqualErr(`{"type":"created","ID":1,"Name":"a"}`, string(testMarshalErr(&ev, h, t, "json-key")), t, "json-key")
+	var ev2 testPolyEvent
+	h.ErrorIfNoField = true
+	testUnmarshalErr(&ev2, []byte(`{"ID":1,"type":"created","Name":"a"}`), h, t, "json-key-anywhere")
+	testDeepEqualErr(ev, ev2, t, "json-key-anywhere")
+
+	for i, v := range []struct {
+		h   Handle
+		in  string
+		err string
+	}{
+		{h, `{"type":"updated","ID":1}`, `no type registered as "updated"`},
+		{h, `{"ID":1}`, `without the key "type"`},
+		{register(&JsonHandle{}), `{"created":{},"note":""}`, "more than one entry"},
+		{register(&JsonHandle{}), `["created",{}]`, "expecting a map with one entry"},
+	} {
+		err := NewDecoderBytes([]byte(v.in), v.h).Decode(&ev2)
+		if err == nil || !strings.Contains(err.Error(), v.err) {
+			t.Fatalf("%d: expected error containing %q, got: %v", i, v.err, err)
+		}
+	}
+	// the key is only ignored when decoding an interface,
+	// and an error decoding the value has the path to it
+	var created testPolyCreated
+	if err := NewDecoderBytes([]byte(`{"type":"created","ID":1}`), h).Decode(&created); !errors.Is(err, ErrUnknownField) {
+		t.Fatalf("expected ErrUnknownField decoding the key into a struct, got: %v", err)
+	}
+	in := `{"Last":{"type":"created","ID":"x"}}`
+	err := NewDecoderBytes([]byte(in), h).Decode(new(testPolyLog))
+	var de *DecodeError
+	path := ".Last.ID"
+	if codecgen {
+		path = "" // neither struct adds to the path
+	}
+	if off := strings.Index(in, `"x"`) + 3; !errors.As(err, &de) || de.Path != path || de.Offset != off {
+		t.Fatalf("expected error at %q, offset %d, got: %v", path, off, err)
+	}
+	type other struct{ testPolyCreated }
+	ev = other{}
+	if err := NewEncoderBytes(new([]byte), h).Encode(&ev); err == nil || !strings.Contains(err.Error(), "is not registered") {
+		t.Fatalf("expected error encoding an unregistered type, got: %v", err)
+	}
+	bh := &h.BasicHandle
+	for _, err := range []error{
+		bh.RegisterType(reflect.TypeOf((*interface{})(nil)).Elem(), "x", reflect.TypeOf(0)),
+		bh.RegisterType(intf, "x", reflect.TypeOf(testPolyDeleted{})),
+		bh.RegisterType(intf, "created", reflect.TypeOf(other{})),
+		bh.RegisterType(intf, "x", reflect.TypeOf(testPolyCreated{})),
+	} {
+		if err == nil {
+			t.Fatalf("expected RegisterType error")
+		}
+	}
+}
+
+func TestMsgpackAsSymbols(t *testing.T) {
+	testOnce.Do(testInitAll)
+	type T struct {
+		Name   string
+		Labels map[string]string
+		Kind   string
+	}
+	var v []T
+	for i := 0; i < 300; i++ {
+		v = append(v, T{Name: fmt.Sprintf("name-%d", i), Labels: map[string]string{"region": "east"}, Kind: "service"})
+	}
+	// hs encodes symbols, and h decodes them (only doing so as AsSymbols is set)
+	var h, hs MsgpackHandle
+	h.WriteExt, hs.WriteExt = true, true
+	h.AsSymbols = AsSymbolMapStringKeys
+	hs.Canonical = true
+
+	encode := func(v interface{}, flag AsSymbolFlag) (bs []byte) {
+		hs.AsSymbols = flag
+		NewEncoderBytes(&bs, &hs).MustEncode(v)
+		return
+	}
+	bs := encode(v, AsSymbolNone)
+	var size = map[AsSymbolFlag]int{AsSymbolNone: len(bs)}
+	for _, flag := range []AsSymbolFlag{AsSymbolNone, AsSymbolMapStringKeys, AsSymbolStructFieldNameFlag,
+		AsSymbolMapStringKeysFlag | AsSymbolStructFieldNameFlag, AsSymbolAll} {
+		bs2 := encode(v, flag)
+		size[flag] = len(bs2)
+		// symbols are decoded, whichever AsSymbols flags are set, from a []byte or an io.Reader
+		var v2, v3 []T
+		testUnmarshalErr(&v2, bs2, &h, t, "symbols")
+		testDeepEqualErr(v, v2, t, "symbols")
+		NewDecoder(bytes.NewReader(bs2), &h).MustDecode(&v3)
+		testDeepEqualErr(v, v3, t, "symbols-reader")
+	}
+	if size[AsSymbolNone] != len(bs) || !(size[AsSymbolAll] < size[AsSymbolMapStringKeysFlag|AsSymbolStructFieldNameFlag] &&
+		size[AsSymbolMapStringKeysFlag|AsSymbolStructFieldNameFlag] < size[AsSymbolStructFieldNameFlag] &&
+		size[AsSymbolStructFieldNameFlag] < size[AsSymbolMapStringKeys] && size[AsSymbolMapStringKeys] < size[AsSymbolNone]) {
+		t.Fatalf("unexpected encoded sizes: %v", size)
+	}
+
+	// symbols only last within a top-level value, so a single small struct gets larger
+	type T2 struct{ Name, Kind string }
+	testDeepEqualErr([]int{len(encode(T2{"name-0", "service"}, AsSymbolNone)), len(encode(T2{"name-0", "service"}, AsSymbolAll))},
+		[]int{26, 32}, t, "symbols-size")
+
+	// symbols are decoded into an interface{}, and walked via Token and Lookup
+	bs = encode(v[:3], AsSymbolAll)
+	var vi interface{}
+	testUnmarshalErr(&vi, bs, &h, t, "symbols-intf")
+	testDeepEqualErr("service", vi.([]interface{})[2].(map[interface{}]interface{})["Kind"], t, "symbols-intf")
+	bs2, err := NewDecoderBytes(bs, &h).Lookup("[2].Labels.region")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testDeepEqualErr([]byte{0xd4, MsgpackSymbolRefTag, 4}, bs2, t, "symbols-lookup")
+
+	// keys sorted by their encoding are not symbols, as sorting would reorder definitions
+	mi := map[interface{}]interface{}{"key-a": "key-b", "key-b": []interface{}{"key-a", "key-b"}}
+	vi = nil
+	testUnmarshalErr(&vi, encode(mi, AsSymbolAll), &h, t, "symbols-canonical")
+	testDeepEqualErr(mi, vi, t, "symbols-canonical")
+
+	// each top-level value defines its own symbols
+	bs = append(encode(v[0], AsSymbolAll), encode(v[1], AsSymbolAll)...)
+	var w bytes.Buffer
+	e := NewEncoder(&w, &hs)
+	e.MustEncode(v[0])
+	e.MustEncode(v[1])
+	testDeepEqualErr(bs, w.Bytes(), t, "symbols-stream")
+	d := NewDecoderBytes(bs, &h)
+	for i := 0; i < 2; i++ {
+		var v2 T
+		d.MustDecode(&v2)
+		testDeepEqualErr(v[i], v2, t, "symbols-stream")
+	}
+
+	// unknown fields have their symbols expanded, as they may be written out elsewhere
+	type TU struct {
+		Name    string
+		Unknown UnknownFields
+	}
+	var vu []TU
+	testUnmarshalErr(&vu, encode(v[:3], AsSymbolAll), &h, t, "symbols-unknown")
+	var h2 MsgpackHandle // without AsSymbols
+	h2.WriteExt = true
+	for i := range vu {
+		var v2 T
+		testUnmarshalErr(&v2, testMarshalErr(&vu[i], &h2, t, "symbols-unknown"), &h2, t, "symbols-unknown")
+		testDeepEqualErr(v[i], v2, t, "symbols-unknown")
+	}
+
+	for _, b := range [][]byte{
+		{0x91, 0xd4, MsgpackSymbolRefTag, 0x00},
+		{0x91, 0xd6, MsgpackSymbolRefTag, 0x00, 0x00, 0x00, 0x00},
+	} {
+		var ss []string
+		if err := NewDecoderBytes(b, &h).Decode(&ss); err == nil || !strings.Contains(err.Error(), "invalid symbol reference") {
+			t.Fatalf("expected invalid symbol reference error, got: %v", err)
+		}
+	}
+
+	// without AsSymbols, the symbol tags are read as any other extension
+	bs = encode([]string{"key-a", "key-a"}, AsSymbolAll)
+	vi = nil
+	testUnmarshalErr(&vi, bs, &h2, t, "symbols-off")
+	testDeepEqualErr([]interface{}{RawExt{Tag: MsgpackSymbolDefineTag, Data: []byte("key-a")}, RawExt{Tag: MsgpackSymbolRefTag, Data: []byte{0}}},
+		vi, t, "symbols-off")
+	var ss []string
+	if err := NewDecoderBytes(bs, &h2).Decode(&ss); !errors.Is(err, ErrTypeMismatch) {
+		t.Fatalf("expected a type mismatch decoding a symbol without AsSymbols, got: %v", err)
+	}
+}
+
 func TestMultipleEncDec(t *testing.T) {
 	doTestMultipleEncDec(t, "json", testJsonH)
 }
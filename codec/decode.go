@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -39,9 +40,70 @@ var (
 	errDecUnreadByteNothingToRead   = errors.New("cannot unread - nothing has been read")
 	errDecUnreadByteLastByteNotRead = errors.New("cannot unread - last byte has not been read")
 	errDecUnreadByteUnknown         = errors.New("cannot unread - reason unknown")
-	errMaxDepthExceeded             = errors.New("maximum decoding depth exceeded")
 )
 
+// Sentinel errors for the common causes of a failed decode.
+// The error returned by Decode matches one of them (via errors.Is) if it was the cause.
+var (
+	// ErrOverflow is the error when a value in the stream does not fit the number
+	// being decoded into e.g. 300 into an int8, or a negative value into a uint.
+	ErrOverflow = errors.New("overflow")
+
+	// ErrTypeMismatch is the error when a value in the stream cannot be decoded into
+	// the type being decoded into e.g. a string into an int, or a number into a struct.
+	ErrTypeMismatch = errors.New("type mismatch")
+
+	// ErrUnknownField is the error when a struct is decoded from a map with a key,
+	// or an array with an element, which matches no field (iff ErrorIfNoField).
+	ErrUnknownField = errors.New("unknown field")
+
+	// ErrArrayCannotExpand is the error when an array is decoded from a stream
+	// with more elements than its length (iff ErrorIfNoArrayExpand).
+	ErrArrayCannotExpand = errors.New("array cannot expand")
+
+	// ErrMaxDepthExceeded is the error when the stream is nested deeper than MaxDepth.
+	ErrMaxDepthExceeded = errors.New("maximum decoding depth exceeded")
+
+	// ErrMissingField is the error when a struct is decoded from a map without
+	// any of its fields tagged "required". See MissingFieldsError.
+	ErrMissingField = errors.New("missing field")
+)
+
+// DecodeLimitError is the error when decoding exceeds one of the limits
+// configured in DecodeOptions e.g. MaxBytesLen.
+//
+// The error returned by Decode wraps it, so retrieve it via errors.As.
+type DecodeLimitError struct {
+	// Limit is the name of the DecodeOptions field whose limit was exceeded.
+	Limit string
+	// Max is the configured limit.
+	Max int
+	// Value is the value which exceeded it. For MaxBytesRead, it is Max+1.
+	Value int
+}
+
+func (e *DecodeLimitError) Error() string {
+	return fmt.Sprintf("%s exceeded: %d > %d", e.Limit, e.Value, e.Max)
+}
+
+// MissingFieldsError is the error when a struct is decoded from a map without
+// any of its fields tagged "required". It matches ErrMissingField (via errors.Is).
+//
+// The error returned by Decode wraps it, so retrieve it via errors.As.
+type MissingFieldsError struct {
+	// Fields are the keys of all the missing fields, sorted.
+	Fields []string
+}
+
+func (e *MissingFieldsError) Error() string {
+	return "missing required fields: " + strings.Join(e.Fields, ", ")
+}
+
+// Unwrap returns ErrMissingField.
+func (e *MissingFieldsError) Unwrap() error {
+	return ErrMissingField
+}
+
 /*
 
 // decReader abstracts the reading source, allowing implementations that can
@@ -81,6 +143,10 @@ type decDriver interface {
 	TryDecodeAsNil() bool
 	// ContainerType returns one of: Bytes, String, Nil, Slice or Map. Return unSet if not known.
 	ContainerType() (vt valueType)
+	// nextValueType returns the type of the next value, as far as it can be
+	// determined without consuming it, mirroring the types set by DecodeNaked.
+	// It returns unSet if the type is only known once the value is read.
+	nextValueType() (vt valueType)
 	// IsBuiltinType(rt uintptr) bool
 
 	// DecodeNaked will decode primitives (number, bool, string, []byte) and RawExt.
@@ -136,13 +202,67 @@ type decDriver interface {
 	uncacheRead()
 }
 
-type decodeError struct {
-	codecError
-	pos int
+// decDriverSymbols is implemented by a decDriver for a format which supports symbols.
+type decDriverSymbols interface {
+	// resetSymbols forgets all symbols, at the start of a top-level value.
+	resetSymbols()
+	// numSymbolsRead returns the number of symbol definitions and references read.
+	numSymbolsRead() uint
+	// appendSymbolsExpanded appends the raw encoded values in b to dst,
+	// with each symbol replaced by its string.
+	appendSymbolsExpanded(dst, b []byte) []byte
 }
 
-func (d decodeError) Error() string {
-	return fmt.Sprintf("%s decode error [pos %d]: %v", d.name, d.pos, d.err)
+// DecodeError is the error returned when decoding fails.
+//
+// Err is the cause, which may match (via errors.Is) one of the sentinel errors
+// e.g. ErrTypeMismatch, or be a *DecodeLimitError.
+type DecodeError struct {
+	// Name is the name of the Handle e.g. msgpack.
+	Name string
+	// Offset is the number of bytes read from the stream when the error occurred.
+	Offset int
+	// Path is the path to the value being decoded, from the top-level value
+	// e.g. `.Entries[12].Data` or `.Tags["env"]`. It is "" at the top-level.
+	//
+	// Path is only tracked while decoding via reflection: code generated by codecgen
+	// (or a Selfer) never adds to it. So for a value decoded by such code, Path ends
+	// at that value, and is "" if it is the top-level value.
+	Path string
+	// Type is the Go type being decoded into, if known.
+	Type reflect.Type
+	// ValueType is the type of the value found in the stream (e.g. Int, String, Map)
+	// if known, else "".
+	ValueType string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("%s decode error [pos %d]: %v", e.Name, e.Offset, e.Err)
+	}
+	return fmt.Sprintf("%s decode error [pos %d] at %s: %v", e.Name, e.Offset, e.Path, e.Err)
+}
+
+// Unwrap returns the underlying error, for use with errors.Is and errors.As.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the underlying error, for use with github.com/pkg/errors.Cause.
+func (e *DecodeError) Cause() error {
+	return e.Err
+}
+
+// decPathElem tracks the element being decoded within a container
+// nested at some depth, to give the Path of a DecodeError.
+type decPathElem struct {
+	st  reflect.Type     // type of the struct, if a struct
+	sf  *structFieldInfo // field being decoded, if a struct
+	key reflect.Value    // key of the entry being decoded, if a map
+	idx int              // index of the element being decoded, if an array; else -1
+	typ reflect.Type     // type of the elements, if an array or map
 }
 
 type decDriverNoopContainerReader struct{}
@@ -187,6 +307,33 @@ type DecodeOptions struct {
 	// maps and slices. If 0 or negative, we default to a suitably large number (currently 1024).
 	MaxDepth int16
 
+	// MaxBytesRead, MaxBytesLen, MaxMapLen, MaxArrayLen and MaxAlloc bound the work done
+	// (and memory allocated) when decoding untrusted data. If 0 or negative, there is no limit.
+	// If a limit is exceeded, decoding fails with a *DecodeLimitError naming it.
+	//
+	// Note that the length of a map or array whose length is not known up front
+	// (e.g. in json) is only checked while decoding it into a slice or map
+	// via reflection (not in code generated by codecgen).
+
+	// MaxBytesRead is the maximum number of bytes read from the stream,
+	// since the Decoder was created or last Reset.
+	MaxBytesRead int
+
+	// MaxBytesLen is the maximum length of a string or binary value in the stream.
+	MaxBytesLen int
+
+	// MaxMapLen is the maximum number of entries of a map in the stream.
+	MaxMapLen int
+
+	// MaxArrayLen is the maximum number of elements of an array in the stream.
+	MaxArrayLen int
+
+	// MaxAlloc is the budget for memory allocated by each call to Decode.
+	//
+	// It is approximate: the length of every string or binary value read is charged
+	// against it, as is the size of every element decoded into a slice or map.
+	MaxAlloc int
+
 	// If ErrorIfNoField, return an error when decoding a map
 	// from a codec stream into a struct, and no matching struct field is found.
 	ErrorIfNoField bool
@@ -460,7 +607,7 @@ func (z *ioDecReader) skip(accept *bitset256) (token byte) {
 	// for {
 	// 	token, eof = z.readn1eof()
 	// 	if eof {
-	// 		return
+	// 		panic(io.EOF)
 	// 	}
 	// 	if accept.isset(token) {
 	// 		continue
@@ -470,7 +617,9 @@ func (z *ioDecReader) skip(accept *bitset256) (token byte) {
 LOOP:
 	token, eof = z.readn1eof()
 	if eof {
-		return
+		// as bytesDecReader and bufioDecReader do,
+		// so the end of the input is not mistaken for a token.
+		panic(io.EOF)
 	}
 	if accept.isset(token) {
 		goto LOOP
@@ -932,6 +1081,40 @@ func (z *bufioDecReader) readUntilFill(in []byte, stop byte) (out []byte) {
 
 // ------------------------------------
 
+// decLimitReader reads from r, failing with a *DecodeLimitError (for MaxBytesRead)
+// on reading past the first n bytes, unless r has been read to its end.
+type decLimitReader struct {
+	r   io.Reader
+	n   int // bytes left to read
+	max int
+	b   [1]byte
+}
+
+func (z *decLimitReader) reset(r io.Reader, max int) {
+	z.r, z.n, z.max = r, max, max
+}
+
+func (z *decLimitReader) Read(p []byte) (n int, err error) {
+	if len(p) == 0 {
+		return
+	}
+	if z.n <= 0 {
+		// only fail if there is more to read
+		if _, err = io.ReadFull(z.r, z.b[:]); err == nil {
+			err = &DecodeLimitError{Limit: "MaxBytesRead", Max: z.max, Value: z.max + 1}
+		}
+		return
+	}
+	if len(p) > z.n {
+		p = p[:z.n]
+	}
+	n, err = z.r.Read(p)
+	z.n -= n
+	return
+}
+
+// ------------------------------------
+
 var errBytesDecReaderCannotUnread = errors.New("cannot unread last byte read")
 
 // bytesDecReader is a decReader that reads off a byte slice with zero copying
@@ -940,6 +1123,8 @@ type bytesDecReader struct {
 	c uint   // cursor
 	t uint   // track start
 	// a int    // available
+
+	lim error // if b was truncated to MaxBytesRead, the error on reading past its end
 }
 
 func (z *bytesDecReader) reset(in []byte) {
@@ -947,6 +1132,15 @@ func (z *bytesDecReader) reset(in []byte) {
 	// z.a = len(in)
 	z.c = 0
 	z.t = 0
+	z.lim = nil
+}
+
+// eof returns the error on reading past the end of b.
+func (z *bytesDecReader) eof() error {
+	if z.lim != nil {
+		return z.lim
+	}
+	return io.EOF
 }
 
 func (z *bytesDecReader) numread() uint {
@@ -983,7 +1177,7 @@ func (z *bytesDecReader) readx(n uint) (bs []byte) {
 		z.c += n
 		if z.c > uint(len(z.b)) {
 			z.c = uint(len(z.b))
-			panic(io.EOF)
+			panic(z.eof())
 		}
 		bs = z.b[z.c-n : z.c]
 	}
@@ -1019,7 +1213,7 @@ func (z *bytesDecReader) readb(bs []byte) {
 
 func (z *bytesDecReader) readn1() (v uint8) {
 	if z.c == uint(len(z.b)) {
-		panic(io.EOF)
+		panic(z.eof())
 	}
 	v = z.b[z.c]
 	z.c++
@@ -1069,7 +1263,7 @@ LOOP:
 		return
 	}
 	// END:
-	panic(io.EOF)
+	panic(z.eof())
 	// // z.a = 0
 	// z.c = blen
 	// return
@@ -1082,7 +1276,7 @@ func (z *bytesDecReader) readTo(_ []byte, accept *bitset256) (out []byte) {
 func (z *bytesDecReader) readToNoInput(accept *bitset256) (out []byte) {
 	i := z.c
 	if i == uint(len(z.b)) {
-		panic(io.EOF)
+		panic(z.eof())
 	}
 
 	// Replace loop with goto construct, so that this can be inlined
@@ -1123,6 +1317,9 @@ LOOP:
 			i++
 			goto LOOP
 		}
+	} else if z.lim != nil {
+		z.c = i
+		panic(z.lim)
 	}
 
 	out = z.b[z.c:i]
@@ -1167,7 +1364,7 @@ LOOP:
 	}
 	// z.a = 0
 	// z.c = blen
-	panic(io.EOF)
+	panic(z.eof())
 }
 
 func (z *bytesDecReader) track() {
@@ -1344,6 +1541,12 @@ func (d *Decoder) kInterface(f *codecFnInfo, rv reflect.Value) {
 	// We do not replace with a generic value (as got from decodeNaked).
 
 	// every interface passed here MUST be settable.
+	if len(d.h.polyTypes) != 0 {
+		if pt := d.h.polyType(f.ti.rtid); pt != nil {
+			d.kInterfacePoly(pt, rv)
+			return
+		}
+	}
 	var rvn reflect.Value
 	if rv.IsNil() || d.h.InterfaceReset {
 		// check if mapping to a type: if so, initialize it and move on
@@ -1416,32 +1619,72 @@ func (d *Decoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 	} else if fti.mfp {
 		mf = rv2i(rv.Addr()).(MissingFielder)
 	}
+	var uf *UnknownFields
+	if fti.uf >= 0 {
+		uf = rv2i(rv.Field(int(fti.uf)).Addr()).(*UnknownFields)
+		uf.Reset()
+	}
+	var fs *FieldSet
+	if fti.fs >= 0 {
+		fs = rv2i(rv.Field(int(fti.fs)).Addr()).(*FieldSet)
+		fs.Reset()
+	}
 	if ctyp == valueTypeMap {
 		containerLen := dd.ReadMapStart()
 		if containerLen == 0 {
 			dd.ReadMapEnd()
+			if fti.anyDefault || fti.df {
+				d.setDefaults(fti, rv, fti.sfiSort, nil)
+			}
+			if fti.anyRequired {
+				d.checkRequiredFields(fti, nil)
+			}
 			return
 		}
+		var seen []bool // by index into tisfi, if any fields are required or have defaults
+		if fti.anyRequired || fti.anyDefault || fti.df {
+			seen = make([]bool, len(fti.sfiSort))
+		}
 		d.depthIncr()
+		d.pathElem().st = fti.rt
 		tisfi := fti.sfiSort
 		hasLen := containerLen >= 0
 
+		var ufkey []byte
 		var rvkencname []byte
 		for j := 0; (hasLen && j < containerLen) || !(hasLen || dd.CheckBreak()); j++ {
+			d.pathElem().sf = nil
 			if elemsep {
 				dd.ReadMapElemKey()
 			}
+			if uf != nil {
+				d.unknownFieldKeyStart()
+			}
 			rvkencname = decStructFieldKey(dd, fti.keyType, &d.b)
+			if uf != nil {
+				ufkey = d.unknownFieldKeyEnd()
+			}
 			if elemsep {
 				dd.ReadMapElemValue()
 			}
 			if k := fti.indexForEncName(rvkencname); k > -1 {
 				si := tisfi[k]
+				d.pathElem().sf = si
+				if seen != nil {
+					seen[k] = true
+				}
+				if fs != nil {
+					fs.Add(si.fieldName)
+				}
 				if dd.TryDecodeAsNil() {
 					si.setToZeroValue(rv)
 				} else {
 					d.decodeValue(sfn.field(si), nil, true)
 				}
+			} else if uf != nil {
+				d.unknownField(uf, ufkey)
+			} else if d.atPolyKey() {
+				d.swallow()
 			} else if mf != nil {
 				// store rvkencname in new []byte, as it previously shares Decoder.b, which is used in decode
 				name2 := rvkencname
@@ -1453,8 +1696,8 @@ func (d *Decoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 				d.decode(&f)
 				// xdebugf("kStruct: mf != nil: after decode: rvkencname: %s", rvkencname)
 				if !mf.CodecMissingField(rvkencname, f) && d.h.ErrorIfNoField {
-					d.errorf("no matching struct field found when decoding stream map with key: %s ",
-						stringView(rvkencname))
+					d.errorIs(ErrUnknownField, valueTypeUnset,
+						"no matching struct field found when decoding stream map with key: %s ", stringView(rvkencname))
 				}
 			} else {
 				d.structFieldNotFound(-1, stringView(rvkencname))
@@ -1463,28 +1706,45 @@ func (d *Decoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 		}
 		dd.ReadMapEnd()
 		d.depthDecr()
+		if fti.anyDefault || fti.df {
+			d.setDefaults(fti, rv, fti.sfiSort, seen)
+		}
+		if fti.anyRequired {
+			d.checkRequiredFields(fti, seen)
+		}
 	} else if ctyp == valueTypeArray {
 		containerLen := dd.ReadArrayStart()
 		if containerLen == 0 {
 			dd.ReadArrayEnd()
+			if fti.anyDefault || fti.df {
+				d.setDefaults(fti, rv, fti.sfiSrc, nil)
+			}
 			return
 		}
 		d.depthIncr()
+		d.pathElem().st = fti.rt
 		// Not much gain from doing it two ways for array.
 		// Arrays are not used as much for structs.
 		hasLen := containerLen >= 0
 		var checkbreak bool
+		numRead := len(fti.sfiSrc) // number of fields read, less if a short array
 		for j, si := range fti.sfiSrc {
 			if hasLen && j == containerLen {
+				numRead = j
 				break
 			}
 			if !hasLen && dd.CheckBreak() {
 				checkbreak = true
+				numRead = j
 				break
 			}
 			if elemsep {
 				dd.ReadArrayElem()
 			}
+			d.pathElem().sf = si
+			if fs != nil {
+				fs.Add(si.fieldName)
+			}
 			if dd.TryDecodeAsNil() {
 				si.setToZeroValue(rv)
 			} else {
@@ -1493,6 +1753,7 @@ func (d *Decoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 		}
 		if (hasLen && containerLen > len(fti.sfiSrc)) || (!hasLen && !checkbreak) {
 			// read remaining values and throw away
+			d.pathElem().sf = nil
 			for j := len(fti.sfiSrc); ; j++ {
 				if (hasLen && j == containerLen) || (!hasLen && dd.CheckBreak()) {
 					break
@@ -1505,8 +1766,11 @@ func (d *Decoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 		}
 		dd.ReadArrayEnd()
 		d.depthDecr()
+		if numRead < len(fti.sfiSrc) && (fti.anyDefault || fti.df) {
+			d.setDefaults(fti, rv, fti.sfiSrc[numRead:], nil)
+		}
 	} else {
-		d.errorstr(errstrOnlyMapOrArrayCanDecodeIntoStruct)
+		d.errorIs(ErrTypeMismatch, ctyp, "%s", errstrOnlyMapOrArrayCanDecodeIntoStruct)
 		return
 	}
 }
@@ -1524,7 +1788,7 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 	if ctyp == valueTypeBytes || ctyp == valueTypeString {
 		// you can only decode bytes or string in the stream into a slice or array of bytes
 		if !(ti.rtid == uint8SliceTypId || rtelem0.Kind() == reflect.Uint8) {
-			d.errorf("bytes/string in stream must decode into slice/array of bytes, not %v", ti.rt)
+			d.errorIs(ErrTypeMismatch, ctyp, "bytes/string in stream must decode into slice/array of bytes, not %v", ti.rt)
 		}
 		if f.seq == seqTypeChan {
 			bs2 := dd.DecodeBytes(nil, true)
@@ -1575,6 +1839,7 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 	}
 
 	d.depthIncr()
+	d.pathElem().typ = rtelem0
 
 	rtelem0Size := int(rtelem0.Size())
 	rtElem0Kind := rtelem0.Kind()
@@ -1634,6 +1899,7 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 	var j int
 
 	for ; (hasLen && j < containerLenS) || !(hasLen || dd.CheckBreak()); j++ {
+		d.pathElem().idx = j
 		if j == 0 && (f.seq == seqTypeSlice || f.seq == seqTypeChan) && rv.IsNil() {
 			if hasLen {
 				rvlen = decInferLen(containerLenS, d.h.MaxInitLen, rtelem0Size)
@@ -1654,6 +1920,13 @@ func (d *Decoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 				d.errorf("cannot decode into non-settable slice")
 			}
 		}
+		if d.limits {
+			if slh.array {
+				d.limitElem(j+1, false, hasLen, rtelem0Size)
+			} else {
+				d.limitElem(j/2+1, true, hasLen, rtelem0Size)
+			}
+		}
 		slh.ElemContainerState(j)
 		decodeAsNil = dd.TryDecodeAsNil()
 		if f.seq == seqTypeChan {
@@ -1764,6 +2037,7 @@ func (d *Decoder) kMap(f *codecFnInfo, rv reflect.Value) {
 	d.depthIncr()
 
 	ktype, vtype := ti.key, ti.elem
+	d.pathElem().typ = vtype
 	ktypeId := rt2id(ktype)
 	vtypeKind := vtype.Kind()
 
@@ -1802,6 +2076,10 @@ func (d *Decoder) kMap(f *codecFnInfo, rv reflect.Value) {
 	var kstrbs []byte
 
 	for j := 0; (hasLen && j < containerLen) || !(hasLen || dd.CheckBreak()); j++ {
+		d.pathElem().key = reflect.Value{}
+		if d.limits {
+			d.limitElem(j+1, true, hasLen, int(ktype.Size()+vtype.Size()))
+		}
 		if rvkMut || !rvkp.IsValid() {
 			rvkp = reflect.New(ktype)
 			rvk = rvkp.Elem()
@@ -1888,9 +2166,12 @@ func (d *Decoder) kMap(f *codecFnInfo, rv reflect.Value) {
 
 		// We MUST be done with the stringview of the key, before decoding the value
 		// so that we don't bastardize the reused byte array.
-		if mapSet && ktypeIsString {
+		// If not doing an insert, the key is only kept as the path of the value,
+		// for which the stringview will do in safe mode (where it is a copy).
+		if ktypeIsString && (mapSet || !safeMode) {
 			rvk.SetString(d.string(kstrbs))
 		}
+		d.pathElem().key = rvk
 		if valFn == nil {
 			valFn = d.h.fn(vtypeLo, true, true)
 		}
@@ -2286,6 +2567,9 @@ type Decoder struct {
 
 	d decDriver
 
+	ds     decDriverSymbols // set if the format supports symbols
+	ufsyms uint             // symbols read when capturing of an unknown field started
+
 	// NOTE: Decoder shouldn't call it's read methods,
 	// as the handler MAY need to do some coordination.
 	r *decReaderSwitch
@@ -2314,6 +2598,18 @@ type Decoder struct {
 
 	is map[string]string // used for interning strings
 
+	tok []decTokenContainer // containers started via Token, innermost last
+
+	limits bool // whether any of MaxBytesLen, MaxMapLen, MaxArrayLen or MaxAlloc is set
+	alloc  int  // charged against MaxAlloc in this call to Decode
+
+	lr *decLimitReader // wraps the io.Reader, if MaxBytesRead is set
+
+	path []decPathElem // element being decoded at each depth, for DecodeError.Path
+	typ  reflect.Type  // type of the value passed to Decode, for DecodeError.Type
+
+	polyKeyEnd int // offset just after the InterfaceTypeKey to ignore, if set (see kInterfacePolyKey)
+
 	// ---- cpu cache line boundary?
 	b [decScratchByteArrayLen]byte // scratch buffer, used by Decoder and xxxEncDrivers
 
@@ -2362,6 +2658,7 @@ func newDecoder(h Handle) *Decoder {
 		d.is = make(map[string]string, 32)
 	}
 	d.d = h.newDecDriver(d)
+	d.ds, _ = d.d.(decDriverSymbols)
 	// d.cr, _ = d.d.(containerStateRecv)
 	return d
 }
@@ -2371,6 +2668,10 @@ func (d *Decoder) resetCommon() {
 	d.d.reset()
 	d.err = nil
 	d.depth = 0
+	d.tok = d.tok[:0]
+	d.limits = d.h.MaxBytesLen > 0 || d.h.MaxMapLen > 0 || d.h.MaxArrayLen > 0 || d.h.MaxAlloc > 0
+	d.alloc = 0
+	d.polyKeyEnd = 0
 	d.maxdepth = d.h.MaxDepth
 	if d.maxdepth <= 0 {
 		d.maxdepth = decDefMaxDepth
@@ -2395,6 +2696,13 @@ func (d *Decoder) Reset(r io.Reader) {
 		return
 	}
 	d.bytes = false
+	if d.h.MaxBytesRead > 0 {
+		if d.lr == nil {
+			d.lr = new(decLimitReader)
+		}
+		d.lr.reset(r, d.h.MaxBytesRead)
+		r = d.lr
+	}
 	// d.typ = entryTypeUnset
 	if d.h.ReaderBufferSize > 0 {
 		if d.bi == nil {
@@ -2428,6 +2736,10 @@ func (d *Decoder) ResetBytes(in []byte) {
 	d.bufio = false
 	// d.typ = entryTypeBytes
 	d.rb.reset(in)
+	if m := d.h.MaxBytesRead; m > 0 && len(in) > m {
+		d.rb.b = in[:m]
+		d.rb.lim = &DecodeLimitError{Limit: "MaxBytesRead", Max: m, Value: m + 1}
+	}
 	// d.r = &d.rb
 	d.resetCommon()
 }
@@ -2518,6 +2830,7 @@ func (d *Decoder) Decode(v interface{}) (err error) {
 	}
 
 	// defer d.deferred(&err)
+	d.resetSymbols()
 	d.mustDecode(v)
 	return
 }
@@ -2528,13 +2841,27 @@ func (d *Decoder) MustDecode(v interface{}) {
 	if d.err != nil {
 		panic(d.err)
 	}
+	d.resetSymbols()
 	d.mustDecode(v)
 }
 
+// resetSymbols forgets the symbols defined in the previous top-level value,
+// if a new one is about to be read.
+func (d *Decoder) resetSymbols() {
+	if d.ds != nil && len(d.tok) == 0 {
+		d.ds.resetSymbols()
+	}
+}
+
 // MustDecode is like Decode, but panics if unable to Decode.
 // This provides insight to the code location that triggered the error.
 func (d *Decoder) mustDecode(v interface{}) {
 	// TODO: Top-level: ensure that v is a pointer and not nil.
+	if len(d.tok) != 0 {
+		d.tokenElem()
+	}
+	d.alloc = 0
+	d.typ = reflect.TypeOf(v)
 	if d.d.TryDecodeAsNil() {
 		setZero(v)
 		return
@@ -2737,7 +3064,7 @@ func (d *Decoder) decode(iv interface{}) {
 	case *float32:
 		f64 := d.d.DecodeFloat64()
 		if chkOvf.Float32(f64) {
-			d.errorf("float32 overflow: %v", f64)
+			d.errorIs(ErrOverflow, valueTypeFloat, "float32 overflow: %v", f64)
 		}
 		*v = float32(f64)
 	case *float64:
@@ -2811,21 +3138,98 @@ func (d *Decoder) decodeValue(rv reflect.Value, fn *codecFn, chkAll bool) {
 
 func (d *Decoder) structFieldNotFound(index int, rvkencname string) {
 	// NOTE: rvkencname may be a stringView, so don't pass it to another function.
-	if d.h.ErrorIfNoField {
+	if d.h.ErrorIfNoField && !(index < 0 && d.atPolyKey()) {
 		if index >= 0 {
-			d.errorf("no matching struct field found when decoding stream array at index %v", index)
+			d.errorIs(ErrUnknownField, d.d.nextValueType(),
+				"no matching struct field found when decoding stream array at index %v", index)
 			return
 		} else if rvkencname != "" {
-			d.errorf("no matching struct field found when decoding stream map with key %s", rvkencname)
+			d.errorIs(ErrUnknownField, d.d.nextValueType(),
+				"no matching struct field found when decoding stream map with key %s", rvkencname)
 			return
 		}
 	}
 	d.swallow()
 }
 
+// setDefaults sets each of the fields sfis of the struct rv, which was not seen
+// (by index into sfis) when decoding it, to its default value if it has one.
+func (d *Decoder) setDefaults(ti *typeInfo, rv reflect.Value, sfis []*structFieldInfo, seen []bool) {
+	var dv reflect.Value // the defaults, if a Defaulter
+	if ti.df {
+		dv = reflect.New(ti.rt).Elem()
+		for _, si := range ti.sfiSrc {
+			if si.dflt.IsValid() {
+				if f, valid := si.field(dv, true); valid {
+					f.Set(si.dflt)
+				}
+			}
+		}
+		rv2i(dv.Addr()).(Defaulter).CodecDefaults()
+	}
+	for i, si := range sfis {
+		if i < len(seen) && seen[i] {
+			continue
+		}
+		v := si.dflt
+		if dv.IsValid() {
+			if f, valid := si.field(dv, false); valid && (v.IsValid() || !f.IsZero()) {
+				v = f
+			}
+		}
+		if v.IsValid() {
+			if f, valid := si.field(rv, true); valid {
+				f.Set(v)
+			}
+		}
+	}
+}
+
+// structDefaults is the codecgen analogue of setDefaults, for the struct pointed to by v,
+// given which fields were seen when decoding it from a map (by index into sfiSort),
+// or else the number of fields decoded from an array (if n >= 0).
+func (d *Decoder) structDefaults(v interface{}, seen []bool, n int) {
+	rv := reflect.ValueOf(v).Elem()
+	ti := d.h.getTypeInfo(rt2id(rv.Type()), rv.Type())
+	if n >= 0 {
+		d.setDefaults(ti, rv, ti.sfiSrc[n:], nil)
+	} else {
+		d.setDefaults(ti, rv, ti.sfiSort, seen)
+	}
+}
+
+// checkRequiredFields fails with a MissingFieldsError if any of the fields of ti
+// tagged "required" were not seen (by index into ti.sfiSort) when decoding from a map.
+func (d *Decoder) checkRequiredFields(ti *typeInfo, seen []bool) {
+	var missing []string
+	for k, si := range ti.sfiSort {
+		if si.required() && (k >= len(seen) || !seen[k]) {
+			missing = append(missing, si.encName)
+		}
+	}
+	if len(missing) != 0 {
+		panic(&MissingFieldsError{Fields: missing})
+	}
+}
+
+// requiredFieldsMissing is the codecgen analogue of checkRequiredFields,
+// given the keys of the required fields in order, and which of them were seen.
+func (d *Decoder) requiredFieldsMissing(seen []bool, names []string) {
+	var missing []string
+	for i, name := range names {
+		if i >= len(seen) || !seen[i] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) != 0 {
+		panic(&MissingFieldsError{Fields: missing})
+	}
+}
+
 func (d *Decoder) arrayCannotExpand(sliceLen, streamLen int) {
 	if d.h.ErrorIfNoArrayExpand {
-		d.errorf("cannot expand array len during decode from %v to %v", sliceLen, streamLen)
+		d.errorIs(ErrArrayCannotExpand, valueTypeArray,
+			"cannot expand array len during decode from %v to %v", sliceLen, streamLen)
 	}
 }
 
@@ -2873,14 +3277,68 @@ func (d *Decoder) ensureDecodeable(rv reflect.Value) (rv2 reflect.Value) {
 func (d *Decoder) depthIncr() {
 	d.depth++
 	if d.depth >= d.maxdepth {
-		panic(errMaxDepthExceeded)
+		panic(ErrMaxDepthExceeded)
 	}
+	d.path = append(d.path[:d.depth-1], decPathElem{idx: -1})
+}
+
+// pathElem returns the element being decoded at the current depth.
+// Do not hold on to it across decoding a value, as d.path may grow.
+func (d *Decoder) pathElem() *decPathElem {
+	return &d.path[d.depth-1]
+}
+
+// depthFrom sets the depth (and max depth) to that of src,
+// when continuing to decode a value of src via d.
+func (d *Decoder) depthFrom(src *Decoder) {
+	d.depth, d.maxdepth = src.depth, src.maxdepth
+	d.path = append(d.path[:0], src.path[:src.depth]...)
 }
 
 func (d *Decoder) depthDecr() {
 	d.depth--
 }
 
+// limit fails with a *DecodeLimitError if n exceeds the named limit max (if set).
+func (d *Decoder) limit(name string, max, n int) {
+	if max > 0 && n > max {
+		panic(&DecodeLimitError{Limit: name, Max: max, Value: n})
+	}
+}
+
+// limitBytesLen checks the length of a string or binary value in the stream,
+// and charges it against MaxAlloc. It is called by decDrivers iff d.limits.
+func (d *Decoder) limitBytesLen(n int) {
+	d.limit("MaxBytesLen", d.h.MaxBytesLen, n)
+	d.limitAlloc(n)
+}
+
+// limitContainerLen checks the length of a map or array in the stream.
+// It is called by decDrivers iff d.limits.
+func (d *Decoder) limitContainerLen(n int, isMap bool) {
+	if isMap {
+		d.limit("MaxMapLen", d.h.MaxMapLen, n)
+	} else {
+		d.limit("MaxArrayLen", d.h.MaxArrayLen, n)
+	}
+}
+
+// limitElem is called iff d.limits, for the n'th element (or entry) decoded into a slice or map,
+// with the given size. If the length of the container was not known up front, it is checked.
+func (d *Decoder) limitElem(n int, isMap, hasLen bool, size int) {
+	if !hasLen {
+		d.limitContainerLen(n, isMap)
+	}
+	d.limitAlloc(size)
+}
+
+func (d *Decoder) limitAlloc(n int) {
+	if n > 0 && d.h.MaxAlloc > 0 {
+		d.alloc += n
+		d.limit("MaxAlloc", d.h.MaxAlloc, d.alloc)
+	}
+}
+
 // Possibly get an interned version of a string
 //
 // This should mostly be used for map keys, where the key type is string.
@@ -2916,7 +3374,65 @@ func (d *Decoder) rawBytes() []byte {
 }
 
 func (d *Decoder) wrapErr(v interface{}, err *error) {
-	*err = decodeError{codecError: codecError{name: d.hh.Name(), err: v}, pos: int(d.r.numread())}
+	if x, ok := v.(decPolyErr); ok {
+		*err = x.DecodeError
+		return
+	}
+	e := &DecodeError{Name: d.hh.Name(), Offset: int(d.r.numread()), Err: errFromPanicVal(v)}
+	var de *decErr
+	if errors.As(e.Err, &de) && de.vt != valueTypeUnset {
+		e.ValueType = de.vt.String()
+	}
+	e.Path, e.Type = d.errPath()
+	*err = e
+}
+
+// errPath returns the path to the value being decoded when an error occurred,
+// and its type (if known).
+//
+// As containers do not decrement the depth on a panic, d.path[:d.depth]
+// holds the element being decoded at each depth.
+func (d *Decoder) errPath() (path string, typ reflect.Type) {
+	typ = d.typ
+	if typ == reflectValTyp {
+		typ = nil
+	} else if typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	n := int(d.depth)
+	if n > len(d.path) {
+		n = len(d.path) // depthIncr failed with ErrMaxDepthExceeded
+	}
+	var buf []byte
+	for _, x := range d.path[:n] {
+		switch {
+		case x.sf != nil:
+			buf = append(buf, '.')
+			buf = append(buf, x.sf.fieldName...)
+			typ = x.st
+			for _, i := range x.sf.is[:x.sf.nis] {
+				for typ.Kind() == reflect.Pointer {
+					typ = typ.Elem()
+				}
+				typ = typ.Field(int(i)).Type
+			}
+		case x.key.IsValid():
+			if x.key.Kind() == reflect.String {
+				buf = append(buf, '[')
+				buf = strconv.AppendQuote(buf, x.key.String())
+				buf = append(buf, ']')
+			} else {
+				buf = fmt.Appendf(buf, "[%v]", x.key)
+			}
+			typ = x.typ
+		case x.idx >= 0:
+			buf = append(buf, '[')
+			buf = strconv.AppendInt(buf, int64(x.idx), 10)
+			buf = append(buf, ']')
+			typ = x.typ
+		}
+	}
+	return string(buf), typ
 }
 
 // NumBytesRead returns the number of bytes read
@@ -2944,7 +3460,7 @@ func (d *Decoder) decSliceHelperStart() (x decSliceHelper, clen int) {
 	case valueTypeMap:
 		clen = dd.ReadMapStart() * 2
 	default:
-		d.errorf("only encoded map or array can be decoded into a slice (%d)", ctyp)
+		d.errorIs(ErrTypeMismatch, ctyp, "only encoded map or array can be decoded into a slice (%d)", ctyp)
 	}
 	// x.ct = ctyp
 	x.d = d
@@ -3,11 +3,12 @@
 
 /*
 Package codec provides a High Performance, Feature-Rich Idiomatic
-codec/encoding library for msgpack, json.
+codec/encoding library for msgpack, cbor, json.
 
 Supported Serialization formats are:
 
   - msgpack: https://github.com/msgpack/msgpack
+  - cbor:    http://cbor.io https://tools.ietf.org/html/rfc8949
   - json:    http://json.org http://tools.ietf.org/html/rfc7159
 
 For detailed usage information, read the primer at
@@ -161,6 +162,10 @@ Sample usage model:
 	enc = codec.NewEncoderBytes(&b, h)
 	err = enc.Encode(v)
 
+	// OR use the generic helpers, which draw pooled Encoders/Decoders
+	b, err = codec.Marshal(h, v)
+	v, err = codec.Unmarshal[T](h, b)
+
 	//RPC Server
 	go func() {
 	    for {
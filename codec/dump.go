@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// msgpackDumpMaxValueLen is the max number of bytes of a string, binary or
+// extension value shown in a dump, before it is elided.
+const msgpackDumpMaxValueLen = 32
+
+// MsgpackDump writes a human-readable, annotated tree of the msgpack values in b to w,
+// in the spirit of cbor's diagnostic notation. It is meant for debugging bad payloads.
+//
+// Each value is written on its own line, showing its byte offset (as reported in
+// decode errors), its header bytes, its format (e.g. fixstr, uint16, ext8) and type
+// (per the descriptor byte), and its length and value. Extension tags are shown,
+// and timestamps (extension -1) are decoded.
+//
+// If b is truncated or invalid, a line starting with "!!" is written at the offset
+// where decoding fails, and an error naming the offset is returned.
+func MsgpackDump(w io.Writer, b []byte) error {
+	z := msgpackDumper{w: w, b: b}
+	for i := 0; i < len(b) && z.err == nil; {
+		i = z.dump(i, 0)
+	}
+	return z.err
+}
+
+type msgpackDumper struct {
+	w   io.Writer
+	b   []byte
+	err error
+}
+
+// dump writes the value at offset i, returning the offset just after it.
+// On failure, it sets z.err and returns len(z.b).
+func (z *msgpackDumper) dump(i, depth int) int {
+	if depth >= int(decDefMaxDepth) {
+		return z.fail(i, depth, "maximum depth exceeded")
+	}
+	bd := z.b[i]
+	name, hl, n := msgpackDumpFormat(bd)
+	if name == "" {
+		return z.fail(i, depth, fmt.Sprintf("invalid descriptor 0x%02x", bd))
+	}
+	start := i
+	i++
+	if len(z.b)-i < hl {
+		return z.fail(start, depth, fmt.Sprintf("truncated %s: need %d header bytes, have %d", name, hl, len(z.b)-i))
+	}
+	h := z.b[i : i+hl]
+	i += hl
+	var val string
+	switch {
+	case bd <= mpPosFixNumMax:
+		val = strconv.FormatUint(uint64(bd), 10)
+	case bd >= mpNegFixNumMin:
+		val = strconv.FormatInt(int64(int8(bd)), 10)
+	case bd == mpNil:
+		val = "nil"
+	case bd == mpFalse:
+		val = "false"
+	case bd == mpTrue:
+		val = "true"
+	case bd >= mpUint8 && bd <= mpUint64:
+		val = strconv.FormatUint(msgpackDumpUint(h), 10)
+	case bd == mpInt8:
+		val = strconv.FormatInt(int64(int8(h[0])), 10)
+	case bd == mpInt16:
+		val = strconv.FormatInt(int64(int16(bigen.Uint16(h))), 10)
+	case bd == mpInt32:
+		val = strconv.FormatInt(int64(int32(bigen.Uint32(h))), 10)
+	case bd == mpInt64:
+		val = strconv.FormatInt(int64(bigen.Uint64(h)), 10)
+	case bd == mpFloat:
+		val = strconv.FormatFloat(float64(math.Float32frombits(bigen.Uint32(h))), 'g', -1, 32)
+	case bd == mpDouble:
+		val = strconv.FormatFloat(math.Float64frombits(bigen.Uint64(h)), 'g', -1, 64)
+	default:
+		// containers, strings, binary and extensions: get the length
+		ln, tag := msgpackDumpLen(bd, h, n)
+		desc := mpdesc(bd)
+		if desc == "map" || desc == "array" {
+			z.line(start, depth, h, name, bd, "len="+strconv.FormatUint(ln, 10))
+			if desc == "map" {
+				ln *= 2
+			}
+			for j := uint64(0); j < ln && z.err == nil; j++ {
+				if i >= len(z.b) {
+					return z.fail(i, depth+1, fmt.Sprintf("truncated %s at offset %d: have %d of %d items", name, start, j, ln))
+				}
+				i = z.dump(i, depth+1)
+			}
+			return i
+		}
+		// compare before converting, as the length may not fit in an int
+		if uint64(len(z.b)-i) < ln {
+			return z.fail(start, depth, fmt.Sprintf("truncated %s: need %d bytes, have %d", name, ln, len(z.b)-i))
+		}
+		n = int(ln)
+		v := z.b[i : i+n]
+		i += n
+		val = "len=" + strconv.Itoa(n)
+		switch desc {
+		case "string|bytes":
+			if len(v) > msgpackDumpMaxValueLen {
+				val += " " + strconv.Quote(string(v[:msgpackDumpMaxValueLen])) + "..."
+			} else {
+				val += " " + strconv.Quote(string(v))
+			}
+		case "ext":
+			val += " tag=" + strconv.Itoa(int(tag))
+			if tag == mpTimeExtTag {
+				if t, ok := msgpackDumpTime(v); ok {
+					val += " time=" + t.Format(time.RFC3339Nano)
+				} else {
+					val += " time=invalid"
+				}
+			} else {
+				val += " " + msgpackDumpHex(v)
+			}
+		default:
+			val += " " + msgpackDumpHex(v)
+		}
+	}
+	z.line(start, depth, h, name, bd, val)
+	return i
+}
+
+func (z *msgpackDumper) line(offset, depth int, h []byte, name string, bd byte, val string) {
+	if z.err != nil {
+		return
+	}
+	hs := fmt.Sprintf("%02x", bd)
+	if len(h) > 0 {
+		hs += " " + hex.EncodeToString(h)
+	}
+	_, z.err = fmt.Fprintf(z.w, "%6d  %-28s %-10s %-12s %s\n",
+		offset, strings.Repeat("  ", depth)+hs, name, mpdesc(bd), val)
+}
+
+// fail writes a line describing why decoding failed at offset i, and records the error.
+func (z *msgpackDumper) fail(i, depth int, msg string) int {
+	if z.err == nil {
+		if _, z.err = fmt.Fprintf(z.w, "%6d  %s!! %s\n", i, strings.Repeat("  ", depth), msg); z.err == nil {
+			z.err = fmt.Errorf("msgpack: offset %d: %s", i, msg)
+		}
+	}
+	return len(z.b)
+}
+
+// msgpackDumpFormat returns the format name of a descriptor, the number of header bytes
+// following it (for its value, length and/or extension tag), and the length of its
+// contents if known from the descriptor (else -1). It returns "" for an invalid descriptor.
+func msgpackDumpFormat(bd byte) (name string, hl, n int) {
+	switch {
+	case bd <= mpPosFixNumMax:
+		return "posfixint", 0, 0
+	case bd >= mpNegFixNumMin:
+		return "negfixint", 0, 0
+	case bd >= mpFixMapMin && bd <= mpFixMapMax:
+		return "fixmap", 0, int(bd - mpFixMapMin)
+	case bd >= mpFixArrayMin && bd <= mpFixArrayMax:
+		return "fixarray", 0, int(bd - mpFixArrayMin)
+	case bd >= mpFixStrMin && bd <= mpFixStrMax:
+		return "fixstr", 0, int(bd - mpFixStrMin)
+	case bd >= mpFixExt1 && bd <= mpFixExt16:
+		return "fixext" + strconv.Itoa(1<<(bd-mpFixExt1)), 1, 1 << (bd - mpFixExt1)
+	}
+	switch bd {
+	case mpNil:
+		return "nil", 0, 0
+	case mpFalse:
+		return "false", 0, 0
+	case mpTrue:
+		return "true", 0, 0
+	case mpFloat:
+		return "float32", 4, 0
+	case mpDouble:
+		return "float64", 8, 0
+	case mpUint8:
+		return "uint8", 1, 0
+	case mpUint16:
+		return "uint16", 2, 0
+	case mpUint32:
+		return "uint32", 4, 0
+	case mpUint64:
+		return "uint64", 8, 0
+	case mpInt8:
+		return "int8", 1, 0
+	case mpInt16:
+		return "int16", 2, 0
+	case mpInt32:
+		return "int32", 4, 0
+	case mpInt64:
+		return "int64", 8, 0
+	case mpBin8:
+		return "bin8", 1, -1
+	case mpBin16:
+		return "bin16", 2, -1
+	case mpBin32:
+		return "bin32", 4, -1
+	case mpExt8:
+		return "ext8", 2, -1
+	case mpExt16:
+		return "ext16", 3, -1
+	case mpExt32:
+		return "ext32", 5, -1
+	case mpStr8:
+		return "str8", 1, -1
+	case mpStr16:
+		return "str16", 2, -1
+	case mpStr32:
+		return "str32", 4, -1
+	case mpArray16:
+		return "array16", 2, -1
+	case mpArray32:
+		return "array32", 4, -1
+	case mpMap16:
+		return "map16", 2, -1
+	case mpMap32:
+		return "map32", 4, -1
+	}
+	return "", 0, 0
+}
+
+// msgpackDumpLen returns the length and extension tag of a container, string, binary or
+// extension value, given its descriptor, header bytes, and n as returned by msgpackDumpFormat.
+//
+// The length is a uint64, as a 32-bit length may not fit in an int:
+// callers check it against the input remaining before converting it.
+func msgpackDumpLen(bd byte, h []byte, n int) (uint64, int8) {
+	var tag int8
+	isExt := bd >= mpExt8 && bd <= mpExt32
+	if isExt || bd >= mpFixExt1 && bd <= mpFixExt16 {
+		tag = int8(h[len(h)-1])
+	}
+	if n >= 0 {
+		return uint64(n), tag
+	}
+	if isExt {
+		h = h[:len(h)-1]
+	}
+	return msgpackDumpUint(h), tag
+}
+
+func msgpackDumpUint(h []byte) uint64 {
+	switch len(h) {
+	case 1:
+		return uint64(h[0])
+	case 2:
+		return uint64(bigen.Uint16(h))
+	case 4:
+		return uint64(bigen.Uint32(h))
+	}
+	return bigen.Uint64(h)
+}
+
+func msgpackDumpHex(v []byte) string {
+	if len(v) > msgpackDumpMaxValueLen {
+		return hex.EncodeToString(v[:msgpackDumpMaxValueLen]) + "..."
+	}
+	return hex.EncodeToString(v)
+}
+
+// msgpackDumpTime decodes the data of a timestamp extension, mirroring msgpackDecDriver.decodeTime.
+func msgpackDumpTime(v []byte) (t time.Time, ok bool) {
+	switch len(v) {
+	case 4:
+		t = time.Unix(int64(bigen.Uint32(v)), 0).UTC()
+	case 8:
+		tv := bigen.Uint64(v)
+		t = time.Unix(int64(tv&0x00000003ffffffff), int64(tv>>34)).UTC()
+	case 12:
+		t = time.Unix(int64(bigen.Uint64(v[4:])), int64(bigen.Uint32(v[:4]))).UTC()
+	default:
+		return
+	}
+	return t, true
+}
@@ -74,12 +74,33 @@ type encDriverAsis interface {
 	EncodeAsis(v []byte)
 }
 
-type encodeError struct {
-	codecError
+// encDriverSymbols is implemented by an encDriver for a format which supports symbols.
+type encDriverSymbols interface {
+	// EncodeSymbol encodes s as a symbol if the AsSymbols option includes flag,
+	// else as a string.
+	EncodeSymbol(flag AsSymbolFlag, s string)
 }
 
-func (e encodeError) Error() string {
-	return fmt.Sprintf("%s encode error: %v", e.name, e.err)
+// EncodeError is the error returned when encoding fails.
+type EncodeError struct {
+	// Name is the name of the Handle e.g. msgpack.
+	Name string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *EncodeError) Error() string {
+	return fmt.Sprintf("%s encode error: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the underlying error, for use with errors.Is and errors.As.
+func (e *EncodeError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the underlying error, for use with github.com/pkg/errors.Cause.
+func (e *EncodeError) Cause() error {
+	return e.Err
 }
 
 type encDriverNoopContainerWriter struct{}
@@ -144,6 +165,8 @@ type EncodeOptions struct {
 	//       encoded into []byte, and then sorted,
 	//       before writing the sorted keys and the corresponding map values to the stream.
 	//
+	// For cbor, map keys are always sorted by their encoded bytes, and the
+	// other deterministic encoding rules of RFC 8949 are applied (see CborHandle).
 	Canonical bool
 
 	// CheckCircularRef controls whether we check for circular references
@@ -181,22 +204,57 @@ type EncodeOptions struct {
 	// These include encoding.TextMarshaler, time.Format calls, struct field names, etc.
 	StringToRaw bool
 
-	// // AsSymbols defines what should be encoded as symbols.
-	// //
-	// // Encoding as symbols can reduce the encoded size significantly.
-	// //
-	// // However, during decoding, each string to be encoded as a symbol must
-	// // be checked to see if it has been seen before. Consequently, encoding time
-	// // will increase if using symbols, because string comparisons has a clear cost.
-	// //
-	// // Sample values:
-	// //   AsSymbolNone
-	// //   AsSymbolAll
-	// //   AsSymbolMapStringKeys
-	// //   AsSymbolMapStringKeysFlag | AsSymbolStructFieldNameFlag
-	// AsSymbols AsSymbolFlag
+	// AsSymbols defines what should be encoded as symbols.
+	//
+	// A symbol is a string written in full at its first occurrence within a top-level value,
+	// and thereafter as a reference to it. Encoding as symbols can reduce the encoded
+	// size significantly, e.g. when encoding many structs with the same field names.
+	//
+	// However, during encoding, each string to be encoded as a symbol must
+	// be checked to see if it has been seen before. Consequently, encoding time
+	// will increase if using symbols, because string lookups have a clear cost.
+	//
+	// Symbols last only within one top-level value: each value defines its symbols anew.
+	// So encoding as symbols only pays off for strings repeated within a value;
+	// e.g. a single struct gets larger, as each string carries the extension header
+	// of its definition (32 vs 26 bytes for struct{Name, Kind string}{"name-0", "service"}).
+	//
+	// It is only supported by msgpack (with WriteExt set), and ignored by other formats.
+	// A msgpack Handle only decodes symbols if AsSymbols is set (to any flags).
+	// Note that the bytes of a value within a top-level value (e.g. as returned by Lookup)
+	// may then refer to symbols defined before it, so cannot be decoded on their own.
+	//
+	// Sample values:
+	//   AsSymbolNone
+	//   AsSymbolAll
+	//   AsSymbolMapStringKeys
+	//   AsSymbolMapStringKeysFlag | AsSymbolStructFieldNameFlag
+	AsSymbols AsSymbolFlag
 }
 
+// AsSymbolFlag defines what should be encoded as symbols (see EncodeOptions.AsSymbols).
+type AsSymbolFlag uint8
+
+const (
+	// AsSymbolNone says to not encode any strings as symbols.
+	AsSymbolNone AsSymbolFlag = 0
+
+	// AsSymbolMapStringKeysFlag says to encode the keys of a map[string]XXX as symbols.
+	AsSymbolMapStringKeysFlag AsSymbolFlag = 1 << 0
+
+	// AsSymbolStructFieldNameFlag says to encode struct field names as symbols.
+	AsSymbolStructFieldNameFlag AsSymbolFlag = 1 << 1
+
+	// asSymbolValueFlag says to encode all other strings as symbols.
+	asSymbolValueFlag AsSymbolFlag = 1 << 2
+
+	// AsSymbolMapStringKeys says to encode the keys of a map[string]XXX as symbols.
+	AsSymbolMapStringKeys = AsSymbolMapStringKeysFlag
+
+	// AsSymbolAll says to encode all strings as symbols.
+	AsSymbolAll AsSymbolFlag = 0xff
+)
+
 // ---------------------------------------------
 
 /*
@@ -640,11 +698,15 @@ func (e *Encoder) kSlice(f *codecFnInfo, rv reflect.Value) {
 }
 
 func (e *Encoder) kStructNoOmitempty(f *codecFnInfo, rv reflect.Value) {
+	if e.fm != nil {
+		e.kStruct(f, rv)
+		return
+	}
 	fti := f.ti
 	tisfi := fti.sfiSrc
 	toMap := !(fti.toArray || e.h.StructToArray)
 	if toMap {
-		tisfi = fti.sfiSort
+		tisfi = e.sfiSort(fti)
 	}
 
 	ee := e.e
@@ -682,7 +744,35 @@ func (e *Encoder) kStructNoOmitempty(f *codecFnInfo, rv reflect.Value) {
 	}
 }
 
+// structReflect returns whether a struct must be encoded via reflection, not by a Selfer
+// generated by codecgen: with a field mask, or if Canonical orders keys by their encoding.
+func (e *Encoder) structReflect() bool {
+	return e.fm != nil || (e.h.Canonical && e.h.ke)
+}
+
+// encStructReflect encodes v, a struct or pointer to one, via reflection (see structReflect).
+func (e *Encoder) encStructReflect(v interface{}) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	rt := rv.Type()
+	e.kStruct(&codecFnInfo{ti: e.h.getTypeInfo(rt2id(rt), rt)}, rv)
+}
+
+// sfiSort returns the fields of a struct encoded as a map, in the order they are written.
+func (e *Encoder) sfiSort(fti *typeInfo) []*structFieldInfo {
+	if e.h.Canonical && e.h.ke {
+		return fti.sfiSortEncoded()
+	}
+	return fti.sfiSort
+}
+
 func (e *Encoder) kStructFieldKey(keyType valueType, encNameAsciiAlphaNum bool, encName string) {
+	if e.es != nil && keyType == valueTypeString {
+		e.es.EncodeSymbol(AsSymbolStructFieldNameFlag, encName)
+		return
+	}
 	encStructFieldKey(encName, e.e, e.w, keyType, encNameAsciiAlphaNum, e.js)
 }
 
@@ -711,7 +801,7 @@ func (e *Encoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 	}
 	// if toMap, use the sorted array. If toArray, use unsorted array (to match sequence in struct)
 	if toMap {
-		tisfi = fti.sfiSort
+		tisfi = e.sfiSort(fti)
 	}
 	newlen += len(tisfi)
 	ee := e.e
@@ -735,15 +825,21 @@ func (e *Encoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 	var kv sfiRv
 	recur := e.h.RecursiveEmptyCheck
 	sfn := structFieldNode{v: rv, update: false}
+	fm := e.fm
 	newlen = 0
 	for _, si := range tisfi {
 		// kv.r = si.field(rv, false)
 		kv.r = sfn.field(si)
-		if toMap {
+		kv.v = si // si.encName
+		if fm != nil && !fm.has(si.encName) {
+			if toMap {
+				continue
+			}
+			kv.r = reflect.Value{} // encode as nil
+		} else if toMap {
 			if si.omitEmpty() && isEmptyValue(kv.r, e.h.TypeInfos, recur, recur) {
 				continue
 			}
-			kv.v = si // si.encName
 		} else {
 			// use the zero value.
 			// if a reference or struct, set to nil (so you do not output too much)
@@ -760,9 +856,14 @@ func (e *Encoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 	}
 	fkvs = fkvs[:newlen]
 
+	var uf UnknownFields
+	if fti.uf >= 0 && fm == nil {
+		uf = rv2i(rv.Field(int(fti.uf))).(UnknownFields)
+	}
+
 	var mflen int
 	for k, v := range mf {
-		if k == "" {
+		if k == "" || (fm != nil && !fm.has(k)) {
 			delete(mf, k)
 			continue
 		}
@@ -775,19 +876,25 @@ func (e *Encoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 
 	var j int
 	if toMap {
-		ee.WriteMapStart(newlen + mflen)
+		ee.WriteMapStart(newlen + mflen + uf.Len())
 		if elemsep {
 			for j = 0; j < len(fkvs); j++ {
 				kv = fkvs[j]
 				ee.WriteMapElemKey()
 				e.kStructFieldKey(fti.keyType, kv.v.encNameAsciiAlphaNum, kv.v.encName)
 				ee.WriteMapElemValue()
+				if fm != nil {
+					e.fm = fm.fields[kv.v.encName]
+				}
 				e.encodeValue(kv.r, nil, true)
 			}
 		} else {
 			for j = 0; j < len(fkvs); j++ {
 				kv = fkvs[j]
 				e.kStructFieldKey(fti.keyType, kv.v.encNameAsciiAlphaNum, kv.v.encName)
+				if fm != nil {
+					e.fm = fm.fields[kv.v.encName]
+				}
 				e.encodeValue(kv.r, nil, true)
 			}
 		}
@@ -796,21 +903,33 @@ func (e *Encoder) kStruct(f *codecFnInfo, rv reflect.Value) {
 			ee.WriteMapElemKey()
 			e.kStructFieldKey(fti.keyType, false, k)
 			ee.WriteMapElemValue()
+			if fm != nil {
+				e.fm = fm.fields[k]
+			}
 			e.encode(v)
 		}
+		e.fm = fm
+		e.encUnknownFields(&uf)
 		ee.WriteMapEnd()
 	} else {
 		ee.WriteArrayStart(newlen)
 		if elemsep {
 			for j = 0; j < len(fkvs); j++ {
 				ee.WriteArrayElem()
+				if fm != nil {
+					e.fm = fm.fields[fkvs[j].v.encName]
+				}
 				e.encodeValue(fkvs[j].r, nil, true)
 			}
 		} else {
 			for j = 0; j < len(fkvs); j++ {
+				if fm != nil {
+					e.fm = fm.fields[fkvs[j].v.encName]
+				}
 				e.encodeValue(fkvs[j].r, nil, true)
 			}
 		}
+		e.fm = fm
 		ee.WriteArrayEnd()
 	}
 
@@ -879,11 +998,13 @@ func (e *Encoder) kMap(f *codecFnInfo, rv reflect.Value) {
 			ee.WriteMapElemKey()
 		}
 		if keyTypeIsString {
-			if e.h.StringToRaw {
-				ee.EncodeStringBytesRaw(bytesView(mks[j].String()))
-			} else {
-				ee.EncodeStringEnc(cUTF8, mks[j].String())
-			}
+			e.encodeString(AsSymbolMapStringKeysFlag, mks[j].String())
+		} else if e.fm != nil {
+			// the mask is for the values, not the keys
+			fm := e.fm
+			e.fm = nil
+			e.encodeValue(mks[j], keyFn, true)
+			e.fm = fm
 		} else {
 			e.encodeValue(mks[j], keyFn, true)
 		}
@@ -901,8 +1022,15 @@ func (e *Encoder) kMapCanonical(rtkey reflect.Type, rv reflect.Value, mks []refl
 	elemsep := e.esep
 	// we previously did out-of-band if an extension was registered.
 	// This is not necessary, as the natural kind is sufficient for ordering.
+	//
+	// cbor is the exception: its deterministic encoding orders keys by their encoded bytes,
+	// which differs from the natural order for numbers and strings.
+	rk := rtkey.Kind()
+	if e.h.ke {
+		rk = reflect.Invalid
+	}
 
-	switch rtkey.Kind() {
+	switch rk {
 	case reflect.Bool:
 		mksv := make([]boolRv, len(mks))
 		for i, k := range mks {
@@ -933,11 +1061,7 @@ func (e *Encoder) kMapCanonical(rtkey reflect.Type, rv reflect.Value, mks []refl
 			if elemsep {
 				ee.WriteMapElemKey()
 			}
-			if e.h.StringToRaw {
-				ee.EncodeStringBytesRaw(bytesView(mksv[i].v))
-			} else {
-				ee.EncodeStringEnc(cUTF8, mksv[i].v)
-			}
+			e.encodeString(AsSymbolMapStringKeysFlag, mksv[i].v)
 			if elemsep {
 				ee.WriteMapElemValue()
 			}
@@ -1042,6 +1166,7 @@ func (e *Encoder) kMapCanonical(rtkey reflect.Type, rv reflect.Value, mks []refl
 		// first encode each key to a []byte first, then sort them, then record
 		var mksv []byte = make([]byte, 0, len(mks)*16) // temporary byte slice for the encoding
 		e2 := NewEncoderBytes(&mksv, e.hh)
+		e2.es = nil // symbols are defined in order of the stream, which the sort changes
 		mksbv := make([]bytesRv, len(mks))
 		for i, k := range mks {
 			v := &mksbv[i]
@@ -1234,6 +1359,7 @@ type Encoder struct {
 
 	// bw *bufio.Writer
 	as encDriverAsis
+	es encDriverSymbols // set if AsSymbols is set, and supported by the format
 
 	err error
 
@@ -1244,6 +1370,10 @@ type Encoder struct {
 
 	ci set
 
+	tok []encTokenContainer // containers started via WriteMapStart/WriteArrayStart, innermost last
+
+	fm *FieldMask // the mask for the value being encoded, if any (see EncodeMasked)
+
 	b [(5 * 8)]byte // for encoding chan or (non-addressable) [N]byte
 
 	// ---- writable fields during execution --- *try* to keep in sep cache line
@@ -1296,10 +1426,16 @@ func (e *Encoder) resetCommon() {
 		e.as, e.isas = e.e.(encDriverAsis)
 		// e.cr, _ = e.e.(containerStateRecv)
 	}
+	e.es = nil
+	if e.h.AsSymbols != AsSymbolNone {
+		e.es, _ = e.e.(encDriverSymbols)
+	}
 	e.be = e.hh.isBinary()
 	_, e.js = e.hh.(*JsonHandle)
 	e.e.reset()
 	e.err = nil
+	e.tok = e.tok[:0]
+	e.fm = nil
 }
 
 // Reset resets the Encoder with a new output stream.
@@ -1384,6 +1520,13 @@ func (e *Encoder) ResetBytes(out *[]byte) {
 //
 // When encoding as a map, the first string in the tag (before the comma)
 // is the map key string to use when encoding.
+// Other keys which the field is decoded from may be given with "alias=" options,
+// so a renamed field is still decoded from streams written with its old names.
+// Fields with the "required" option must be in the map when decoding,
+// else Decode fails with a MissingFieldsError listing all those missing.
+// Fields absent when decoding (from a map, or past the end of a short array)
+// are set to the value of their "default=" option if any (a bool, number, time.Duration
+// or string without a comma), or as set by CodecDefaults if the struct is a Defaulter.
 // ...
 // This key is typically encoded as a string.
 // However, there are instances where the encoded stream has mapping keys encoded as numbers.
@@ -1417,6 +1560,9 @@ func (e *Encoder) ResetBytes(out *[]byte) {
 //	    Field2 int      `codec:"myName"`       //Use key "myName" in encode stream
 //	    Field3 int32    `codec:",omitempty"`   //use key "Field3". Omit if empty.
 //	    Field4 bool     `codec:"f4,omitempty"` //use key "f4". Omit if empty.
+//	    Field5 int      `codec:"f5,alias=x5"`  //use key "f5". Also decode from key "x5".
+//	    Field6 int      `codec:"f6,required"`  //use key "f6". Fail decode if absent.
+//	    Field7 int      `codec:"f7,default=3"` //use key "f7". Decode as 3 if absent.
 //	    io.Reader                              //use key "Reader".
 //	    MyStruct        `codec:"my1"           //use key "my1".
 //	    MyStruct                               //inline it
@@ -1439,9 +1585,9 @@ func (e *Encoder) ResetBytes(out *[]byte) {
 //   - If implements encoding.(Binary|Text|JSON)Marshaler, call Marshal(Binary|Text|JSON) method
 //   - Else encode it based on its reflect.Kind
 //
-// Note that struct field names and keys in map[string]XXX will be treated as symbols.
-// Some formats support symbols (e.g. binc) and will properly encode the string
-// only once in the stream, and use a tag to refer to it thereafter.
+// Note that struct field names and keys in map[string]XXX can be treated as symbols
+// (see AsSymbols). Some formats support symbols (e.g. msgpack) and will encode the string
+// only once in each top-level value, and use a tag to refer to it thereafter.
 func (e *Encoder) Encode(v interface{}) (err error) {
 	// tried to use closure, as runtime optimizes defer with no params.
 	// This seemed to be causing weird issues (like circular reference found, unexpected panic, etc).
@@ -1481,6 +1627,11 @@ func (e *Encoder) MustEncode(v interface{}) {
 }
 
 func (e *Encoder) mustEncode(v interface{}) {
+	if len(e.tok) != 0 {
+		e.tokenElem(false)
+		e.encode(v)
+		return
+	}
 	if e.wf == nil {
 		e.encode(v)
 		e.e.atEndOfEncode()
@@ -1550,11 +1701,7 @@ func (e *Encoder) encode(iv interface{}) {
 		e.encodeValue(v, nil, true)
 
 	case string:
-		if e.h.StringToRaw {
-			e.e.EncodeStringBytesRaw(bytesView(v))
-		} else {
-			e.e.EncodeStringEnc(cUTF8, v)
-		}
+		e.encodeString(asSymbolValueFlag, v)
 	case bool:
 		e.e.EncodeBool(v)
 	case int:
@@ -1592,11 +1739,7 @@ func (e *Encoder) encode(iv interface{}) {
 		e.rawBytes(*v)
 
 	case *string:
-		if e.h.StringToRaw {
-			e.e.EncodeStringBytesRaw(bytesView(*v))
-		} else {
-			e.e.EncodeStringEnc(cUTF8, *v)
-		}
+		e.encodeString(asSymbolValueFlag, *v)
 	case *bool:
 		e.e.EncodeBool(*v)
 	case *int:
@@ -1667,6 +1810,12 @@ TOP:
 			e.e.EncodeNil()
 			return
 		}
+		if len(e.h.polyTypes) != 0 && rv.NumMethod() != 0 {
+			if pt := e.h.polyType(rt2id(rv.Type())); pt != nil {
+				e.encodePoly(pt, rv.Elem())
+				return
+			}
+		}
 		rv = rv.Elem()
 		goto TOP
 	case reflect.Slice, reflect.Map:
@@ -1769,7 +1918,7 @@ func (e *Encoder) rawBytes(vv Raw) {
 }
 
 func (e *Encoder) wrapErr(v interface{}, err *error) {
-	*err = encodeError{codecError{name: e.hh.Name(), err: v}}
+	*err = &EncodeError{Name: e.hh.Name(), Err: errFromPanicVal(v)}
 }
 
 func encStructFieldKey(encName string, ee encDriver, w *encWriterSwitch,
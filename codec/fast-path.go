@@ -0,0 +1,526 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"reflect"
+	"sort"
+)
+
+// fastpath encodes and decodes the common slices and maps of builtin types
+// (e.g. []string, map[string]string, map[string]interface{}) without reflection
+// on each element.
+//
+// The original fast-path was generated code which used unsafe, and was removed.
+// This one uses generics instead: each supported type is registered in fastpathAV,
+// with functions which mirror kSlice and kMap exactly, only typed.
+//
+// It is used for values passed to Encode or Decode (via fastpathEncodeTypeSwitch
+// and fastpathDecodeTypeSwitch) and for fields, elements, etc (via the codecFn
+// looked up for their type). Element types which are not builtin (including named
+// types e.g. type S string, or types with an extension) are never on the fast-path.
+
+const fastpathEnabled = true
+
+type fastpathT struct{}
+
+type fastpathE struct {
+	rtid  uintptr
+	rt    reflect.Type
+	krtid uintptr // of the key, if a map
+	ertid uintptr // of the element or value
+	encfn func(*Encoder, *codecFnInfo, reflect.Value)
+	decfn func(*Decoder, *codecFnInfo, reflect.Value)
+}
+
+// fastpathA is the set of fast-path types, sorted by rtid.
+type fastpathA []fastpathE
+
+func (x fastpathA) index(rtid uintptr) int {
+	// binary search. adapted from sort/search.go.
+	i, j := 0, len(x)
+	for i < j {
+		h := i + (j-i)/2
+		if x[h].rtid < rtid {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(x) && x[i].rtid == rtid {
+		return i
+	}
+	return -1
+}
+
+var fastpathAV fastpathA
+var fastpathTV fastpathT
+
+// fastpathOK returns true if the fast-path type at idx can be used with the Handle
+// i.e. no extension is registered for the type of its elements (or keys).
+func (x *BasicHandle) fastpathOK(idx int) bool {
+	if len(x.extHandle) == 0 {
+		return true
+	}
+	fe := &fastpathAV[idx]
+	return x.getExt(fe.ertid) == nil && (fe.krtid == 0 || x.getExt(fe.krtid) == nil)
+}
+
+func init() {
+	var (
+		encString = fastpathEncString
+		encBool   = fastpathEncBool
+		encIntf   = fastpathEncIntf
+
+		decString = fastpathDecString
+		decBool   = fastpathDecBool
+		decIntf   = fastpathDecIntf
+	)
+	fastpathAV = fastpathA{
+		fastpathSlice(encString, decString),
+		fastpathSlice(encBool, decBool),
+		fastpathSlice(encIntf, decIntf),
+		fastpathSlice(fastpathEncInt[int], fastpathDecInt[int](intBitsize)),
+		fastpathSlice(fastpathEncInt[int8], fastpathDecInt[int8](8)),
+		fastpathSlice(fastpathEncInt[int16], fastpathDecInt[int16](16)),
+		fastpathSlice(fastpathEncInt[int32], fastpathDecInt[int32](32)),
+		fastpathSlice(fastpathEncInt[int64], fastpathDecInt[int64](64)),
+		fastpathSlice(fastpathEncUint[uint], fastpathDecUint[uint](uintBitsize)),
+		fastpathSlice(fastpathEncUint[uint16], fastpathDecUint[uint16](16)),
+		fastpathSlice(fastpathEncUint[uint32], fastpathDecUint[uint32](32)),
+		fastpathSlice(fastpathEncUint[uint64], fastpathDecUint[uint64](64)),
+		fastpathSlice(fastpathEncFloat32, fastpathDecFloat32),
+		fastpathSlice(fastpathEncFloat64, fastpathDecFloat64),
+	}
+	fastpathAV = append(fastpathAV, fastpathMaps(fastpathEncMapKeyString, fastpathDecMapKeyString)...)
+	fastpathAV = append(fastpathAV, fastpathMaps(encIntf, fastpathDecMapKeyIntf)...)
+	fastpathAV = append(fastpathAV, fastpathMaps(fastpathEncInt[int], fastpathDecInt[int](intBitsize))...)
+	fastpathAV = append(fastpathAV, fastpathMaps(fastpathEncInt[int64], fastpathDecInt[int64](64))...)
+	fastpathAV = append(fastpathAV, fastpathMaps(fastpathEncUint[uint64], fastpathDecUint[uint64](64))...)
+	sort.Slice(fastpathAV, func(i, j int) bool { return fastpathAV[i].rtid < fastpathAV[j].rtid })
+}
+
+// fastpathMaps returns the entries for the maps with keys of type K,
+// and values of each of the builtin types supported in map values.
+func fastpathMaps[K comparable](encK func(*Encoder, K), decK func(*Decoder, *K)) []fastpathE {
+	return []fastpathE{
+		fastpathMap(encK, decK, fastpathEncString, fastpathDecString),
+		fastpathMap(encK, decK, fastpathEncBool, fastpathDecBool),
+		fastpathMap(encK, decK, fastpathEncIntf, fastpathDecIntf),
+		fastpathMap(encK, decK, fastpathEncInt[int], fastpathDecInt[int](intBitsize)),
+		fastpathMap(encK, decK, fastpathEncInt[int64], fastpathDecInt[int64](64)),
+		fastpathMap(encK, decK, fastpathEncUint[uint64], fastpathDecUint[uint64](64)),
+		fastpathMap(encK, decK, fastpathEncFloat64, fastpathDecFloat64),
+	}
+}
+
+// fastpathEncodeTypeSwitch encodes iv via the fast-path if it is
+// (a pointer to) a fast-path type, and returns true if so.
+func fastpathEncodeTypeSwitch(iv interface{}, e *Encoder) bool {
+	rt := reflect.TypeOf(iv)
+	isPtr := rt.Kind() == reflect.Pointer
+	if isPtr {
+		rt = rt.Elem()
+	}
+	if k := rt.Kind(); k != reflect.Slice && k != reflect.Map {
+		return false
+	}
+	if idx := fastpathAV.index(rt2id(rt)); idx != -1 && e.h.fastpathOK(idx) {
+		rv := reflect.ValueOf(iv)
+		if isPtr {
+			rv = rv.Elem()
+		}
+		fastpathAV[idx].encfn(e, nil, rv)
+		return true
+	}
+	return false
+}
+
+// fastpathDecodeTypeSwitch decodes into iv via the fast-path if it is
+// a pointer to a fast-path type, and returns true if so.
+func fastpathDecodeTypeSwitch(iv interface{}, d *Decoder) bool {
+	rt := reflect.TypeOf(iv)
+	if rt.Kind() != reflect.Pointer {
+		return false
+	}
+	if idx := fastpathAV.index(rt2id(rt.Elem())); idx != -1 && d.h.fastpathOK(idx) {
+		fastpathAV[idx].decfn(d, nil, reflect.ValueOf(iv))
+		return true
+	}
+	return false
+}
+
+func fastpathEncodeTypeSwitchSlice(iv interface{}, e *Encoder) bool { return false }
+func fastpathEncodeTypeSwitchMap(iv interface{}, e *Encoder) bool   { return false }
+func fastpathDecodeSetZeroTypeSwitch(iv interface{}) bool           { return false }
+
+func (fastpathT) DecSliceUint8V(v []uint8, canChange bool, d *Decoder) (_ []uint8, changed bool) {
+	fn := d.h.fn(uint8SliceTyp, true, true)
+	d.kSlice(&fn.i, reflect.ValueOf(&v).Elem())
+	return v, true
+}
+
+// fastpathRv returns the value of type T held in rv.
+//
+// An addressable rv is read via its address, as rv.Interface() would copy it.
+func fastpathRv[T any](rv reflect.Value) T {
+	if rv.CanAddr() {
+		return *rv.Addr().Interface().(*T)
+	}
+	return rv.Interface().(T)
+}
+
+// fastpathFnInfo returns the codecFnInfo for kSlice or kMap to encode or decode rv,
+// when the fast-path must defer to them.
+func fastpathFnInfo(h *BasicHandle, rv reflect.Value) (reflect.Value, *codecFnInfo) {
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	rt := rv.Type()
+	f := &codecFnInfo{ti: h.getTypeInfo(rt2id(rt), rt)}
+	if rt.Kind() == reflect.Slice {
+		f.seq = seqTypeSlice
+	}
+	return rv, f
+}
+
+// ---- slices
+
+func fastpathSlice[T any](enc func(*Encoder, T), dec func(*Decoder, *T)) fastpathE {
+	rt := reflect.TypeFor[[]T]()
+	return fastpathE{
+		rtid:  rt2id(rt),
+		rt:    rt,
+		ertid: rt2id(rt.Elem()),
+		encfn: func(e *Encoder, _ *codecFnInfo, rv reflect.Value) {
+			fastpathEncSlice(e, fastpathRv[[]T](rv), enc)
+		},
+		decfn: func(d *Decoder, f *codecFnInfo, rv reflect.Value) {
+			if rv.Kind() != reflect.Pointer {
+				// only the existing elements can be decoded into e.g. those of an array
+				if f == nil || f.seq != seqTypeArray {
+					rv, f = fastpathFnInfo(d.h, rv)
+				}
+				d.kSlice(f, rv)
+				return
+			}
+			fastpathDecSlice(d, rv.Interface().(*[]T), dec)
+		},
+	}
+}
+
+// fastpathEncSlice encodes v as kSlice does.
+func fastpathEncSlice[T any](e *Encoder, v []T, enc func(*Encoder, T)) {
+	ee := e.e
+	if v == nil {
+		ee.EncodeNil()
+		return
+	}
+	ee.WriteArrayStart(len(v))
+	for j := range v {
+		if e.esep {
+			ee.WriteArrayElem()
+		}
+		enc(e, v[j])
+	}
+	ee.WriteArrayEnd()
+}
+
+// fastpathDecSlice decodes into *v as kSlice does.
+func fastpathDecSlice[T any](d *Decoder, v *[]T, dec func(*Decoder, *T)) {
+	dd := d.d
+	rtelem := reflect.TypeFor[T]()
+	if ctyp := dd.ContainerType(); ctyp == valueTypeBytes || ctyp == valueTypeString {
+		d.errorIs(ErrTypeMismatch, ctyp, "bytes/string in stream must decode into slice/array of bytes, not %v",
+			reflect.SliceOf(rtelem))
+	}
+
+	slh, containerLenS := d.decSliceHelperStart() // only expects valueType(Array|Map)
+	s := *v
+	if containerLenS == 0 {
+		if s == nil {
+			*v = []T{}
+		} else {
+			*v = s[:0]
+		}
+		slh.End()
+		return
+	}
+
+	d.depthIncr()
+	d.pathElem().typ = rtelem
+	size := int(rtelem.Size())
+
+	hasLen := containerLenS > 0
+	if hasLen {
+		if containerLenS > cap(s) {
+			if n := decInferLen(containerLenS, d.h.MaxInitLen, size); n <= cap(s) {
+				s = s[:n]
+			} else {
+				s2 := make([]T, n)
+				copy(s2, s)
+				s = s2
+			}
+		} else if containerLenS != len(s) {
+			s = s[:containerLenS]
+		}
+	}
+
+	var j int
+	for ; (hasLen && j < containerLenS) || !(hasLen || dd.CheckBreak()); j++ {
+		d.pathElem().idx = j
+		if j == 0 && s == nil {
+			if hasLen {
+				s = make([]T, decInferLen(containerLenS, d.h.MaxInitLen, size))
+			} else {
+				s = make([]T, decDefSliceCap)
+			}
+		}
+		if d.limits {
+			if slh.array {
+				d.limitElem(j+1, false, hasLen, size)
+			} else {
+				d.limitElem(j/2+1, true, hasLen, size)
+			}
+		}
+		slh.ElemContainerState(j)
+		decodeAsNil := dd.TryDecodeAsNil()
+		if j >= len(s) {
+			if j == cap(s) {
+				s2 := make([]T, j+1, growCap(cap(s), size, 1))
+				copy(s2, s)
+				s = s2
+			} else {
+				s = s[:j+1]
+			}
+		}
+		if d.h.SliceElementReset || decodeAsNil {
+			var zero T
+			s[j] = zero
+			if decodeAsNil {
+				continue
+			}
+		}
+		dec(d, &s[j])
+	}
+	if j < len(s) {
+		s = s[:j]
+	} else if j == 0 && s == nil {
+		s = []T{}
+	}
+	slh.End()
+	*v = s
+
+	d.depthDecr()
+}
+
+// ---- maps
+
+func fastpathMap[K comparable, V any](encK func(*Encoder, K), decK func(*Decoder, *K),
+	encV func(*Encoder, V), decV func(*Decoder, *V)) fastpathE {
+	rt := reflect.TypeFor[map[K]V]()
+	return fastpathE{
+		rtid:  rt2id(rt),
+		rt:    rt,
+		krtid: rt2id(rt.Key()),
+		ertid: rt2id(rt.Elem()),
+		encfn: func(e *Encoder, _ *codecFnInfo, rv reflect.Value) {
+			if e.h.Canonical {
+				// the order of the keys is per kMapCanonical
+				rv, f := fastpathFnInfo(e.h, rv)
+				e.kMap(f, rv)
+				return
+			}
+			fastpathEncMap(e, fastpathRv[map[K]V](rv), encK, encV)
+		},
+		decfn: func(d *Decoder, _ *codecFnInfo, rv reflect.Value) {
+			if rv.Kind() != reflect.Pointer {
+				// a nil map cannot be set
+				rv, f := fastpathFnInfo(d.h, rv)
+				d.kMap(f, rv)
+				return
+			}
+			fastpathDecMap(d, rv.Interface().(*map[K]V), decK, decV)
+		},
+	}
+}
+
+// fastpathEncMap encodes v as kMap does (when not Canonical).
+func fastpathEncMap[K comparable, V any](e *Encoder, v map[K]V, encK func(*Encoder, K), encV func(*Encoder, V)) {
+	ee := e.e
+	if v == nil {
+		ee.EncodeNil()
+		return
+	}
+	ee.WriteMapStart(len(v))
+	for k, mv := range v {
+		if e.esep {
+			ee.WriteMapElemKey()
+		}
+		encK(e, k)
+		if e.esep {
+			ee.WriteMapElemValue()
+		}
+		encV(e, mv)
+	}
+	ee.WriteMapEnd()
+}
+
+// fastpathMapEntry holds the entry being decoded by fastpathDecMap.
+type fastpathMapEntry[K comparable, V any] struct {
+	k K
+	v V
+}
+
+// fastpathDecMap decodes into *v as kMap does.
+func fastpathDecMap[K comparable, V any](d *Decoder, v *map[K]V, decK func(*Decoder, *K), decV func(*Decoder, *V)) {
+	dd := d.d
+	containerLen := dd.ReadMapStart()
+	rtkey, rtval := reflect.TypeFor[K](), reflect.TypeFor[V]()
+	size := int(rtkey.Size() + rtval.Size())
+	m := *v
+	if m == nil {
+		m = make(map[K]V, decInferLen(containerLen, d.h.MaxInitLen, size))
+		*v = m
+	}
+	if containerLen == 0 {
+		dd.ReadMapEnd()
+		return
+	}
+
+	d.depthIncr()
+	d.pathElem().typ = rtval
+
+	// Only an interface value is decoded into the existing value (per kMap),
+	// as all other fast-path value types are immutable.
+	mapGet := rtval.Kind() == reflect.Interface && !d.h.MapValueReset && !d.h.InterfaceReset
+
+	// The entry is decoded into storage allocated once per map (not per entry),
+	// which also lets the key be the path of the value without boxing each key.
+	var kv fastpathMapEntry[K, V]
+	kvk := reflect.ValueOf(&kv.k).Elem()
+
+	hasLen := containerLen > 0
+	for j := 0; (hasLen && j < containerLen) || !(hasLen || dd.CheckBreak()); j++ {
+		d.pathElem().key = reflect.Value{}
+		if d.limits {
+			d.limitElem(j+1, true, hasLen, size)
+		}
+		if d.esep {
+			dd.ReadMapElemKey()
+		}
+		kv = fastpathMapEntry[K, V]{}
+		decK(d, &kv.k)
+		if d.esep {
+			dd.ReadMapElemValue()
+		}
+		if dd.TryDecodeAsNil() {
+			if d.h.DeleteOnNilMapValue {
+				delete(m, kv.k)
+			} else {
+				m[kv.k] = kv.v
+			}
+			continue
+		}
+		if mapGet {
+			kv.v = m[kv.k]
+		}
+		d.pathElem().key = kvk
+		decV(d, &kv.v)
+		m[kv.k] = kv.v
+	}
+
+	d.pathElem().key = reflect.Value{}
+	dd.ReadMapEnd()
+
+	d.depthDecr()
+}
+
+// ---- elements
+
+type fastpathInt interface {
+	int | int8 | int16 | int32 | int64
+}
+
+type fastpathUint interface {
+	uint | uint16 | uint32 | uint64
+}
+
+func fastpathEncString(e *Encoder, v string) {
+	e.encodeString(asSymbolValueFlag, v)
+}
+
+func fastpathEncMapKeyString(e *Encoder, v string) {
+	e.encodeString(AsSymbolMapStringKeysFlag, v)
+}
+
+func fastpathEncBool(e *Encoder, v bool) {
+	e.e.EncodeBool(v)
+}
+
+func fastpathEncInt[T fastpathInt](e *Encoder, v T) {
+	e.e.EncodeInt(int64(v))
+}
+
+func fastpathEncUint[T fastpathUint](e *Encoder, v T) {
+	e.e.EncodeUint(uint64(v))
+}
+
+func fastpathEncFloat32(e *Encoder, v float32) {
+	e.e.EncodeFloat32(v)
+}
+
+func fastpathEncFloat64(e *Encoder, v float64) {
+	e.e.EncodeFloat64(v)
+}
+
+func fastpathEncIntf(e *Encoder, v interface{}) {
+	e.encode(v)
+}
+
+func fastpathDecString(d *Decoder, v *string) {
+	*v = d.d.DecodeString()
+}
+
+func fastpathDecBool(d *Decoder, v *bool) {
+	*v = d.d.DecodeBool()
+}
+
+func fastpathDecInt[T fastpathInt](bitsize uint8) func(*Decoder, *T) {
+	return func(d *Decoder, v *T) {
+		*v = T(chkOvf.IntV(d.d.DecodeInt64(), bitsize))
+	}
+}
+
+func fastpathDecUint[T fastpathUint](bitsize uint8) func(*Decoder, *T) {
+	return func(d *Decoder, v *T) {
+		*v = T(chkOvf.UintV(d.d.DecodeUint64(), bitsize))
+	}
+}
+
+func fastpathDecFloat32(d *Decoder, v *float32) {
+	*v = float32(chkOvf.Float32V(d.d.DecodeFloat64()))
+}
+
+func fastpathDecFloat64(d *Decoder, v *float64) {
+	*v = d.d.DecodeFloat64()
+}
+
+func fastpathDecIntf(d *Decoder, v *interface{}) {
+	d.decode(v)
+}
+
+// fastpathDecMapKeyString decodes a string map key, interning it if InternString.
+func fastpathDecMapKeyString(d *Decoder, v *string) {
+	*v = d.string(d.d.DecodeStringAsBytes())
+}
+
+// fastpathDecMapKeyIntf decodes an interface{} map key, as a string if it is bytes
+// (as a []byte cannot be a map key).
+func fastpathDecMapKeyIntf(d *Decoder, v *interface{}) {
+	d.decode(v)
+	if bs, ok := (*v).([]byte); ok {
+		*v = d.string(bs)
+	}
+}
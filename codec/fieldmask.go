@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldMask selects the struct fields written by Encoder.EncodeMasked.
+//
+// It is made from a set of paths, each a sequence of struct field names separated
+// by '.' e.g. `Spec.Tasks.Name`. A name is the key the field is encoded with
+// (as given by its struct tag), and a path selects all of the value of the last
+// field in it. Only the selected fields of a struct are encoded, and only the
+// selected fields of the value of a selected field, and so on.
+//
+// Slices, arrays, maps and pointers are transparent: the mask for a field
+// applies to each element or map value within it. So for
+//
+//	type Spec struct {
+//	    Name  string
+//	    Tasks []Task
+//	    Meta  map[string]string
+//	}
+//
+// the paths `Name` and `Tasks.Driver` select the name, and the driver of each task.
+//
+// When encoding a struct to an array, unselected fields are encoded as nil, so that the
+// selected ones keep their positions. The fields of a MissingFielder are selected by their
+// keys, while UnknownFields are never encoded with a mask.
+//
+// A FieldMask may be used concurrently by many Encoders.
+type FieldMask struct {
+	fields map[string]*FieldMask // the selected fields; a nil value selects all of it
+}
+
+// NewFieldMask returns a FieldMask which selects the given paths.
+//
+// It returns an error if a path is empty, or has an empty field name.
+func NewFieldMask(paths ...string) (m *FieldMask, err error) {
+	m = &FieldMask{fields: make(map[string]*FieldMask)}
+	for _, p := range paths {
+		segs := strings.Split(p, ".")
+		for _, s := range segs {
+			if s == "" {
+				return nil, fmt.Errorf("codec: invalid field mask path: %q", p)
+			}
+		}
+		m.add(segs)
+	}
+	return
+}
+
+// add selects the field with the path segs.
+func (m *FieldMask) add(segs []string) {
+	for i, s := range segs {
+		m2, ok := m.fields[s]
+		if i == len(segs)-1 {
+			m.fields[s] = nil
+			return
+		}
+		if ok && m2 == nil {
+			return // already selects all of it
+		}
+		if !ok {
+			m2 = &FieldMask{fields: make(map[string]*FieldMask)}
+			m.fields[s] = m2
+		}
+		m = m2
+	}
+}
+
+// has returns whether the field with the given key is selected.
+func (m *FieldMask) has(name string) bool {
+	_, ok := m.fields[name]
+	return ok
+}
+
+// EncodeMasked is like Encode, but only encodes the fields of structs selected by m.
+//
+// If m is nil, all fields are encoded.
+func (e *Encoder) EncodeMasked(v interface{}, m *FieldMask) (err error) {
+	e.fm = m
+	err = e.Encode(v)
+	e.fm = nil
+	return
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import "reflect"
+
+var fieldSetTyp = reflect.TypeOf(FieldSet{})
+
+// FieldSet holds the names of the fields of a struct which were present in the
+// stream it was last decoded from.
+//
+// A struct with an exported field of type FieldSet records into it, when decoded,
+// the Go name of each field (or promoted field of an embedded struct) whose key
+// was in the map, or which was within the array, it was decoded from.
+// A field decoded from nil is present. The FieldSet itself is never encoded or
+// decoded as a field.
+//
+// This allows a partial update (e.g. a PATCH request) to tell a field which was
+// sent as its zero value from one which was not sent, without making it a pointer:
+//
+//	type UserPatch struct {
+//	    Name   string
+//	    Admin  bool
+//	    Fields codec.FieldSet
+//	}
+//	...
+//	if p.Fields.Has("Admin") {
+//	    user.Admin = p.Admin
+//	}
+//
+// This is supported both via reflection and by codecgen, for a field of the struct
+// itself (not of an embedded struct).
+type FieldSet struct {
+	names []string
+}
+
+// Has returns whether the field with the given Go name is in the set.
+func (x *FieldSet) Has(name string) bool {
+	for _, n := range x.names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Add adds the field with the given Go name to the set, if not already in it.
+func (x *FieldSet) Add(name string) {
+	if !x.Has(name) {
+		x.names = append(x.names, name)
+	}
+}
+
+// Len returns the number of fields in the set.
+func (x *FieldSet) Len() int {
+	return len(x.names)
+}
+
+// Names returns the names of the fields in the set, in the order they were added
+// (for a decoded struct, the order of the stream). It must not be modified.
+func (x *FieldSet) Names() []string {
+	return x.names
+}
+
+// Reset removes all the fields from the set.
+func (x *FieldSet) Reset() {
+	x.names = x.names[:0]
+}
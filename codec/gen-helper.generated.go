@@ -13,7 +13,7 @@ import (
 )
 
 // GenVersion is the current version of codecgen.
-const GenVersion = 10
+const GenVersion = 11
 
 // This file is used to generate helper code for codecgen.
 // The values here i.e. genHelper(En|De)coder are not to be used directly by
@@ -30,7 +30,7 @@ const GenVersion = 10
 // Library users: DO NOT USE IT DIRECTLY. IT WILL CHANGE CONTINOUSLY WITHOUT NOTICE.
 func GenHelperEncoder(e *Encoder) (ge genHelperEncoder, ee genHelperEncDriver) {
 	ge = genHelperEncoder{e: e}
-	ee = genHelperEncDriver{encDriver: e.e}
+	ee = genHelperEncDriver{encDriver: e.e, es: e.es}
 	return
 }
 
@@ -45,6 +45,7 @@ func GenHelperDecoder(d *Decoder) (gd genHelperDecoder, dd genHelperDecDriver) {
 
 type genHelperEncDriver struct {
 	encDriver
+	es encDriverSymbols
 }
 
 func (x genHelperEncDriver) EncodeBuiltin(rt uintptr, v interface{}) {}
@@ -52,7 +53,11 @@ func (x genHelperEncDriver) EncStructFieldKey(keyType valueType, s string) {
 	encStructFieldKey(s, x.encDriver, nil, keyType, false, false)
 }
 func (x genHelperEncDriver) EncodeSymbol(s string) {
-	x.encDriver.EncodeStringEnc(cUTF8, s)
+	if x.es != nil {
+		x.es.EncodeSymbol(AsSymbolStructFieldNameFlag, s)
+	} else {
+		x.encDriver.EncodeStringEnc(cUTF8, s)
+	}
 }
 
 type genHelperDecDriver struct {
@@ -73,14 +78,14 @@ func (x genHelperDecDriver) DecodeUint(bitsize uint8) (ui uint64) {
 func (x genHelperDecDriver) DecodeFloat(chkOverflow32 bool) (f float64) {
 	f = x.DecodeFloat64()
 	if chkOverflow32 && chkOvf.Float32(f) {
-		panicv.errorf("float32 overflow: %v", f)
+		panicv.errorIs(ErrOverflow, valueTypeFloat, "float32 overflow: %v", f)
 	}
 	return
 }
 func (x genHelperDecDriver) DecodeFloat32As64() (f float64) {
 	f = x.DecodeFloat64()
 	if chkOvf.Float32(f) {
-		panicv.errorf("float32 overflow: %v", f)
+		panicv.errorIs(ErrOverflow, valueTypeFloat, "float32 overflow: %v", f)
 	}
 	return
 }
@@ -142,6 +147,15 @@ func (f genHelperEncoder) EncBinaryMarshal(iv encoding.BinaryMarshaler) {
 // FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
 func (f genHelperEncoder) EncRaw(iv Raw) { f.e.rawBytes(iv) }
 
+// FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
+func (f genHelperEncoder) EncUnknownFields(x *UnknownFields) { f.e.encUnknownFields(x) }
+
+// FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
+func (f genHelperEncoder) EncStructReflect() bool { return f.e.structReflect() }
+
+// FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
+func (f genHelperEncoder) EncStructByReflection(iv interface{}) { f.e.encStructReflect(iv) }
+
 // FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
 //
 // Deprecated: builtin no longer supported - so we make this method a no-op,
@@ -243,6 +257,30 @@ func (f genHelperDecoder) DecStructFieldNotFound(index int, name string) {
 	f.d.structFieldNotFound(index, name)
 }
 
+// FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
+func (f genHelperDecoder) DecUnknownFieldKeyStart() { f.d.unknownFieldKeyStart() }
+
+// FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
+func (f genHelperDecoder) DecUnknownFieldKeyEnd() []byte { return f.d.unknownFieldKeyEnd() }
+
+// FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
+func (f genHelperDecoder) DecUnknownField(x *UnknownFields, key []byte) { f.d.unknownField(x, key) }
+
+// FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
+func (f genHelperDecoder) DecRequiredFields(seen []bool, names ...string) {
+	f.d.requiredFieldsMissing(seen, names)
+}
+
+// FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
+func (f genHelperDecoder) DecStructDefaults(v interface{}, seen []bool) {
+	f.d.structDefaults(v, seen, -1)
+}
+
+// FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
+func (f genHelperDecoder) DecStructDefaultsFrom(v interface{}, n int) {
+	f.d.structDefaults(v, nil, n)
+}
+
 // FOR USE BY CODECGEN ONLY. IT *WILL* CHANGE WITHOUT NOTICE. *DO NOT USE*
 func (f genHelperDecoder) DecArrayCannotExpand(sliceLen, streamLen int) {
 	f.d.arrayCannotExpand(sliceLen, streamLen)
@@ -13,7 +13,7 @@ import (
 	"text/template"
 )
 
-const genVersion = 10
+const genVersion = 11
 
 func genInternalEncCommandAsString(s string, vname string) string {
 	switch s {
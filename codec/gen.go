@@ -103,6 +103,7 @@ import (
 // v8: current - we now maintain compatibility with old generated code.
 // v9: skipped
 // v10: modified encDriver and decDriver interfaces. Remove deprecated methods after Jan 1, 2019
+// v11: new helper methods for UnknownFields, FieldSet, FieldMask and the alias, required and default= tag options
 const (
 	genCodecPkg        = "codec1978"
 	genTempVarPfx      = "yy"
@@ -803,33 +804,23 @@ func (x *genRunner) enc(varname string, t reflect.Type) {
 	case reflect.Slice:
 		// if nil, call dedicated function
 		// if a []uint8, call dedicated function
-		// if a known fastpath slice, call dedicated function
 		// else write encode function in-line.
 		// - if elements are primitives or Selfers, call dedicated function on each member.
 		// - else call Encoder.encode(XXX) on it.
 		if rtid == uint8SliceTypId {
 			x.line("r.EncodeStringBytesRaw([]byte(" + varname + "))")
-		} else if fastpathAV.index(rtid) != -1 {
-			g := x.newGenV(t)
-			x.line("z.F." + g.MethodNamePfx("Enc", false) + "V(" + varname + ", e)")
 		} else {
 			x.xtraSM(varname, t, true, false)
 			// x.encListFallback(varname, rtid, t)
 		}
 	case reflect.Map:
 		// if nil, call dedicated function
-		// if a known fastpath map, call dedicated function
 		// else write encode function in-line.
 		// - if elements are primitives or Selfers, call dedicated function on each member.
 		// - else call Encoder.encode(XXX) on it.
 		// x.line("if " + varname + " == nil { \nr.EncodeNil()\n } else { ")
-		if fastpathAV.index(rtid) != -1 {
-			g := x.newGenV(t)
-			x.line("z.F." + g.MethodNamePfx("Enc", false) + "V(" + varname + ", e)")
-		} else {
-			x.xtraSM(varname, t, true, false)
-			// x.encMapFallback(varname, rtid, t)
-		}
+		x.xtraSM(varname, t, true, false)
+		// x.encMapFallback(varname, rtid, t)
 	case reflect.Struct:
 		if !inlist {
 			delete(x.te, rtid)
@@ -841,7 +832,13 @@ func (x *genRunner) enc(varname string, t reflect.Type) {
 		if rtidAdded {
 			delete(x.te, rtid)
 		}
-		x.line("z.EncFallback(" + varname + ")")
+		if tk == reflect.Interface && t.NumMethod() != 0 {
+			// pass a pointer, so the interface type is known e.g. for RegisterType
+			x.linef("%sip%s := %s", genTempVarPfx, mi, varname)
+			x.linef("z.EncFallback(&%sip%s)", genTempVarPfx, mi)
+		} else {
+			x.line("z.EncFallback(" + varname + ")")
+		}
 	}
 }
 
@@ -917,6 +914,8 @@ func (x *genRunner) encStruct(varname string, rtid uintptr, t reflect.Type) {
 	ti2arrayvar := genTempVarPfx + "r" + i
 	struct2arrvar := genTempVarPfx + "2arr" + i
 
+	// a field mask, and keys ordered by their encoding, are only supported via reflection
+	x.linef("if z.EncStructReflect() { z.EncStructByReflection(%s) } else {", varname)
 	x.line(sepVarname + " := !z.EncBinary()")
 	x.linef("%s := z.EncBasicHandle().StructToArray", struct2arrvar)
 	x.linef("_, _ = %s, %s", sepVarname, struct2arrvar)
@@ -979,10 +978,10 @@ func (x *genRunner) encStruct(varname string, rtid uintptr, t reflect.Type) {
 		// x.linef("var %snn%s = %v", genTempVarPfx, i, nn)
 		x.linef("var %snn%s int", genTempVarPfx, i)
 		x.linef("for _, b := range %s { if b { %snn%s++ } }", numfieldsvar, genTempVarPfx, i)
-		x.linef("r.WriteMapStart(%snn%s)", genTempVarPfx, i)
+		x.linef("r.WriteMapStart(%snn%s%s)", genTempVarPfx, i, x.encUnknownFieldsLen(varname, t, ti))
 		x.linef("%snn%s = %v", genTempVarPfx, i, 0)
 	} else {
-		x.linef("r.WriteMapStart(%d)", len(tisfi))
+		x.linef("r.WriteMapStart(%d%s)", len(tisfi), x.encUnknownFieldsLen(varname, t, ti))
 	}
 	x.line("}") // close if not StructToArray
 
@@ -1059,7 +1058,7 @@ func (x *genRunner) encStruct(varname string, rtid uintptr, t reflect.Type) {
 			if si.encNameAsciiAlphaNum {
 				x.linef(`if z.IsJSONHandle() { z.WriteStr("\"%s\"") } else { `, si.encName)
 			}
-			x.linef("r.EncodeStringEnc(codecSelferCcUTF8%s, `%s`)", x.xs, si.encName)
+			x.linef("r.EncodeSymbol(`%s`)", si.encName)
 			if si.encNameAsciiAlphaNum {
 				x.linef("}")
 			}
@@ -1081,9 +1080,21 @@ func (x *genRunner) encStruct(varname string, rtid uintptr, t reflect.Type) {
 	x.linef("if %s || %s {", ti2arrayvar, struct2arrvar) // if ti.toArray {
 	x.line("r.WriteArrayEnd()")
 	x.line("} else {")
+	if ti.uf >= 0 {
+		x.linef("z.EncUnknownFields(&%s.%s)", varname, t.Field(int(ti.uf)).Name)
+	}
 	x.line("r.WriteMapEnd()")
 	x.line("}")
+	x.line("} // end if z.EncStructReflect()")
+}
 
+// encUnknownFieldsLen returns the expression to add to the length of the map a struct
+// is encoded as, for its UnknownFields if any.
+func (x *genRunner) encUnknownFieldsLen(varname string, t reflect.Type, ti *typeInfo) string {
+	if ti.uf < 0 {
+		return ""
+	}
+	return " + " + varname + "." + t.Field(int(ti.uf)).Name + ".Len()"
 }
 
 func (x *genRunner) encListFallback(varname string, t reflect.Type) {
@@ -1343,7 +1354,8 @@ func (x *genRunner) dec(varname string, t reflect.Type, isptr bool) {
 	}
 
 	// only check for extensions if the type is named, and has a packagePath.
-	if !x.nx && genImportPath(t) != "" && t.Name() != "" {
+	// an interface may be nil, so has no type to check.
+	if !x.nx && genImportPath(t) != "" && t.Name() != "" && t.Kind() != reflect.Interface {
 		// first check if extensions are configued, before doing the interface conversion
 		// x.linef("} else if z.HasExtensions() && z.DecExt(%s) {", varname)
 		yy := fmt.Sprintf("%sxt%s", genTempVarPfx, mi)
@@ -1370,32 +1382,22 @@ func (x *genRunner) dec(varname string, t reflect.Type, isptr bool) {
 		x.xtraSM(varname, t, false, isptr)
 	case reflect.Slice:
 		// if a []uint8, call dedicated function
-		// if a known fastpath slice, call dedicated function
 		// else write encode function in-line.
 		// - if elements are primitives or Selfers, call dedicated function on each member.
 		// - else call Encoder.encode(XXX) on it.
 		if rtid == uint8SliceTypId {
 			x.linef("%s%s = r.DecodeBytes(%s(%s[]byte)(%s), false)",
 				ptrPfx, varname, ptrPfx, ptrPfx, varname)
-		} else if fastpathAV.index(rtid) != -1 {
-			g := x.newGenV(t)
-			x.linef("z.F.%sX(%s%s, d)", g.MethodNamePfx("Dec", false), addrPfx, varname)
 		} else {
 			x.xtraSM(varname, t, false, isptr)
 			// x.decListFallback(varname, rtid, false, t)
 		}
 	case reflect.Map:
-		// if a known fastpath map, call dedicated function
 		// else write encode function in-line.
 		// - if elements are primitives or Selfers, call dedicated function on each member.
 		// - else call Encoder.encode(XXX) on it.
-		if fastpathAV.index(rtid) != -1 {
-			g := x.newGenV(t)
-			x.linef("z.F.%sX(%s%s, d)", g.MethodNamePfx("Dec", false), addrPfx, varname)
-		} else {
-			x.xtraSM(varname, t, false, isptr)
-			// x.decMapFallback(varname, rtid, t)
-		}
+		x.xtraSM(varname, t, false, isptr)
+		// x.decMapFallback(varname, rtid, t)
 	case reflect.Struct:
 		if inlist {
 			// no need to create temp variable if isptr, or x.F or x[F]
@@ -1540,7 +1542,9 @@ func (x *genRunner) decMapFallback(varname string, rtid uintptr, t reflect.Type)
 		return x.genZeroValueR(telem)
 	}
 	funcs["decElemKindImmutable"] = func() bool {
-		return genIsImmutable(telem)
+		// a struct is decoded into field by field, so it is reset for each entry
+		// lest its pointers, slices and maps be shared across entries.
+		return genIsImmutable(telem) && telem.Kind() != reflect.Struct
 	}
 	funcs["decElemKindPtr"] = func() bool {
 		return telem.Kind() == reflect.Ptr
@@ -1569,13 +1573,44 @@ func (x *genRunner) decMapFallback(varname string, rtid uintptr, t reflect.Type)
 	}
 }
 
-func (x *genRunner) decStructMapSwitch(kName string, varname string, rtid uintptr, t reflect.Type) {
+func (x *genRunner) decStructMapSwitch(kName, rqName, dfName string, varname string, rtid uintptr, t reflect.Type) {
 	ti := x.ti.get(rtid, t)
+	rqs, _ := genRequiredFields(ti)
+	dfs := genDefaultsFields(ti)
 	tisfi := ti.sfiSrc // always use sequence from file. decStruct expects same thing.
 	x.line("switch (" + kName + ") {")
+	// as in typeInfo.indexForEncName, a name takes precedence over an alias,
+	// and an alias is matched to the first field (sorted by name) which has it.
+	names := make(map[string]*structFieldInfo, len(tisfi))
+	for _, si := range tisfi {
+		names[si.encName] = si
+	}
+	for _, si := range ti.sfiSort {
+		for _, a := range si.aliases {
+			if names[a] == nil {
+				names[a] = si
+			}
+		}
+	}
 	var newbuf, nilbuf genBuf
 	for _, si := range tisfi {
-		x.line("case \"" + si.encName + "\":")
+		cases := "\"" + si.encName + "\""
+		for _, a := range si.aliases {
+			if names[a] == si && a != si.encName {
+				cases += ", \"" + a + "\""
+				names[a] = nil // only once, if listed more than once
+			}
+		}
+		x.line("case " + cases + ":")
+		if j, ok := rqs[si]; ok {
+			x.linef("%s[%d] = true", rqName, j)
+		}
+		if j, ok := dfs[si]; ok {
+			x.linef("%s[%d] = true", dfName, j)
+		}
+		if ti.fs >= 0 {
+			x.linef("%s.%s.Add(%q)", varname, t.Field(int(ti.fs)).Name, si.fieldName)
+		}
 		newbuf.reset()
 		nilbuf.reset()
 		t2 := x.decVarInitPtr(varname, "", t, si, &newbuf, &nilbuf)
@@ -1584,8 +1619,12 @@ func (x *genRunner) decStructMapSwitch(kName string, varname string, rtid uintpt
 		x.line("}")
 	}
 	x.line("default:")
-	// pass the slice here, so that the string will not escape, and maybe save allocation
-	x.line("z.DecStructFieldNotFound(-1, " + kName + ")")
+	if ti.uf >= 0 {
+		x.linef("z.DecUnknownField(&%s.%s, %suk)", varname, t.Field(int(ti.uf)).Name, kName)
+	} else {
+		// pass the slice here, so that the string will not escape, and maybe save allocation
+		x.line("z.DecStructFieldNotFound(-1, " + kName + ")")
+	}
 	x.line("} // end switch " + kName)
 }
 
@@ -1594,6 +1633,16 @@ func (x *genRunner) decStructMap(varname, lenvarname string, rtid uintptr, t ref
 	ti := x.ti.get(rtid, t)
 	i := x.varsfx()
 	kName := tpfx + "s" + i
+	rqName := tpfx + "rq" + i
+	rqs, rqNames := genRequiredFields(ti)
+	if len(rqs) != 0 {
+		x.linef("var %s [%d]bool // required fields seen", rqName, len(rqs))
+	}
+	dfName := tpfx + "df" + i
+	dfs := genDefaultsFields(ti)
+	if dfs != nil {
+		x.linef("var %s [%d]bool // fields seen, for defaults", dfName, len(ti.sfiSort))
+	}
 
 	switch style {
 	case genStructMapStyleLenPrefix:
@@ -1607,6 +1656,9 @@ func (x *genRunner) decStructMap(varname, lenvarname string, rtid uintptr, t ref
 		x.line("} else { if r.CheckBreak() { break }; }")
 	}
 	x.line("r.ReadMapElemKey()")
+	if ti.uf >= 0 {
+		x.line("z.DecUnknownFieldKeyStart()")
+	}
 
 	// emulate decstructfieldkey
 	switch ti.keyType {
@@ -1620,12 +1672,52 @@ func (x *genRunner) decStructMap(varname, lenvarname string, rtid uintptr, t ref
 		x.linef("%s := z.StringView(r.DecodeStringAsBytes())", kName)
 	}
 	// x.linef("%s := z.StringView(r.DecStructFieldKey(codecSelferValueType%s%s, z.DecScratchArrayBuffer()))", kName, ti.keyType.String(), x.xs)
+	if ti.uf >= 0 {
+		x.linef("%suk := z.DecUnknownFieldKeyEnd()", kName)
+	}
 
 	x.line("r.ReadMapElemValue()")
-	x.decStructMapSwitch(kName, varname, rtid, t)
+	x.decStructMapSwitch(kName, rqName, dfName, varname, rtid, t)
 
 	x.line("} // end for " + tpfx + "j" + i)
 	x.line("r.ReadMapEnd()")
+	if dfs != nil {
+		x.linef("z.DecStructDefaults(%s, %s[:])", varname, dfName)
+	}
+	if len(rqs) != 0 {
+		x.linef("z.DecRequiredFields(%s[:], %s)", rqName, rqNames)
+	}
+}
+
+// genRequiredFields returns the index of each field tagged "required" amongst them,
+// in the order of ti.sfiSort as in Decoder.checkRequiredFields, and a list of their keys.
+func genRequiredFields(ti *typeInfo) (rqs map[*structFieldInfo]int, names string) {
+	for _, si := range ti.sfiSort {
+		if !si.required() {
+			continue
+		}
+		if rqs == nil {
+			rqs = make(map[*structFieldInfo]int)
+		} else {
+			names += ", "
+		}
+		rqs[si] = len(rqs)
+		names += strconv.Quote(si.encName)
+	}
+	return
+}
+
+// genDefaultsFields returns the index into ti.sfiSort of each field, iff ti.sfiSort
+// is to be passed to DecStructDefaults (as the struct has defaults).
+func genDefaultsFields(ti *typeInfo) (dfs map[*structFieldInfo]int) {
+	if !(ti.anyDefault || ti.df) {
+		return
+	}
+	dfs = make(map[*structFieldInfo]int, len(ti.sfiSort))
+	for j, si := range ti.sfiSort {
+		dfs[si] = j
+	}
+	return
 }
 
 func (x *genRunner) decStructArray(varname, lenvarname, breakString string, rtid uintptr, t reflect.Type) {
@@ -1637,12 +1729,20 @@ func (x *genRunner) decStructArray(varname, lenvarname, breakString string, rtid
 	x.linef("var %sb%s bool", tpfx, i)                        // break
 	x.linef("var %shl%s bool = %s >= 0", tpfx, i, lenvarname) // has length
 	var newbuf, nilbuf genBuf
-	for _, si := range tisfi {
+	for j, si := range tisfi {
 		x.linef("%sj%s++; if %shl%s { %sb%s = %sj%s > %s } else { %sb%s = r.CheckBreak() }",
 			tpfx, i, tpfx, i, tpfx, i,
 			tpfx, i, lenvarname, tpfx, i)
-		x.linef("if %sb%s { r.ReadArrayEnd(); %s }", tpfx, i, breakString)
+		if ti.anyDefault || ti.df {
+			// the remaining fields are absent from a short array
+			x.linef("if %sb%s { r.ReadArrayEnd(); z.DecStructDefaultsFrom(%s, %d); %s }", tpfx, i, varname, j, breakString)
+		} else {
+			x.linef("if %sb%s { r.ReadArrayEnd(); %s }", tpfx, i, breakString)
+		}
 		x.line("r.ReadArrayElem()")
+		if ti.fs >= 0 {
+			x.linef("%s.%s.Add(%q)", varname, t.Field(int(ti.fs)).Name, si.fieldName)
+		}
 		newbuf.reset()
 		nilbuf.reset()
 		t2 := x.decVarInitPtr(varname, "", t, si, &newbuf, &nilbuf)
@@ -1665,30 +1765,49 @@ func (x *genRunner) decStructArray(varname, lenvarname, breakString string, rtid
 func (x *genRunner) decStruct(varname string, rtid uintptr, t reflect.Type) {
 	// varname MUST be a ptr, or a struct field or a slice element.
 	i := x.varsfx()
+	ti := x.ti.get(rtid, t)
+	if ti.uf >= 0 {
+		x.linef("%s.%s.Reset()", varname, t.Field(int(ti.uf)).Name)
+	}
+	if ti.fs >= 0 {
+		x.linef("%s.%s.Reset()", varname, t.Field(int(ti.fs)).Name)
+	}
+	// an empty map or array is decoded as any other,
+	// if fields are then checked for being required or set to defaults.
+	skipEmpty := !(ti.anyRequired || ti.anyDefault || ti.df)
 	x.linef("%sct%s := r.ContainerType()", genTempVarPfx, i)
 	x.linef("if %sct%s == codecSelferValueTypeMap%s {", genTempVarPfx, i, x.xs)
 	x.line(genTempVarPfx + "l" + i + " := r.ReadMapStart()")
-	x.linef("if %sl%s == 0 {", genTempVarPfx, i)
-	x.line("r.ReadMapEnd()")
-	if genUseOneFunctionForDecStructMap {
+	if skipEmpty {
+		x.linef("if %sl%s == 0 {", genTempVarPfx, i)
+		x.line("r.ReadMapEnd()")
 		x.line("} else { ")
+	}
+	if genUseOneFunctionForDecStructMap {
 		x.linef("%s.codecDecodeSelfFromMap(%sl%s, d)", varname, genTempVarPfx, i)
 	} else {
-		x.line("} else if " + genTempVarPfx + "l" + i + " > 0 { ")
+		x.line("if " + genTempVarPfx + "l" + i + " >= 0 { ")
 		x.line(varname + ".codecDecodeSelfFromMapLenPrefix(" + genTempVarPfx + "l" + i + ", d)")
 		x.line("} else {")
 		x.line(varname + ".codecDecodeSelfFromMapCheckBreak(" + genTempVarPfx + "l" + i + ", d)")
+		x.line("}")
+	}
+	if skipEmpty {
+		x.line("}")
 	}
-	x.line("}")
 
 	// else if container is array
 	x.linef("} else if %sct%s == codecSelferValueTypeArray%s {", genTempVarPfx, i, x.xs)
 	x.line(genTempVarPfx + "l" + i + " := r.ReadArrayStart()")
-	x.linef("if %sl%s == 0 {", genTempVarPfx, i)
-	x.line("r.ReadArrayEnd()")
-	x.line("} else { ")
+	if skipEmpty {
+		x.linef("if %sl%s == 0 {", genTempVarPfx, i)
+		x.line("r.ReadArrayEnd()")
+		x.line("} else { ")
+	}
 	x.linef("%s.codecDecodeSelfFromArray(%sl%s, d)", varname, genTempVarPfx, i)
-	x.line("}")
+	if skipEmpty {
+		x.line("}")
+	}
 	// else panic
 	x.line("} else { ")
 	x.line("panic(errCodecSelferOnlyMapOrArrayEncodeToStruct" + x.xs + ")")
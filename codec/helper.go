@@ -323,13 +323,9 @@ type isZeroer interface {
 	IsZero() bool
 }
 
-type codecError struct {
-	name string
-	err  interface{}
-}
-
-func (e codecError) Cause() error {
-	switch xerr := e.err.(type) {
+// errFromPanicVal returns the error for a value recovered from a panic.
+func errFromPanicVal(v interface{}) error {
+	switch xerr := v.(type) {
 	case nil:
 		return nil
 	case error:
@@ -339,14 +335,10 @@ func (e codecError) Cause() error {
 	case fmt.Stringer:
 		return errors.New(xerr.String())
 	default:
-		return fmt.Errorf("%v", e.err)
+		return fmt.Errorf("%v", v)
 	}
 }
 
-func (e codecError) Error() string {
-	return fmt.Sprintf("%s error: %v", e.name, e.err)
-}
-
 // type byteAccepter func(byte) bool
 
 var (
@@ -362,6 +354,7 @@ var (
 
 	stringTyp     = reflect.TypeOf("")
 	timeTyp       = reflect.TypeOf(time.Time{})
+	durationTyp   = reflect.TypeOf(time.Duration(0))
 	rawExtTyp     = reflect.TypeOf(RawExt{})
 	rawTyp        = reflect.TypeOf(Raw{})
 	uintptrTyp    = reflect.TypeOf(uintptr(0))
@@ -383,7 +376,9 @@ var (
 
 	selferTyp         = reflect.TypeOf((*Selfer)(nil)).Elem()
 	missingFielderTyp = reflect.TypeOf((*MissingFielder)(nil)).Elem()
+	defaulterTyp      = reflect.TypeOf((*Defaulter)(nil)).Elem()
 	iszeroTyp         = reflect.TypeOf((*isZeroer)(nil)).Elem()
+	errorTyp          = reflect.TypeOf((*error)(nil)).Elem()
 
 	uint8TypId      = rt2id(uint8Typ)
 	uint8SliceTypId = rt2id(uint8SliceTyp)
@@ -482,6 +477,19 @@ type MissingFielder interface {
 	CodecMissingFields() map[string]interface{}
 }
 
+// Defaulter defines an interface for setting the default values of the fields of a struct.
+//
+// When decoding a struct, its fields absent from the stream (i.e. with no key in the map,
+// or past the end of a short array) are set to their default values.
+// These are taken from a new value of the struct type, with each "default=" struct tag option
+// applied, on which CodecDefaults is called. Absent fields left at the zero value
+// and without a "default=" option are left as they are.
+//
+// CodecDefaults is called on a pointer, so may be bound to one.
+type Defaulter interface {
+	CodecDefaults()
+}
+
 // MapBySlice is a tag interface that denotes wrapped slice should encode as a map in the stream.
 // The slice contains a sequence of key-value pairs.
 // This affords storing a map in a specific sequence in the stream.
@@ -524,6 +532,8 @@ type BasicHandle struct {
 
 	intf2impls
 
+	polyTypes
+
 	inited uint32
 	_      uint32 // padding
 
@@ -543,6 +553,18 @@ type BasicHandle struct {
 	// (for Cbor and Msgpack), where time.Time was not a builtin supported type.
 	TimeNotBuiltin bool
 
+	// InterfaceTypeKey configures how the type of the value of an interface registered
+	// via RegisterType is given in the stream.
+	//
+	// If "", the value is encoded as a map with a single entry, whose key is the name
+	// of the type, and value the encoded value. This supports a type of any kind.
+	//
+	// Else, the type must be encoded as a map (e.g. a struct, but not toarray), and
+	// the name is the value of this key, prepended to it. When decoding, the key may be
+	// anywhere in the map, and is ignored by a struct with no field for it, but only
+	// when decoding the value of such an interface.
+	InterfaceTypeKey string
+
 	// ExplicitRelease configures whether Release() is implicitly called after an encode or
 	// decode call.
 	//
@@ -561,10 +583,11 @@ type BasicHandle struct {
 	//    runtime.SetFinalizer(d, (*Decoder).Release)
 	ExplicitRelease bool
 
-	be bool   // is handle a binary encoding?
-	js bool   // is handle javascript handler?
-	n  byte   // first letter of handle name
-	_  uint16 // padding
+	be bool  // is handle a binary encoding?
+	js bool  // is handle javascript handler?
+	n  byte  // first letter of handle name
+	ke bool  // Canonical orders keys by their encoding
+	_  uint8 // padding
 
 	// ---- cache line
 
@@ -578,6 +601,8 @@ type BasicHandle struct {
 
 	rtidFns atomicRtidFnSlice
 	mu      sync.Mutex
+
+	encs, decs sync.Pool // Encoders and Decoders, for Marshal and Unmarshal
 	// r []uintptr     // rtids mapped to s above
 }
 
@@ -609,6 +634,7 @@ func (x *BasicHandle) init(hh Handle) {
 		x.be = hh.isBinary()
 		_, x.js = hh.(*JsonHandle)
 		x.n = hh.Name()[0]
+		x.ke = hh.canonicalByEncoding()
 		atomic.StoreUint32(&x.inited, 1)
 	}
 	x.mu.Unlock()
@@ -618,6 +644,10 @@ func (x *BasicHandle) getBasicHandle() *BasicHandle {
 	return x
 }
 
+func (x *BasicHandle) canonicalByEncoding() bool {
+	return false
+}
+
 func (x *BasicHandle) getTypeInfo(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
 	if x.TypeInfos == nil {
 		return defTypeInfos.get(rtid, rt)
@@ -715,13 +745,13 @@ func (x *BasicHandle) fn(rt reflect.Type, checkFastpath, checkCodecSelfer bool)
 	} else {
 		if fastpathEnabled && checkFastpath && (rk == reflect.Map || rk == reflect.Slice) {
 			if ti.pkgpath == "" { // un-named slice or map
-				if idx := fastpathAV.index(rtid); idx != -1 {
+				if idx := fastpathAV.index(rtid); idx != -1 && c.fastpathOK(idx) {
 					fn.fe = fastpathAV[idx].encfn
 					fn.fd = fastpathAV[idx].decfn
 					fi.addrD = true
 					fi.addrF = false
 				}
-			} else {
+			} else if !ti.mbs {
 				// use mapping for underlying type if there
 				var rtu reflect.Type
 				if rk == reflect.Map {
@@ -730,7 +760,7 @@ func (x *BasicHandle) fn(rt reflect.Type, checkFastpath, checkCodecSelfer bool)
 					rtu = reflect.SliceOf(ti.elem)
 				}
 				rtuid := rt2id(rtu)
-				if idx := fastpathAV.index(rtuid); idx != -1 {
+				if idx := fastpathAV.index(rtuid); idx != -1 && c.fastpathOK(idx) {
 					xfnf := fastpathAV[idx].encfn
 					xrt := fastpathAV[idx].rt
 					fn.fe = func(e *Encoder, xf *codecFnInfo, xrv reflect.Value) {
@@ -818,7 +848,7 @@ func (x *BasicHandle) fn(rt reflect.Type, checkFastpath, checkCodecSelfer bool)
 				}
 				// fn.fd = (*Decoder).kArray
 			case reflect.Struct:
-				if ti.anyOmitEmpty || ti.mf || ti.mfp {
+				if ti.anyOmitEmpty || ti.mf || ti.mfp || ti.uf >= 0 {
 					fn.fe = (*Encoder).kStruct
 				} else {
 					fn.fe = (*Encoder).kStructNoOmitempty
@@ -885,6 +915,9 @@ type Handle interface {
 	newDecDriver(r *Decoder) decDriver
 	isBinary() bool
 	hasElemSeparators() bool
+	// canonicalByEncoding returns whether Canonical orders the keys of maps and structs
+	// by the bytes they are encoded as (as cbor does), instead of their natural order.
+	canonicalByEncoding() bool
 	// IsBuiltinType(rtid uintptr) bool
 }
 
@@ -1187,6 +1220,7 @@ const (
 	_ structFieldInfoFlag = 1 << iota
 	structFieldInfoFlagReady
 	structFieldInfoFlagOmitEmpty
+	structFieldInfoFlagRequired
 )
 
 func (x *structFieldInfoFlag) flagSet(f structFieldInfoFlag) {
@@ -1205,13 +1239,20 @@ func (x structFieldInfoFlag) omitEmpty() bool {
 	return x.flagGet(structFieldInfoFlagOmitEmpty)
 }
 
+func (x structFieldInfoFlag) required() bool {
+	return x.flagGet(structFieldInfoFlagRequired)
+}
+
 func (x structFieldInfoFlag) ready() bool {
 	return x.flagGet(structFieldInfoFlagReady)
 }
 
 type structFieldInfo struct {
-	encName   string // encode name
-	fieldName string // field name
+	encName   string   // encode name
+	fieldName string   // field name
+	aliases   []string // other names accepted when decoding, from the alias= tag options
+
+	dflt reflect.Value // value from the default= tag option, if any
 
 	is  [maxLevelsEmbedding]uint16 // (recursive/embedded) field index in struct
 	nis uint8                      // num levels of embedding. if 1, then it's not embedded.
@@ -1298,11 +1339,66 @@ func (si *structFieldInfo) parseTag(stag string) {
 				// si.omitEmpty = true
 				// case "toarray":
 				// 	si.toArray = true
+			case "required":
+				si.flagSet(structFieldInfoFlagRequired)
+			default:
+				if s2 := strings.TrimPrefix(s, "alias="); len(s2) < len(s) && s2 != "" {
+					si.aliases = append(si.aliases, s2)
+				}
 			}
 		}
 	}
 }
 
+// structTagDefault returns the value of the default= option in the struct tag, if any.
+func structTagDefault(stag string) (s string, ok bool) {
+	for i, s := range strings.Split(stag, ",") {
+		if i > 0 && strings.HasPrefix(s, "default=") {
+			return s[len("default="):], true
+		}
+	}
+	return
+}
+
+// parseStructTagDefault returns the value of the default= option s, for the field f of rt.
+// It must be a bool, number (or time.Duration) or string.
+func parseStructTagDefault(rt reflect.Type, f reflect.StructField, s string) (v reflect.Value) {
+	v = reflect.New(f.Type).Elem()
+	var err error
+	switch f.Type.Kind() {
+	case reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(s)
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if f.Type == durationTyp {
+			var d time.Duration
+			d, err = time.ParseDuration(s)
+			i = int64(d)
+		} else {
+			i, err = strconv.ParseInt(s, 0, f.Type.Bits())
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+		u, err = strconv.ParseUint(s, 0, f.Type.Bits())
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		var x float64
+		x, err = strconv.ParseFloat(s, f.Type.Bits())
+		v.SetFloat(x)
+	case reflect.String:
+		v.SetString(s)
+	default:
+		err = errors.New("unsupported type " + f.Type.String())
+	}
+	if err != nil {
+		panicv.errorf("codec: invalid default value %q for field %v.%s: %v", s, rt, f.Name, err)
+	}
+	return
+}
+
 type sfiSortedByEncName []*structFieldInfo
 
 func (p sfiSortedByEncName) Len() int           { return len(p) }
@@ -1431,11 +1527,19 @@ type typeInfo struct {
 	toArray      bool      // whether this (struct) type should be encoded as an array
 	keyType      valueType // if struct, how is the field name stored in a stream? default is string
 	mbs          bool      // base type (T or *T) is a MapBySlice
+	anyRequired  bool      // true if a struct, and any of the fields are tagged "required"
+	anyDefault   bool      // true if a struct, and any of the fields are tagged "default="
+	uf           int16     // if struct, index of its field of type UnknownFields, else -1
+	fs           int16     // if struct, index of its field of type FieldSet, else -1
 
 	// ---- cpu cache line boundary?
 	sfiSort []*structFieldInfo // sorted. Used when enc/dec struct to map.
 	sfiSrc  []*structFieldInfo // unsorted. Used when enc/dec struct to array.
 
+	// sorted by encoded key. Used when encoding to map, if canonicalByEncoding.
+	// Computed when first needed (see sfiSortEncoded).
+	sfiSortEnc atomic.Pointer[[]*structFieldInfo]
+
 	key reflect.Type
 
 	// ---- cpu cache line boundary?
@@ -1461,6 +1565,7 @@ type typeInfo struct {
 	csp bool // *T is a Selfer
 	mf  bool // T is a MissingFielder
 	mfp bool // *T is a MissingFielder
+	df  bool // T or *T is a Defaulter
 
 	// other flags, with individual bits representing if set.
 	flags              typeInfoFlag
@@ -1470,6 +1575,17 @@ type typeInfo struct {
 	_ [2]uint64 // padding
 }
 
+// sfiSortEncoded returns the fields sorted by their encoded key, as cbor canonical
+// encoding needs, computing them the first time they are asked for.
+func (ti *typeInfo) sfiSortEncoded() []*structFieldInfo {
+	if p := ti.sfiSortEnc.Load(); p != nil {
+		return *p
+	}
+	sfis := cborSortSFI(ti.sfiSort, ti.keyType)
+	ti.sfiSortEnc.Store(&sfis)
+	return sfis
+}
+
 func (ti *typeInfo) isFlag(f typeInfoFlag) bool {
 	return ti.flags&f != 0
 }
@@ -1580,6 +1696,8 @@ func (x *TypeInfos) get(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
 		kind:    uint8(rk),
 		pkgpath: rt.PkgPath(),
 		keyType: valueTypeString, // default it - so it's never 0
+		uf:      -1,
+		fs:      -1,
 	}
 	// ti.rv0 = reflect.Zero(rt)
 
@@ -1594,6 +1712,9 @@ func (x *TypeInfos) get(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
 	ti.ju, ti.jup = implIntf(rt, jsonUnmarshalerTyp)
 	ti.cs, ti.csp = implIntf(rt, selferTyp)
 	ti.mf, ti.mfp = implIntf(rt, missingFielderTyp)
+	if b1, b2 := implIntf(rt, defaulterTyp); b1 || b2 {
+		ti.df = rk == reflect.Struct
+	}
 
 	b1, b2 := implIntf(rt, iszeroTyp)
 	if b1 {
@@ -1624,6 +1745,21 @@ func (x *TypeInfos) get(rtid uintptr, rt reflect.Type) (pti *typeInfo) {
 		// ti.sfis = vv.sfis
 		ti.sfiSrc, ti.sfiSort, ti.sfiNamesSort, ti.anyOmitEmpty = rgetResolveSFI(rt, vv.sfis, pv)
 		pp.Put(pi)
+		for _, si := range ti.sfiSrc {
+			if si.required() {
+				ti.anyRequired = true
+			}
+			if si.dflt.IsValid() {
+				ti.anyDefault = true
+			}
+		}
+		for i, n := 0, rt.NumField(); i < n; i++ {
+			if f := rt.Field(i); f.Type == unknownFieldsTyp && f.PkgPath == "" {
+				ti.uf = int16(i)
+			} else if f.Type == fieldSetTyp && f.PkgPath == "" {
+				ti.fs = int16(i)
+			}
+		}
 	case reflect.Map:
 		ti.elem = rt.Elem()
 		ti.key = rt.Key()
@@ -1691,6 +1827,9 @@ LOOP:
 		if isUnexported && !f.Anonymous {
 			continue
 		}
+		if f.Type == unknownFieldsTyp || f.Type == fieldSetTyp {
+			continue
+		}
 		stag := x.structTag(f.Tag)
 		if stag == "-" {
 			continue
@@ -1782,6 +1921,9 @@ LOOP:
 		}
 		si.fieldName = f.Name
 		si.flagSet(structFieldInfoFlagReady)
+		if s, ok := structTagDefault(stag); ok {
+			si.dflt = parseStructTagDefault(rt, f, s)
+		}
 
 		// pv.encNames = append(pv.encNames, si.encName)
 
@@ -1888,6 +2030,9 @@ func rgetResolveSFI(rt reflect.Type, x []structFieldInfo, pv *typeInfoLoadArray)
 			y[n] = &x[i]
 		}
 		sslen = sslen + len(x[i].encName) + 4
+		for _, a := range x[i].aliases {
+			sslen = sslen + len(a) + 4
+		}
 		n++
 	}
 	if n != len(y) {
@@ -1913,6 +2058,16 @@ func rgetResolveSFI(rt reflect.Type, x []structFieldInfo, pv *typeInfoLoadArray)
 		ss = append(ss, xn...)
 		ss = append(ss, 0xff, byte(ui>>8), byte(ui))
 	}
+	// aliases come after all the names, so a name always takes precedence over
+	// an alias (and an earlier alias over a later one) on a search
+	for i := range z {
+		ui = uint16(i)
+		for _, xn = range z[i].aliases {
+			ss = append(ss, tiSep(xn))
+			ss = append(ss, xn...)
+			ss = append(ss, 0xff, byte(ui>>8), byte(ui))
+		}
+	}
 	return
 }
 
@@ -2100,25 +2255,25 @@ func (checkOverflow) SignedInt(v uint64) (overflow bool) {
 
 func (x checkOverflow) Float32V(v float64) float64 {
 	if x.Float32(v) {
-		panicv.errorf("float32 overflow: %v", v)
+		panicv.errorIs(ErrOverflow, valueTypeFloat, "float32 overflow: %v", v)
 	}
 	return v
 }
 func (x checkOverflow) UintV(v uint64, bitsize uint8) uint64 {
 	if x.Uint(v, bitsize) {
-		panicv.errorf("uint64 overflow: %v", v)
+		panicv.errorIs(ErrOverflow, valueTypeUint, "uint64 overflow: %v", v)
 	}
 	return v
 }
 func (x checkOverflow) IntV(v int64, bitsize uint8) int64 {
 	if x.Int(v, bitsize) {
-		panicv.errorf("int64 overflow: %v", v)
+		panicv.errorIs(ErrOverflow, valueTypeInt, "int64 overflow: %v", v)
 	}
 	return v
 }
 func (x checkOverflow) SignedIntV(v uint64) int64 {
 	if x.SignedInt(v) {
-		panicv.errorf("uint64 to int64 overflow: %v", v)
+		panicv.errorIs(ErrOverflow, valueTypeUint, "uint64 to int64 overflow: %v", v)
 	}
 	return int64(v)
 }
@@ -2506,6 +2661,24 @@ func (panicHdl) errorf(format string, params ...interface{}) {
 	}
 }
 
+// errorIs panics with an error which matches the sentinel error is (via errors.Is),
+// having found a value of type vt in the stream (or valueTypeUnset if unknown).
+func (panicHdl) errorIs(is error, vt valueType, format string, params ...interface{}) {
+	panic(&decErr{is: is, vt: vt, msg: fmt.Sprintf(format, params...)})
+}
+
+// decErr is an error which matches one of the exported sentinel errors
+// e.g. ErrOverflow, and records the type of the value found in the stream.
+type decErr struct {
+	is  error
+	vt  valueType
+	msg string
+}
+
+func (e *decErr) Error() string { return e.msg }
+
+func (e *decErr) Unwrap() error { return e.is }
+
 // ----------------------------------------------------
 
 type errDecorator interface {
@@ -2794,7 +2967,7 @@ func (d *Decoder) kTime(f *codecFnInfo, rv reflect.Value) {
 func (d *Decoder) kFloat32(f *codecFnInfo, rv reflect.Value) {
 	fv := d.d.DecodeFloat64()
 	if chkOvf.Float32(fv) {
-		d.errorf("float32 overflow: %v", fv)
+		d.errorIs(ErrOverflow, valueTypeFloat, "float32 overflow: %v", fv)
 	}
 	rv.SetFloat(fv)
 }
@@ -2858,9 +3031,15 @@ func (e *Encoder) kTime(f *codecFnInfo, rv reflect.Value) {
 }
 
 func (e *Encoder) kString(f *codecFnInfo, rv reflect.Value) {
-	s := rv.String()
+	e.encodeString(asSymbolValueFlag, rv.String())
+}
+
+// encodeString encodes s per StringToRaw, or as a symbol if AsSymbols includes flag.
+func (e *Encoder) encodeString(flag AsSymbolFlag, s string) {
 	if e.h.StringToRaw {
 		e.e.EncodeStringBytesRaw(bytesView(s))
+	} else if e.es != nil {
+		e.es.EncodeSymbol(flag, s)
 	} else {
 		e.e.EncodeStringEnc(cUTF8, s)
 	}
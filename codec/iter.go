@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"io"
+	"iter"
+)
+
+// MapEntry is an entry of a map, as yielded by DecodeEntries.
+type MapEntry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// DecodeValues returns an iterator over the successive top-level values
+// in the stream, each decoded into a new T.
+//
+// Iteration stops at the end of the input, or after yielding the first error.
+// A value truncated by the end of the input yields a *DecodeError
+// wrapping io.ErrUnexpectedEOF.
+func DecodeValues[T any](d *Decoder) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			// peek first, so the end of the input can be told from a truncated value
+			if _, err := d.PeekKind(); err == io.EOF {
+				return
+			}
+			var v T
+			err := d.Decode(&v)
+			if err == io.EOF {
+				d.wrapErr(io.ErrUnexpectedEOF, &err)
+			}
+			if !yield(v, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// DecodeElems returns an iterator over the elements of the array at the current
+// position of the stream, each decoded into a new T as it is reached.
+// This allows a huge array to be processed without holding all of it in memory.
+//
+// The current position is either the top-level, or the next item of a map or array
+// started via Token. A nil is treated as an empty array.
+//
+// Once iteration stops, the stream is positioned after the array;
+// if the loop is exited early, the remaining elements are skipped.
+// Iteration stops after yielding the first error.
+func DecodeElems[T any](d *Decoder) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if isNil, err := d.seqStart(valueTypeArray); err != nil || isNil {
+			if err != nil {
+				yield(zero, err)
+			}
+			return
+		}
+		for {
+			end, err := d.seqNext()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if end {
+				return
+			}
+			var v T
+			if err = d.Decode(&v); err != nil {
+				yield(v, err)
+				return
+			}
+			if !yield(v, nil) {
+				d.seqSkip()
+				return
+			}
+		}
+	}
+}
+
+// DecodeEntries returns an iterator over the entries of the map at the current
+// position of the stream, each decoded into a new MapEntry as it is reached.
+//
+// It is otherwise the same as DecodeElems.
+func DecodeEntries[K, V any](d *Decoder) iter.Seq2[MapEntry[K, V], error] {
+	return func(yield func(MapEntry[K, V], error) bool) {
+		var zero MapEntry[K, V]
+		if isNil, err := d.seqStart(valueTypeMap); err != nil || isNil {
+			if err != nil {
+				yield(zero, err)
+			}
+			return
+		}
+		for {
+			end, err := d.seqNext()
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if end {
+				return
+			}
+			var x MapEntry[K, V]
+			if err = d.Decode(&x.Key); err == nil {
+				err = d.Decode(&x.Value)
+			}
+			if err != nil {
+				yield(x, err)
+				return
+			}
+			if !yield(x, nil) {
+				d.seqSkip()
+				return
+			}
+		}
+	}
+}
+
+// seqStart reads the start of the map or array (per vt) at the current position,
+// tracking it as a container started via Token. It returns true if it is a nil instead.
+func (d *Decoder) seqStart(vt valueType) (isNil bool, err error) {
+	err = d.tokenCall(func() {
+		if d.tokenNext() {
+			d.errorstr("cannot decode: no more items in the container started via Token")
+		}
+		if vt2 := d.d.nextValueType(); vt2 != vt && vt2 != valueTypeNil {
+			d.errorIs(ErrTypeMismatch, vt2, "cannot iterate over %v in stream as %v", vt2, vt)
+		}
+		var t Token
+		d.token(&t)
+		isNil = t.Kind == TokenNil
+	})
+	return
+}
+
+// seqNext positions the stream at the next item of the container started by seqStart.
+// It returns true, having read the end of the container, if there are no more items.
+func (d *Decoder) seqNext() (end bool, err error) {
+	err = d.tokenCall(func() {
+		if end = d.tokenNext(); end {
+			d.tokenEnd()
+		}
+	})
+	return
+}
+
+// seqSkip skips the remaining items of the container started by seqStart,
+// and reads its end. Any error is returned by the next call on the Decoder.
+func (d *Decoder) seqSkip() {
+	_ = d.tokenCall(func() {
+		for !d.tokenNext() {
+			d.tokenElem()
+			d.swallow()
+		}
+		d.tokenEnd()
+	})
+}
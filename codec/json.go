@@ -642,7 +642,7 @@ func (d *jsonDecDriver) ReadMapStart() int {
 	}
 	const xc uint8 = '{'
 	if d.tok != xc {
-		d.d.errorf("read map - expect char '%c' but got char '%c'", xc, d.tok)
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "read map - expect char '%c' but got char '%c'", xc, d.tok)
 	}
 	d.tok = 0
 	d.c = containerMapStart
@@ -655,7 +655,7 @@ func (d *jsonDecDriver) ReadArrayStart() int {
 	}
 	const xc uint8 = '['
 	if d.tok != xc {
-		d.d.errorf("read array - expect char '%c' but got char '%c'", xc, d.tok)
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "read array - expect char '%c' but got char '%c'", xc, d.tok)
 	}
 	d.tok = 0
 	d.c = containerArrayStart
@@ -805,7 +805,7 @@ func (d *jsonDecDriver) DecodeBool() (v bool) {
 		d.readLit4True()
 		v = true
 	default:
-		d.d.errorf("decode bool: got first char %c", d.tok)
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "decode bool: got first char %c", d.tok)
 		// v = false // "unreachable"
 	}
 	if fquot {
@@ -850,6 +850,20 @@ func (d *jsonDecDriver) ContainerType() (vt valueType) {
 	return valueTypeUnset
 }
 
+func (d *jsonDecDriver) nextValueType() (vt valueType) {
+	if vt = d.ContainerType(); vt != valueTypeUnset {
+		return
+	}
+	if b := d.tok; b == 't' || b == 'f' {
+		return valueTypeBool
+	}
+	// a number: whether it is an int, uint or float is only known once it is read.
+	if d.h.PreferFloat {
+		return valueTypeFloat
+	}
+	return valueTypeUnset
+}
+
 func (d *jsonDecDriver) decNumBytes() (bs []byte) {
 	// stores num bytes in d.bs
 	if d.tok == 0 {
@@ -873,9 +887,9 @@ func (d *jsonDecDriver) DecodeUint64() (u uint64) {
 	}
 	n, neg, badsyntax, overflow := jsonParseInteger(bs)
 	if overflow {
-		d.d.errorf("overflow parsing unsigned integer: %s", bs)
+		d.d.errorIs(ErrOverflow, valueTypeUint, "overflow parsing unsigned integer: %s", bs)
 	} else if neg {
-		d.d.errorf("minus found parsing unsigned integer: %s", bs)
+		d.d.errorIs(ErrOverflow, valueTypeInt, "minus found parsing unsigned integer: %s", bs)
 	} else if badsyntax {
 		// fallback: try to decode as float, and cast
 		n = d.decUint64ViaFloat(stringView(bs))
@@ -891,7 +905,7 @@ func (d *jsonDecDriver) DecodeInt64() (i int64) {
 	}
 	n, neg, badsyntax, overflow := jsonParseInteger(bs)
 	if overflow {
-		d.d.errorf("overflow parsing integer: %s", bs)
+		d.d.errorIs(ErrOverflow, valueTypeInt, "overflow parsing integer: %s", bs)
 	} else if badsyntax {
 		// d.d.errorf("invalid syntax for integer: %s", bs)
 		// fallback: try to decode as float, and cast
@@ -903,12 +917,12 @@ func (d *jsonDecDriver) DecodeInt64() (i int64) {
 	}
 	if neg {
 		if n > cutoff {
-			d.d.errorf("overflow parsing integer: %s", bs)
+			d.d.errorIs(ErrOverflow, valueTypeInt, "overflow parsing integer: %s", bs)
 		}
 		i = -(int64(n))
 	} else {
 		if n >= cutoff {
-			d.d.errorf("overflow parsing integer: %s", bs)
+			d.d.errorIs(ErrOverflow, valueTypeInt, "overflow parsing integer: %s", bs)
 		}
 		i = int64(n)
 	}
@@ -928,7 +942,7 @@ func (d *jsonDecDriver) decUint64ViaFloat(s string) (u uint64) {
 	if ff > 0 {
 		d.d.errorf("fractional part found parsing integer: %s", s)
 	} else if fi > float64(math.MaxUint64) {
-		d.d.errorf("overflow parsing integer: %s", s)
+		d.d.errorIs(ErrOverflow, valueTypeFloat, "overflow parsing integer: %s", s)
 	}
 	return uint64(fi)
 }
@@ -1065,6 +1079,9 @@ func (d *jsonDecDriver) appendStringAsBytes() {
 	for {
 		if i == cslen {
 			v = append(v, cs[cursor:]...)
+			if d.d.limits {
+				d.d.limit("MaxBytesLen", d.d.h.MaxBytesLen, len(v))
+			}
 			cs = r.readUntil(d.b2[:0], '"')
 			cslen = uint(len(cs))
 			i, cursor = 0, 0
@@ -1155,6 +1172,9 @@ func (d *jsonDecDriver) appendStringAsBytes() {
 		cursor = i
 	}
 	d.bs = v
+	if d.d.limits {
+		d.d.limitBytesLen(len(v))
+	}
 }
 
 func (d *jsonDecDriver) nakedNum(z *decNaked, bs []byte) (err error) {
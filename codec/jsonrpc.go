@@ -0,0 +1,451 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/rpc"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Codes of a JsonRpc2Error, as defined by the JSON-RPC 2.0 specification.
+// The codes from -32000 to -32099 are reserved for errors of the server.
+const (
+	JsonRpc2ParseError     = -32700
+	JsonRpc2InvalidRequest = -32600
+	JsonRpc2MethodNotFound = -32601
+	JsonRpc2InvalidParams  = -32602
+	JsonRpc2InternalError  = -32603
+	JsonRpc2ServerError    = -32000
+)
+
+// JsonRpc2Error is the error object of a JSON-RPC 2.0 response.
+type JsonRpc2Error struct {
+	Code    int         `codec:"code"`
+	Message string      `codec:"message"`
+	Data    interface{} `codec:"data,omitempty"`
+}
+
+func (e *JsonRpc2Error) Error() string {
+	if e.Message == "" {
+		return "jsonrpc2 error " + strconv.Itoa(e.Code)
+	}
+	return e.Message
+}
+
+// fields returns the error object to write, as a map.
+func (e *JsonRpc2Error) fields() map[string]interface{} {
+	m := map[string]interface{}{"code": e.Code, "message": e.Message}
+	if e.Data != nil {
+		m["data"] = e.Data
+	}
+	return m
+}
+
+// JsonRpc2ErrorReply is implemented by the reply of a method served by JsonRpc2,
+// to send an error object with its own code and data.
+//
+// If JsonRpc2Error returns non-nil, it is sent in place of the result. The method
+// should return a nil error, as net/rpc only passes the message of a returned error to the codec.
+type JsonRpc2ErrorReply interface {
+	JsonRpc2Error() *JsonRpc2Error
+}
+
+// jsonRpc2Request is a request or notification, as read by a server.
+type jsonRpc2Request struct {
+	Version Raw         `codec:"jsonrpc"`
+	Method  Raw         `codec:"method"`
+	Params  Raw         `codec:"params"`
+	ID      interface{} `codec:"id"`
+	Fields  FieldSet    // a notification has no ID
+
+	method string // Method, once checked to be a string
+}
+
+// jsonRpc2Response is a response, as read by a client.
+type jsonRpc2Response struct {
+	Version string         `codec:"jsonrpc"`
+	Result  Raw            `codec:"result"`
+	Error   *JsonRpc2Error `codec:"error"`
+	ID      Raw            `codec:"id"`
+}
+
+// jsonRpc2ErrorResp returns a response with the error object e.
+//
+// Messages are written as maps, not structs, so that they are objects whatever
+// the options of the Handle (e.g. StructToArray).
+func jsonRpc2ErrorResp(id interface{}, e *JsonRpc2Error) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "error": e.fields(), "id": id}
+}
+
+// jsonRpc2Call is a request read by a server, whose response is not yet written.
+type jsonRpc2Call struct {
+	id     interface{}
+	notify bool // a notification, whose response is not written
+	code   int  // the code of the error, if its params could not be read
+	batch  *jsonRpc2Batch
+}
+
+// response returns the response to the call, written by net/rpc as r and body.
+func (x *jsonRpc2Call) response(r *rpc.Response, body interface{}) map[string]interface{} {
+	var e *JsonRpc2Error
+	if r.Error != "" {
+		code := x.code
+		if code == 0 {
+			code = JsonRpc2ServerError
+			if strings.HasPrefix(r.Error, "rpc: can't find ") ||
+				strings.HasPrefix(r.Error, "rpc: service/method request ill-formed") {
+				code = JsonRpc2MethodNotFound
+			}
+		}
+		e = &JsonRpc2Error{Code: code, Message: r.Error}
+	} else if y, ok := body.(JsonRpc2ErrorReply); ok {
+		e = y.JsonRpc2Error()
+	}
+	if e != nil {
+		return jsonRpc2ErrorResp(x.id, e)
+	}
+	return map[string]interface{}{"jsonrpc": "2.0", "result": body, "id": x.id}
+}
+
+// jsonRpc2Batch collects the responses to a batch of requests, which are written together.
+type jsonRpc2Batch struct {
+	resps   []interface{}
+	waiting int  // the number of requests read whose responses are not yet collected
+	read    bool // all its requests have been read
+}
+
+// flush returns the responses to write, once all are collected, or nil.
+// A batch of only notifications has no response.
+func (b *jsonRpc2Batch) flush() interface{} {
+	if !b.read || b.waiting != 0 || len(b.resps) == 0 {
+		return nil
+	}
+	return b.resps
+}
+
+// /////////////// JSON-RPC 2.0 Server Codec ///////////////////
+
+type jsonRpc2ServerCodec struct {
+	rpcCodec
+
+	wmu sync.Mutex // for writes, as invalid requests are answered while reading
+
+	pd *Decoder // for each request of a message, and its params
+
+	req   jsonRpc2Request // the last request read
+	queue []Raw           // the requests of the message being read, not yet read
+	batch *jsonRpc2Batch  // the batch being read, if any
+	seq   uint64          // the Seq of the last request read
+
+	mu      sync.Mutex
+	pending map[uint64]*jsonRpc2Call // requests read whose response is not yet written, by Seq
+}
+
+func (c *jsonRpc2ServerCodec) ReadRequestHeader(r *rpc.Request) (err error) {
+	for {
+		for len(c.queue) == 0 {
+			if err = c.readMsg(); err != nil {
+				return
+			}
+		}
+		raw := c.queue[0]
+		c.queue = c.queue[1:]
+		e := c.readRequest(raw)
+		var out interface{}
+		c.mu.Lock()
+		if e != nil {
+			resp := jsonRpc2ErrorResp(c.req.ID, e)
+			if b := c.batch; b != nil {
+				b.resps = append(b.resps, resp)
+			} else {
+				out = resp
+			}
+		} else {
+			c.seq++
+			x := &jsonRpc2Call{id: c.req.ID, notify: !c.req.Fields.Has("ID"), batch: c.batch}
+			if x.batch != nil && !x.notify {
+				x.batch.waiting++
+			}
+			c.pending[c.seq] = x
+		}
+		if b := c.batch; b != nil && len(c.queue) == 0 {
+			b.read = true
+			out = b.flush()
+			c.batch = nil
+		}
+		c.mu.Unlock()
+		if out != nil {
+			if err = c.writeMsg(out); err != nil {
+				return
+			}
+		}
+		if e == nil {
+			r.ServiceMethod = c.req.method
+			r.Seq = c.seq
+			return
+		}
+	}
+}
+
+// readMsg reads the next message into the queue: a request, or the requests of a batch.
+func (c *jsonRpc2ServerCodec) readMsg() (err error) {
+	var raw Raw
+	// peek first, so the end of the stream can be told from a truncated message
+	if _, err = c.dec.PeekKind(); err == nil {
+		if err = c.read(&raw); err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+	}
+	if err != nil {
+		if err != io.EOF {
+			// the stream cannot be read on from a value which is not valid
+			_ = c.writeMsg(jsonRpc2ErrorResp(nil, &JsonRpc2Error{Code: JsonRpc2ParseError, Message: "Parse error"}))
+		}
+		return
+	}
+	if c.pd == nil {
+		c.pd = NewDecoderBytes(nil, c.h)
+	}
+	if raw != nil { // raw is nil if the message is null
+		c.pd.ResetBytes(raw)
+		if k, _ := c.pd.PeekKind(); k == TokenArrayStart {
+			var batch []Raw
+			if err = c.pd.Decode(&batch); err != nil {
+				return
+			}
+			if len(batch) == 0 {
+				return c.writeMsg(jsonRpc2ErrorResp(nil, &JsonRpc2Error{Code: JsonRpc2InvalidRequest, Message: "Invalid Request"}))
+			}
+			c.queue = batch
+			c.batch = &jsonRpc2Batch{}
+			return
+		}
+	}
+	c.queue = append(c.queue, raw)
+	return
+}
+
+// readRequest reads raw into req, returning the error to respond with if it is not a valid request.
+func (c *jsonRpc2ServerCodec) readRequest(raw Raw) *JsonRpc2Error {
+	c.req = jsonRpc2Request{}
+	if raw != nil {
+		c.pd.ResetBytes(raw)
+		if k, _ := c.pd.PeekKind(); k == TokenMapStart {
+			if c.pd.Decode(&c.req) == nil && c.str(c.req.Version) == "2.0" {
+				if c.req.method = c.str(c.req.Method); c.req.method != "" {
+					return nil
+				}
+			}
+		}
+	}
+	return &JsonRpc2Error{Code: JsonRpc2InvalidRequest, Message: "Invalid Request"}
+}
+
+// str returns the string raw holds, or "" if it does not hold a string
+// (which would otherwise be decoded from a number or bool as its text).
+func (c *jsonRpc2ServerCodec) str(raw Raw) (s string) {
+	c.pd.ResetBytes(raw)
+	if k, _ := c.pd.PeekKind(); k == TokenString {
+		c.pd.Decode(&s)
+	}
+	return
+}
+
+func (c *jsonRpc2ServerCodec) ReadRequestBody(body interface{}) (err error) {
+	if body == nil || c.req.Params == nil {
+		return
+	}
+	if err = c.readParams(body); err != nil {
+		c.mu.Lock()
+		if x := c.pending[c.seq]; x != nil {
+			x.code = JsonRpc2InvalidParams
+		}
+		c.mu.Unlock()
+	}
+	return
+}
+
+// readParams reads the params of the last request into body, a pointer to the argument.
+//
+// Params by name (an object) are decoded into the argument. Of params by position (an array),
+// one is the argument, unless it is a struct, whose fields are then decoded from them in turn.
+func (c *jsonRpc2ServerCodec) readParams(body interface{}) (err error) {
+	params := c.req.Params
+	c.pd.ResetBytes(params)
+	k, err := c.pd.PeekKind()
+	if err != nil {
+		return
+	}
+	if k == TokenMapStart {
+		return c.pd.Decode(body)
+	}
+	if k != TokenArrayStart {
+		return fmt.Errorf("rpc: params must be an array or object, not %v", k)
+	}
+	var elems []Raw
+	if err = c.pd.Decode(&elems); err != nil {
+		return
+	}
+	rt := reflect.TypeOf(body).Elem()
+	byField := rt.Kind() == reflect.Struct && rt != timeTyp
+	if byField && len(elems) == 1 && elems[0] != nil {
+		// an object (or an array, for a struct encoded toarray) is the struct itself
+		c.pd.ResetBytes(elems[0])
+		k, _ = c.pd.PeekKind()
+		byField = k != TokenMapStart && k != TokenArrayStart
+	}
+	want := 1
+	if byField {
+		want = len(basicHandle(c.h).getTypeInfo(rt2id(rt), rt).sfiSrc)
+	}
+	if len(elems) != want {
+		return fmt.Errorf("rpc: wrong number of params for %v: expecting %d, got %d", rt, want, len(elems))
+	}
+	if byField {
+		c.pd.ResetBytes(params)
+		return c.pd.Decode(body)
+	}
+	if elems[0] == nil {
+		return
+	}
+	c.pd.ResetBytes(elems[0])
+	return c.pd.Decode(body)
+}
+
+func (c *jsonRpc2ServerCodec) WriteResponse(r *rpc.Response, body interface{}) (err error) {
+	var out interface{}
+	c.mu.Lock()
+	x := c.pending[r.Seq]
+	delete(c.pending, r.Seq)
+	if x != nil && !x.notify {
+		resp := x.response(r, body)
+		if b := x.batch; b != nil {
+			b.resps = append(b.resps, resp)
+			b.waiting--
+			out = b.flush()
+		} else {
+			out = resp
+		}
+	}
+	c.mu.Unlock()
+	if out == nil {
+		return
+	}
+	if err = c.writeMsg(out); err != nil {
+		// as for GoRpc, close the connection if a response could not be written
+		c.Close()
+	}
+	return
+}
+
+func (c *jsonRpc2ServerCodec) writeMsg(v interface{}) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return c.write(v, nil, false)
+}
+
+// /////////////// JSON-RPC 2.0 Client Codec ///////////////////
+
+type jsonRpc2ClientCodec struct {
+	rpcCodec
+
+	wmu sync.Mutex // for writes, as notifications may be sent at any time
+
+	resp jsonRpc2Response // the last response read
+	pd   *Decoder         // for its result
+
+	rpcServerErrors // error objects read
+}
+
+func (c *jsonRpc2ClientCodec) WriteRequest(r *rpc.Request, body interface{}) error {
+	return c.writeMsg(map[string]interface{}{
+		"jsonrpc": "2.0", "method": r.ServiceMethod, "params": msgpackSpecRpcParams(c.requestBody(r.Seq, body)), "id": r.Seq,
+	})
+}
+
+func (c *jsonRpc2ClientCodec) Notify(method string, params interface{}) error {
+	return c.writeMsg(map[string]interface{}{
+		"jsonrpc": "2.0", "method": method, "params": msgpackSpecRpcParams(params),
+	})
+}
+
+func (c *jsonRpc2ClientCodec) writeMsg(v interface{}) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return c.write(v, nil, false)
+}
+
+func (c *jsonRpc2ClientCodec) ReadResponseHeader(r *rpc.Response) (err error) {
+	c.resp = jsonRpc2Response{}
+	if err = c.read(&c.resp); err != nil {
+		return
+	}
+	if c.resp.ID == nil {
+		// the server could not read a request, so the call it is for is not known
+		if c.resp.Error != nil {
+			return c.resp.Error
+		}
+		return errors.New("rpc: jsonrpc2 response without id")
+	}
+	if r.Seq, err = strconv.ParseUint(string(c.resp.ID), 10, 64); err != nil {
+		return fmt.Errorf("rpc: invalid jsonrpc2 response id: %s", c.resp.ID)
+	}
+	r.Error = ""
+	if e := c.resp.Error; e != nil {
+		r.Error = e.Error()
+		c.addServerError(r.Seq, e)
+	}
+	return
+}
+
+func (c *jsonRpc2ClientCodec) ReadResponseBody(body interface{}) error {
+	if body == nil || c.resp.Result == nil {
+		return nil
+	}
+	if c.pd == nil {
+		c.pd = NewDecoderBytes(nil, c.h)
+	}
+	c.pd.ResetBytes(c.resp.Result)
+	return c.pd.Decode(body)
+}
+
+//--------------------------------------------------
+
+// jsonRpc2 is the implementation of Rpc that uses the JSON-RPC 2.0 protocol.
+type jsonRpc2 struct{}
+
+// JsonRpc2 implements Rpc using the JSON-RPC 2.0 protocol, as defined at
+// https://www.jsonrpc.org/specification . It is used with a JsonHandle.
+//
+// The method of a request is the name of the service method e.g. "Arith.Multiply".
+// Params by name (an object) are decoded into the argument of the method. Params by
+// position (an array) must hold the argument as their one element, unless it is a struct,
+// when each param may instead be decoded into a field in turn (as when the struct is
+// encoded with toarray). Params which do not fit the argument get an Invalid params error.
+//
+// A request without an id is a notification: the method is called, but no response is sent.
+// The requests of a batch may be served concurrently, and their responses are sent
+// together once all are written. An error returned by a method is sent with the code
+// JsonRpc2ServerError (see JsonRpc2ErrorReply for sending another).
+//
+// Its client codec sends the argument of a call as params of 1 element, unless it is
+// a MsgpackSpecRpcMultiArgs. It implements MsgpackSpecRpcNotifier, for sending notifications,
+// and MsgpackSpecRpcServerErrors, whose Call returns the *JsonRpc2Error of a response.
+//
+// See GoRpc documentation, for information on buffering for better performance.
+var JsonRpc2 jsonRpc2
+
+func (x jsonRpc2) ServerCodec(conn io.ReadWriteCloser, h Handle) rpc.ServerCodec {
+	return &jsonRpc2ServerCodec{rpcCodec: newRPCCodec(conn, h), pending: make(map[uint64]*jsonRpc2Call)}
+}
+
+func (x jsonRpc2) ClientCodec(conn io.ReadWriteCloser, h Handle) rpc.ClientCodec {
+	return &jsonRpc2ClientCodec{rpcCodec: newRPCCodec(conn, h)}
+}
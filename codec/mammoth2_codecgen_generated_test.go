@@ -29,9 +29,9 @@ var (
 type codecSelfer19781 struct{}
 
 func init() {
-	if GenVersion != 10 {
+	if GenVersion != 11 {
 		_, file, _, _ := runtime.Caller(0)
-		panic("codecgen version mismatch: current: 10, need " + strconv.FormatInt(int64(GenVersion), 10) + ". Re-generate file: " + file)
+		panic("codecgen version mismatch: current: 11, need " + strconv.FormatInt(int64(GenVersion), 10) + ". Re-generate file: " + file)
 	}
 	if false {
 		var _ byte = 0 // reference the types, but skip this branch at build/run time
@@ -49,20364 +49,20368 @@ func (x *TestMammoth2) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(576)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapStart(576)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FIntf == nil {
-					r.EncodeNil()
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(576)
+				} else {
+					r.WriteMapStart(576)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FIntf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							z.EncFallback(x.FIntf)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FIntf\"")
+					} else {
+						r.EncodeSymbol(`FIntf`)
+					}
+					r.WriteMapElemValue()
+					if x.FIntf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							z.EncFallback(x.FIntf)
+						}
+					}
+				}
+				var yyn6 bool
+				if x.FptrIntf == nil {
+					yyn6 = true
+					goto LABEL6
+				}
+			LABEL6:
+				if yyr2 || yy2arr2 {
+					if yyn6 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy7 := *x.FptrIntf
+							if false {
+							} else {
+								z.EncFallback(yy7)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrIntf\"")
+					} else {
+						r.EncodeSymbol(`FptrIntf`)
+					}
+					r.WriteMapElemValue()
+					if yyn6 {
+						r.EncodeNil()
+					} else {
+						if x.FptrIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy9 := *x.FptrIntf
+							if false {
+							} else {
+								z.EncFallback(yy9)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.FString)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819781, string(x.FString))
+						}
+					}
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FString\"")
+					} else {
+						r.EncodeSymbol(`FString`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						z.EncFallback(x.FIntf)
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.FString)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819781, string(x.FString))
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FIntf\"")
+				var yyn14 bool
+				if x.FptrString == nil {
+					yyn14 = true
+					goto LABEL14
+				}
+			LABEL14:
+				if yyr2 || yy2arr2 {
+					if yyn14 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrString == nil {
+							r.EncodeNil()
+						} else {
+							yy15 := *x.FptrString
+							if false {
+							} else {
+								if z.EncBasicHandle().StringToRaw {
+									r.EncodeStringBytesRaw(z.BytesView(string(yy15)))
+								} else {
+									r.EncodeStringEnc(codecSelferCcUTF819781, string(yy15))
+								}
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrString\"")
+					} else {
+						r.EncodeSymbol(`FptrString`)
+					}
+					r.WriteMapElemValue()
+					if yyn14 {
+						r.EncodeNil()
+					} else {
+						if x.FptrString == nil {
+							r.EncodeNil()
+						} else {
+							yy17 := *x.FptrString
+							if false {
+							} else {
+								if z.EncBasicHandle().StringToRaw {
+									r.EncodeStringBytesRaw(z.BytesView(string(yy17)))
+								} else {
+									r.EncodeStringEnc(codecSelferCcUTF819781, string(yy17))
+								}
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeFloat32(float32(x.FFloat32))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FFloat32\"")
+					} else {
+						r.EncodeSymbol(`FFloat32`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeFloat32(float32(x.FFloat32))
+					}
+				}
+				var yyn22 bool
+				if x.FptrFloat32 == nil {
+					yyn22 = true
+					goto LABEL22
+				}
+			LABEL22:
+				if yyr2 || yy2arr2 {
+					if yyn22 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy23 := *x.FptrFloat32
+							if false {
+							} else {
+								r.EncodeFloat32(float32(yy23))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrFloat32\"")
+					} else {
+						r.EncodeSymbol(`FptrFloat32`)
+					}
+					r.WriteMapElemValue()
+					if yyn22 {
+						r.EncodeNil()
+					} else {
+						if x.FptrFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy25 := *x.FptrFloat32
+							if false {
+							} else {
+								r.EncodeFloat32(float32(yy25))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeFloat64(float64(x.FFloat64))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FFloat64\"")
+					} else {
+						r.EncodeSymbol(`FFloat64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeFloat64(float64(x.FFloat64))
+					}
+				}
+				var yyn30 bool
+				if x.FptrFloat64 == nil {
+					yyn30 = true
+					goto LABEL30
+				}
+			LABEL30:
+				if yyr2 || yy2arr2 {
+					if yyn30 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy31 := *x.FptrFloat64
+							if false {
+							} else {
+								r.EncodeFloat64(float64(yy31))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrFloat64\"")
+					} else {
+						r.EncodeSymbol(`FptrFloat64`)
+					}
+					r.WriteMapElemValue()
+					if yyn30 {
+						r.EncodeNil()
+					} else {
+						if x.FptrFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy33 := *x.FptrFloat64
+							if false {
+							} else {
+								r.EncodeFloat64(float64(yy33))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FUint\"")
+					} else {
+						r.EncodeSymbol(`FUint`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint))
+					}
+				}
+				var yyn38 bool
+				if x.FptrUint == nil {
+					yyn38 = true
+					goto LABEL38
+				}
+			LABEL38:
+				if yyr2 || yy2arr2 {
+					if yyn38 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrUint == nil {
+							r.EncodeNil()
+						} else {
+							yy39 := *x.FptrUint
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy39))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrUint\"")
+					} else {
+						r.EncodeSymbol(`FptrUint`)
+					}
+					r.WriteMapElemValue()
+					if yyn38 {
+						r.EncodeNil()
+					} else {
+						if x.FptrUint == nil {
+							r.EncodeNil()
+						} else {
+							yy41 := *x.FptrUint
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy41))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint8))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FUint8\"")
+					} else {
+						r.EncodeSymbol(`FUint8`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint8))
+					}
+				}
+				var yyn46 bool
+				if x.FptrUint8 == nil {
+					yyn46 = true
+					goto LABEL46
+				}
+			LABEL46:
+				if yyr2 || yy2arr2 {
+					if yyn46 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy47 := *x.FptrUint8
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy47))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrUint8\"")
+					} else {
+						r.EncodeSymbol(`FptrUint8`)
+					}
+					r.WriteMapElemValue()
+					if yyn46 {
+						r.EncodeNil()
+					} else {
+						if x.FptrUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy49 := *x.FptrUint8
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy49))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint16))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FUint16\"")
+					} else {
+						r.EncodeSymbol(`FUint16`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint16))
+					}
+				}
+				var yyn54 bool
+				if x.FptrUint16 == nil {
+					yyn54 = true
+					goto LABEL54
+				}
+			LABEL54:
+				if yyr2 || yy2arr2 {
+					if yyn54 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy55 := *x.FptrUint16
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy55))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrUint16\"")
+					} else {
+						r.EncodeSymbol(`FptrUint16`)
+					}
+					r.WriteMapElemValue()
+					if yyn54 {
+						r.EncodeNil()
+					} else {
+						if x.FptrUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy57 := *x.FptrUint16
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy57))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint32))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FUint32\"")
+					} else {
+						r.EncodeSymbol(`FUint32`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint32))
+					}
+				}
+				var yyn62 bool
+				if x.FptrUint32 == nil {
+					yyn62 = true
+					goto LABEL62
+				}
+			LABEL62:
+				if yyr2 || yy2arr2 {
+					if yyn62 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy63 := *x.FptrUint32
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy63))
+							}
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FIntf`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrUint32\"")
+					} else {
+						r.EncodeSymbol(`FptrUint32`)
+					}
+					r.WriteMapElemValue()
+					if yyn62 {
+						r.EncodeNil()
+					} else {
+						if x.FptrUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy65 := *x.FptrUint32
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy65))
+							}
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if x.FIntf == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint64))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FUint64\"")
+					} else {
+						r.EncodeSymbol(`FUint64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUint64))
+					}
+				}
+				var yyn70 bool
+				if x.FptrUint64 == nil {
+					yyn70 = true
+					goto LABEL70
+				}
+			LABEL70:
+				if yyr2 || yy2arr2 {
+					if yyn70 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy71 := *x.FptrUint64
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy71))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrUint64\"")
+					} else {
+						r.EncodeSymbol(`FptrUint64`)
+					}
+					r.WriteMapElemValue()
+					if yyn70 {
+						r.EncodeNil()
+					} else {
+						if x.FptrUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy73 := *x.FptrUint64
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy73))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUintptr))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FUintptr\"")
+					} else {
+						r.EncodeSymbol(`FUintptr`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.FUintptr))
+					}
+				}
+				var yyn78 bool
+				if x.FptrUintptr == nil {
+					yyn78 = true
+					goto LABEL78
+				}
+			LABEL78:
+				if yyr2 || yy2arr2 {
+					if yyn78 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy79 := *x.FptrUintptr
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy79))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrUintptr\"")
+					} else {
+						r.EncodeSymbol(`FptrUintptr`)
+					}
+					r.WriteMapElemValue()
+					if yyn78 {
+						r.EncodeNil()
+					} else {
+						if x.FptrUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy81 := *x.FptrUintptr
+							if false {
+							} else {
+								r.EncodeUint(uint64(yy81))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FInt\"")
+					} else {
+						r.EncodeSymbol(`FInt`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt))
+					}
+				}
+				var yyn86 bool
+				if x.FptrInt == nil {
+					yyn86 = true
+					goto LABEL86
+				}
+			LABEL86:
+				if yyr2 || yy2arr2 {
+					if yyn86 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrInt == nil {
+							r.EncodeNil()
+						} else {
+							yy87 := *x.FptrInt
+							if false {
+							} else {
+								r.EncodeInt(int64(yy87))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrInt\"")
+					} else {
+						r.EncodeSymbol(`FptrInt`)
+					}
+					r.WriteMapElemValue()
+					if yyn86 {
+						r.EncodeNil()
+					} else {
+						if x.FptrInt == nil {
+							r.EncodeNil()
+						} else {
+							yy89 := *x.FptrInt
+							if false {
+							} else {
+								r.EncodeInt(int64(yy89))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt8))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FInt8\"")
+					} else {
+						r.EncodeSymbol(`FInt8`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt8))
+					}
+				}
+				var yyn94 bool
+				if x.FptrInt8 == nil {
+					yyn94 = true
+					goto LABEL94
+				}
+			LABEL94:
+				if yyr2 || yy2arr2 {
+					if yyn94 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy95 := *x.FptrInt8
+							if false {
+							} else {
+								r.EncodeInt(int64(yy95))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrInt8\"")
+					} else {
+						r.EncodeSymbol(`FptrInt8`)
+					}
+					r.WriteMapElemValue()
+					if yyn94 {
+						r.EncodeNil()
+					} else {
+						if x.FptrInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy97 := *x.FptrInt8
+							if false {
+							} else {
+								r.EncodeInt(int64(yy97))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt16))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FInt16\"")
+					} else {
+						r.EncodeSymbol(`FInt16`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt16))
+					}
+				}
+				var yyn102 bool
+				if x.FptrInt16 == nil {
+					yyn102 = true
+					goto LABEL102
+				}
+			LABEL102:
+				if yyr2 || yy2arr2 {
+					if yyn102 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy103 := *x.FptrInt16
+							if false {
+							} else {
+								r.EncodeInt(int64(yy103))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrInt16\"")
+					} else {
+						r.EncodeSymbol(`FptrInt16`)
+					}
+					r.WriteMapElemValue()
+					if yyn102 {
+						r.EncodeNil()
+					} else {
+						if x.FptrInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy105 := *x.FptrInt16
+							if false {
+							} else {
+								r.EncodeInt(int64(yy105))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt32))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FInt32\"")
+					} else {
+						r.EncodeSymbol(`FInt32`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt32))
+					}
+				}
+				var yyn110 bool
+				if x.FptrInt32 == nil {
+					yyn110 = true
+					goto LABEL110
+				}
+			LABEL110:
+				if yyr2 || yy2arr2 {
+					if yyn110 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy111 := *x.FptrInt32
+							if false {
+							} else {
+								r.EncodeInt(int64(yy111))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrInt32\"")
+					} else {
+						r.EncodeSymbol(`FptrInt32`)
+					}
+					r.WriteMapElemValue()
+					if yyn110 {
+						r.EncodeNil()
+					} else {
+						if x.FptrInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy113 := *x.FptrInt32
+							if false {
+							} else {
+								r.EncodeInt(int64(yy113))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt64))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FInt64\"")
+					} else {
+						r.EncodeSymbol(`FInt64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.FInt64))
+					}
+				}
+				var yyn118 bool
+				if x.FptrInt64 == nil {
+					yyn118 = true
+					goto LABEL118
+				}
+			LABEL118:
+				if yyr2 || yy2arr2 {
+					if yyn118 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy119 := *x.FptrInt64
+							if false {
+							} else {
+								r.EncodeInt(int64(yy119))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrInt64\"")
+					} else {
+						r.EncodeSymbol(`FptrInt64`)
+					}
+					r.WriteMapElemValue()
+					if yyn118 {
+						r.EncodeNil()
+					} else {
+						if x.FptrInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy121 := *x.FptrInt64
+							if false {
+							} else {
+								r.EncodeInt(int64(yy121))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.FBool))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FBool\"")
+					} else {
+						r.EncodeSymbol(`FBool`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.FBool))
+					}
+				}
+				var yyn126 bool
+				if x.FptrBool == nil {
+					yyn126 = true
+					goto LABEL126
+				}
+			LABEL126:
+				if yyr2 || yy2arr2 {
+					if yyn126 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrBool == nil {
+							r.EncodeNil()
+						} else {
+							yy127 := *x.FptrBool
+							if false {
+							} else {
+								r.EncodeBool(bool(yy127))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrBool\"")
+					} else {
+						r.EncodeSymbol(`FptrBool`)
+					}
+					r.WriteMapElemValue()
+					if yyn126 {
+						r.EncodeNil()
+					} else {
+						if x.FptrBool == nil {
+							r.EncodeNil()
+						} else {
+							yy129 := *x.FptrBool
+							if false {
+							} else {
+								r.EncodeBool(bool(yy129))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceIntf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceInterface(([]interface{})(x.FSliceIntf), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceIntf\"")
+					} else {
+						r.EncodeSymbol(`FSliceIntf`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceIntf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceInterface(([]interface{})(x.FSliceIntf), e)
+						}
+					}
+				}
+				var yyn134 bool
+				if x.FptrSliceIntf == nil {
+					yyn134 = true
+					goto LABEL134
+				}
+			LABEL134:
+				if yyr2 || yy2arr2 {
+					if yyn134 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy135 := *x.FptrSliceIntf
+							if false {
+							} else {
+								h.encSliceInterface(([]interface{})(yy135), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceIntf\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceIntf`)
+					}
+					r.WriteMapElemValue()
+					if yyn134 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy137 := *x.FptrSliceIntf
+							if false {
+							} else {
+								h.encSliceInterface(([]interface{})(yy137), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceString == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.FSliceString), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceString\"")
+					} else {
+						r.EncodeSymbol(`FSliceString`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceString == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.FSliceString), e)
+						}
+					}
+				}
+				var yyn142 bool
+				if x.FptrSliceString == nil {
+					yyn142 = true
+					goto LABEL142
+				}
+			LABEL142:
+				if yyr2 || yy2arr2 {
+					if yyn142 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceString == nil {
+							r.EncodeNil()
+						} else {
+							yy143 := *x.FptrSliceString
+							if false {
+							} else {
+								h.encSlicestring(([]string)(yy143), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceString\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceString`)
+					}
+					r.WriteMapElemValue()
+					if yyn142 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceString == nil {
+							r.EncodeNil()
+						} else {
+							yy145 := *x.FptrSliceString
+							if false {
+							} else {
+								h.encSlicestring(([]string)(yy145), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceFloat32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat32(([]float32)(x.FSliceFloat32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceFloat32\"")
+					} else {
+						r.EncodeSymbol(`FSliceFloat32`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceFloat32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat32(([]float32)(x.FSliceFloat32), e)
+						}
+					}
+				}
+				var yyn150 bool
+				if x.FptrSliceFloat32 == nil {
+					yyn150 = true
+					goto LABEL150
+				}
+			LABEL150:
+				if yyr2 || yy2arr2 {
+					if yyn150 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy151 := *x.FptrSliceFloat32
+							if false {
+							} else {
+								h.encSlicefloat32(([]float32)(yy151), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceFloat32\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceFloat32`)
+					}
+					r.WriteMapElemValue()
+					if yyn150 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy153 := *x.FptrSliceFloat32
+							if false {
+							} else {
+								h.encSlicefloat32(([]float32)(yy153), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceFloat64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat64(([]float64)(x.FSliceFloat64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceFloat64\"")
+					} else {
+						r.EncodeSymbol(`FSliceFloat64`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceFloat64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat64(([]float64)(x.FSliceFloat64), e)
+						}
+					}
+				}
+				var yyn158 bool
+				if x.FptrSliceFloat64 == nil {
+					yyn158 = true
+					goto LABEL158
+				}
+			LABEL158:
+				if yyr2 || yy2arr2 {
+					if yyn158 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy159 := *x.FptrSliceFloat64
+							if false {
+							} else {
+								h.encSlicefloat64(([]float64)(yy159), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceFloat64\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceFloat64`)
+					}
+					r.WriteMapElemValue()
+					if yyn158 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy161 := *x.FptrSliceFloat64
+							if false {
+							} else {
+								h.encSlicefloat64(([]float64)(yy161), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceUint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint(([]uint)(x.FSliceUint), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceUint\"")
+					} else {
+						r.EncodeSymbol(`FSliceUint`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceUint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint(([]uint)(x.FSliceUint), e)
+						}
+					}
+				}
+				var yyn166 bool
+				if x.FptrSliceUint == nil {
+					yyn166 = true
+					goto LABEL166
+				}
+			LABEL166:
+				if yyr2 || yy2arr2 {
+					if yyn166 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceUint == nil {
+							r.EncodeNil()
+						} else {
+							yy167 := *x.FptrSliceUint
+							if false {
+							} else {
+								h.encSliceuint(([]uint)(yy167), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceUint\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceUint`)
+					}
+					r.WriteMapElemValue()
+					if yyn166 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceUint == nil {
+							r.EncodeNil()
+						} else {
+							yy169 := *x.FptrSliceUint
+							if false {
+							} else {
+								h.encSliceuint(([]uint)(yy169), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceUint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.FSliceUint8))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceUint8\"")
+					} else {
+						r.EncodeSymbol(`FSliceUint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceUint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.FSliceUint8))
+						}
+					}
+				}
+				var yyn174 bool
+				if x.FptrSliceUint8 == nil {
+					yyn174 = true
+					goto LABEL174
+				}
+			LABEL174:
+				if yyr2 || yy2arr2 {
+					if yyn174 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy175 := *x.FptrSliceUint8
+							if false {
+							} else {
+								r.EncodeStringBytesRaw([]byte(yy175))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceUint8\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceUint8`)
+					}
+					r.WriteMapElemValue()
+					if yyn174 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy177 := *x.FptrSliceUint8
+							if false {
+							} else {
+								r.EncodeStringBytesRaw([]byte(yy177))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceUint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint16(([]uint16)(x.FSliceUint16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceUint16\"")
+					} else {
+						r.EncodeSymbol(`FSliceUint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceUint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint16(([]uint16)(x.FSliceUint16), e)
+						}
+					}
+				}
+				var yyn182 bool
+				if x.FptrSliceUint16 == nil {
+					yyn182 = true
+					goto LABEL182
+				}
+			LABEL182:
+				if yyr2 || yy2arr2 {
+					if yyn182 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy183 := *x.FptrSliceUint16
+							if false {
+							} else {
+								h.encSliceuint16(([]uint16)(yy183), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceUint16\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceUint16`)
+					}
+					r.WriteMapElemValue()
+					if yyn182 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy185 := *x.FptrSliceUint16
+							if false {
+							} else {
+								h.encSliceuint16(([]uint16)(yy185), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceUint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint32(([]uint32)(x.FSliceUint32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceUint32\"")
+					} else {
+						r.EncodeSymbol(`FSliceUint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceUint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint32(([]uint32)(x.FSliceUint32), e)
+						}
+					}
+				}
+				var yyn190 bool
+				if x.FptrSliceUint32 == nil {
+					yyn190 = true
+					goto LABEL190
+				}
+			LABEL190:
+				if yyr2 || yy2arr2 {
+					if yyn190 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy191 := *x.FptrSliceUint32
+							if false {
+							} else {
+								h.encSliceuint32(([]uint32)(yy191), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceUint32\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceUint32`)
+					}
+					r.WriteMapElemValue()
+					if yyn190 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy193 := *x.FptrSliceUint32
+							if false {
+							} else {
+								h.encSliceuint32(([]uint32)(yy193), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceUint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.FSliceUint64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceUint64\"")
+					} else {
+						r.EncodeSymbol(`FSliceUint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceUint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.FSliceUint64), e)
+						}
+					}
+				}
+				var yyn198 bool
+				if x.FptrSliceUint64 == nil {
+					yyn198 = true
+					goto LABEL198
+				}
+			LABEL198:
+				if yyr2 || yy2arr2 {
+					if yyn198 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy199 := *x.FptrSliceUint64
+							if false {
+							} else {
+								h.encSliceuint64(([]uint64)(yy199), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceUint64\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceUint64`)
+					}
+					r.WriteMapElemValue()
+					if yyn198 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy201 := *x.FptrSliceUint64
+							if false {
+							} else {
+								h.encSliceuint64(([]uint64)(yy201), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceUintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuintptr(([]uintptr)(x.FSliceUintptr), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceUintptr\"")
+					} else {
+						r.EncodeSymbol(`FSliceUintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceUintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuintptr(([]uintptr)(x.FSliceUintptr), e)
+						}
+					}
+				}
+				var yyn206 bool
+				if x.FptrSliceUintptr == nil {
+					yyn206 = true
+					goto LABEL206
+				}
+			LABEL206:
+				if yyr2 || yy2arr2 {
+					if yyn206 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy207 := *x.FptrSliceUintptr
+							if false {
+							} else {
+								h.encSliceuintptr(([]uintptr)(yy207), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceUintptr\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceUintptr`)
+					}
+					r.WriteMapElemValue()
+					if yyn206 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy209 := *x.FptrSliceUintptr
+							if false {
+							} else {
+								h.encSliceuintptr(([]uintptr)(yy209), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceInt == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint(([]int)(x.FSliceInt), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceInt\"")
+					} else {
+						r.EncodeSymbol(`FSliceInt`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceInt == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint(([]int)(x.FSliceInt), e)
+						}
+					}
+				}
+				var yyn214 bool
+				if x.FptrSliceInt == nil {
+					yyn214 = true
+					goto LABEL214
+				}
+			LABEL214:
+				if yyr2 || yy2arr2 {
+					if yyn214 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceInt == nil {
+							r.EncodeNil()
+						} else {
+							yy215 := *x.FptrSliceInt
+							if false {
+							} else {
+								h.encSliceint(([]int)(yy215), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceInt\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceInt`)
+					}
+					r.WriteMapElemValue()
+					if yyn214 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceInt == nil {
+							r.EncodeNil()
+						} else {
+							yy217 := *x.FptrSliceInt
+							if false {
+							} else {
+								h.encSliceint(([]int)(yy217), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceInt8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint8(([]int8)(x.FSliceInt8), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceInt8\"")
+					} else {
+						r.EncodeSymbol(`FSliceInt8`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceInt8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint8(([]int8)(x.FSliceInt8), e)
+						}
+					}
+				}
+				var yyn222 bool
+				if x.FptrSliceInt8 == nil {
+					yyn222 = true
+					goto LABEL222
+				}
+			LABEL222:
+				if yyr2 || yy2arr2 {
+					if yyn222 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy223 := *x.FptrSliceInt8
+							if false {
+							} else {
+								h.encSliceint8(([]int8)(yy223), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceInt8\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceInt8`)
+					}
+					r.WriteMapElemValue()
+					if yyn222 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy225 := *x.FptrSliceInt8
+							if false {
+							} else {
+								h.encSliceint8(([]int8)(yy225), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceInt16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint16(([]int16)(x.FSliceInt16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceInt16\"")
+					} else {
+						r.EncodeSymbol(`FSliceInt16`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceInt16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint16(([]int16)(x.FSliceInt16), e)
+						}
+					}
+				}
+				var yyn230 bool
+				if x.FptrSliceInt16 == nil {
+					yyn230 = true
+					goto LABEL230
+				}
+			LABEL230:
+				if yyr2 || yy2arr2 {
+					if yyn230 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy231 := *x.FptrSliceInt16
+							if false {
+							} else {
+								h.encSliceint16(([]int16)(yy231), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceInt16\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceInt16`)
+					}
+					r.WriteMapElemValue()
+					if yyn230 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy233 := *x.FptrSliceInt16
+							if false {
+							} else {
+								h.encSliceint16(([]int16)(yy233), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceInt32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint32(([]int32)(x.FSliceInt32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceInt32\"")
+					} else {
+						r.EncodeSymbol(`FSliceInt32`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceInt32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint32(([]int32)(x.FSliceInt32), e)
+						}
+					}
+				}
+				var yyn238 bool
+				if x.FptrSliceInt32 == nil {
+					yyn238 = true
+					goto LABEL238
+				}
+			LABEL238:
+				if yyr2 || yy2arr2 {
+					if yyn238 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy239 := *x.FptrSliceInt32
+							if false {
+							} else {
+								h.encSliceint32(([]int32)(yy239), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceInt32\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceInt32`)
+					}
+					r.WriteMapElemValue()
+					if yyn238 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy241 := *x.FptrSliceInt32
+							if false {
+							} else {
+								h.encSliceint32(([]int32)(yy241), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceInt64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.FSliceInt64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceInt64\"")
+					} else {
+						r.EncodeSymbol(`FSliceInt64`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceInt64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.FSliceInt64), e)
+						}
+					}
+				}
+				var yyn246 bool
+				if x.FptrSliceInt64 == nil {
+					yyn246 = true
+					goto LABEL246
+				}
+			LABEL246:
+				if yyr2 || yy2arr2 {
+					if yyn246 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy247 := *x.FptrSliceInt64
+							if false {
+							} else {
+								h.encSliceint64(([]int64)(yy247), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceInt64\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceInt64`)
+					}
+					r.WriteMapElemValue()
+					if yyn246 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy249 := *x.FptrSliceInt64
+							if false {
+							} else {
+								h.encSliceint64(([]int64)(yy249), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FSliceBool == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicebool(([]bool)(x.FSliceBool), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FSliceBool\"")
+					} else {
+						r.EncodeSymbol(`FSliceBool`)
+					}
+					r.WriteMapElemValue()
+					if x.FSliceBool == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicebool(([]bool)(x.FSliceBool), e)
+						}
+					}
+				}
+				var yyn254 bool
+				if x.FptrSliceBool == nil {
+					yyn254 = true
+					goto LABEL254
+				}
+			LABEL254:
+				if yyr2 || yy2arr2 {
+					if yyn254 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrSliceBool == nil {
+							r.EncodeNil()
+						} else {
+							yy255 := *x.FptrSliceBool
+							if false {
+							} else {
+								h.encSlicebool(([]bool)(yy255), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrSliceBool\"")
+					} else {
+						r.EncodeSymbol(`FptrSliceBool`)
+					}
+					r.WriteMapElemValue()
+					if yyn254 {
+						r.EncodeNil()
+					} else {
+						if x.FptrSliceBool == nil {
+							r.EncodeNil()
+						} else {
+							yy257 := *x.FptrSliceBool
+							if false {
+							} else {
+								h.encSlicebool(([]bool)(yy257), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfIntf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceInterface((map[interface{}]interface{})(x.FMapIntfIntf), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfIntf\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfIntf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfIntf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceInterface((map[interface{}]interface{})(x.FMapIntfIntf), e)
+						}
+					}
+				}
+				var yyn262 bool
+				if x.FptrMapIntfIntf == nil {
+					yyn262 = true
+					goto LABEL262
+				}
+			LABEL262:
+				if yyr2 || yy2arr2 {
+					if yyn262 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy263 := *x.FptrMapIntfIntf
+							if false {
+							} else {
+								h.encMapInterfaceInterface((map[interface{}]interface{})(yy263), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfIntf\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfIntf`)
+					}
+					r.WriteMapElemValue()
+					if yyn262 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy265 := *x.FptrMapIntfIntf
+							if false {
+							} else {
+								h.encMapInterfaceInterface((map[interface{}]interface{})(yy265), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfString == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfacestring((map[interface{}]string)(x.FMapIntfString), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfString\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfString`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfString == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfacestring((map[interface{}]string)(x.FMapIntfString), e)
+						}
+					}
+				}
+				var yyn270 bool
+				if x.FptrMapIntfString == nil {
+					yyn270 = true
+					goto LABEL270
+				}
+			LABEL270:
+				if yyr2 || yy2arr2 {
+					if yyn270 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfString == nil {
+							r.EncodeNil()
+						} else {
+							yy271 := *x.FptrMapIntfString
+							if false {
+							} else {
+								h.encMapInterfacestring((map[interface{}]string)(yy271), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfString\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfString`)
+					}
+					r.WriteMapElemValue()
+					if yyn270 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfString == nil {
+							r.EncodeNil()
+						} else {
+							yy273 := *x.FptrMapIntfString
+							if false {
+							} else {
+								h.encMapInterfacestring((map[interface{}]string)(yy273), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfUint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint((map[interface{}]uint)(x.FMapIntfUint), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfUint\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfUint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfUint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint((map[interface{}]uint)(x.FMapIntfUint), e)
+						}
+					}
+				}
+				var yyn278 bool
+				if x.FptrMapIntfUint == nil {
+					yyn278 = true
+					goto LABEL278
+				}
+			LABEL278:
+				if yyr2 || yy2arr2 {
+					if yyn278 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfUint == nil {
+							r.EncodeNil()
+						} else {
+							yy279 := *x.FptrMapIntfUint
+							if false {
+							} else {
+								h.encMapInterfaceuint((map[interface{}]uint)(yy279), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfUint\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfUint`)
+					}
+					r.WriteMapElemValue()
+					if yyn278 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfUint == nil {
+							r.EncodeNil()
+						} else {
+							yy281 := *x.FptrMapIntfUint
+							if false {
+							} else {
+								h.encMapInterfaceuint((map[interface{}]uint)(yy281), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfUint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint8((map[interface{}]uint8)(x.FMapIntfUint8), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfUint8\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfUint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfUint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint8((map[interface{}]uint8)(x.FMapIntfUint8), e)
+						}
+					}
+				}
+				var yyn286 bool
+				if x.FptrMapIntfUint8 == nil {
+					yyn286 = true
+					goto LABEL286
+				}
+			LABEL286:
+				if yyr2 || yy2arr2 {
+					if yyn286 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy287 := *x.FptrMapIntfUint8
+							if false {
+							} else {
+								h.encMapInterfaceuint8((map[interface{}]uint8)(yy287), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfUint8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfUint8`)
+					}
+					r.WriteMapElemValue()
+					if yyn286 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy289 := *x.FptrMapIntfUint8
+							if false {
+							} else {
+								h.encMapInterfaceuint8((map[interface{}]uint8)(yy289), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfUint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint16((map[interface{}]uint16)(x.FMapIntfUint16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfUint16\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfUint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfUint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint16((map[interface{}]uint16)(x.FMapIntfUint16), e)
+						}
+					}
+				}
+				var yyn294 bool
+				if x.FptrMapIntfUint16 == nil {
+					yyn294 = true
+					goto LABEL294
+				}
+			LABEL294:
+				if yyr2 || yy2arr2 {
+					if yyn294 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy295 := *x.FptrMapIntfUint16
+							if false {
+							} else {
+								h.encMapInterfaceuint16((map[interface{}]uint16)(yy295), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfUint16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfUint16`)
+					}
+					r.WriteMapElemValue()
+					if yyn294 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy297 := *x.FptrMapIntfUint16
+							if false {
+							} else {
+								h.encMapInterfaceuint16((map[interface{}]uint16)(yy297), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfUint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint32((map[interface{}]uint32)(x.FMapIntfUint32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfUint32\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfUint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfUint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint32((map[interface{}]uint32)(x.FMapIntfUint32), e)
+						}
+					}
+				}
+				var yyn302 bool
+				if x.FptrMapIntfUint32 == nil {
+					yyn302 = true
+					goto LABEL302
+				}
+			LABEL302:
+				if yyr2 || yy2arr2 {
+					if yyn302 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy303 := *x.FptrMapIntfUint32
+							if false {
+							} else {
+								h.encMapInterfaceuint32((map[interface{}]uint32)(yy303), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfUint32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfUint32`)
+					}
+					r.WriteMapElemValue()
+					if yyn302 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy305 := *x.FptrMapIntfUint32
+							if false {
+							} else {
+								h.encMapInterfaceuint32((map[interface{}]uint32)(yy305), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfUint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint64((map[interface{}]uint64)(x.FMapIntfUint64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfUint64\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfUint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfUint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuint64((map[interface{}]uint64)(x.FMapIntfUint64), e)
+						}
+					}
+				}
+				var yyn310 bool
+				if x.FptrMapIntfUint64 == nil {
+					yyn310 = true
+					goto LABEL310
+				}
+			LABEL310:
+				if yyr2 || yy2arr2 {
+					if yyn310 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy311 := *x.FptrMapIntfUint64
+							if false {
+							} else {
+								h.encMapInterfaceuint64((map[interface{}]uint64)(yy311), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfUint64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfUint64`)
+					}
+					r.WriteMapElemValue()
+					if yyn310 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy313 := *x.FptrMapIntfUint64
+							if false {
+							} else {
+								h.encMapInterfaceuint64((map[interface{}]uint64)(yy313), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfUintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuintptr((map[interface{}]uintptr)(x.FMapIntfUintptr), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfUintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfUintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfUintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceuintptr((map[interface{}]uintptr)(x.FMapIntfUintptr), e)
+						}
+					}
+				}
+				var yyn318 bool
+				if x.FptrMapIntfUintptr == nil {
+					yyn318 = true
+					goto LABEL318
+				}
+			LABEL318:
+				if yyr2 || yy2arr2 {
+					if yyn318 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy319 := *x.FptrMapIntfUintptr
+							if false {
+							} else {
+								h.encMapInterfaceuintptr((map[interface{}]uintptr)(yy319), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfUintptr\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfUintptr`)
+					}
+					r.WriteMapElemValue()
+					if yyn318 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy321 := *x.FptrMapIntfUintptr
+							if false {
+							} else {
+								h.encMapInterfaceuintptr((map[interface{}]uintptr)(yy321), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfInt == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint((map[interface{}]int)(x.FMapIntfInt), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfInt\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfInt`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfInt == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint((map[interface{}]int)(x.FMapIntfInt), e)
+						}
+					}
+				}
+				var yyn326 bool
+				if x.FptrMapIntfInt == nil {
+					yyn326 = true
+					goto LABEL326
+				}
+			LABEL326:
+				if yyr2 || yy2arr2 {
+					if yyn326 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfInt == nil {
+							r.EncodeNil()
+						} else {
+							yy327 := *x.FptrMapIntfInt
+							if false {
+							} else {
+								h.encMapInterfaceint((map[interface{}]int)(yy327), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfInt\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfInt`)
+					}
+					r.WriteMapElemValue()
+					if yyn326 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfInt == nil {
+							r.EncodeNil()
+						} else {
+							yy329 := *x.FptrMapIntfInt
+							if false {
+							} else {
+								h.encMapInterfaceint((map[interface{}]int)(yy329), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfInt8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint8((map[interface{}]int8)(x.FMapIntfInt8), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfInt8\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfInt8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfInt8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint8((map[interface{}]int8)(x.FMapIntfInt8), e)
+						}
+					}
+				}
+				var yyn334 bool
+				if x.FptrMapIntfInt8 == nil {
+					yyn334 = true
+					goto LABEL334
+				}
+			LABEL334:
+				if yyr2 || yy2arr2 {
+					if yyn334 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy335 := *x.FptrMapIntfInt8
+							if false {
+							} else {
+								h.encMapInterfaceint8((map[interface{}]int8)(yy335), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfInt8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfInt8`)
+					}
+					r.WriteMapElemValue()
+					if yyn334 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy337 := *x.FptrMapIntfInt8
+							if false {
+							} else {
+								h.encMapInterfaceint8((map[interface{}]int8)(yy337), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfInt16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint16((map[interface{}]int16)(x.FMapIntfInt16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfInt16\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfInt16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfInt16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint16((map[interface{}]int16)(x.FMapIntfInt16), e)
+						}
+					}
+				}
+				var yyn342 bool
+				if x.FptrMapIntfInt16 == nil {
+					yyn342 = true
+					goto LABEL342
+				}
+			LABEL342:
+				if yyr2 || yy2arr2 {
+					if yyn342 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy343 := *x.FptrMapIntfInt16
+							if false {
+							} else {
+								h.encMapInterfaceint16((map[interface{}]int16)(yy343), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfInt16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfInt16`)
+					}
+					r.WriteMapElemValue()
+					if yyn342 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy345 := *x.FptrMapIntfInt16
+							if false {
+							} else {
+								h.encMapInterfaceint16((map[interface{}]int16)(yy345), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfInt32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint32((map[interface{}]int32)(x.FMapIntfInt32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfInt32\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfInt32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfInt32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint32((map[interface{}]int32)(x.FMapIntfInt32), e)
+						}
+					}
+				}
+				var yyn350 bool
+				if x.FptrMapIntfInt32 == nil {
+					yyn350 = true
+					goto LABEL350
+				}
+			LABEL350:
+				if yyr2 || yy2arr2 {
+					if yyn350 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy351 := *x.FptrMapIntfInt32
+							if false {
+							} else {
+								h.encMapInterfaceint32((map[interface{}]int32)(yy351), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfInt32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfInt32`)
+					}
+					r.WriteMapElemValue()
+					if yyn350 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy353 := *x.FptrMapIntfInt32
+							if false {
+							} else {
+								h.encMapInterfaceint32((map[interface{}]int32)(yy353), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfInt64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint64((map[interface{}]int64)(x.FMapIntfInt64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfInt64\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfInt64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfInt64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfaceint64((map[interface{}]int64)(x.FMapIntfInt64), e)
+						}
+					}
+				}
+				var yyn358 bool
+				if x.FptrMapIntfInt64 == nil {
+					yyn358 = true
+					goto LABEL358
+				}
+			LABEL358:
+				if yyr2 || yy2arr2 {
+					if yyn358 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy359 := *x.FptrMapIntfInt64
+							if false {
+							} else {
+								h.encMapInterfaceint64((map[interface{}]int64)(yy359), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfInt64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfInt64`)
+					}
+					r.WriteMapElemValue()
+					if yyn358 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy361 := *x.FptrMapIntfInt64
+							if false {
+							} else {
+								h.encMapInterfaceint64((map[interface{}]int64)(yy361), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfFloat32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfacefloat32((map[interface{}]float32)(x.FMapIntfFloat32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfFloat32\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfFloat32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfFloat32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfacefloat32((map[interface{}]float32)(x.FMapIntfFloat32), e)
+						}
+					}
+				}
+				var yyn366 bool
+				if x.FptrMapIntfFloat32 == nil {
+					yyn366 = true
+					goto LABEL366
+				}
+			LABEL366:
+				if yyr2 || yy2arr2 {
+					if yyn366 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy367 := *x.FptrMapIntfFloat32
+							if false {
+							} else {
+								h.encMapInterfacefloat32((map[interface{}]float32)(yy367), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfFloat32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfFloat32`)
+					}
+					r.WriteMapElemValue()
+					if yyn366 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy369 := *x.FptrMapIntfFloat32
+							if false {
+							} else {
+								h.encMapInterfacefloat32((map[interface{}]float32)(yy369), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfFloat64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfacefloat64((map[interface{}]float64)(x.FMapIntfFloat64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfFloat64\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfFloat64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfFloat64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfacefloat64((map[interface{}]float64)(x.FMapIntfFloat64), e)
+						}
+					}
+				}
+				var yyn374 bool
+				if x.FptrMapIntfFloat64 == nil {
+					yyn374 = true
+					goto LABEL374
+				}
+			LABEL374:
+				if yyr2 || yy2arr2 {
+					if yyn374 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy375 := *x.FptrMapIntfFloat64
+							if false {
+							} else {
+								h.encMapInterfacefloat64((map[interface{}]float64)(yy375), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfFloat64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfFloat64`)
+					}
+					r.WriteMapElemValue()
+					if yyn374 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy377 := *x.FptrMapIntfFloat64
+							if false {
+							} else {
+								h.encMapInterfacefloat64((map[interface{}]float64)(yy377), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntfBool == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfacebool((map[interface{}]bool)(x.FMapIntfBool), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntfBool\"")
+					} else {
+						r.EncodeSymbol(`FMapIntfBool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntfBool == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapInterfacebool((map[interface{}]bool)(x.FMapIntfBool), e)
+						}
+					}
+				}
+				var yyn382 bool
+				if x.FptrMapIntfBool == nil {
+					yyn382 = true
+					goto LABEL382
+				}
+			LABEL382:
+				if yyr2 || yy2arr2 {
+					if yyn382 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapIntfBool == nil {
+							r.EncodeNil()
+						} else {
+							yy383 := *x.FptrMapIntfBool
+							if false {
+							} else {
+								h.encMapInterfacebool((map[interface{}]bool)(yy383), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntfBool\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntfBool`)
+					}
+					r.WriteMapElemValue()
+					if yyn382 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntfBool == nil {
+							r.EncodeNil()
+						} else {
+							yy385 := *x.FptrMapIntfBool
+							if false {
+							} else {
+								h.encMapInterfacebool((map[interface{}]bool)(yy385), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringIntf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringInterface((map[string]interface{})(x.FMapStringIntf), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringIntf\"")
+					} else {
+						r.EncodeSymbol(`FMapStringIntf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringIntf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringInterface((map[string]interface{})(x.FMapStringIntf), e)
+						}
+					}
+				}
+				var yyn390 bool
+				if x.FptrMapStringIntf == nil {
+					yyn390 = true
+					goto LABEL390
+				}
+			LABEL390:
+				if yyr2 || yy2arr2 {
+					if yyn390 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy391 := *x.FptrMapStringIntf
+							if false {
+							} else {
+								h.encMapstringInterface((map[string]interface{})(yy391), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringIntf\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringIntf`)
+					}
+					r.WriteMapElemValue()
+					if yyn390 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy393 := *x.FptrMapStringIntf
+							if false {
+							} else {
+								h.encMapstringInterface((map[string]interface{})(yy393), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringString == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringstring((map[string]string)(x.FMapStringString), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringString\"")
+					} else {
+						r.EncodeSymbol(`FMapStringString`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringString == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringstring((map[string]string)(x.FMapStringString), e)
+						}
+					}
+				}
+				var yyn398 bool
+				if x.FptrMapStringString == nil {
+					yyn398 = true
+					goto LABEL398
+				}
+			LABEL398:
+				if yyr2 || yy2arr2 {
+					if yyn398 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringString == nil {
+							r.EncodeNil()
+						} else {
+							yy399 := *x.FptrMapStringString
+							if false {
+							} else {
+								h.encMapstringstring((map[string]string)(yy399), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringString\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringString`)
+					}
+					r.WriteMapElemValue()
+					if yyn398 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringString == nil {
+							r.EncodeNil()
+						} else {
+							yy401 := *x.FptrMapStringString
+							if false {
+							} else {
+								h.encMapstringstring((map[string]string)(yy401), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringUint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint((map[string]uint)(x.FMapStringUint), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringUint\"")
+					} else {
+						r.EncodeSymbol(`FMapStringUint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringUint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint((map[string]uint)(x.FMapStringUint), e)
+						}
+					}
+				}
+				var yyn406 bool
+				if x.FptrMapStringUint == nil {
+					yyn406 = true
+					goto LABEL406
+				}
+			LABEL406:
+				if yyr2 || yy2arr2 {
+					if yyn406 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringUint == nil {
+							r.EncodeNil()
+						} else {
+							yy407 := *x.FptrMapStringUint
+							if false {
+							} else {
+								h.encMapstringuint((map[string]uint)(yy407), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringUint\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringUint`)
+					}
+					r.WriteMapElemValue()
+					if yyn406 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringUint == nil {
+							r.EncodeNil()
+						} else {
+							yy409 := *x.FptrMapStringUint
+							if false {
+							} else {
+								h.encMapstringuint((map[string]uint)(yy409), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringUint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint8((map[string]uint8)(x.FMapStringUint8), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringUint8\"")
+					} else {
+						r.EncodeSymbol(`FMapStringUint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringUint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint8((map[string]uint8)(x.FMapStringUint8), e)
+						}
+					}
+				}
+				var yyn414 bool
+				if x.FptrMapStringUint8 == nil {
+					yyn414 = true
+					goto LABEL414
+				}
+			LABEL414:
+				if yyr2 || yy2arr2 {
+					if yyn414 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy415 := *x.FptrMapStringUint8
+							if false {
+							} else {
+								h.encMapstringuint8((map[string]uint8)(yy415), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringUint8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringUint8`)
+					}
+					r.WriteMapElemValue()
+					if yyn414 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy417 := *x.FptrMapStringUint8
+							if false {
+							} else {
+								h.encMapstringuint8((map[string]uint8)(yy417), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringUint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.FMapStringUint16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringUint16\"")
+					} else {
+						r.EncodeSymbol(`FMapStringUint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringUint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.FMapStringUint16), e)
+						}
+					}
+				}
+				var yyn422 bool
+				if x.FptrMapStringUint16 == nil {
+					yyn422 = true
+					goto LABEL422
+				}
+			LABEL422:
+				if yyr2 || yy2arr2 {
+					if yyn422 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy423 := *x.FptrMapStringUint16
+							if false {
+							} else {
+								h.encMapstringuint16((map[string]uint16)(yy423), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringUint16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringUint16`)
+					}
+					r.WriteMapElemValue()
+					if yyn422 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy425 := *x.FptrMapStringUint16
+							if false {
+							} else {
+								h.encMapstringuint16((map[string]uint16)(yy425), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringUint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint32((map[string]uint32)(x.FMapStringUint32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringUint32\"")
+					} else {
+						r.EncodeSymbol(`FMapStringUint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringUint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint32((map[string]uint32)(x.FMapStringUint32), e)
+						}
+					}
+				}
+				var yyn430 bool
+				if x.FptrMapStringUint32 == nil {
+					yyn430 = true
+					goto LABEL430
+				}
+			LABEL430:
+				if yyr2 || yy2arr2 {
+					if yyn430 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy431 := *x.FptrMapStringUint32
+							if false {
+							} else {
+								h.encMapstringuint32((map[string]uint32)(yy431), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringUint32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringUint32`)
+					}
+					r.WriteMapElemValue()
+					if yyn430 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy433 := *x.FptrMapStringUint32
+							if false {
+							} else {
+								h.encMapstringuint32((map[string]uint32)(yy433), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringUint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint64((map[string]uint64)(x.FMapStringUint64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringUint64\"")
+					} else {
+						r.EncodeSymbol(`FMapStringUint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringUint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint64((map[string]uint64)(x.FMapStringUint64), e)
+						}
+					}
+				}
+				var yyn438 bool
+				if x.FptrMapStringUint64 == nil {
+					yyn438 = true
+					goto LABEL438
+				}
+			LABEL438:
+				if yyr2 || yy2arr2 {
+					if yyn438 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy439 := *x.FptrMapStringUint64
+							if false {
+							} else {
+								h.encMapstringuint64((map[string]uint64)(yy439), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringUint64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringUint64`)
+					}
+					r.WriteMapElemValue()
+					if yyn438 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy441 := *x.FptrMapStringUint64
+							if false {
+							} else {
+								h.encMapstringuint64((map[string]uint64)(yy441), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringUintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuintptr((map[string]uintptr)(x.FMapStringUintptr), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringUintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapStringUintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringUintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuintptr((map[string]uintptr)(x.FMapStringUintptr), e)
+						}
+					}
+				}
+				var yyn446 bool
+				if x.FptrMapStringUintptr == nil {
+					yyn446 = true
+					goto LABEL446
+				}
+			LABEL446:
+				if yyr2 || yy2arr2 {
+					if yyn446 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy447 := *x.FptrMapStringUintptr
+							if false {
+							} else {
+								h.encMapstringuintptr((map[string]uintptr)(yy447), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringUintptr\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringUintptr`)
+					}
+					r.WriteMapElemValue()
+					if yyn446 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy449 := *x.FptrMapStringUintptr
+							if false {
+							} else {
+								h.encMapstringuintptr((map[string]uintptr)(yy449), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringInt == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint((map[string]int)(x.FMapStringInt), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringInt\"")
+					} else {
+						r.EncodeSymbol(`FMapStringInt`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringInt == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint((map[string]int)(x.FMapStringInt), e)
+						}
+					}
+				}
+				var yyn454 bool
+				if x.FptrMapStringInt == nil {
+					yyn454 = true
+					goto LABEL454
+				}
+			LABEL454:
+				if yyr2 || yy2arr2 {
+					if yyn454 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringInt == nil {
+							r.EncodeNil()
+						} else {
+							yy455 := *x.FptrMapStringInt
+							if false {
+							} else {
+								h.encMapstringint((map[string]int)(yy455), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringInt\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringInt`)
+					}
+					r.WriteMapElemValue()
+					if yyn454 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringInt == nil {
+							r.EncodeNil()
+						} else {
+							yy457 := *x.FptrMapStringInt
+							if false {
+							} else {
+								h.encMapstringint((map[string]int)(yy457), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringInt8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint8((map[string]int8)(x.FMapStringInt8), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringInt8\"")
+					} else {
+						r.EncodeSymbol(`FMapStringInt8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringInt8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint8((map[string]int8)(x.FMapStringInt8), e)
+						}
+					}
+				}
+				var yyn462 bool
+				if x.FptrMapStringInt8 == nil {
+					yyn462 = true
+					goto LABEL462
+				}
+			LABEL462:
+				if yyr2 || yy2arr2 {
+					if yyn462 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy463 := *x.FptrMapStringInt8
+							if false {
+							} else {
+								h.encMapstringint8((map[string]int8)(yy463), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringInt8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringInt8`)
+					}
+					r.WriteMapElemValue()
+					if yyn462 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy465 := *x.FptrMapStringInt8
+							if false {
+							} else {
+								h.encMapstringint8((map[string]int8)(yy465), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringInt16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint16((map[string]int16)(x.FMapStringInt16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringInt16\"")
+					} else {
+						r.EncodeSymbol(`FMapStringInt16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringInt16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint16((map[string]int16)(x.FMapStringInt16), e)
+						}
+					}
+				}
+				var yyn470 bool
+				if x.FptrMapStringInt16 == nil {
+					yyn470 = true
+					goto LABEL470
+				}
+			LABEL470:
+				if yyr2 || yy2arr2 {
+					if yyn470 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy471 := *x.FptrMapStringInt16
+							if false {
+							} else {
+								h.encMapstringint16((map[string]int16)(yy471), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringInt16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringInt16`)
+					}
+					r.WriteMapElemValue()
+					if yyn470 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy473 := *x.FptrMapStringInt16
+							if false {
+							} else {
+								h.encMapstringint16((map[string]int16)(yy473), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringInt32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint32((map[string]int32)(x.FMapStringInt32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringInt32\"")
+					} else {
+						r.EncodeSymbol(`FMapStringInt32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringInt32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint32((map[string]int32)(x.FMapStringInt32), e)
+						}
+					}
+				}
+				var yyn478 bool
+				if x.FptrMapStringInt32 == nil {
+					yyn478 = true
+					goto LABEL478
+				}
+			LABEL478:
+				if yyr2 || yy2arr2 {
+					if yyn478 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy479 := *x.FptrMapStringInt32
+							if false {
+							} else {
+								h.encMapstringint32((map[string]int32)(yy479), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringInt32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringInt32`)
+					}
+					r.WriteMapElemValue()
+					if yyn478 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy481 := *x.FptrMapStringInt32
+							if false {
+							} else {
+								h.encMapstringint32((map[string]int32)(yy481), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringInt64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint64((map[string]int64)(x.FMapStringInt64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringInt64\"")
+					} else {
+						r.EncodeSymbol(`FMapStringInt64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringInt64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint64((map[string]int64)(x.FMapStringInt64), e)
+						}
+					}
+				}
+				var yyn486 bool
+				if x.FptrMapStringInt64 == nil {
+					yyn486 = true
+					goto LABEL486
+				}
+			LABEL486:
+				if yyr2 || yy2arr2 {
+					if yyn486 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy487 := *x.FptrMapStringInt64
+							if false {
+							} else {
+								h.encMapstringint64((map[string]int64)(yy487), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringInt64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringInt64`)
+					}
+					r.WriteMapElemValue()
+					if yyn486 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy489 := *x.FptrMapStringInt64
+							if false {
+							} else {
+								h.encMapstringint64((map[string]int64)(yy489), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringFloat32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringfloat32((map[string]float32)(x.FMapStringFloat32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringFloat32\"")
+					} else {
+						r.EncodeSymbol(`FMapStringFloat32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringFloat32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringfloat32((map[string]float32)(x.FMapStringFloat32), e)
+						}
+					}
+				}
+				var yyn494 bool
+				if x.FptrMapStringFloat32 == nil {
+					yyn494 = true
+					goto LABEL494
+				}
+			LABEL494:
+				if yyr2 || yy2arr2 {
+					if yyn494 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy495 := *x.FptrMapStringFloat32
+							if false {
+							} else {
+								h.encMapstringfloat32((map[string]float32)(yy495), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringFloat32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringFloat32`)
+					}
+					r.WriteMapElemValue()
+					if yyn494 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy497 := *x.FptrMapStringFloat32
+							if false {
+							} else {
+								h.encMapstringfloat32((map[string]float32)(yy497), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringFloat64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringfloat64((map[string]float64)(x.FMapStringFloat64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringFloat64\"")
+					} else {
+						r.EncodeSymbol(`FMapStringFloat64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringFloat64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringfloat64((map[string]float64)(x.FMapStringFloat64), e)
+						}
+					}
+				}
+				var yyn502 bool
+				if x.FptrMapStringFloat64 == nil {
+					yyn502 = true
+					goto LABEL502
+				}
+			LABEL502:
+				if yyr2 || yy2arr2 {
+					if yyn502 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy503 := *x.FptrMapStringFloat64
+							if false {
+							} else {
+								h.encMapstringfloat64((map[string]float64)(yy503), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringFloat64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringFloat64`)
+					}
+					r.WriteMapElemValue()
+					if yyn502 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy505 := *x.FptrMapStringFloat64
+							if false {
+							} else {
+								h.encMapstringfloat64((map[string]float64)(yy505), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapStringBool == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringbool((map[string]bool)(x.FMapStringBool), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapStringBool\"")
+					} else {
+						r.EncodeSymbol(`FMapStringBool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapStringBool == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringbool((map[string]bool)(x.FMapStringBool), e)
+						}
+					}
+				}
+				var yyn510 bool
+				if x.FptrMapStringBool == nil {
+					yyn510 = true
+					goto LABEL510
+				}
+			LABEL510:
+				if yyr2 || yy2arr2 {
+					if yyn510 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapStringBool == nil {
+							r.EncodeNil()
+						} else {
+							yy511 := *x.FptrMapStringBool
+							if false {
+							} else {
+								h.encMapstringbool((map[string]bool)(yy511), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapStringBool\"")
+					} else {
+						r.EncodeSymbol(`FptrMapStringBool`)
+					}
+					r.WriteMapElemValue()
+					if yyn510 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapStringBool == nil {
+							r.EncodeNil()
+						} else {
+							yy513 := *x.FptrMapStringBool
+							if false {
+							} else {
+								h.encMapstringbool((map[string]bool)(yy513), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Intf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32Interface((map[float32]interface{})(x.FMapFloat32Intf), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Intf\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Intf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Intf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32Interface((map[float32]interface{})(x.FMapFloat32Intf), e)
+						}
+					}
+				}
+				var yyn518 bool
+				if x.FptrMapFloat32Intf == nil {
+					yyn518 = true
+					goto LABEL518
+				}
+			LABEL518:
+				if yyr2 || yy2arr2 {
+					if yyn518 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy519 := *x.FptrMapFloat32Intf
+							if false {
+							} else {
+								h.encMapfloat32Interface((map[float32]interface{})(yy519), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Intf\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Intf`)
+					}
+					r.WriteMapElemValue()
+					if yyn518 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy521 := *x.FptrMapFloat32Intf
+							if false {
+							} else {
+								h.encMapfloat32Interface((map[float32]interface{})(yy521), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32String == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32string((map[float32]string)(x.FMapFloat32String), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32String\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32String`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32String == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32string((map[float32]string)(x.FMapFloat32String), e)
+						}
+					}
+				}
+				var yyn526 bool
+				if x.FptrMapFloat32String == nil {
+					yyn526 = true
+					goto LABEL526
+				}
+			LABEL526:
+				if yyr2 || yy2arr2 {
+					if yyn526 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32String == nil {
+							r.EncodeNil()
+						} else {
+							yy527 := *x.FptrMapFloat32String
+							if false {
+							} else {
+								h.encMapfloat32string((map[float32]string)(yy527), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32String\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32String`)
+					}
+					r.WriteMapElemValue()
+					if yyn526 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32String == nil {
+							r.EncodeNil()
+						} else {
+							yy529 := *x.FptrMapFloat32String
+							if false {
+							} else {
+								h.encMapfloat32string((map[float32]string)(yy529), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Uint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint((map[float32]uint)(x.FMapFloat32Uint), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Uint\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Uint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Uint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint((map[float32]uint)(x.FMapFloat32Uint), e)
+						}
+					}
+				}
+				var yyn534 bool
+				if x.FptrMapFloat32Uint == nil {
+					yyn534 = true
+					goto LABEL534
+				}
+			LABEL534:
+				if yyr2 || yy2arr2 {
+					if yyn534 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy535 := *x.FptrMapFloat32Uint
+							if false {
+							} else {
+								h.encMapfloat32uint((map[float32]uint)(yy535), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Uint\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Uint`)
+					}
+					r.WriteMapElemValue()
+					if yyn534 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy537 := *x.FptrMapFloat32Uint
+							if false {
+							} else {
+								h.encMapfloat32uint((map[float32]uint)(yy537), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Uint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint8((map[float32]uint8)(x.FMapFloat32Uint8), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Uint8\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Uint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Uint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint8((map[float32]uint8)(x.FMapFloat32Uint8), e)
+						}
+					}
+				}
+				var yyn542 bool
+				if x.FptrMapFloat32Uint8 == nil {
+					yyn542 = true
+					goto LABEL542
+				}
+			LABEL542:
+				if yyr2 || yy2arr2 {
+					if yyn542 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy543 := *x.FptrMapFloat32Uint8
+							if false {
+							} else {
+								h.encMapfloat32uint8((map[float32]uint8)(yy543), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Uint8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Uint8`)
+					}
+					r.WriteMapElemValue()
+					if yyn542 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy545 := *x.FptrMapFloat32Uint8
+							if false {
+							} else {
+								h.encMapfloat32uint8((map[float32]uint8)(yy545), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Uint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint16((map[float32]uint16)(x.FMapFloat32Uint16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Uint16\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Uint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Uint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint16((map[float32]uint16)(x.FMapFloat32Uint16), e)
+						}
+					}
+				}
+				var yyn550 bool
+				if x.FptrMapFloat32Uint16 == nil {
+					yyn550 = true
+					goto LABEL550
+				}
+			LABEL550:
+				if yyr2 || yy2arr2 {
+					if yyn550 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy551 := *x.FptrMapFloat32Uint16
+							if false {
+							} else {
+								h.encMapfloat32uint16((map[float32]uint16)(yy551), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Uint16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Uint16`)
+					}
+					r.WriteMapElemValue()
+					if yyn550 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy553 := *x.FptrMapFloat32Uint16
+							if false {
+							} else {
+								h.encMapfloat32uint16((map[float32]uint16)(yy553), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Uint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint32((map[float32]uint32)(x.FMapFloat32Uint32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Uint32\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Uint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Uint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint32((map[float32]uint32)(x.FMapFloat32Uint32), e)
+						}
+					}
+				}
+				var yyn558 bool
+				if x.FptrMapFloat32Uint32 == nil {
+					yyn558 = true
+					goto LABEL558
+				}
+			LABEL558:
+				if yyr2 || yy2arr2 {
+					if yyn558 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy559 := *x.FptrMapFloat32Uint32
+							if false {
+							} else {
+								h.encMapfloat32uint32((map[float32]uint32)(yy559), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Uint32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Uint32`)
+					}
+					r.WriteMapElemValue()
+					if yyn558 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy561 := *x.FptrMapFloat32Uint32
+							if false {
+							} else {
+								h.encMapfloat32uint32((map[float32]uint32)(yy561), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Uint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint64((map[float32]uint64)(x.FMapFloat32Uint64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Uint64\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Uint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Uint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uint64((map[float32]uint64)(x.FMapFloat32Uint64), e)
+						}
+					}
+				}
+				var yyn566 bool
+				if x.FptrMapFloat32Uint64 == nil {
+					yyn566 = true
+					goto LABEL566
+				}
+			LABEL566:
+				if yyr2 || yy2arr2 {
+					if yyn566 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy567 := *x.FptrMapFloat32Uint64
+							if false {
+							} else {
+								h.encMapfloat32uint64((map[float32]uint64)(yy567), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Uint64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Uint64`)
+					}
+					r.WriteMapElemValue()
+					if yyn566 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy569 := *x.FptrMapFloat32Uint64
+							if false {
+							} else {
+								h.encMapfloat32uint64((map[float32]uint64)(yy569), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Uintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uintptr((map[float32]uintptr)(x.FMapFloat32Uintptr), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Uintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Uintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32uintptr((map[float32]uintptr)(x.FMapFloat32Uintptr), e)
+						}
+					}
+				}
+				var yyn574 bool
+				if x.FptrMapFloat32Uintptr == nil {
+					yyn574 = true
+					goto LABEL574
+				}
+			LABEL574:
+				if yyr2 || yy2arr2 {
+					if yyn574 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy575 := *x.FptrMapFloat32Uintptr
+							if false {
+							} else {
+								h.encMapfloat32uintptr((map[float32]uintptr)(yy575), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Uintptr\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if yyn574 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy577 := *x.FptrMapFloat32Uintptr
+							if false {
+							} else {
+								h.encMapfloat32uintptr((map[float32]uintptr)(yy577), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Int == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int((map[float32]int)(x.FMapFloat32Int), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Int\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Int`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Int == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int((map[float32]int)(x.FMapFloat32Int), e)
+						}
+					}
+				}
+				var yyn582 bool
+				if x.FptrMapFloat32Int == nil {
+					yyn582 = true
+					goto LABEL582
+				}
+			LABEL582:
+				if yyr2 || yy2arr2 {
+					if yyn582 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Int == nil {
+							r.EncodeNil()
+						} else {
+							yy583 := *x.FptrMapFloat32Int
+							if false {
+							} else {
+								h.encMapfloat32int((map[float32]int)(yy583), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Int\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Int`)
+					}
+					r.WriteMapElemValue()
+					if yyn582 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Int == nil {
+							r.EncodeNil()
+						} else {
+							yy585 := *x.FptrMapFloat32Int
+							if false {
+							} else {
+								h.encMapfloat32int((map[float32]int)(yy585), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Int8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int8((map[float32]int8)(x.FMapFloat32Int8), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Int8\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Int8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Int8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int8((map[float32]int8)(x.FMapFloat32Int8), e)
+						}
+					}
+				}
+				var yyn590 bool
+				if x.FptrMapFloat32Int8 == nil {
+					yyn590 = true
+					goto LABEL590
+				}
+			LABEL590:
+				if yyr2 || yy2arr2 {
+					if yyn590 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy591 := *x.FptrMapFloat32Int8
+							if false {
+							} else {
+								h.encMapfloat32int8((map[float32]int8)(yy591), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Int8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Int8`)
+					}
+					r.WriteMapElemValue()
+					if yyn590 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy593 := *x.FptrMapFloat32Int8
+							if false {
+							} else {
+								h.encMapfloat32int8((map[float32]int8)(yy593), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Int16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int16((map[float32]int16)(x.FMapFloat32Int16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Int16\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Int16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Int16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int16((map[float32]int16)(x.FMapFloat32Int16), e)
+						}
+					}
+				}
+				var yyn598 bool
+				if x.FptrMapFloat32Int16 == nil {
+					yyn598 = true
+					goto LABEL598
+				}
+			LABEL598:
+				if yyr2 || yy2arr2 {
+					if yyn598 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy599 := *x.FptrMapFloat32Int16
+							if false {
+							} else {
+								h.encMapfloat32int16((map[float32]int16)(yy599), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Int16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Int16`)
+					}
+					r.WriteMapElemValue()
+					if yyn598 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy601 := *x.FptrMapFloat32Int16
+							if false {
+							} else {
+								h.encMapfloat32int16((map[float32]int16)(yy601), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Int32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int32((map[float32]int32)(x.FMapFloat32Int32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Int32\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Int32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Int32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int32((map[float32]int32)(x.FMapFloat32Int32), e)
+						}
+					}
+				}
+				var yyn606 bool
+				if x.FptrMapFloat32Int32 == nil {
+					yyn606 = true
+					goto LABEL606
+				}
+			LABEL606:
+				if yyr2 || yy2arr2 {
+					if yyn606 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy607 := *x.FptrMapFloat32Int32
+							if false {
+							} else {
+								h.encMapfloat32int32((map[float32]int32)(yy607), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Int32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Int32`)
+					}
+					r.WriteMapElemValue()
+					if yyn606 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy609 := *x.FptrMapFloat32Int32
+							if false {
+							} else {
+								h.encMapfloat32int32((map[float32]int32)(yy609), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Int64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int64((map[float32]int64)(x.FMapFloat32Int64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Int64\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Int64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Int64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32int64((map[float32]int64)(x.FMapFloat32Int64), e)
+						}
+					}
+				}
+				var yyn614 bool
+				if x.FptrMapFloat32Int64 == nil {
+					yyn614 = true
+					goto LABEL614
+				}
+			LABEL614:
+				if yyr2 || yy2arr2 {
+					if yyn614 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy615 := *x.FptrMapFloat32Int64
+							if false {
+							} else {
+								h.encMapfloat32int64((map[float32]int64)(yy615), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Int64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Int64`)
+					}
+					r.WriteMapElemValue()
+					if yyn614 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy617 := *x.FptrMapFloat32Int64
+							if false {
+							} else {
+								h.encMapfloat32int64((map[float32]int64)(yy617), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Float32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32float32((map[float32]float32)(x.FMapFloat32Float32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Float32\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Float32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Float32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32float32((map[float32]float32)(x.FMapFloat32Float32), e)
+						}
+					}
+				}
+				var yyn622 bool
+				if x.FptrMapFloat32Float32 == nil {
+					yyn622 = true
+					goto LABEL622
+				}
+			LABEL622:
+				if yyr2 || yy2arr2 {
+					if yyn622 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy623 := *x.FptrMapFloat32Float32
+							if false {
+							} else {
+								h.encMapfloat32float32((map[float32]float32)(yy623), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Float32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Float32`)
+					}
+					r.WriteMapElemValue()
+					if yyn622 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy625 := *x.FptrMapFloat32Float32
+							if false {
+							} else {
+								h.encMapfloat32float32((map[float32]float32)(yy625), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Float64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32float64((map[float32]float64)(x.FMapFloat32Float64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Float64\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Float64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Float64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32float64((map[float32]float64)(x.FMapFloat32Float64), e)
+						}
+					}
+				}
+				var yyn630 bool
+				if x.FptrMapFloat32Float64 == nil {
+					yyn630 = true
+					goto LABEL630
+				}
+			LABEL630:
+				if yyr2 || yy2arr2 {
+					if yyn630 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy631 := *x.FptrMapFloat32Float64
+							if false {
+							} else {
+								h.encMapfloat32float64((map[float32]float64)(yy631), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Float64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Float64`)
+					}
+					r.WriteMapElemValue()
+					if yyn630 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy633 := *x.FptrMapFloat32Float64
+							if false {
+							} else {
+								h.encMapfloat32float64((map[float32]float64)(yy633), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat32Bool == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32bool((map[float32]bool)(x.FMapFloat32Bool), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat32Bool\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat32Bool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat32Bool == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat32bool((map[float32]bool)(x.FMapFloat32Bool), e)
+						}
+					}
+				}
+				var yyn638 bool
+				if x.FptrMapFloat32Bool == nil {
+					yyn638 = true
+					goto LABEL638
+				}
+			LABEL638:
+				if yyr2 || yy2arr2 {
+					if yyn638 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat32Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy639 := *x.FptrMapFloat32Bool
+							if false {
+							} else {
+								h.encMapfloat32bool((map[float32]bool)(yy639), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat32Bool\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat32Bool`)
+					}
+					r.WriteMapElemValue()
+					if yyn638 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat32Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy641 := *x.FptrMapFloat32Bool
+							if false {
+							} else {
+								h.encMapfloat32bool((map[float32]bool)(yy641), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat64Intf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64Interface((map[float64]interface{})(x.FMapFloat64Intf), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Intf\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Intf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Intf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64Interface((map[float64]interface{})(x.FMapFloat64Intf), e)
+						}
+					}
+				}
+				var yyn646 bool
+				if x.FptrMapFloat64Intf == nil {
+					yyn646 = true
+					goto LABEL646
+				}
+			LABEL646:
+				if yyr2 || yy2arr2 {
+					if yyn646 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy647 := *x.FptrMapFloat64Intf
+							if false {
+							} else {
+								h.encMapfloat64Interface((map[float64]interface{})(yy647), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Intf\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Intf`)
+					}
+					r.WriteMapElemValue()
+					if yyn646 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy649 := *x.FptrMapFloat64Intf
+							if false {
+							} else {
+								h.encMapfloat64Interface((map[float64]interface{})(yy649), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat64String == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64string((map[float64]string)(x.FMapFloat64String), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64String\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64String`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64String == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64string((map[float64]string)(x.FMapFloat64String), e)
+						}
+					}
+				}
+				var yyn654 bool
+				if x.FptrMapFloat64String == nil {
+					yyn654 = true
+					goto LABEL654
+				}
+			LABEL654:
+				if yyr2 || yy2arr2 {
+					if yyn654 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64String == nil {
+							r.EncodeNil()
+						} else {
+							yy655 := *x.FptrMapFloat64String
+							if false {
+							} else {
+								h.encMapfloat64string((map[float64]string)(yy655), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64String\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64String`)
+					}
+					r.WriteMapElemValue()
+					if yyn654 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64String == nil {
+							r.EncodeNil()
+						} else {
+							yy657 := *x.FptrMapFloat64String
+							if false {
+							} else {
+								h.encMapfloat64string((map[float64]string)(yy657), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat64Uint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint((map[float64]uint)(x.FMapFloat64Uint), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Uint\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Uint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Uint == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint((map[float64]uint)(x.FMapFloat64Uint), e)
+						}
+					}
+				}
+				var yyn662 bool
+				if x.FptrMapFloat64Uint == nil {
+					yyn662 = true
+					goto LABEL662
+				}
+			LABEL662:
+				if yyr2 || yy2arr2 {
+					if yyn662 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy663 := *x.FptrMapFloat64Uint
+							if false {
+							} else {
+								h.encMapfloat64uint((map[float64]uint)(yy663), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Uint\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Uint`)
+					}
+					r.WriteMapElemValue()
+					if yyn662 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy665 := *x.FptrMapFloat64Uint
+							if false {
+							} else {
+								h.encMapfloat64uint((map[float64]uint)(yy665), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat64Uint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint8((map[float64]uint8)(x.FMapFloat64Uint8), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Uint8\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Uint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Uint8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint8((map[float64]uint8)(x.FMapFloat64Uint8), e)
+						}
+					}
+				}
+				var yyn670 bool
+				if x.FptrMapFloat64Uint8 == nil {
+					yyn670 = true
+					goto LABEL670
+				}
+			LABEL670:
+				if yyr2 || yy2arr2 {
+					if yyn670 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy671 := *x.FptrMapFloat64Uint8
+							if false {
+							} else {
+								h.encMapfloat64uint8((map[float64]uint8)(yy671), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Uint8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Uint8`)
+					}
+					r.WriteMapElemValue()
+					if yyn670 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy673 := *x.FptrMapFloat64Uint8
+							if false {
+							} else {
+								h.encMapfloat64uint8((map[float64]uint8)(yy673), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat64Uint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint16((map[float64]uint16)(x.FMapFloat64Uint16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Uint16\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Uint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Uint16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint16((map[float64]uint16)(x.FMapFloat64Uint16), e)
+						}
+					}
+				}
+				var yyn678 bool
+				if x.FptrMapFloat64Uint16 == nil {
+					yyn678 = true
+					goto LABEL678
+				}
+			LABEL678:
+				if yyr2 || yy2arr2 {
+					if yyn678 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy679 := *x.FptrMapFloat64Uint16
+							if false {
+							} else {
+								h.encMapfloat64uint16((map[float64]uint16)(yy679), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Uint16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Uint16`)
+					}
+					r.WriteMapElemValue()
+					if yyn678 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy681 := *x.FptrMapFloat64Uint16
+							if false {
+							} else {
+								h.encMapfloat64uint16((map[float64]uint16)(yy681), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat64Uint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint32((map[float64]uint32)(x.FMapFloat64Uint32), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Uint32\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Uint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Uint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint32((map[float64]uint32)(x.FMapFloat64Uint32), e)
+						}
+					}
+				}
+				var yyn686 bool
+				if x.FptrMapFloat64Uint32 == nil {
+					yyn686 = true
+					goto LABEL686
+				}
+			LABEL686:
+				if yyr2 || yy2arr2 {
+					if yyn686 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy687 := *x.FptrMapFloat64Uint32
+							if false {
+							} else {
+								h.encMapfloat64uint32((map[float64]uint32)(yy687), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Uint32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Uint32`)
+					}
+					r.WriteMapElemValue()
+					if yyn686 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy689 := *x.FptrMapFloat64Uint32
+							if false {
+							} else {
+								h.encMapfloat64uint32((map[float64]uint32)(yy689), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat64Uint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint64((map[float64]uint64)(x.FMapFloat64Uint64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Uint64\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Uint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Uint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uint64((map[float64]uint64)(x.FMapFloat64Uint64), e)
+						}
+					}
+				}
+				var yyn694 bool
+				if x.FptrMapFloat64Uint64 == nil {
+					yyn694 = true
+					goto LABEL694
+				}
+			LABEL694:
+				if yyr2 || yy2arr2 {
+					if yyn694 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy695 := *x.FptrMapFloat64Uint64
+							if false {
+							} else {
+								h.encMapfloat64uint64((map[float64]uint64)(yy695), e)
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Uint64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Uint64`)
+					}
+					r.WriteMapElemValue()
+					if yyn694 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy697 := *x.FptrMapFloat64Uint64
+							if false {
+							} else {
+								h.encMapfloat64uint64((map[float64]uint64)(yy697), e)
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapFloat64Uintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uintptr((map[float64]uintptr)(x.FMapFloat64Uintptr), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Uintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Uintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64uintptr((map[float64]uintptr)(x.FMapFloat64Uintptr), e)
+						}
+					}
+				}
+				var yyn702 bool
+				if x.FptrMapFloat64Uintptr == nil {
+					yyn702 = true
+					goto LABEL702
+				}
+			LABEL702:
+				if yyr2 || yy2arr2 {
+					if yyn702 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy703 := *x.FptrMapFloat64Uintptr
+							if false {
+							} else {
+								h.encMapfloat64uintptr((map[float64]uintptr)(yy703), e)
+							}
+						}
+					}
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Uintptr\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if yyn702 {
+						r.EncodeNil()
 					} else {
-						z.EncFallback(x.FIntf)
+						if x.FptrMapFloat64Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy705 := *x.FptrMapFloat64Uintptr
+							if false {
+							} else {
+								h.encMapfloat64uintptr((map[float64]uintptr)(yy705), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn6 bool
-			if x.FptrIntf == nil {
-				yyn6 = true
-				goto LABEL6
-			}
-		LABEL6:
-			if yyr2 || yy2arr2 {
-				if yyn6 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrIntf == nil {
+					if x.FMapFloat64Int == nil {
 						r.EncodeNil()
 					} else {
-						yy7 := *x.FptrIntf
 						if false {
 						} else {
-							z.EncFallback(yy7)
+							h.encMapfloat64int((map[float64]int)(x.FMapFloat64Int), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrIntf\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrIntf`)
-				}
-				r.WriteMapElemValue()
-				if yyn6 {
-					r.EncodeNil()
-				} else {
-					if x.FptrIntf == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Int\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Int`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Int == nil {
 						r.EncodeNil()
 					} else {
-						yy9 := *x.FptrIntf
 						if false {
 						} else {
-							z.EncFallback(yy9)
+							h.encMapfloat64int((map[float64]int)(x.FMapFloat64Int), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.FString)))
+				var yyn710 bool
+				if x.FptrMapFloat64Int == nil {
+					yyn710 = true
+					goto LABEL710
+				}
+			LABEL710:
+				if yyr2 || yy2arr2 {
+					if yyn710 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819781, string(x.FString))
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Int == nil {
+							r.EncodeNil()
+						} else {
+							yy711 := *x.FptrMapFloat64Int
+							if false {
+							} else {
+								h.encMapfloat64int((map[float64]int)(yy711), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FString\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FString`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.FString)))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Int\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Int`)
+					}
+					r.WriteMapElemValue()
+					if yyn710 {
+						r.EncodeNil()
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819781, string(x.FString))
+						if x.FptrMapFloat64Int == nil {
+							r.EncodeNil()
+						} else {
+							yy713 := *x.FptrMapFloat64Int
+							if false {
+							} else {
+								h.encMapfloat64int((map[float64]int)(yy713), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn14 bool
-			if x.FptrString == nil {
-				yyn14 = true
-				goto LABEL14
-			}
-		LABEL14:
-			if yyr2 || yy2arr2 {
-				if yyn14 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.FMapFloat64Int8 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64int8((map[float64]int8)(x.FMapFloat64Int8), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.FptrString == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Int8\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Int8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy15 := *x.FptrString
 						if false {
 						} else {
-							if z.EncBasicHandle().StringToRaw {
-								r.EncodeStringBytesRaw(z.BytesView(string(yy15)))
-							} else {
-								r.EncodeStringEnc(codecSelferCcUTF819781, string(yy15))
-							}
+							h.encMapfloat64int8((map[float64]int8)(x.FMapFloat64Int8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrString`)
+				var yyn718 bool
+				if x.FptrMapFloat64Int8 == nil {
+					yyn718 = true
+					goto LABEL718
 				}
-				r.WriteMapElemValue()
-				if yyn14 {
-					r.EncodeNil()
-				} else {
-					if x.FptrString == nil {
+			LABEL718:
+				if yyr2 || yy2arr2 {
+					if yyn718 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy17 := *x.FptrString
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Int8 == nil {
+							r.EncodeNil()
 						} else {
-							if z.EncBasicHandle().StringToRaw {
-								r.EncodeStringBytesRaw(z.BytesView(string(yy17)))
+							yy719 := *x.FptrMapFloat64Int8
+							if false {
 							} else {
-								r.EncodeStringEnc(codecSelferCcUTF819781, string(yy17))
+								h.encMapfloat64int8((map[float64]int8)(yy719), e)
 							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeFloat32(float32(x.FFloat32))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FFloat32`)
-				}
-				r.WriteMapElemValue()
-				if false {
 				} else {
-					r.EncodeFloat32(float32(x.FFloat32))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Int8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Int8`)
+					}
+					r.WriteMapElemValue()
+					if yyn718 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy721 := *x.FptrMapFloat64Int8
+							if false {
+							} else {
+								h.encMapfloat64int8((map[float64]int8)(yy721), e)
+							}
+						}
+					}
 				}
-			}
-			var yyn22 bool
-			if x.FptrFloat32 == nil {
-				yyn22 = true
-				goto LABEL22
-			}
-		LABEL22:
-			if yyr2 || yy2arr2 {
-				if yyn22 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrFloat32 == nil {
+					if x.FMapFloat64Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy23 := *x.FptrFloat32
 						if false {
 						} else {
-							r.EncodeFloat32(float32(yy23))
+							h.encMapfloat64int16((map[float64]int16)(x.FMapFloat64Int16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrFloat32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrFloat32`)
-				}
-				r.WriteMapElemValue()
-				if yyn22 {
-					r.EncodeNil()
-				} else {
-					if x.FptrFloat32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Int16\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Int16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy25 := *x.FptrFloat32
 						if false {
 						} else {
-							r.EncodeFloat32(float32(yy25))
+							h.encMapfloat64int16((map[float64]int16)(x.FMapFloat64Int16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeFloat64(float64(x.FFloat64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FFloat64`)
+				var yyn726 bool
+				if x.FptrMapFloat64Int16 == nil {
+					yyn726 = true
+					goto LABEL726
 				}
-				r.WriteMapElemValue()
-				if false {
+			LABEL726:
+				if yyr2 || yy2arr2 {
+					if yyn726 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy727 := *x.FptrMapFloat64Int16
+							if false {
+							} else {
+								h.encMapfloat64int16((map[float64]int16)(yy727), e)
+							}
+						}
+					}
 				} else {
-					r.EncodeFloat64(float64(x.FFloat64))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Int16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Int16`)
+					}
+					r.WriteMapElemValue()
+					if yyn726 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy729 := *x.FptrMapFloat64Int16
+							if false {
+							} else {
+								h.encMapfloat64int16((map[float64]int16)(yy729), e)
+							}
+						}
+					}
 				}
-			}
-			var yyn30 bool
-			if x.FptrFloat64 == nil {
-				yyn30 = true
-				goto LABEL30
-			}
-		LABEL30:
-			if yyr2 || yy2arr2 {
-				if yyn30 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrFloat64 == nil {
+					if x.FMapFloat64Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy31 := *x.FptrFloat64
 						if false {
 						} else {
-							r.EncodeFloat64(float64(yy31))
+							h.encMapfloat64int32((map[float64]int32)(x.FMapFloat64Int32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrFloat64`)
-				}
-				r.WriteMapElemValue()
-				if yyn30 {
-					r.EncodeNil()
 				} else {
-					if x.FptrFloat64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Int32\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Int32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy33 := *x.FptrFloat64
 						if false {
 						} else {
-							r.EncodeFloat64(float64(yy33))
+							h.encMapfloat64int32((map[float64]int32)(x.FMapFloat64Int32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.FUint))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FUint`)
+				var yyn734 bool
+				if x.FptrMapFloat64Int32 == nil {
+					yyn734 = true
+					goto LABEL734
 				}
-				r.WriteMapElemValue()
-				if false {
+			LABEL734:
+				if yyr2 || yy2arr2 {
+					if yyn734 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy735 := *x.FptrMapFloat64Int32
+							if false {
+							} else {
+								h.encMapfloat64int32((map[float64]int32)(yy735), e)
+							}
+						}
+					}
 				} else {
-					r.EncodeUint(uint64(x.FUint))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Int32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Int32`)
+					}
+					r.WriteMapElemValue()
+					if yyn734 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy737 := *x.FptrMapFloat64Int32
+							if false {
+							} else {
+								h.encMapfloat64int32((map[float64]int32)(yy737), e)
+							}
+						}
+					}
 				}
-			}
-			var yyn38 bool
-			if x.FptrUint == nil {
-				yyn38 = true
-				goto LABEL38
-			}
-		LABEL38:
-			if yyr2 || yy2arr2 {
-				if yyn38 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrUint == nil {
+					if x.FMapFloat64Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy39 := *x.FptrUint
 						if false {
 						} else {
-							r.EncodeUint(uint64(yy39))
+							h.encMapfloat64int64((map[float64]int64)(x.FMapFloat64Int64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrUint\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrUint`)
-				}
-				r.WriteMapElemValue()
-				if yyn38 {
-					r.EncodeNil()
-				} else {
-					if x.FptrUint == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Int64\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Int64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy41 := *x.FptrUint
 						if false {
 						} else {
-							r.EncodeUint(uint64(yy41))
+							h.encMapfloat64int64((map[float64]int64)(x.FMapFloat64Int64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.FUint8))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FUint8`)
+				var yyn742 bool
+				if x.FptrMapFloat64Int64 == nil {
+					yyn742 = true
+					goto LABEL742
 				}
-				r.WriteMapElemValue()
-				if false {
+			LABEL742:
+				if yyr2 || yy2arr2 {
+					if yyn742 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy743 := *x.FptrMapFloat64Int64
+							if false {
+							} else {
+								h.encMapfloat64int64((map[float64]int64)(yy743), e)
+							}
+						}
+					}
 				} else {
-					r.EncodeUint(uint64(x.FUint8))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Int64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Int64`)
+					}
+					r.WriteMapElemValue()
+					if yyn742 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy745 := *x.FptrMapFloat64Int64
+							if false {
+							} else {
+								h.encMapfloat64int64((map[float64]int64)(yy745), e)
+							}
+						}
+					}
 				}
-			}
-			var yyn46 bool
-			if x.FptrUint8 == nil {
-				yyn46 = true
-				goto LABEL46
-			}
-		LABEL46:
-			if yyr2 || yy2arr2 {
-				if yyn46 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrUint8 == nil {
+					if x.FMapFloat64Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy47 := *x.FptrUint8
 						if false {
 						} else {
-							r.EncodeUint(uint64(yy47))
+							h.encMapfloat64float32((map[float64]float32)(x.FMapFloat64Float32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrUint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn46 {
-					r.EncodeNil()
 				} else {
-					if x.FptrUint8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Float32\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Float32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy49 := *x.FptrUint8
 						if false {
 						} else {
-							r.EncodeUint(uint64(yy49))
+							h.encMapfloat64float32((map[float64]float32)(x.FMapFloat64Float32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.FUint16))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FUint16`)
+				var yyn750 bool
+				if x.FptrMapFloat64Float32 == nil {
+					yyn750 = true
+					goto LABEL750
 				}
-				r.WriteMapElemValue()
-				if false {
+			LABEL750:
+				if yyr2 || yy2arr2 {
+					if yyn750 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy751 := *x.FptrMapFloat64Float32
+							if false {
+							} else {
+								h.encMapfloat64float32((map[float64]float32)(yy751), e)
+							}
+						}
+					}
 				} else {
-					r.EncodeUint(uint64(x.FUint16))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Float32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Float32`)
+					}
+					r.WriteMapElemValue()
+					if yyn750 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy753 := *x.FptrMapFloat64Float32
+							if false {
+							} else {
+								h.encMapfloat64float32((map[float64]float32)(yy753), e)
+							}
+						}
+					}
 				}
-			}
-			var yyn54 bool
-			if x.FptrUint16 == nil {
-				yyn54 = true
-				goto LABEL54
-			}
-		LABEL54:
-			if yyr2 || yy2arr2 {
-				if yyn54 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrUint16 == nil {
+					if x.FMapFloat64Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy55 := *x.FptrUint16
 						if false {
 						} else {
-							r.EncodeUint(uint64(yy55))
+							h.encMapfloat64float64((map[float64]float64)(x.FMapFloat64Float64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrUint16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrUint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn54 {
-					r.EncodeNil()
-				} else {
-					if x.FptrUint16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Float64\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Float64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy57 := *x.FptrUint16
 						if false {
 						} else {
-							r.EncodeUint(uint64(yy57))
+							h.encMapfloat64float64((map[float64]float64)(x.FMapFloat64Float64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.FUint32))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FUint32`)
+				var yyn758 bool
+				if x.FptrMapFloat64Float64 == nil {
+					yyn758 = true
+					goto LABEL758
 				}
-				r.WriteMapElemValue()
-				if false {
+			LABEL758:
+				if yyr2 || yy2arr2 {
+					if yyn758 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy759 := *x.FptrMapFloat64Float64
+							if false {
+							} else {
+								h.encMapfloat64float64((map[float64]float64)(yy759), e)
+							}
+						}
+					}
 				} else {
-					r.EncodeUint(uint64(x.FUint32))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Float64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Float64`)
+					}
+					r.WriteMapElemValue()
+					if yyn758 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy761 := *x.FptrMapFloat64Float64
+							if false {
+							} else {
+								h.encMapfloat64float64((map[float64]float64)(yy761), e)
+							}
+						}
+					}
 				}
-			}
-			var yyn62 bool
-			if x.FptrUint32 == nil {
-				yyn62 = true
-				goto LABEL62
-			}
-		LABEL62:
-			if yyr2 || yy2arr2 {
-				if yyn62 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.FMapFloat64Bool == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapfloat64bool((map[float64]bool)(x.FMapFloat64Bool), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.FptrUint32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapFloat64Bool\"")
+					} else {
+						r.EncodeSymbol(`FMapFloat64Bool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapFloat64Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy63 := *x.FptrUint32
 						if false {
 						} else {
-							r.EncodeUint(uint64(yy63))
+							h.encMapfloat64bool((map[float64]bool)(x.FMapFloat64Bool), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrUint32`)
+				var yyn766 bool
+				if x.FptrMapFloat64Bool == nil {
+					yyn766 = true
+					goto LABEL766
 				}
-				r.WriteMapElemValue()
-				if yyn62 {
-					r.EncodeNil()
-				} else {
-					if x.FptrUint32 == nil {
+			LABEL766:
+				if yyr2 || yy2arr2 {
+					if yyn766 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy65 := *x.FptrUint32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapFloat64Bool == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeUint(uint64(yy65))
+							yy767 := *x.FptrMapFloat64Bool
+							if false {
+							} else {
+								h.encMapfloat64bool((map[float64]bool)(yy767), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.FUint64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FUint64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FUint64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.FUint64))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapFloat64Bool\"")
+					} else {
+						r.EncodeSymbol(`FptrMapFloat64Bool`)
+					}
+					r.WriteMapElemValue()
+					if yyn766 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapFloat64Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy769 := *x.FptrMapFloat64Bool
+							if false {
+							} else {
+								h.encMapfloat64bool((map[float64]bool)(yy769), e)
+							}
+						}
+					}
 				}
-			}
-			var yyn70 bool
-			if x.FptrUint64 == nil {
-				yyn70 = true
-				goto LABEL70
-			}
-		LABEL70:
-			if yyr2 || yy2arr2 {
-				if yyn70 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrUint64 == nil {
+					if x.FMapUintIntf == nil {
 						r.EncodeNil()
 					} else {
-						yy71 := *x.FptrUint64
 						if false {
 						} else {
-							r.EncodeUint(uint64(yy71))
+							h.encMapuintInterface((map[uint]interface{})(x.FMapUintIntf), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrUint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn70 {
-					r.EncodeNil()
 				} else {
-					if x.FptrUint64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintIntf\"")
+					} else {
+						r.EncodeSymbol(`FMapUintIntf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintIntf == nil {
 						r.EncodeNil()
 					} else {
-						yy73 := *x.FptrUint64
 						if false {
 						} else {
-							r.EncodeUint(uint64(yy73))
+							h.encMapuintInterface((map[uint]interface{})(x.FMapUintIntf), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.FUintptr))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FUintptr`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.FUintptr))
+				var yyn774 bool
+				if x.FptrMapUintIntf == nil {
+					yyn774 = true
+					goto LABEL774
 				}
-			}
-			var yyn78 bool
-			if x.FptrUintptr == nil {
-				yyn78 = true
-				goto LABEL78
-			}
-		LABEL78:
-			if yyr2 || yy2arr2 {
-				if yyn78 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrUintptr == nil {
+			LABEL774:
+				if yyr2 || yy2arr2 {
+					if yyn774 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy79 := *x.FptrUintptr
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintIntf == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeUint(uint64(yy79))
+							yy775 := *x.FptrMapUintIntf
+							if false {
+							} else {
+								h.encMapuintInterface((map[uint]interface{})(yy775), e)
+							}
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrUintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn78 {
-					r.EncodeNil()
 				} else {
-					if x.FptrUintptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintIntf\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUintIntf`)
+					}
+					r.WriteMapElemValue()
+					if yyn774 {
 						r.EncodeNil()
 					} else {
-						yy81 := *x.FptrUintptr
-						if false {
+						if x.FptrMapUintIntf == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeUint(uint64(yy81))
+							yy777 := *x.FptrMapUintIntf
+							if false {
+							} else {
+								h.encMapuintInterface((map[uint]interface{})(yy777), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FInt`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt))
-				}
-			}
-			var yyn86 bool
-			if x.FptrInt == nil {
-				yyn86 = true
-				goto LABEL86
-			}
-		LABEL86:
-			if yyr2 || yy2arr2 {
-				if yyn86 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrInt == nil {
+					if x.FMapUintString == nil {
 						r.EncodeNil()
 					} else {
-						yy87 := *x.FptrInt
 						if false {
 						} else {
-							r.EncodeInt(int64(yy87))
+							h.encMapuintstring((map[uint]string)(x.FMapUintString), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrInt`)
-				}
-				r.WriteMapElemValue()
-				if yyn86 {
-					r.EncodeNil()
 				} else {
-					if x.FptrInt == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintString\"")
+					} else {
+						r.EncodeSymbol(`FMapUintString`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintString == nil {
 						r.EncodeNil()
 					} else {
-						yy89 := *x.FptrInt
 						if false {
 						} else {
-							r.EncodeInt(int64(yy89))
+							h.encMapuintstring((map[uint]string)(x.FMapUintString), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt8))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FInt8`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt8))
+				var yyn782 bool
+				if x.FptrMapUintString == nil {
+					yyn782 = true
+					goto LABEL782
 				}
-			}
-			var yyn94 bool
-			if x.FptrInt8 == nil {
-				yyn94 = true
-				goto LABEL94
-			}
-		LABEL94:
-			if yyr2 || yy2arr2 {
-				if yyn94 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrInt8 == nil {
+			LABEL782:
+				if yyr2 || yy2arr2 {
+					if yyn782 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy95 := *x.FptrInt8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintString == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeInt(int64(yy95))
+							yy783 := *x.FptrMapUintString
+							if false {
+							} else {
+								h.encMapuintstring((map[uint]string)(yy783), e)
+							}
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrInt8`)
-				}
-				r.WriteMapElemValue()
-				if yyn94 {
-					r.EncodeNil()
 				} else {
-					if x.FptrInt8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintString\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUintString`)
+					}
+					r.WriteMapElemValue()
+					if yyn782 {
 						r.EncodeNil()
 					} else {
-						yy97 := *x.FptrInt8
-						if false {
+						if x.FptrMapUintString == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeInt(int64(yy97))
+							yy785 := *x.FptrMapUintString
+							if false {
+							} else {
+								h.encMapuintstring((map[uint]string)(yy785), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt16))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FInt16`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt16))
-				}
-			}
-			var yyn102 bool
-			if x.FptrInt16 == nil {
-				yyn102 = true
-				goto LABEL102
-			}
-		LABEL102:
-			if yyr2 || yy2arr2 {
-				if yyn102 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrInt16 == nil {
+					if x.FMapUintUint == nil {
 						r.EncodeNil()
 					} else {
-						yy103 := *x.FptrInt16
 						if false {
 						} else {
-							r.EncodeInt(int64(yy103))
+							h.encMapuintuint((map[uint]uint)(x.FMapUintUint), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrInt16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrInt16`)
-				}
-				r.WriteMapElemValue()
-				if yyn102 {
-					r.EncodeNil()
-				} else {
-					if x.FptrInt16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintUint\"")
+					} else {
+						r.EncodeSymbol(`FMapUintUint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintUint == nil {
 						r.EncodeNil()
 					} else {
-						yy105 := *x.FptrInt16
 						if false {
 						} else {
-							r.EncodeInt(int64(yy105))
+							h.encMapuintuint((map[uint]uint)(x.FMapUintUint), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt32))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FInt32`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt32))
+				var yyn790 bool
+				if x.FptrMapUintUint == nil {
+					yyn790 = true
+					goto LABEL790
 				}
-			}
-			var yyn110 bool
-			if x.FptrInt32 == nil {
-				yyn110 = true
-				goto LABEL110
-			}
-		LABEL110:
-			if yyr2 || yy2arr2 {
-				if yyn110 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrInt32 == nil {
+			LABEL790:
+				if yyr2 || yy2arr2 {
+					if yyn790 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy111 := *x.FptrInt32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintUint == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeInt(int64(yy111))
+							yy791 := *x.FptrMapUintUint
+							if false {
+							} else {
+								h.encMapuintuint((map[uint]uint)(yy791), e)
+							}
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrInt32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrInt32`)
-				}
-				r.WriteMapElemValue()
-				if yyn110 {
-					r.EncodeNil()
-				} else {
-					if x.FptrInt32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintUint\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUintUint`)
+					}
+					r.WriteMapElemValue()
+					if yyn790 {
 						r.EncodeNil()
 					} else {
-						yy113 := *x.FptrInt32
-						if false {
+						if x.FptrMapUintUint == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeInt(int64(yy113))
+							yy793 := *x.FptrMapUintUint
+							if false {
+							} else {
+								h.encMapuintuint((map[uint]uint)(yy793), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FInt64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.FInt64))
-				}
-			}
-			var yyn118 bool
-			if x.FptrInt64 == nil {
-				yyn118 = true
-				goto LABEL118
-			}
-		LABEL118:
-			if yyr2 || yy2arr2 {
-				if yyn118 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrInt64 == nil {
+					if x.FMapUintUint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy119 := *x.FptrInt64
 						if false {
 						} else {
-							r.EncodeInt(int64(yy119))
+							h.encMapuintuint8((map[uint]uint8)(x.FMapUintUint8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrInt64`)
-				}
-				r.WriteMapElemValue()
-				if yyn118 {
-					r.EncodeNil()
 				} else {
-					if x.FptrInt64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintUint8\"")
+					} else {
+						r.EncodeSymbol(`FMapUintUint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintUint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy121 := *x.FptrInt64
 						if false {
 						} else {
-							r.EncodeInt(int64(yy121))
+							h.encMapuintuint8((map[uint]uint8)(x.FMapUintUint8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.FBool))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FBool`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.FBool))
+				var yyn798 bool
+				if x.FptrMapUintUint8 == nil {
+					yyn798 = true
+					goto LABEL798
 				}
-			}
-			var yyn126 bool
-			if x.FptrBool == nil {
-				yyn126 = true
-				goto LABEL126
-			}
-		LABEL126:
-			if yyr2 || yy2arr2 {
-				if yyn126 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrBool == nil {
+			LABEL798:
+				if yyr2 || yy2arr2 {
+					if yyn798 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy127 := *x.FptrBool
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintUint8 == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeBool(bool(yy127))
+							yy799 := *x.FptrMapUintUint8
+							if false {
+							} else {
+								h.encMapuintuint8((map[uint]uint8)(yy799), e)
+							}
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrBool`)
-				}
-				r.WriteMapElemValue()
-				if yyn126 {
-					r.EncodeNil()
 				} else {
-					if x.FptrBool == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintUint8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUintUint8`)
+					}
+					r.WriteMapElemValue()
+					if yyn798 {
 						r.EncodeNil()
 					} else {
-						yy129 := *x.FptrBool
-						if false {
+						if x.FptrMapUintUint8 == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeBool(bool(yy129))
+							yy801 := *x.FptrMapUintUint8
+							if false {
+							} else {
+								h.encMapuintuint8((map[uint]uint8)(yy801), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceIntf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintUint16 == nil {
+						r.EncodeNil()
 					} else {
-						h.encSliceInterface(([]interface{})(x.FSliceIntf), e)
+						if false {
+						} else {
+							h.encMapuintuint16((map[uint]uint16)(x.FMapUintUint16), e)
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceIntf\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceIntf`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceIntf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintUint16\"")
 					} else {
-						h.encSliceInterface(([]interface{})(x.FSliceIntf), e)
+						r.EncodeSymbol(`FMapUintUint16`)
 					}
-				}
-			}
-			var yyn134 bool
-			if x.FptrSliceIntf == nil {
-				yyn134 = true
-				goto LABEL134
-			}
-		LABEL134:
-			if yyr2 || yy2arr2 {
-				if yyn134 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceIntf == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintUint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy135 := *x.FptrSliceIntf
 						if false {
 						} else {
-							h.encSliceInterface(([]interface{})(yy135), e)
+							h.encMapuintuint16((map[uint]uint16)(x.FMapUintUint16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceIntf`)
+				var yyn806 bool
+				if x.FptrMapUintUint16 == nil {
+					yyn806 = true
+					goto LABEL806
 				}
-				r.WriteMapElemValue()
-				if yyn134 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceIntf == nil {
+			LABEL806:
+				if yyr2 || yy2arr2 {
+					if yyn806 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy137 := *x.FptrSliceIntf
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintUint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSliceInterface(([]interface{})(yy137), e)
+							yy807 := *x.FptrMapUintUint16
+							if false {
+							} else {
+								h.encMapuintuint16((map[uint]uint16)(yy807), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceString == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintUint16\"")
 					} else {
-						h.encSlicestring(([]string)(x.FSliceString), e)
+						r.EncodeSymbol(`FptrMapUintUint16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceString`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceString == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn806 {
+						r.EncodeNil()
 					} else {
-						h.encSlicestring(([]string)(x.FSliceString), e)
+						if x.FptrMapUintUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy809 := *x.FptrMapUintUint16
+							if false {
+							} else {
+								h.encMapuintuint16((map[uint]uint16)(yy809), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn142 bool
-			if x.FptrSliceString == nil {
-				yyn142 = true
-				goto LABEL142
-			}
-		LABEL142:
-			if yyr2 || yy2arr2 {
-				if yyn142 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.FMapUintUint32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapuintuint32((map[uint]uint32)(x.FMapUintUint32), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceString == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintUint32\"")
+					} else {
+						r.EncodeSymbol(`FMapUintUint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintUint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy143 := *x.FptrSliceString
 						if false {
 						} else {
-							h.encSlicestring(([]string)(yy143), e)
+							h.encMapuintuint32((map[uint]uint32)(x.FMapUintUint32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceString`)
+				var yyn814 bool
+				if x.FptrMapUintUint32 == nil {
+					yyn814 = true
+					goto LABEL814
 				}
-				r.WriteMapElemValue()
-				if yyn142 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceString == nil {
+			LABEL814:
+				if yyr2 || yy2arr2 {
+					if yyn814 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy145 := *x.FptrSliceString
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintUint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicestring(([]string)(yy145), e)
+							yy815 := *x.FptrMapUintUint32
+							if false {
+							} else {
+								h.encMapuintuint32((map[uint]uint32)(yy815), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceFloat32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSlicefloat32(([]float32)(x.FSliceFloat32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceFloat32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceFloat32`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceFloat32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintUint32\"")
 					} else {
-						h.encSlicefloat32(([]float32)(x.FSliceFloat32), e)
+						r.EncodeSymbol(`FptrMapUintUint32`)
 					}
-				}
-			}
-			var yyn150 bool
-			if x.FptrSliceFloat32 == nil {
-				yyn150 = true
-				goto LABEL150
-			}
-		LABEL150:
-			if yyr2 || yy2arr2 {
-				if yyn150 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceFloat32 == nil {
+					r.WriteMapElemValue()
+					if yyn814 {
 						r.EncodeNil()
 					} else {
-						yy151 := *x.FptrSliceFloat32
-						if false {
+						if x.FptrMapUintUint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicefloat32(([]float32)(yy151), e)
+							yy817 := *x.FptrMapUintUint32
+							if false {
+							} else {
+								h.encMapuintuint32((map[uint]uint32)(yy817), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceFloat32`)
-				}
-				r.WriteMapElemValue()
-				if yyn150 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceFloat32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintUint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy153 := *x.FptrSliceFloat32
 						if false {
 						} else {
-							h.encSlicefloat32(([]float32)(yy153), e)
+							h.encMapuintuint64((map[uint]uint64)(x.FMapUintUint64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceFloat64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSlicefloat64(([]float64)(x.FSliceFloat64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceFloat64`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceFloat64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintUint64\"")
 					} else {
-						h.encSlicefloat64(([]float64)(x.FSliceFloat64), e)
+						r.EncodeSymbol(`FMapUintUint64`)
 					}
-				}
-			}
-			var yyn158 bool
-			if x.FptrSliceFloat64 == nil {
-				yyn158 = true
-				goto LABEL158
-			}
-		LABEL158:
-			if yyr2 || yy2arr2 {
-				if yyn158 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceFloat64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintUint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy159 := *x.FptrSliceFloat64
 						if false {
 						} else {
-							h.encSlicefloat64(([]float64)(yy159), e)
+							h.encMapuintuint64((map[uint]uint64)(x.FMapUintUint64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceFloat64`)
+				var yyn822 bool
+				if x.FptrMapUintUint64 == nil {
+					yyn822 = true
+					goto LABEL822
 				}
-				r.WriteMapElemValue()
-				if yyn158 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceFloat64 == nil {
+			LABEL822:
+				if yyr2 || yy2arr2 {
+					if yyn822 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy161 := *x.FptrSliceFloat64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintUint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicefloat64(([]float64)(yy161), e)
+							yy823 := *x.FptrMapUintUint64
+							if false {
+							} else {
+								h.encMapuintuint64((map[uint]uint64)(yy823), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceUint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintUint64\"")
 					} else {
-						h.encSliceuint(([]uint)(x.FSliceUint), e)
+						r.EncodeSymbol(`FptrMapUintUint64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceUint`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceUint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn822 {
+						r.EncodeNil()
 					} else {
-						h.encSliceuint(([]uint)(x.FSliceUint), e)
+						if x.FptrMapUintUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy825 := *x.FptrMapUintUint64
+							if false {
+							} else {
+								h.encMapuintuint64((map[uint]uint64)(yy825), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn166 bool
-			if x.FptrSliceUint == nil {
-				yyn166 = true
-				goto LABEL166
-			}
-		LABEL166:
-			if yyr2 || yy2arr2 {
-				if yyn166 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrSliceUint == nil {
+					if x.FMapUintUintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy167 := *x.FptrSliceUint
 						if false {
 						} else {
-							h.encSliceuint(([]uint)(yy167), e)
+							h.encMapuintuintptr((map[uint]uintptr)(x.FMapUintUintptr), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceUint`)
-				}
-				r.WriteMapElemValue()
-				if yyn166 {
-					r.EncodeNil()
 				} else {
-					if x.FptrSliceUint == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintUintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapUintUintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintUintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy169 := *x.FptrSliceUint
 						if false {
 						} else {
-							h.encSliceuint(([]uint)(yy169), e)
+							h.encMapuintuintptr((map[uint]uintptr)(x.FMapUintUintptr), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceUint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn830 bool
+				if x.FptrMapUintUintptr == nil {
+					yyn830 = true
+					goto LABEL830
+				}
+			LABEL830:
+				if yyr2 || yy2arr2 {
+					if yyn830 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.FSliceUint8))
+						r.WriteArrayElem()
+						if x.FptrMapUintUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy831 := *x.FptrMapUintUintptr
+							if false {
+							} else {
+								h.encMapuintuintptr((map[uint]uintptr)(yy831), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceUint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceUint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintUintptr\"")
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.FSliceUint8))
+						r.EncodeSymbol(`FptrMapUintUintptr`)
 					}
-				}
-			}
-			var yyn174 bool
-			if x.FptrSliceUint8 == nil {
-				yyn174 = true
-				goto LABEL174
-			}
-		LABEL174:
-			if yyr2 || yy2arr2 {
-				if yyn174 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceUint8 == nil {
+					r.WriteMapElemValue()
+					if yyn830 {
 						r.EncodeNil()
 					} else {
-						yy175 := *x.FptrSliceUint8
-						if false {
+						if x.FptrMapUintUintptr == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeStringBytesRaw([]byte(yy175))
+							yy833 := *x.FptrMapUintUintptr
+							if false {
+							} else {
+								h.encMapuintuintptr((map[uint]uintptr)(yy833), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceUint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn174 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceUint8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintInt == nil {
 						r.EncodeNil()
 					} else {
-						yy177 := *x.FptrSliceUint8
 						if false {
 						} else {
-							r.EncodeStringBytesRaw([]byte(yy177))
+							h.encMapuintint((map[uint]int)(x.FMapUintInt), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceUint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceuint16(([]uint16)(x.FSliceUint16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceUint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceUint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintInt\"")
 					} else {
-						h.encSliceuint16(([]uint16)(x.FSliceUint16), e)
+						r.EncodeSymbol(`FMapUintInt`)
 					}
-				}
-			}
-			var yyn182 bool
-			if x.FptrSliceUint16 == nil {
-				yyn182 = true
-				goto LABEL182
-			}
-		LABEL182:
-			if yyr2 || yy2arr2 {
-				if yyn182 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceUint16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintInt == nil {
 						r.EncodeNil()
 					} else {
-						yy183 := *x.FptrSliceUint16
 						if false {
 						} else {
-							h.encSliceuint16(([]uint16)(yy183), e)
+							h.encMapuintint((map[uint]int)(x.FMapUintInt), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceUint16`)
+				var yyn838 bool
+				if x.FptrMapUintInt == nil {
+					yyn838 = true
+					goto LABEL838
 				}
-				r.WriteMapElemValue()
-				if yyn182 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceUint16 == nil {
+			LABEL838:
+				if yyr2 || yy2arr2 {
+					if yyn838 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy185 := *x.FptrSliceUint16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintInt == nil {
+							r.EncodeNil()
 						} else {
-							h.encSliceuint16(([]uint16)(yy185), e)
+							yy839 := *x.FptrMapUintInt
+							if false {
+							} else {
+								h.encMapuintint((map[uint]int)(yy839), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceUint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintInt\"")
 					} else {
-						h.encSliceuint32(([]uint32)(x.FSliceUint32), e)
+						r.EncodeSymbol(`FptrMapUintInt`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceUint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceUint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn838 {
+						r.EncodeNil()
 					} else {
-						h.encSliceuint32(([]uint32)(x.FSliceUint32), e)
+						if x.FptrMapUintInt == nil {
+							r.EncodeNil()
+						} else {
+							yy841 := *x.FptrMapUintInt
+							if false {
+							} else {
+								h.encMapuintint((map[uint]int)(yy841), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn190 bool
-			if x.FptrSliceUint32 == nil {
-				yyn190 = true
-				goto LABEL190
-			}
-		LABEL190:
-			if yyr2 || yy2arr2 {
-				if yyn190 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrSliceUint32 == nil {
+					if x.FMapUintInt8 == nil {
 						r.EncodeNil()
 					} else {
-						yy191 := *x.FptrSliceUint32
 						if false {
 						} else {
-							h.encSliceuint32(([]uint32)(yy191), e)
+							h.encMapuintint8((map[uint]int8)(x.FMapUintInt8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceUint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn190 {
-					r.EncodeNil()
 				} else {
-					if x.FptrSliceUint32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintInt8\"")
+					} else {
+						r.EncodeSymbol(`FMapUintInt8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintInt8 == nil {
 						r.EncodeNil()
 					} else {
-						yy193 := *x.FptrSliceUint32
 						if false {
 						} else {
-							h.encSliceuint32(([]uint32)(yy193), e)
+							h.encMapuintint8((map[uint]int8)(x.FMapUintInt8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceUint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn846 bool
+				if x.FptrMapUintInt8 == nil {
+					yyn846 = true
+					goto LABEL846
+				}
+			LABEL846:
+				if yyr2 || yy2arr2 {
+					if yyn846 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encSliceuint64(([]uint64)(x.FSliceUint64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy847 := *x.FptrMapUintInt8
+							if false {
+							} else {
+								h.encMapuintint8((map[uint]int8)(yy847), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceUint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceUint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintInt8\"")
 					} else {
-						h.encSliceuint64(([]uint64)(x.FSliceUint64), e)
+						r.EncodeSymbol(`FptrMapUintInt8`)
 					}
-				}
-			}
-			var yyn198 bool
-			if x.FptrSliceUint64 == nil {
-				yyn198 = true
-				goto LABEL198
-			}
-		LABEL198:
-			if yyr2 || yy2arr2 {
-				if yyn198 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceUint64 == nil {
+					r.WriteMapElemValue()
+					if yyn846 {
 						r.EncodeNil()
 					} else {
-						yy199 := *x.FptrSliceUint64
-						if false {
+						if x.FptrMapUintInt8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSliceuint64(([]uint64)(yy199), e)
+							yy849 := *x.FptrMapUintInt8
+							if false {
+							} else {
+								h.encMapuintint8((map[uint]int8)(yy849), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceUint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn198 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceUint64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintInt16 == nil {
 						r.EncodeNil()
 					} else {
-						yy201 := *x.FptrSliceUint64
 						if false {
 						} else {
-							h.encSliceuint64(([]uint64)(yy201), e)
+							h.encMapuintint16((map[uint]int16)(x.FMapUintInt16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceUintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceuintptr(([]uintptr)(x.FSliceUintptr), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceUintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceUintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintInt16\"")
 					} else {
-						h.encSliceuintptr(([]uintptr)(x.FSliceUintptr), e)
+						r.EncodeSymbol(`FMapUintInt16`)
 					}
-				}
-			}
-			var yyn206 bool
-			if x.FptrSliceUintptr == nil {
-				yyn206 = true
-				goto LABEL206
-			}
-		LABEL206:
-			if yyr2 || yy2arr2 {
-				if yyn206 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceUintptr == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintInt16 == nil {
 						r.EncodeNil()
 					} else {
-						yy207 := *x.FptrSliceUintptr
 						if false {
 						} else {
-							h.encSliceuintptr(([]uintptr)(yy207), e)
+							h.encMapuintint16((map[uint]int16)(x.FMapUintInt16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceUintptr`)
+				var yyn854 bool
+				if x.FptrMapUintInt16 == nil {
+					yyn854 = true
+					goto LABEL854
 				}
-				r.WriteMapElemValue()
-				if yyn206 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceUintptr == nil {
+			LABEL854:
+				if yyr2 || yy2arr2 {
+					if yyn854 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy209 := *x.FptrSliceUintptr
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintInt16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSliceuintptr(([]uintptr)(yy209), e)
+							yy855 := *x.FptrMapUintInt16
+							if false {
+							} else {
+								h.encMapuintint16((map[uint]int16)(yy855), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceInt == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintInt16\"")
 					} else {
-						h.encSliceint(([]int)(x.FSliceInt), e)
+						r.EncodeSymbol(`FptrMapUintInt16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceInt`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn854 {
+						r.EncodeNil()
 					} else {
-						h.encSliceint(([]int)(x.FSliceInt), e)
+						if x.FptrMapUintInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy857 := *x.FptrMapUintInt16
+							if false {
+							} else {
+								h.encMapuintint16((map[uint]int16)(yy857), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn214 bool
-			if x.FptrSliceInt == nil {
-				yyn214 = true
-				goto LABEL214
-			}
-		LABEL214:
-			if yyr2 || yy2arr2 {
-				if yyn214 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrSliceInt == nil {
+					if x.FMapUintInt32 == nil {
 						r.EncodeNil()
 					} else {
-						yy215 := *x.FptrSliceInt
 						if false {
 						} else {
-							h.encSliceint(([]int)(yy215), e)
+							h.encMapuintint32((map[uint]int32)(x.FMapUintInt32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceInt`)
-				}
-				r.WriteMapElemValue()
-				if yyn214 {
-					r.EncodeNil()
 				} else {
-					if x.FptrSliceInt == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintInt32\"")
+					} else {
+						r.EncodeSymbol(`FMapUintInt32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintInt32 == nil {
 						r.EncodeNil()
 					} else {
-						yy217 := *x.FptrSliceInt
 						if false {
 						} else {
-							h.encSliceint(([]int)(yy217), e)
+							h.encMapuintint32((map[uint]int32)(x.FMapUintInt32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceInt8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn862 bool
+				if x.FptrMapUintInt32 == nil {
+					yyn862 = true
+					goto LABEL862
+				}
+			LABEL862:
+				if yyr2 || yy2arr2 {
+					if yyn862 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encSliceint8(([]int8)(x.FSliceInt8), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy863 := *x.FptrMapUintInt32
+							if false {
+							} else {
+								h.encMapuintint32((map[uint]int32)(yy863), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceInt8`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceInt8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintInt32\"")
 					} else {
-						h.encSliceint8(([]int8)(x.FSliceInt8), e)
+						r.EncodeSymbol(`FptrMapUintInt32`)
 					}
-				}
-			}
-			var yyn222 bool
-			if x.FptrSliceInt8 == nil {
-				yyn222 = true
-				goto LABEL222
-			}
-		LABEL222:
-			if yyr2 || yy2arr2 {
-				if yyn222 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceInt8 == nil {
+					r.WriteMapElemValue()
+					if yyn862 {
 						r.EncodeNil()
 					} else {
-						yy223 := *x.FptrSliceInt8
-						if false {
+						if x.FptrMapUintInt32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSliceint8(([]int8)(yy223), e)
+							yy865 := *x.FptrMapUintInt32
+							if false {
+							} else {
+								h.encMapuintint32((map[uint]int32)(yy865), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceInt8`)
-				}
-				r.WriteMapElemValue()
-				if yyn222 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceInt8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintInt64 == nil {
 						r.EncodeNil()
 					} else {
-						yy225 := *x.FptrSliceInt8
 						if false {
 						} else {
-							h.encSliceint8(([]int8)(yy225), e)
+							h.encMapuintint64((map[uint]int64)(x.FMapUintInt64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceint16(([]int16)(x.FSliceInt16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceInt16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceInt16`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintInt64\"")
 					} else {
-						h.encSliceint16(([]int16)(x.FSliceInt16), e)
+						r.EncodeSymbol(`FMapUintInt64`)
 					}
-				}
-			}
-			var yyn230 bool
-			if x.FptrSliceInt16 == nil {
-				yyn230 = true
-				goto LABEL230
-			}
-		LABEL230:
-			if yyr2 || yy2arr2 {
-				if yyn230 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceInt16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintInt64 == nil {
 						r.EncodeNil()
 					} else {
-						yy231 := *x.FptrSliceInt16
 						if false {
 						} else {
-							h.encSliceint16(([]int16)(yy231), e)
+							h.encMapuintint64((map[uint]int64)(x.FMapUintInt64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceInt16`)
+				var yyn870 bool
+				if x.FptrMapUintInt64 == nil {
+					yyn870 = true
+					goto LABEL870
 				}
-				r.WriteMapElemValue()
-				if yyn230 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceInt16 == nil {
+			LABEL870:
+				if yyr2 || yy2arr2 {
+					if yyn870 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy233 := *x.FptrSliceInt16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintInt64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSliceint16(([]int16)(yy233), e)
+							yy871 := *x.FptrMapUintInt64
+							if false {
+							} else {
+								h.encMapuintint64((map[uint]int64)(yy871), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceInt32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintInt64\"")
 					} else {
-						h.encSliceint32(([]int32)(x.FSliceInt32), e)
+						r.EncodeSymbol(`FptrMapUintInt64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceInt32`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceInt32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn870 {
+						r.EncodeNil()
 					} else {
-						h.encSliceint32(([]int32)(x.FSliceInt32), e)
+						if x.FptrMapUintInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy873 := *x.FptrMapUintInt64
+							if false {
+							} else {
+								h.encMapuintint64((map[uint]int64)(yy873), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn238 bool
-			if x.FptrSliceInt32 == nil {
-				yyn238 = true
-				goto LABEL238
-			}
-		LABEL238:
-			if yyr2 || yy2arr2 {
-				if yyn238 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceInt32 == nil {
+					if x.FMapUintFloat32 == nil {
 						r.EncodeNil()
 					} else {
-						yy239 := *x.FptrSliceInt32
 						if false {
 						} else {
-							h.encSliceint32(([]int32)(yy239), e)
+							h.encMapuintfloat32((map[uint]float32)(x.FMapUintFloat32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceInt32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceInt32`)
-				}
-				r.WriteMapElemValue()
-				if yyn238 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceInt32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintFloat32\"")
+					} else {
+						r.EncodeSymbol(`FMapUintFloat32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintFloat32 == nil {
 						r.EncodeNil()
 					} else {
-						yy241 := *x.FptrSliceInt32
 						if false {
 						} else {
-							h.encSliceint32(([]int32)(yy241), e)
+							h.encMapuintfloat32((map[uint]float32)(x.FMapUintFloat32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceInt64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn878 bool
+				if x.FptrMapUintFloat32 == nil {
+					yyn878 = true
+					goto LABEL878
+				}
+			LABEL878:
+				if yyr2 || yy2arr2 {
+					if yyn878 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encSliceint64(([]int64)(x.FSliceInt64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy879 := *x.FptrMapUintFloat32
+							if false {
+							} else {
+								h.encMapuintfloat32((map[uint]float32)(yy879), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceInt64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceInt64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintFloat32\"")
 					} else {
-						h.encSliceint64(([]int64)(x.FSliceInt64), e)
+						r.EncodeSymbol(`FptrMapUintFloat32`)
 					}
-				}
-			}
-			var yyn246 bool
-			if x.FptrSliceInt64 == nil {
-				yyn246 = true
-				goto LABEL246
-			}
-		LABEL246:
-			if yyr2 || yy2arr2 {
-				if yyn246 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceInt64 == nil {
+					r.WriteMapElemValue()
+					if yyn878 {
 						r.EncodeNil()
 					} else {
-						yy247 := *x.FptrSliceInt64
-						if false {
+						if x.FptrMapUintFloat32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSliceint64(([]int64)(yy247), e)
+							yy881 := *x.FptrMapUintFloat32
+							if false {
+							} else {
+								h.encMapuintfloat32((map[uint]float32)(yy881), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceInt64`)
-				}
-				r.WriteMapElemValue()
-				if yyn246 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceInt64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintFloat64 == nil {
 						r.EncodeNil()
 					} else {
-						yy249 := *x.FptrSliceInt64
 						if false {
 						} else {
-							h.encSliceint64(([]int64)(yy249), e)
+							h.encMapuintfloat64((map[uint]float64)(x.FMapUintFloat64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FSliceBool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSlicebool(([]bool)(x.FSliceBool), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FSliceBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FSliceBool`)
-				}
-				r.WriteMapElemValue()
-				if x.FSliceBool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintFloat64\"")
 					} else {
-						h.encSlicebool(([]bool)(x.FSliceBool), e)
+						r.EncodeSymbol(`FMapUintFloat64`)
 					}
-				}
-			}
-			var yyn254 bool
-			if x.FptrSliceBool == nil {
-				yyn254 = true
-				goto LABEL254
-			}
-		LABEL254:
-			if yyr2 || yy2arr2 {
-				if yyn254 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrSliceBool == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintFloat64 == nil {
 						r.EncodeNil()
 					} else {
-						yy255 := *x.FptrSliceBool
 						if false {
 						} else {
-							h.encSlicebool(([]bool)(yy255), e)
+							h.encMapuintfloat64((map[uint]float64)(x.FMapUintFloat64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrSliceBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrSliceBool`)
+				var yyn886 bool
+				if x.FptrMapUintFloat64 == nil {
+					yyn886 = true
+					goto LABEL886
 				}
-				r.WriteMapElemValue()
-				if yyn254 {
-					r.EncodeNil()
-				} else {
-					if x.FptrSliceBool == nil {
+			LABEL886:
+				if yyr2 || yy2arr2 {
+					if yyn886 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy257 := *x.FptrSliceBool
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintFloat64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicebool(([]bool)(yy257), e)
+							yy887 := *x.FptrMapUintFloat64
+							if false {
+							} else {
+								h.encMapuintfloat64((map[uint]float64)(yy887), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfIntf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintFloat64\"")
 					} else {
-						h.encMapInterfaceInterface((map[interface{}]interface{})(x.FMapIntfIntf), e)
+						r.EncodeSymbol(`FptrMapUintFloat64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfIntf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfIntf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn886 {
+						r.EncodeNil()
 					} else {
-						h.encMapInterfaceInterface((map[interface{}]interface{})(x.FMapIntfIntf), e)
+						if x.FptrMapUintFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy889 := *x.FptrMapUintFloat64
+							if false {
+							} else {
+								h.encMapuintfloat64((map[uint]float64)(yy889), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn262 bool
-			if x.FptrMapIntfIntf == nil {
-				yyn262 = true
-				goto LABEL262
-			}
-		LABEL262:
-			if yyr2 || yy2arr2 {
-				if yyn262 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntfIntf == nil {
+					if x.FMapUintBool == nil {
 						r.EncodeNil()
 					} else {
-						yy263 := *x.FptrMapIntfIntf
 						if false {
 						} else {
-							h.encMapInterfaceInterface((map[interface{}]interface{})(yy263), e)
+							h.encMapuintbool((map[uint]bool)(x.FMapUintBool), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfIntf`)
-				}
-				r.WriteMapElemValue()
-				if yyn262 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntfIntf == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintBool\"")
+					} else {
+						r.EncodeSymbol(`FMapUintBool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintBool == nil {
 						r.EncodeNil()
 					} else {
-						yy265 := *x.FptrMapIntfIntf
 						if false {
 						} else {
-							h.encMapInterfaceInterface((map[interface{}]interface{})(yy265), e)
+							h.encMapuintbool((map[uint]bool)(x.FMapUintBool), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfString == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn894 bool
+				if x.FptrMapUintBool == nil {
+					yyn894 = true
+					goto LABEL894
+				}
+			LABEL894:
+				if yyr2 || yy2arr2 {
+					if yyn894 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapInterfacestring((map[interface{}]string)(x.FMapIntfString), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintBool == nil {
+							r.EncodeNil()
+						} else {
+							yy895 := *x.FptrMapUintBool
+							if false {
+							} else {
+								h.encMapuintbool((map[uint]bool)(yy895), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfString`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfString == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintBool\"")
 					} else {
-						h.encMapInterfacestring((map[interface{}]string)(x.FMapIntfString), e)
+						r.EncodeSymbol(`FptrMapUintBool`)
 					}
-				}
-			}
-			var yyn270 bool
-			if x.FptrMapIntfString == nil {
-				yyn270 = true
-				goto LABEL270
-			}
-		LABEL270:
-			if yyr2 || yy2arr2 {
-				if yyn270 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfString == nil {
+					r.WriteMapElemValue()
+					if yyn894 {
 						r.EncodeNil()
 					} else {
-						yy271 := *x.FptrMapIntfString
-						if false {
+						if x.FptrMapUintBool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfacestring((map[interface{}]string)(yy271), e)
+							yy897 := *x.FptrMapUintBool
+							if false {
+							} else {
+								h.encMapuintbool((map[uint]bool)(yy897), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfString`)
-				}
-				r.WriteMapElemValue()
-				if yyn270 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfString == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint8Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy273 := *x.FptrMapIntfString
 						if false {
 						} else {
-							h.encMapInterfacestring((map[interface{}]string)(yy273), e)
+							h.encMapuint8Interface((map[uint8]interface{})(x.FMapUint8Intf), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfUint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapInterfaceuint((map[interface{}]uint)(x.FMapIntfUint), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfUint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfUint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Intf\"")
 					} else {
-						h.encMapInterfaceuint((map[interface{}]uint)(x.FMapIntfUint), e)
+						r.EncodeSymbol(`FMapUint8Intf`)
 					}
-				}
-			}
-			var yyn278 bool
-			if x.FptrMapIntfUint == nil {
-				yyn278 = true
-				goto LABEL278
-			}
-		LABEL278:
-			if yyr2 || yy2arr2 {
-				if yyn278 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfUint == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint8Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy279 := *x.FptrMapIntfUint
 						if false {
 						} else {
-							h.encMapInterfaceuint((map[interface{}]uint)(yy279), e)
+							h.encMapuint8Interface((map[uint8]interface{})(x.FMapUint8Intf), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfUint`)
+				var yyn902 bool
+				if x.FptrMapUint8Intf == nil {
+					yyn902 = true
+					goto LABEL902
 				}
-				r.WriteMapElemValue()
-				if yyn278 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfUint == nil {
+			LABEL902:
+				if yyr2 || yy2arr2 {
+					if yyn902 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy281 := *x.FptrMapIntfUint
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint8Intf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfaceuint((map[interface{}]uint)(yy281), e)
+							yy903 := *x.FptrMapUint8Intf
+							if false {
+							} else {
+								h.encMapuint8Interface((map[uint8]interface{})(yy903), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfUint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Intf\"")
 					} else {
-						h.encMapInterfaceuint8((map[interface{}]uint8)(x.FMapIntfUint8), e)
+						r.EncodeSymbol(`FptrMapUint8Intf`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfUint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfUint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn902 {
+						r.EncodeNil()
 					} else {
-						h.encMapInterfaceuint8((map[interface{}]uint8)(x.FMapIntfUint8), e)
+						if x.FptrMapUint8Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy905 := *x.FptrMapUint8Intf
+							if false {
+							} else {
+								h.encMapuint8Interface((map[uint8]interface{})(yy905), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn286 bool
-			if x.FptrMapIntfUint8 == nil {
-				yyn286 = true
-				goto LABEL286
-			}
-		LABEL286:
-			if yyr2 || yy2arr2 {
-				if yyn286 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntfUint8 == nil {
+					if x.FMapUint8String == nil {
 						r.EncodeNil()
 					} else {
-						yy287 := *x.FptrMapIntfUint8
 						if false {
 						} else {
-							h.encMapInterfaceuint8((map[interface{}]uint8)(yy287), e)
+							h.encMapuint8string((map[uint8]string)(x.FMapUint8String), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfUint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn286 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntfUint8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8String\"")
+					} else {
+						r.EncodeSymbol(`FMapUint8String`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint8String == nil {
 						r.EncodeNil()
 					} else {
-						yy289 := *x.FptrMapIntfUint8
 						if false {
 						} else {
-							h.encMapInterfaceuint8((map[interface{}]uint8)(yy289), e)
+							h.encMapuint8string((map[uint8]string)(x.FMapUint8String), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfUint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn910 bool
+				if x.FptrMapUint8String == nil {
+					yyn910 = true
+					goto LABEL910
+				}
+			LABEL910:
+				if yyr2 || yy2arr2 {
+					if yyn910 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapInterfaceuint16((map[interface{}]uint16)(x.FMapIntfUint16), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint8String == nil {
+							r.EncodeNil()
+						} else {
+							yy911 := *x.FptrMapUint8String
+							if false {
+							} else {
+								h.encMapuint8string((map[uint8]string)(yy911), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfUint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfUint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8String\"")
 					} else {
-						h.encMapInterfaceuint16((map[interface{}]uint16)(x.FMapIntfUint16), e)
+						r.EncodeSymbol(`FptrMapUint8String`)
 					}
-				}
-			}
-			var yyn294 bool
-			if x.FptrMapIntfUint16 == nil {
-				yyn294 = true
-				goto LABEL294
-			}
-		LABEL294:
-			if yyr2 || yy2arr2 {
-				if yyn294 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfUint16 == nil {
+					r.WriteMapElemValue()
+					if yyn910 {
 						r.EncodeNil()
 					} else {
-						yy295 := *x.FptrMapIntfUint16
-						if false {
+						if x.FptrMapUint8String == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfaceuint16((map[interface{}]uint16)(yy295), e)
+							yy913 := *x.FptrMapUint8String
+							if false {
+							} else {
+								h.encMapuint8string((map[uint8]string)(yy913), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfUint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn294 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfUint16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint8Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy297 := *x.FptrMapIntfUint16
 						if false {
 						} else {
-							h.encMapInterfaceuint16((map[interface{}]uint16)(yy297), e)
+							h.encMapuint8uint((map[uint8]uint)(x.FMapUint8Uint), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfUint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapInterfaceuint32((map[interface{}]uint32)(x.FMapIntfUint32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfUint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfUint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Uint\"")
 					} else {
-						h.encMapInterfaceuint32((map[interface{}]uint32)(x.FMapIntfUint32), e)
+						r.EncodeSymbol(`FMapUint8Uint`)
 					}
-				}
-			}
-			var yyn302 bool
-			if x.FptrMapIntfUint32 == nil {
-				yyn302 = true
-				goto LABEL302
-			}
-		LABEL302:
-			if yyr2 || yy2arr2 {
-				if yyn302 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfUint32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint8Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy303 := *x.FptrMapIntfUint32
 						if false {
 						} else {
-							h.encMapInterfaceuint32((map[interface{}]uint32)(yy303), e)
+							h.encMapuint8uint((map[uint8]uint)(x.FMapUint8Uint), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfUint32`)
+				var yyn918 bool
+				if x.FptrMapUint8Uint == nil {
+					yyn918 = true
+					goto LABEL918
 				}
-				r.WriteMapElemValue()
-				if yyn302 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfUint32 == nil {
+			LABEL918:
+				if yyr2 || yy2arr2 {
+					if yyn918 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy305 := *x.FptrMapIntfUint32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint8Uint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfaceuint32((map[interface{}]uint32)(yy305), e)
+							yy919 := *x.FptrMapUint8Uint
+							if false {
+							} else {
+								h.encMapuint8uint((map[uint8]uint)(yy919), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfUint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Uint\"")
 					} else {
-						h.encMapInterfaceuint64((map[interface{}]uint64)(x.FMapIntfUint64), e)
+						r.EncodeSymbol(`FptrMapUint8Uint`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfUint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfUint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn918 {
+						r.EncodeNil()
 					} else {
-						h.encMapInterfaceuint64((map[interface{}]uint64)(x.FMapIntfUint64), e)
+						if x.FptrMapUint8Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy921 := *x.FptrMapUint8Uint
+							if false {
+							} else {
+								h.encMapuint8uint((map[uint8]uint)(yy921), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn310 bool
-			if x.FptrMapIntfUint64 == nil {
-				yyn310 = true
-				goto LABEL310
-			}
-		LABEL310:
-			if yyr2 || yy2arr2 {
-				if yyn310 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntfUint64 == nil {
+					if x.FMapUint8Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy311 := *x.FptrMapIntfUint64
 						if false {
 						} else {
-							h.encMapInterfaceuint64((map[interface{}]uint64)(yy311), e)
+							h.encMapuint8uint8((map[uint8]uint8)(x.FMapUint8Uint8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfUint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn310 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntfUint64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Uint8\"")
+					} else {
+						r.EncodeSymbol(`FMapUint8Uint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint8Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy313 := *x.FptrMapIntfUint64
 						if false {
 						} else {
-							h.encMapInterfaceuint64((map[interface{}]uint64)(yy313), e)
+							h.encMapuint8uint8((map[uint8]uint8)(x.FMapUint8Uint8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfUintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn926 bool
+				if x.FptrMapUint8Uint8 == nil {
+					yyn926 = true
+					goto LABEL926
+				}
+			LABEL926:
+				if yyr2 || yy2arr2 {
+					if yyn926 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapInterfaceuintptr((map[interface{}]uintptr)(x.FMapIntfUintptr), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint8Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy927 := *x.FptrMapUint8Uint8
+							if false {
+							} else {
+								h.encMapuint8uint8((map[uint8]uint8)(yy927), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfUintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfUintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Uint8\"")
 					} else {
-						h.encMapInterfaceuintptr((map[interface{}]uintptr)(x.FMapIntfUintptr), e)
+						r.EncodeSymbol(`FptrMapUint8Uint8`)
 					}
-				}
-			}
-			var yyn318 bool
-			if x.FptrMapIntfUintptr == nil {
-				yyn318 = true
-				goto LABEL318
-			}
-		LABEL318:
-			if yyr2 || yy2arr2 {
-				if yyn318 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfUintptr == nil {
+					r.WriteMapElemValue()
+					if yyn926 {
 						r.EncodeNil()
 					} else {
-						yy319 := *x.FptrMapIntfUintptr
-						if false {
+						if x.FptrMapUint8Uint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfaceuintptr((map[interface{}]uintptr)(yy319), e)
+							yy929 := *x.FptrMapUint8Uint8
+							if false {
+							} else {
+								h.encMapuint8uint8((map[uint8]uint8)(yy929), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfUintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn318 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfUintptr == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint8Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy321 := *x.FptrMapIntfUintptr
 						if false {
 						} else {
-							h.encMapInterfaceuintptr((map[interface{}]uintptr)(yy321), e)
+							h.encMapuint8uint16((map[uint8]uint16)(x.FMapUint8Uint16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapInterfaceint((map[interface{}]int)(x.FMapIntfInt), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfInt\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfInt`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Uint16\"")
 					} else {
-						h.encMapInterfaceint((map[interface{}]int)(x.FMapIntfInt), e)
+						r.EncodeSymbol(`FMapUint8Uint16`)
 					}
-				}
-			}
-			var yyn326 bool
-			if x.FptrMapIntfInt == nil {
-				yyn326 = true
-				goto LABEL326
-			}
-		LABEL326:
-			if yyr2 || yy2arr2 {
-				if yyn326 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfInt == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint8Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy327 := *x.FptrMapIntfInt
 						if false {
 						} else {
-							h.encMapInterfaceint((map[interface{}]int)(yy327), e)
+							h.encMapuint8uint16((map[uint8]uint16)(x.FMapUint8Uint16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfInt`)
+				var yyn934 bool
+				if x.FptrMapUint8Uint16 == nil {
+					yyn934 = true
+					goto LABEL934
 				}
-				r.WriteMapElemValue()
-				if yyn326 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfInt == nil {
+			LABEL934:
+				if yyr2 || yy2arr2 {
+					if yyn934 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy329 := *x.FptrMapIntfInt
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint8Uint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfaceint((map[interface{}]int)(yy329), e)
+							yy935 := *x.FptrMapUint8Uint16
+							if false {
+							} else {
+								h.encMapuint8uint16((map[uint8]uint16)(yy935), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfInt8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Uint16\"")
 					} else {
-						h.encMapInterfaceint8((map[interface{}]int8)(x.FMapIntfInt8), e)
+						r.EncodeSymbol(`FptrMapUint8Uint16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfInt8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfInt8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn934 {
+						r.EncodeNil()
 					} else {
-						h.encMapInterfaceint8((map[interface{}]int8)(x.FMapIntfInt8), e)
+						if x.FptrMapUint8Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy937 := *x.FptrMapUint8Uint16
+							if false {
+							} else {
+								h.encMapuint8uint16((map[uint8]uint16)(yy937), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn334 bool
-			if x.FptrMapIntfInt8 == nil {
-				yyn334 = true
-				goto LABEL334
-			}
-		LABEL334:
-			if yyr2 || yy2arr2 {
-				if yyn334 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfInt8 == nil {
+					if x.FMapUint8Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy335 := *x.FptrMapIntfInt8
 						if false {
 						} else {
-							h.encMapInterfaceint8((map[interface{}]int8)(yy335), e)
+							h.encMapuint8uint32((map[uint8]uint32)(x.FMapUint8Uint32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfInt8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfInt8`)
-				}
-				r.WriteMapElemValue()
-				if yyn334 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfInt8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Uint32\"")
+					} else {
+						r.EncodeSymbol(`FMapUint8Uint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint8Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy337 := *x.FptrMapIntfInt8
 						if false {
 						} else {
-							h.encMapInterfaceint8((map[interface{}]int8)(yy337), e)
+							h.encMapuint8uint32((map[uint8]uint32)(x.FMapUint8Uint32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn942 bool
+				if x.FptrMapUint8Uint32 == nil {
+					yyn942 = true
+					goto LABEL942
+				}
+			LABEL942:
+				if yyr2 || yy2arr2 {
+					if yyn942 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapInterfaceint16((map[interface{}]int16)(x.FMapIntfInt16), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint8Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy943 := *x.FptrMapUint8Uint32
+							if false {
+							} else {
+								h.encMapuint8uint32((map[uint8]uint32)(yy943), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfInt16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfInt16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Uint32\"")
 					} else {
-						h.encMapInterfaceint16((map[interface{}]int16)(x.FMapIntfInt16), e)
+						r.EncodeSymbol(`FptrMapUint8Uint32`)
 					}
-				}
-			}
-			var yyn342 bool
-			if x.FptrMapIntfInt16 == nil {
-				yyn342 = true
-				goto LABEL342
-			}
-		LABEL342:
-			if yyr2 || yy2arr2 {
-				if yyn342 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfInt16 == nil {
+					r.WriteMapElemValue()
+					if yyn942 {
 						r.EncodeNil()
 					} else {
-						yy343 := *x.FptrMapIntfInt16
-						if false {
+						if x.FptrMapUint8Uint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfaceint16((map[interface{}]int16)(yy343), e)
+							yy945 := *x.FptrMapUint8Uint32
+							if false {
+							} else {
+								h.encMapuint8uint32((map[uint8]uint32)(yy945), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfInt16`)
-				}
-				r.WriteMapElemValue()
-				if yyn342 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfInt16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint8Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy345 := *x.FptrMapIntfInt16
 						if false {
 						} else {
-							h.encMapInterfaceint16((map[interface{}]int16)(yy345), e)
+							h.encMapuint8uint64((map[uint8]uint64)(x.FMapUint8Uint64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfInt32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapInterfaceint32((map[interface{}]int32)(x.FMapIntfInt32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfInt32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfInt32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Uint64\"")
 					} else {
-						h.encMapInterfaceint32((map[interface{}]int32)(x.FMapIntfInt32), e)
+						r.EncodeSymbol(`FMapUint8Uint64`)
 					}
-				}
-			}
-			var yyn350 bool
-			if x.FptrMapIntfInt32 == nil {
-				yyn350 = true
-				goto LABEL350
-			}
-		LABEL350:
-			if yyr2 || yy2arr2 {
-				if yyn350 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfInt32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint8Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy351 := *x.FptrMapIntfInt32
 						if false {
 						} else {
-							h.encMapInterfaceint32((map[interface{}]int32)(yy351), e)
+							h.encMapuint8uint64((map[uint8]uint64)(x.FMapUint8Uint64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfInt32`)
+				var yyn950 bool
+				if x.FptrMapUint8Uint64 == nil {
+					yyn950 = true
+					goto LABEL950
 				}
-				r.WriteMapElemValue()
-				if yyn350 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfInt32 == nil {
+			LABEL950:
+				if yyr2 || yy2arr2 {
+					if yyn950 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy353 := *x.FptrMapIntfInt32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint8Uint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfaceint32((map[interface{}]int32)(yy353), e)
+							yy951 := *x.FptrMapUint8Uint64
+							if false {
+							} else {
+								h.encMapuint8uint64((map[uint8]uint64)(yy951), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfInt64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Uint64\"")
 					} else {
-						h.encMapInterfaceint64((map[interface{}]int64)(x.FMapIntfInt64), e)
+						r.EncodeSymbol(`FptrMapUint8Uint64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfInt64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn950 {
+						r.EncodeNil()
 					} else {
-						h.encMapInterfaceint64((map[interface{}]int64)(x.FMapIntfInt64), e)
+						if x.FptrMapUint8Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy953 := *x.FptrMapUint8Uint64
+							if false {
+							} else {
+								h.encMapuint8uint64((map[uint8]uint64)(yy953), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn358 bool
-			if x.FptrMapIntfInt64 == nil {
-				yyn358 = true
-				goto LABEL358
-			}
-		LABEL358:
-			if yyr2 || yy2arr2 {
-				if yyn358 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntfInt64 == nil {
+					if x.FMapUint8Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy359 := *x.FptrMapIntfInt64
 						if false {
 						} else {
-							h.encMapInterfaceint64((map[interface{}]int64)(yy359), e)
+							h.encMapuint8uintptr((map[uint8]uintptr)(x.FMapUint8Uintptr), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfInt64`)
-				}
-				r.WriteMapElemValue()
-				if yyn358 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntfInt64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Uintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapUint8Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint8Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy361 := *x.FptrMapIntfInt64
 						if false {
 						} else {
-							h.encMapInterfaceint64((map[interface{}]int64)(yy361), e)
+							h.encMapuint8uintptr((map[uint8]uintptr)(x.FMapUint8Uintptr), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfFloat32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn958 bool
+				if x.FptrMapUint8Uintptr == nil {
+					yyn958 = true
+					goto LABEL958
+				}
+			LABEL958:
+				if yyr2 || yy2arr2 {
+					if yyn958 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapInterfacefloat32((map[interface{}]float32)(x.FMapIntfFloat32), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint8Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy959 := *x.FptrMapUint8Uintptr
+							if false {
+							} else {
+								h.encMapuint8uintptr((map[uint8]uintptr)(yy959), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfFloat32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfFloat32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Uintptr\"")
 					} else {
-						h.encMapInterfacefloat32((map[interface{}]float32)(x.FMapIntfFloat32), e)
+						r.EncodeSymbol(`FptrMapUint8Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if yyn958 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapUint8Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy961 := *x.FptrMapUint8Uintptr
+							if false {
+							} else {
+								h.encMapuint8uintptr((map[uint8]uintptr)(yy961), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn366 bool
-			if x.FptrMapIntfFloat32 == nil {
-				yyn366 = true
-				goto LABEL366
-			}
-		LABEL366:
-			if yyr2 || yy2arr2 {
-				if yyn366 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntfFloat32 == nil {
+					if x.FMapUint8Int == nil {
 						r.EncodeNil()
 					} else {
-						yy367 := *x.FptrMapIntfFloat32
 						if false {
 						} else {
-							h.encMapInterfacefloat32((map[interface{}]float32)(yy367), e)
+							h.encMapuint8int((map[uint8]int)(x.FMapUint8Int), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfFloat32`)
-				}
-				r.WriteMapElemValue()
-				if yyn366 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntfFloat32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Int\"")
+					} else {
+						r.EncodeSymbol(`FMapUint8Int`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint8Int == nil {
 						r.EncodeNil()
 					} else {
-						yy369 := *x.FptrMapIntfFloat32
 						if false {
 						} else {
-							h.encMapInterfacefloat32((map[interface{}]float32)(yy369), e)
+							h.encMapuint8int((map[uint8]int)(x.FMapUint8Int), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfFloat64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn966 bool
+				if x.FptrMapUint8Int == nil {
+					yyn966 = true
+					goto LABEL966
+				}
+			LABEL966:
+				if yyr2 || yy2arr2 {
+					if yyn966 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapInterfacefloat64((map[interface{}]float64)(x.FMapIntfFloat64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint8Int == nil {
+							r.EncodeNil()
+						} else {
+							yy967 := *x.FptrMapUint8Int
+							if false {
+							} else {
+								h.encMapuint8int((map[uint8]int)(yy967), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfFloat64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfFloat64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Int\"")
 					} else {
-						h.encMapInterfacefloat64((map[interface{}]float64)(x.FMapIntfFloat64), e)
+						r.EncodeSymbol(`FptrMapUint8Int`)
 					}
-				}
-			}
-			var yyn374 bool
-			if x.FptrMapIntfFloat64 == nil {
-				yyn374 = true
-				goto LABEL374
-			}
-		LABEL374:
-			if yyr2 || yy2arr2 {
-				if yyn374 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfFloat64 == nil {
+					r.WriteMapElemValue()
+					if yyn966 {
 						r.EncodeNil()
 					} else {
-						yy375 := *x.FptrMapIntfFloat64
-						if false {
+						if x.FptrMapUint8Int == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfacefloat64((map[interface{}]float64)(yy375), e)
+							yy969 := *x.FptrMapUint8Int
+							if false {
+							} else {
+								h.encMapuint8int((map[uint8]int)(yy969), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfFloat64`)
-				}
-				r.WriteMapElemValue()
-				if yyn374 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfFloat64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint8Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy377 := *x.FptrMapIntfFloat64
 						if false {
 						} else {
-							h.encMapInterfacefloat64((map[interface{}]float64)(yy377), e)
+							h.encMapuint8int8((map[uint8]int8)(x.FMapUint8Int8), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntfBool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapInterfacebool((map[interface{}]bool)(x.FMapIntfBool), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntfBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntfBool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntfBool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Int8\"")
 					} else {
-						h.encMapInterfacebool((map[interface{}]bool)(x.FMapIntfBool), e)
+						r.EncodeSymbol(`FMapUint8Int8`)
 					}
-				}
-			}
-			var yyn382 bool
-			if x.FptrMapIntfBool == nil {
-				yyn382 = true
-				goto LABEL382
-			}
-		LABEL382:
-			if yyr2 || yy2arr2 {
-				if yyn382 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntfBool == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint8Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy383 := *x.FptrMapIntfBool
 						if false {
 						} else {
-							h.encMapInterfacebool((map[interface{}]bool)(yy383), e)
+							h.encMapuint8int8((map[uint8]int8)(x.FMapUint8Int8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntfBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntfBool`)
+				var yyn974 bool
+				if x.FptrMapUint8Int8 == nil {
+					yyn974 = true
+					goto LABEL974
 				}
-				r.WriteMapElemValue()
-				if yyn382 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntfBool == nil {
+			LABEL974:
+				if yyr2 || yy2arr2 {
+					if yyn974 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy385 := *x.FptrMapIntfBool
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint8Int8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapInterfacebool((map[interface{}]bool)(yy385), e)
+							yy975 := *x.FptrMapUint8Int8
+							if false {
+							} else {
+								h.encMapuint8int8((map[uint8]int8)(yy975), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringIntf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Int8\"")
 					} else {
-						h.encMapstringInterface((map[string]interface{})(x.FMapStringIntf), e)
+						r.EncodeSymbol(`FptrMapUint8Int8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringIntf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringIntf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn974 {
+						r.EncodeNil()
 					} else {
-						h.encMapstringInterface((map[string]interface{})(x.FMapStringIntf), e)
+						if x.FptrMapUint8Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy977 := *x.FptrMapUint8Int8
+							if false {
+							} else {
+								h.encMapuint8int8((map[uint8]int8)(yy977), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn390 bool
-			if x.FptrMapStringIntf == nil {
-				yyn390 = true
-				goto LABEL390
-			}
-		LABEL390:
-			if yyr2 || yy2arr2 {
-				if yyn390 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapStringIntf == nil {
+					if x.FMapUint8Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy391 := *x.FptrMapStringIntf
 						if false {
 						} else {
-							h.encMapstringInterface((map[string]interface{})(yy391), e)
+							h.encMapuint8int16((map[uint8]int16)(x.FMapUint8Int16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringIntf`)
-				}
-				r.WriteMapElemValue()
-				if yyn390 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapStringIntf == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Int16\"")
+					} else {
+						r.EncodeSymbol(`FMapUint8Int16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint8Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy393 := *x.FptrMapStringIntf
 						if false {
 						} else {
-							h.encMapstringInterface((map[string]interface{})(yy393), e)
+							h.encMapuint8int16((map[uint8]int16)(x.FMapUint8Int16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringString == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn982 bool
+				if x.FptrMapUint8Int16 == nil {
+					yyn982 = true
+					goto LABEL982
+				}
+			LABEL982:
+				if yyr2 || yy2arr2 {
+					if yyn982 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapstringstring((map[string]string)(x.FMapStringString), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint8Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy983 := *x.FptrMapUint8Int16
+							if false {
+							} else {
+								h.encMapuint8int16((map[uint8]int16)(yy983), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringString`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringString == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Int16\"")
 					} else {
-						h.encMapstringstring((map[string]string)(x.FMapStringString), e)
+						r.EncodeSymbol(`FptrMapUint8Int16`)
 					}
-				}
-			}
-			var yyn398 bool
-			if x.FptrMapStringString == nil {
-				yyn398 = true
-				goto LABEL398
-			}
-		LABEL398:
-			if yyr2 || yy2arr2 {
-				if yyn398 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringString == nil {
+					r.WriteMapElemValue()
+					if yyn982 {
 						r.EncodeNil()
 					} else {
-						yy399 := *x.FptrMapStringString
-						if false {
+						if x.FptrMapUint8Int16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringstring((map[string]string)(yy399), e)
+							yy985 := *x.FptrMapUint8Int16
+							if false {
+							} else {
+								h.encMapuint8int16((map[uint8]int16)(yy985), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringString`)
-				}
-				r.WriteMapElemValue()
-				if yyn398 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringString == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint8Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy401 := *x.FptrMapStringString
 						if false {
 						} else {
-							h.encMapstringstring((map[string]string)(yy401), e)
+							h.encMapuint8int32((map[uint8]int32)(x.FMapUint8Int32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringUint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringuint((map[string]uint)(x.FMapStringUint), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringUint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringUint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Int32\"")
 					} else {
-						h.encMapstringuint((map[string]uint)(x.FMapStringUint), e)
+						r.EncodeSymbol(`FMapUint8Int32`)
 					}
-				}
-			}
-			var yyn406 bool
-			if x.FptrMapStringUint == nil {
-				yyn406 = true
-				goto LABEL406
-			}
-		LABEL406:
-			if yyr2 || yy2arr2 {
-				if yyn406 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringUint == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint8Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy407 := *x.FptrMapStringUint
 						if false {
 						} else {
-							h.encMapstringuint((map[string]uint)(yy407), e)
+							h.encMapuint8int32((map[uint8]int32)(x.FMapUint8Int32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringUint`)
+				var yyn990 bool
+				if x.FptrMapUint8Int32 == nil {
+					yyn990 = true
+					goto LABEL990
 				}
-				r.WriteMapElemValue()
-				if yyn406 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringUint == nil {
+			LABEL990:
+				if yyr2 || yy2arr2 {
+					if yyn990 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy409 := *x.FptrMapStringUint
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint8Int32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringuint((map[string]uint)(yy409), e)
+							yy991 := *x.FptrMapUint8Int32
+							if false {
+							} else {
+								h.encMapuint8int32((map[uint8]int32)(yy991), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringUint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Int32\"")
 					} else {
-						h.encMapstringuint8((map[string]uint8)(x.FMapStringUint8), e)
+						r.EncodeSymbol(`FptrMapUint8Int32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringUint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringUint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn990 {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint8((map[string]uint8)(x.FMapStringUint8), e)
+						if x.FptrMapUint8Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy993 := *x.FptrMapUint8Int32
+							if false {
+							} else {
+								h.encMapuint8int32((map[uint8]int32)(yy993), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn414 bool
-			if x.FptrMapStringUint8 == nil {
-				yyn414 = true
-				goto LABEL414
-			}
-		LABEL414:
-			if yyr2 || yy2arr2 {
-				if yyn414 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapStringUint8 == nil {
+					if x.FMapUint8Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy415 := *x.FptrMapStringUint8
 						if false {
 						} else {
-							h.encMapstringuint8((map[string]uint8)(yy415), e)
+							h.encMapuint8int64((map[uint8]int64)(x.FMapUint8Int64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringUint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn414 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapStringUint8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Int64\"")
+					} else {
+						r.EncodeSymbol(`FMapUint8Int64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint8Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy417 := *x.FptrMapStringUint8
 						if false {
 						} else {
-							h.encMapstringuint8((map[string]uint8)(yy417), e)
+							h.encMapuint8int64((map[uint8]int64)(x.FMapUint8Int64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringUint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn998 bool
+				if x.FptrMapUint8Int64 == nil {
+					yyn998 = true
+					goto LABEL998
+				}
+			LABEL998:
+				if yyr2 || yy2arr2 {
+					if yyn998 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.FMapStringUint16), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint8Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy999 := *x.FptrMapUint8Int64
+							if false {
+							} else {
+								h.encMapuint8int64((map[uint8]int64)(yy999), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringUint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringUint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Int64\"")
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.FMapStringUint16), e)
+						r.EncodeSymbol(`FptrMapUint8Int64`)
 					}
-				}
-			}
-			var yyn422 bool
-			if x.FptrMapStringUint16 == nil {
-				yyn422 = true
-				goto LABEL422
-			}
-		LABEL422:
-			if yyr2 || yy2arr2 {
-				if yyn422 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringUint16 == nil {
+					r.WriteMapElemValue()
+					if yyn998 {
 						r.EncodeNil()
 					} else {
-						yy423 := *x.FptrMapStringUint16
-						if false {
+						if x.FptrMapUint8Int64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringuint16((map[string]uint16)(yy423), e)
+							yy1001 := *x.FptrMapUint8Int64
+							if false {
+							} else {
+								h.encMapuint8int64((map[uint8]int64)(yy1001), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringUint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn422 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringUint16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint8Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy425 := *x.FptrMapStringUint16
 						if false {
 						} else {
-							h.encMapstringuint16((map[string]uint16)(yy425), e)
+							h.encMapuint8float32((map[uint8]float32)(x.FMapUint8Float32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringUint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringuint32((map[string]uint32)(x.FMapStringUint32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringUint32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringUint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringUint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Float32\"")
 					} else {
-						h.encMapstringuint32((map[string]uint32)(x.FMapStringUint32), e)
+						r.EncodeSymbol(`FMapUint8Float32`)
 					}
-				}
-			}
-			var yyn430 bool
-			if x.FptrMapStringUint32 == nil {
-				yyn430 = true
-				goto LABEL430
-			}
-		LABEL430:
-			if yyr2 || yy2arr2 {
-				if yyn430 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringUint32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint8Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy431 := *x.FptrMapStringUint32
 						if false {
 						} else {
-							h.encMapstringuint32((map[string]uint32)(yy431), e)
+							h.encMapuint8float32((map[uint8]float32)(x.FMapUint8Float32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringUint32`)
+				var yyn1006 bool
+				if x.FptrMapUint8Float32 == nil {
+					yyn1006 = true
+					goto LABEL1006
 				}
-				r.WriteMapElemValue()
-				if yyn430 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringUint32 == nil {
+			LABEL1006:
+				if yyr2 || yy2arr2 {
+					if yyn1006 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy433 := *x.FptrMapStringUint32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint8Float32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringuint32((map[string]uint32)(yy433), e)
+							yy1007 := *x.FptrMapUint8Float32
+							if false {
+							} else {
+								h.encMapuint8float32((map[uint8]float32)(yy1007), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringUint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Float32\"")
 					} else {
-						h.encMapstringuint64((map[string]uint64)(x.FMapStringUint64), e)
+						r.EncodeSymbol(`FptrMapUint8Float32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringUint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringUint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1006 {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint64((map[string]uint64)(x.FMapStringUint64), e)
+						if x.FptrMapUint8Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1009 := *x.FptrMapUint8Float32
+							if false {
+							} else {
+								h.encMapuint8float32((map[uint8]float32)(yy1009), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn438 bool
-			if x.FptrMapStringUint64 == nil {
-				yyn438 = true
-				goto LABEL438
-			}
-		LABEL438:
-			if yyr2 || yy2arr2 {
-				if yyn438 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringUint64 == nil {
+					if x.FMapUint8Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy439 := *x.FptrMapStringUint64
 						if false {
 						} else {
-							h.encMapstringuint64((map[string]uint64)(yy439), e)
+							h.encMapuint8float64((map[uint8]float64)(x.FMapUint8Float64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringUint64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringUint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn438 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringUint64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Float64\"")
+					} else {
+						r.EncodeSymbol(`FMapUint8Float64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint8Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy441 := *x.FptrMapStringUint64
 						if false {
 						} else {
-							h.encMapstringuint64((map[string]uint64)(yy441), e)
+							h.encMapuint8float64((map[uint8]float64)(x.FMapUint8Float64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringUintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1014 bool
+				if x.FptrMapUint8Float64 == nil {
+					yyn1014 = true
+					goto LABEL1014
+				}
+			LABEL1014:
+				if yyr2 || yy2arr2 {
+					if yyn1014 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapstringuintptr((map[string]uintptr)(x.FMapStringUintptr), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint8Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1015 := *x.FptrMapUint8Float64
+							if false {
+							} else {
+								h.encMapuint8float64((map[uint8]float64)(yy1015), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringUintptr\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringUintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringUintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Float64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUint8Float64`)
+					}
+					r.WriteMapElemValue()
+					if yyn1014 {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuintptr((map[string]uintptr)(x.FMapStringUintptr), e)
+						if x.FptrMapUint8Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1017 := *x.FptrMapUint8Float64
+							if false {
+							} else {
+								h.encMapuint8float64((map[uint8]float64)(yy1017), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn446 bool
-			if x.FptrMapStringUintptr == nil {
-				yyn446 = true
-				goto LABEL446
-			}
-		LABEL446:
-			if yyr2 || yy2arr2 {
-				if yyn446 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringUintptr == nil {
+					if x.FMapUint8Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy447 := *x.FptrMapStringUintptr
 						if false {
 						} else {
-							h.encMapstringuintptr((map[string]uintptr)(yy447), e)
+							h.encMapuint8bool((map[uint8]bool)(x.FMapUint8Bool), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringUintptr\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringUintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn446 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringUintptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint8Bool\"")
+					} else {
+						r.EncodeSymbol(`FMapUint8Bool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint8Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy449 := *x.FptrMapStringUintptr
 						if false {
 						} else {
-							h.encMapstringuintptr((map[string]uintptr)(yy449), e)
+							h.encMapuint8bool((map[uint8]bool)(x.FMapUint8Bool), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1022 bool
+				if x.FptrMapUint8Bool == nil {
+					yyn1022 = true
+					goto LABEL1022
+				}
+			LABEL1022:
+				if yyr2 || yy2arr2 {
+					if yyn1022 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapstringint((map[string]int)(x.FMapStringInt), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint8Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy1023 := *x.FptrMapUint8Bool
+							if false {
+							} else {
+								h.encMapuint8bool((map[uint8]bool)(yy1023), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringInt\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringInt`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint8Bool\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUint8Bool`)
+					}
+					r.WriteMapElemValue()
+					if yyn1022 {
+						r.EncodeNil()
 					} else {
-						h.encMapstringint((map[string]int)(x.FMapStringInt), e)
+						if x.FptrMapUint8Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy1025 := *x.FptrMapUint8Bool
+							if false {
+							} else {
+								h.encMapuint8bool((map[uint8]bool)(yy1025), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn454 bool
-			if x.FptrMapStringInt == nil {
-				yyn454 = true
-				goto LABEL454
-			}
-		LABEL454:
-			if yyr2 || yy2arr2 {
-				if yyn454 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.FMapUint16Intf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapuint16Interface((map[uint16]interface{})(x.FMapUint16Intf), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringInt == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Intf\"")
+					} else {
+						r.EncodeSymbol(`FMapUint16Intf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint16Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy455 := *x.FptrMapStringInt
 						if false {
 						} else {
-							h.encMapstringint((map[string]int)(yy455), e)
+							h.encMapuint16Interface((map[uint16]interface{})(x.FMapUint16Intf), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringInt`)
+				var yyn1030 bool
+				if x.FptrMapUint16Intf == nil {
+					yyn1030 = true
+					goto LABEL1030
 				}
-				r.WriteMapElemValue()
-				if yyn454 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringInt == nil {
+			LABEL1030:
+				if yyr2 || yy2arr2 {
+					if yyn1030 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy457 := *x.FptrMapStringInt
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint16Intf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringint((map[string]int)(yy457), e)
+							yy1031 := *x.FptrMapUint16Intf
+							if false {
+							} else {
+								h.encMapuint16Interface((map[uint16]interface{})(yy1031), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringInt8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringint8((map[string]int8)(x.FMapStringInt8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringInt8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringInt8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringInt8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Intf\"")
 					} else {
-						h.encMapstringint8((map[string]int8)(x.FMapStringInt8), e)
+						r.EncodeSymbol(`FptrMapUint16Intf`)
 					}
-				}
-			}
-			var yyn462 bool
-			if x.FptrMapStringInt8 == nil {
-				yyn462 = true
-				goto LABEL462
-			}
-		LABEL462:
-			if yyr2 || yy2arr2 {
-				if yyn462 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringInt8 == nil {
+					r.WriteMapElemValue()
+					if yyn1030 {
 						r.EncodeNil()
 					} else {
-						yy463 := *x.FptrMapStringInt8
-						if false {
+						if x.FptrMapUint16Intf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringint8((map[string]int8)(yy463), e)
+							yy1033 := *x.FptrMapUint16Intf
+							if false {
+							} else {
+								h.encMapuint16Interface((map[uint16]interface{})(yy1033), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringInt8`)
-				}
-				r.WriteMapElemValue()
-				if yyn462 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringInt8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint16String == nil {
 						r.EncodeNil()
 					} else {
-						yy465 := *x.FptrMapStringInt8
 						if false {
 						} else {
-							h.encMapstringint8((map[string]int8)(yy465), e)
+							h.encMapuint16string((map[uint16]string)(x.FMapUint16String), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringint16((map[string]int16)(x.FMapStringInt16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringInt16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringInt16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16String\"")
 					} else {
-						h.encMapstringint16((map[string]int16)(x.FMapStringInt16), e)
+						r.EncodeSymbol(`FMapUint16String`)
 					}
-				}
-			}
-			var yyn470 bool
-			if x.FptrMapStringInt16 == nil {
-				yyn470 = true
-				goto LABEL470
-			}
-		LABEL470:
-			if yyr2 || yy2arr2 {
-				if yyn470 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringInt16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint16String == nil {
 						r.EncodeNil()
 					} else {
-						yy471 := *x.FptrMapStringInt16
 						if false {
 						} else {
-							h.encMapstringint16((map[string]int16)(yy471), e)
+							h.encMapuint16string((map[uint16]string)(x.FMapUint16String), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringInt16`)
+				var yyn1038 bool
+				if x.FptrMapUint16String == nil {
+					yyn1038 = true
+					goto LABEL1038
 				}
-				r.WriteMapElemValue()
-				if yyn470 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringInt16 == nil {
+			LABEL1038:
+				if yyr2 || yy2arr2 {
+					if yyn1038 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy473 := *x.FptrMapStringInt16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint16String == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringint16((map[string]int16)(yy473), e)
+							yy1039 := *x.FptrMapUint16String
+							if false {
+							} else {
+								h.encMapuint16string((map[uint16]string)(yy1039), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringInt32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16String\"")
 					} else {
-						h.encMapstringint32((map[string]int32)(x.FMapStringInt32), e)
+						r.EncodeSymbol(`FptrMapUint16String`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringInt32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringInt32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1038 {
+						r.EncodeNil()
 					} else {
-						h.encMapstringint32((map[string]int32)(x.FMapStringInt32), e)
+						if x.FptrMapUint16String == nil {
+							r.EncodeNil()
+						} else {
+							yy1041 := *x.FptrMapUint16String
+							if false {
+							} else {
+								h.encMapuint16string((map[uint16]string)(yy1041), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn478 bool
-			if x.FptrMapStringInt32 == nil {
-				yyn478 = true
-				goto LABEL478
-			}
-		LABEL478:
-			if yyr2 || yy2arr2 {
-				if yyn478 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapStringInt32 == nil {
+					if x.FMapUint16Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy479 := *x.FptrMapStringInt32
 						if false {
 						} else {
-							h.encMapstringint32((map[string]int32)(yy479), e)
+							h.encMapuint16uint((map[uint16]uint)(x.FMapUint16Uint), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringInt32`)
-				}
-				r.WriteMapElemValue()
-				if yyn478 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapStringInt32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Uint\"")
+					} else {
+						r.EncodeSymbol(`FMapUint16Uint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint16Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy481 := *x.FptrMapStringInt32
 						if false {
 						} else {
-							h.encMapstringint32((map[string]int32)(yy481), e)
+							h.encMapuint16uint((map[uint16]uint)(x.FMapUint16Uint), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringInt64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1046 bool
+				if x.FptrMapUint16Uint == nil {
+					yyn1046 = true
+					goto LABEL1046
+				}
+			LABEL1046:
+				if yyr2 || yy2arr2 {
+					if yyn1046 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapstringint64((map[string]int64)(x.FMapStringInt64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint16Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy1047 := *x.FptrMapUint16Uint
+							if false {
+							} else {
+								h.encMapuint16uint((map[uint16]uint)(yy1047), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringInt64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Uint\"")
 					} else {
-						h.encMapstringint64((map[string]int64)(x.FMapStringInt64), e)
+						r.EncodeSymbol(`FptrMapUint16Uint`)
 					}
-				}
-			}
-			var yyn486 bool
-			if x.FptrMapStringInt64 == nil {
-				yyn486 = true
-				goto LABEL486
-			}
-		LABEL486:
-			if yyr2 || yy2arr2 {
-				if yyn486 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringInt64 == nil {
+					r.WriteMapElemValue()
+					if yyn1046 {
 						r.EncodeNil()
 					} else {
-						yy487 := *x.FptrMapStringInt64
-						if false {
+						if x.FptrMapUint16Uint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringint64((map[string]int64)(yy487), e)
+							yy1049 := *x.FptrMapUint16Uint
+							if false {
+							} else {
+								h.encMapuint16uint((map[uint16]uint)(yy1049), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringInt64`)
-				}
-				r.WriteMapElemValue()
-				if yyn486 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringInt64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint16Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy489 := *x.FptrMapStringInt64
 						if false {
 						} else {
-							h.encMapstringint64((map[string]int64)(yy489), e)
+							h.encMapuint16uint8((map[uint16]uint8)(x.FMapUint16Uint8), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringFloat32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringfloat32((map[string]float32)(x.FMapStringFloat32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringFloat32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringFloat32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Uint8\"")
 					} else {
-						h.encMapstringfloat32((map[string]float32)(x.FMapStringFloat32), e)
+						r.EncodeSymbol(`FMapUint16Uint8`)
 					}
-				}
-			}
-			var yyn494 bool
-			if x.FptrMapStringFloat32 == nil {
-				yyn494 = true
-				goto LABEL494
-			}
-		LABEL494:
-			if yyr2 || yy2arr2 {
-				if yyn494 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringFloat32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint16Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy495 := *x.FptrMapStringFloat32
 						if false {
 						} else {
-							h.encMapstringfloat32((map[string]float32)(yy495), e)
+							h.encMapuint16uint8((map[uint16]uint8)(x.FMapUint16Uint8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringFloat32`)
+				var yyn1054 bool
+				if x.FptrMapUint16Uint8 == nil {
+					yyn1054 = true
+					goto LABEL1054
 				}
-				r.WriteMapElemValue()
-				if yyn494 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringFloat32 == nil {
+			LABEL1054:
+				if yyr2 || yy2arr2 {
+					if yyn1054 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy497 := *x.FptrMapStringFloat32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint16Uint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringfloat32((map[string]float32)(yy497), e)
+							yy1055 := *x.FptrMapUint16Uint8
+							if false {
+							} else {
+								h.encMapuint16uint8((map[uint16]uint8)(yy1055), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringFloat64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Uint8\"")
 					} else {
-						h.encMapstringfloat64((map[string]float64)(x.FMapStringFloat64), e)
+						r.EncodeSymbol(`FptrMapUint16Uint8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringFloat64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringFloat64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1054 {
+						r.EncodeNil()
 					} else {
-						h.encMapstringfloat64((map[string]float64)(x.FMapStringFloat64), e)
+						if x.FptrMapUint16Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1057 := *x.FptrMapUint16Uint8
+							if false {
+							} else {
+								h.encMapuint16uint8((map[uint16]uint8)(yy1057), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn502 bool
-			if x.FptrMapStringFloat64 == nil {
-				yyn502 = true
-				goto LABEL502
-			}
-		LABEL502:
-			if yyr2 || yy2arr2 {
-				if yyn502 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapStringFloat64 == nil {
+					if x.FMapUint16Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy503 := *x.FptrMapStringFloat64
 						if false {
 						} else {
-							h.encMapstringfloat64((map[string]float64)(yy503), e)
+							h.encMapuint16uint16((map[uint16]uint16)(x.FMapUint16Uint16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringFloat64`)
-				}
-				r.WriteMapElemValue()
-				if yyn502 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapStringFloat64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Uint16\"")
+					} else {
+						r.EncodeSymbol(`FMapUint16Uint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint16Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy505 := *x.FptrMapStringFloat64
 						if false {
 						} else {
-							h.encMapstringfloat64((map[string]float64)(yy505), e)
+							h.encMapuint16uint16((map[uint16]uint16)(x.FMapUint16Uint16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapStringBool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1062 bool
+				if x.FptrMapUint16Uint16 == nil {
+					yyn1062 = true
+					goto LABEL1062
+				}
+			LABEL1062:
+				if yyr2 || yy2arr2 {
+					if yyn1062 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapstringbool((map[string]bool)(x.FMapStringBool), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint16Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1063 := *x.FptrMapUint16Uint16
+							if false {
+							} else {
+								h.encMapuint16uint16((map[uint16]uint16)(yy1063), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapStringBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapStringBool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapStringBool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Uint16\"")
 					} else {
-						h.encMapstringbool((map[string]bool)(x.FMapStringBool), e)
+						r.EncodeSymbol(`FptrMapUint16Uint16`)
 					}
-				}
-			}
-			var yyn510 bool
-			if x.FptrMapStringBool == nil {
-				yyn510 = true
-				goto LABEL510
-			}
-		LABEL510:
-			if yyr2 || yy2arr2 {
-				if yyn510 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapStringBool == nil {
+					r.WriteMapElemValue()
+					if yyn1062 {
 						r.EncodeNil()
 					} else {
-						yy511 := *x.FptrMapStringBool
-						if false {
+						if x.FptrMapUint16Uint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringbool((map[string]bool)(yy511), e)
+							yy1065 := *x.FptrMapUint16Uint16
+							if false {
+							} else {
+								h.encMapuint16uint16((map[uint16]uint16)(yy1065), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapStringBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapStringBool`)
-				}
-				r.WriteMapElemValue()
-				if yyn510 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapStringBool == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint16Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy513 := *x.FptrMapStringBool
 						if false {
 						} else {
-							h.encMapstringbool((map[string]bool)(yy513), e)
+							h.encMapuint16uint32((map[uint16]uint32)(x.FMapUint16Uint32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat32Interface((map[float32]interface{})(x.FMapFloat32Intf), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Uint32\"")
 					} else {
-						h.encMapfloat32Interface((map[float32]interface{})(x.FMapFloat32Intf), e)
+						r.EncodeSymbol(`FMapUint16Uint32`)
 					}
-				}
-			}
-			var yyn518 bool
-			if x.FptrMapFloat32Intf == nil {
-				yyn518 = true
-				goto LABEL518
-			}
-		LABEL518:
-			if yyr2 || yy2arr2 {
-				if yyn518 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Intf == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint16Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy519 := *x.FptrMapFloat32Intf
 						if false {
 						} else {
-							h.encMapfloat32Interface((map[float32]interface{})(yy519), e)
+							h.encMapuint16uint32((map[uint16]uint32)(x.FMapUint16Uint32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Intf`)
+				var yyn1070 bool
+				if x.FptrMapUint16Uint32 == nil {
+					yyn1070 = true
+					goto LABEL1070
 				}
-				r.WriteMapElemValue()
-				if yyn518 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Intf == nil {
+			LABEL1070:
+				if yyr2 || yy2arr2 {
+					if yyn1070 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy521 := *x.FptrMapFloat32Intf
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint16Uint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32Interface((map[float32]interface{})(yy521), e)
+							yy1071 := *x.FptrMapUint16Uint32
+							if false {
+							} else {
+								h.encMapuint16uint32((map[uint16]uint32)(yy1071), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32String == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Uint32\"")
 					} else {
-						h.encMapfloat32string((map[float32]string)(x.FMapFloat32String), e)
+						r.EncodeSymbol(`FptrMapUint16Uint32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1070 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32string((map[float32]string)(x.FMapFloat32String), e)
+						if x.FptrMapUint16Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1073 := *x.FptrMapUint16Uint32
+							if false {
+							} else {
+								h.encMapuint16uint32((map[uint16]uint32)(yy1073), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn526 bool
-			if x.FptrMapFloat32String == nil {
-				yyn526 = true
-				goto LABEL526
-			}
-		LABEL526:
-			if yyr2 || yy2arr2 {
-				if yyn526 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapFloat32String == nil {
+					if x.FMapUint16Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy527 := *x.FptrMapFloat32String
 						if false {
 						} else {
-							h.encMapfloat32string((map[float32]string)(yy527), e)
+							h.encMapuint16uint64((map[uint16]uint64)(x.FMapUint16Uint64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32String`)
-				}
-				r.WriteMapElemValue()
-				if yyn526 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapFloat32String == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Uint64\"")
+					} else {
+						r.EncodeSymbol(`FMapUint16Uint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint16Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy529 := *x.FptrMapFloat32String
 						if false {
 						} else {
-							h.encMapfloat32string((map[float32]string)(yy529), e)
+							h.encMapuint16uint64((map[uint16]uint64)(x.FMapUint16Uint64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1078 bool
+				if x.FptrMapUint16Uint64 == nil {
+					yyn1078 = true
+					goto LABEL1078
+				}
+			LABEL1078:
+				if yyr2 || yy2arr2 {
+					if yyn1078 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32uint((map[float32]uint)(x.FMapFloat32Uint), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint16Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1079 := *x.FptrMapUint16Uint64
+							if false {
+							} else {
+								h.encMapuint16uint64((map[uint16]uint64)(yy1079), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Uint64\"")
 					} else {
-						h.encMapfloat32uint((map[float32]uint)(x.FMapFloat32Uint), e)
+						r.EncodeSymbol(`FptrMapUint16Uint64`)
 					}
-				}
-			}
-			var yyn534 bool
-			if x.FptrMapFloat32Uint == nil {
-				yyn534 = true
-				goto LABEL534
-			}
-		LABEL534:
-			if yyr2 || yy2arr2 {
-				if yyn534 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Uint == nil {
+					r.WriteMapElemValue()
+					if yyn1078 {
 						r.EncodeNil()
 					} else {
-						yy535 := *x.FptrMapFloat32Uint
-						if false {
+						if x.FptrMapUint16Uint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32uint((map[float32]uint)(yy535), e)
+							yy1081 := *x.FptrMapUint16Uint64
+							if false {
+							} else {
+								h.encMapuint16uint64((map[uint16]uint64)(yy1081), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Uint`)
-				}
-				r.WriteMapElemValue()
-				if yyn534 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Uint == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint16Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy537 := *x.FptrMapFloat32Uint
 						if false {
 						} else {
-							h.encMapfloat32uint((map[float32]uint)(yy537), e)
+							h.encMapuint16uintptr((map[uint16]uintptr)(x.FMapUint16Uintptr), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat32uint8((map[float32]uint8)(x.FMapFloat32Uint8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Uint8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Uintptr\"")
 					} else {
-						h.encMapfloat32uint8((map[float32]uint8)(x.FMapFloat32Uint8), e)
+						r.EncodeSymbol(`FMapUint16Uintptr`)
 					}
-				}
-			}
-			var yyn542 bool
-			if x.FptrMapFloat32Uint8 == nil {
-				yyn542 = true
-				goto LABEL542
-			}
-		LABEL542:
-			if yyr2 || yy2arr2 {
-				if yyn542 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Uint8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint16Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy543 := *x.FptrMapFloat32Uint8
 						if false {
 						} else {
-							h.encMapfloat32uint8((map[float32]uint8)(yy543), e)
+							h.encMapuint16uintptr((map[uint16]uintptr)(x.FMapUint16Uintptr), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Uint8`)
+				var yyn1086 bool
+				if x.FptrMapUint16Uintptr == nil {
+					yyn1086 = true
+					goto LABEL1086
 				}
-				r.WriteMapElemValue()
-				if yyn542 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Uint8 == nil {
+			LABEL1086:
+				if yyr2 || yy2arr2 {
+					if yyn1086 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy545 := *x.FptrMapFloat32Uint8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint16Uintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32uint8((map[float32]uint8)(yy545), e)
+							yy1087 := *x.FptrMapUint16Uintptr
+							if false {
+							} else {
+								h.encMapuint16uintptr((map[uint16]uintptr)(yy1087), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Uint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Uintptr\"")
 					} else {
-						h.encMapfloat32uint16((map[float32]uint16)(x.FMapFloat32Uint16), e)
+						r.EncodeSymbol(`FptrMapUint16Uintptr`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1086 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32uint16((map[float32]uint16)(x.FMapFloat32Uint16), e)
+						if x.FptrMapUint16Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy1089 := *x.FptrMapUint16Uintptr
+							if false {
+							} else {
+								h.encMapuint16uintptr((map[uint16]uintptr)(yy1089), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn550 bool
-			if x.FptrMapFloat32Uint16 == nil {
-				yyn550 = true
-				goto LABEL550
-			}
-		LABEL550:
-			if yyr2 || yy2arr2 {
-				if yyn550 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Uint16 == nil {
+					if x.FMapUint16Int == nil {
 						r.EncodeNil()
 					} else {
-						yy551 := *x.FptrMapFloat32Uint16
 						if false {
 						} else {
-							h.encMapfloat32uint16((map[float32]uint16)(yy551), e)
+							h.encMapuint16int((map[uint16]int)(x.FMapUint16Int), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Uint16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Uint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn550 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Uint16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Int\"")
+					} else {
+						r.EncodeSymbol(`FMapUint16Int`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint16Int == nil {
 						r.EncodeNil()
 					} else {
-						yy553 := *x.FptrMapFloat32Uint16
 						if false {
 						} else {
-							h.encMapfloat32uint16((map[float32]uint16)(yy553), e)
+							h.encMapuint16int((map[uint16]int)(x.FMapUint16Int), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1094 bool
+				if x.FptrMapUint16Int == nil {
+					yyn1094 = true
+					goto LABEL1094
+				}
+			LABEL1094:
+				if yyr2 || yy2arr2 {
+					if yyn1094 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32uint32((map[float32]uint32)(x.FMapFloat32Uint32), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint16Int == nil {
+							r.EncodeNil()
+						} else {
+							yy1095 := *x.FptrMapUint16Int
+							if false {
+							} else {
+								h.encMapuint16int((map[uint16]int)(yy1095), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Uint32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Int\"")
 					} else {
-						h.encMapfloat32uint32((map[float32]uint32)(x.FMapFloat32Uint32), e)
+						r.EncodeSymbol(`FptrMapUint16Int`)
 					}
-				}
-			}
-			var yyn558 bool
-			if x.FptrMapFloat32Uint32 == nil {
-				yyn558 = true
-				goto LABEL558
-			}
-		LABEL558:
-			if yyr2 || yy2arr2 {
-				if yyn558 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Uint32 == nil {
+					r.WriteMapElemValue()
+					if yyn1094 {
 						r.EncodeNil()
 					} else {
-						yy559 := *x.FptrMapFloat32Uint32
-						if false {
+						if x.FptrMapUint16Int == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32uint32((map[float32]uint32)(yy559), e)
+							yy1097 := *x.FptrMapUint16Int
+							if false {
+							} else {
+								h.encMapuint16int((map[uint16]int)(yy1097), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Uint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn558 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Uint32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint16Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy561 := *x.FptrMapFloat32Uint32
 						if false {
 						} else {
-							h.encMapfloat32uint32((map[float32]uint32)(yy561), e)
+							h.encMapuint16int8((map[uint16]int8)(x.FMapUint16Int8), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat32uint64((map[float32]uint64)(x.FMapFloat32Uint64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Uint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Int8\"")
 					} else {
-						h.encMapfloat32uint64((map[float32]uint64)(x.FMapFloat32Uint64), e)
+						r.EncodeSymbol(`FMapUint16Int8`)
 					}
-				}
-			}
-			var yyn566 bool
-			if x.FptrMapFloat32Uint64 == nil {
-				yyn566 = true
-				goto LABEL566
-			}
-		LABEL566:
-			if yyr2 || yy2arr2 {
-				if yyn566 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Uint64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint16Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy567 := *x.FptrMapFloat32Uint64
 						if false {
 						} else {
-							h.encMapfloat32uint64((map[float32]uint64)(yy567), e)
+							h.encMapuint16int8((map[uint16]int8)(x.FMapUint16Int8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Uint64`)
+				var yyn1102 bool
+				if x.FptrMapUint16Int8 == nil {
+					yyn1102 = true
+					goto LABEL1102
 				}
-				r.WriteMapElemValue()
-				if yyn566 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Uint64 == nil {
+			LABEL1102:
+				if yyr2 || yy2arr2 {
+					if yyn1102 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy569 := *x.FptrMapFloat32Uint64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint16Int8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32uint64((map[float32]uint64)(yy569), e)
+							yy1103 := *x.FptrMapUint16Int8
+							if false {
+							} else {
+								h.encMapuint16int8((map[uint16]int8)(yy1103), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Uintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Int8\"")
 					} else {
-						h.encMapfloat32uintptr((map[float32]uintptr)(x.FMapFloat32Uintptr), e)
+						r.EncodeSymbol(`FptrMapUint16Int8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1102 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32uintptr((map[float32]uintptr)(x.FMapFloat32Uintptr), e)
+						if x.FptrMapUint16Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1105 := *x.FptrMapUint16Int8
+							if false {
+							} else {
+								h.encMapuint16int8((map[uint16]int8)(yy1105), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn574 bool
-			if x.FptrMapFloat32Uintptr == nil {
-				yyn574 = true
-				goto LABEL574
-			}
-		LABEL574:
-			if yyr2 || yy2arr2 {
-				if yyn574 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapFloat32Uintptr == nil {
+					if x.FMapUint16Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy575 := *x.FptrMapFloat32Uintptr
 						if false {
 						} else {
-							h.encMapfloat32uintptr((map[float32]uintptr)(yy575), e)
+							h.encMapuint16int16((map[uint16]int16)(x.FMapUint16Int16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn574 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapFloat32Uintptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Int16\"")
+					} else {
+						r.EncodeSymbol(`FMapUint16Int16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint16Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy577 := *x.FptrMapFloat32Uintptr
 						if false {
 						} else {
-							h.encMapfloat32uintptr((map[float32]uintptr)(yy577), e)
+							h.encMapuint16int16((map[uint16]int16)(x.FMapUint16Int16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1110 bool
+				if x.FptrMapUint16Int16 == nil {
+					yyn1110 = true
+					goto LABEL1110
+				}
+			LABEL1110:
+				if yyr2 || yy2arr2 {
+					if yyn1110 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32int((map[float32]int)(x.FMapFloat32Int), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint16Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1111 := *x.FptrMapUint16Int16
+							if false {
+							} else {
+								h.encMapuint16int16((map[uint16]int16)(yy1111), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Int == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Int16\"")
 					} else {
-						h.encMapfloat32int((map[float32]int)(x.FMapFloat32Int), e)
+						r.EncodeSymbol(`FptrMapUint16Int16`)
 					}
-				}
-			}
-			var yyn582 bool
-			if x.FptrMapFloat32Int == nil {
-				yyn582 = true
-				goto LABEL582
-			}
-		LABEL582:
-			if yyr2 || yy2arr2 {
-				if yyn582 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Int == nil {
+					r.WriteMapElemValue()
+					if yyn1110 {
 						r.EncodeNil()
 					} else {
-						yy583 := *x.FptrMapFloat32Int
-						if false {
+						if x.FptrMapUint16Int16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32int((map[float32]int)(yy583), e)
+							yy1113 := *x.FptrMapUint16Int16
+							if false {
+							} else {
+								h.encMapuint16int16((map[uint16]int16)(yy1113), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Int`)
-				}
-				r.WriteMapElemValue()
-				if yyn582 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Int == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint16Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy585 := *x.FptrMapFloat32Int
 						if false {
 						} else {
-							h.encMapfloat32int((map[float32]int)(yy585), e)
+							h.encMapuint16int32((map[uint16]int32)(x.FMapUint16Int32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat32int8((map[float32]int8)(x.FMapFloat32Int8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Int32\"")
 					} else {
-						h.encMapfloat32int8((map[float32]int8)(x.FMapFloat32Int8), e)
+						r.EncodeSymbol(`FMapUint16Int32`)
 					}
-				}
-			}
-			var yyn590 bool
-			if x.FptrMapFloat32Int8 == nil {
-				yyn590 = true
-				goto LABEL590
-			}
-		LABEL590:
-			if yyr2 || yy2arr2 {
-				if yyn590 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Int8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint16Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy591 := *x.FptrMapFloat32Int8
 						if false {
 						} else {
-							h.encMapfloat32int8((map[float32]int8)(yy591), e)
+							h.encMapuint16int32((map[uint16]int32)(x.FMapUint16Int32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Int8`)
+				var yyn1118 bool
+				if x.FptrMapUint16Int32 == nil {
+					yyn1118 = true
+					goto LABEL1118
 				}
-				r.WriteMapElemValue()
-				if yyn590 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Int8 == nil {
+			LABEL1118:
+				if yyr2 || yy2arr2 {
+					if yyn1118 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy593 := *x.FptrMapFloat32Int8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint16Int32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32int8((map[float32]int8)(yy593), e)
+							yy1119 := *x.FptrMapUint16Int32
+							if false {
+							} else {
+								h.encMapuint16int32((map[uint16]int32)(yy1119), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Int16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Int32\"")
 					} else {
-						h.encMapfloat32int16((map[float32]int16)(x.FMapFloat32Int16), e)
+						r.EncodeSymbol(`FptrMapUint16Int32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1118 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32int16((map[float32]int16)(x.FMapFloat32Int16), e)
+						if x.FptrMapUint16Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1121 := *x.FptrMapUint16Int32
+							if false {
+							} else {
+								h.encMapuint16int32((map[uint16]int32)(yy1121), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn598 bool
-			if x.FptrMapFloat32Int16 == nil {
-				yyn598 = true
-				goto LABEL598
-			}
-		LABEL598:
-			if yyr2 || yy2arr2 {
-				if yyn598 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapFloat32Int16 == nil {
+					if x.FMapUint16Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy599 := *x.FptrMapFloat32Int16
 						if false {
 						} else {
-							h.encMapfloat32int16((map[float32]int16)(yy599), e)
+							h.encMapuint16int64((map[uint16]int64)(x.FMapUint16Int64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Int16`)
-				}
-				r.WriteMapElemValue()
-				if yyn598 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapFloat32Int16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Int64\"")
+					} else {
+						r.EncodeSymbol(`FMapUint16Int64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint16Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy601 := *x.FptrMapFloat32Int16
 						if false {
 						} else {
-							h.encMapfloat32int16((map[float32]int16)(yy601), e)
+							h.encMapuint16int64((map[uint16]int64)(x.FMapUint16Int64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1126 bool
+				if x.FptrMapUint16Int64 == nil {
+					yyn1126 = true
+					goto LABEL1126
+				}
+			LABEL1126:
+				if yyr2 || yy2arr2 {
+					if yyn1126 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32int32((map[float32]int32)(x.FMapFloat32Int32), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint16Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1127 := *x.FptrMapUint16Int64
+							if false {
+							} else {
+								h.encMapuint16int64((map[uint16]int64)(yy1127), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Int32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Int64\"")
 					} else {
-						h.encMapfloat32int32((map[float32]int32)(x.FMapFloat32Int32), e)
+						r.EncodeSymbol(`FptrMapUint16Int64`)
 					}
-				}
-			}
-			var yyn606 bool
-			if x.FptrMapFloat32Int32 == nil {
-				yyn606 = true
-				goto LABEL606
-			}
-		LABEL606:
-			if yyr2 || yy2arr2 {
-				if yyn606 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Int32 == nil {
+					r.WriteMapElemValue()
+					if yyn1126 {
 						r.EncodeNil()
 					} else {
-						yy607 := *x.FptrMapFloat32Int32
-						if false {
+						if x.FptrMapUint16Int64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32int32((map[float32]int32)(yy607), e)
+							yy1129 := *x.FptrMapUint16Int64
+							if false {
+							} else {
+								h.encMapuint16int64((map[uint16]int64)(yy1129), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Int32`)
-				}
-				r.WriteMapElemValue()
-				if yyn606 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Int32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint16Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy609 := *x.FptrMapFloat32Int32
 						if false {
 						} else {
-							h.encMapfloat32int32((map[float32]int32)(yy609), e)
+							h.encMapuint16float32((map[uint16]float32)(x.FMapUint16Float32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat32int64((map[float32]int64)(x.FMapFloat32Int64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Int64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Float32\"")
 					} else {
-						h.encMapfloat32int64((map[float32]int64)(x.FMapFloat32Int64), e)
+						r.EncodeSymbol(`FMapUint16Float32`)
 					}
-				}
-			}
-			var yyn614 bool
-			if x.FptrMapFloat32Int64 == nil {
-				yyn614 = true
-				goto LABEL614
-			}
-		LABEL614:
-			if yyr2 || yy2arr2 {
-				if yyn614 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Int64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint16Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy615 := *x.FptrMapFloat32Int64
 						if false {
 						} else {
-							h.encMapfloat32int64((map[float32]int64)(yy615), e)
+							h.encMapuint16float32((map[uint16]float32)(x.FMapUint16Float32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Int64`)
+				var yyn1134 bool
+				if x.FptrMapUint16Float32 == nil {
+					yyn1134 = true
+					goto LABEL1134
 				}
-				r.WriteMapElemValue()
-				if yyn614 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Int64 == nil {
+			LABEL1134:
+				if yyr2 || yy2arr2 {
+					if yyn1134 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy617 := *x.FptrMapFloat32Int64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint16Float32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32int64((map[float32]int64)(yy617), e)
+							yy1135 := *x.FptrMapUint16Float32
+							if false {
+							} else {
+								h.encMapuint16float32((map[uint16]float32)(yy1135), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Float32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Float32\"")
 					} else {
-						h.encMapfloat32float32((map[float32]float32)(x.FMapFloat32Float32), e)
+						r.EncodeSymbol(`FptrMapUint16Float32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1134 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32float32((map[float32]float32)(x.FMapFloat32Float32), e)
+						if x.FptrMapUint16Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1137 := *x.FptrMapUint16Float32
+							if false {
+							} else {
+								h.encMapuint16float32((map[uint16]float32)(yy1137), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn622 bool
-			if x.FptrMapFloat32Float32 == nil {
-				yyn622 = true
-				goto LABEL622
-			}
-		LABEL622:
-			if yyr2 || yy2arr2 {
-				if yyn622 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapFloat32Float32 == nil {
+					if x.FMapUint16Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy623 := *x.FptrMapFloat32Float32
 						if false {
 						} else {
-							h.encMapfloat32float32((map[float32]float32)(yy623), e)
+							h.encMapuint16float64((map[uint16]float64)(x.FMapUint16Float64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Float32`)
-				}
-				r.WriteMapElemValue()
-				if yyn622 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapFloat32Float32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Float64\"")
+					} else {
+						r.EncodeSymbol(`FMapUint16Float64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint16Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy625 := *x.FptrMapFloat32Float32
 						if false {
 						} else {
-							h.encMapfloat32float32((map[float32]float32)(yy625), e)
+							h.encMapuint16float64((map[uint16]float64)(x.FMapUint16Float64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1142 bool
+				if x.FptrMapUint16Float64 == nil {
+					yyn1142 = true
+					goto LABEL1142
+				}
+			LABEL1142:
+				if yyr2 || yy2arr2 {
+					if yyn1142 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat32float64((map[float32]float64)(x.FMapFloat32Float64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint16Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1143 := *x.FptrMapUint16Float64
+							if false {
+							} else {
+								h.encMapuint16float64((map[uint16]float64)(yy1143), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Float64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Float64\"")
 					} else {
-						h.encMapfloat32float64((map[float32]float64)(x.FMapFloat32Float64), e)
+						r.EncodeSymbol(`FptrMapUint16Float64`)
 					}
-				}
-			}
-			var yyn630 bool
-			if x.FptrMapFloat32Float64 == nil {
-				yyn630 = true
-				goto LABEL630
-			}
-		LABEL630:
-			if yyr2 || yy2arr2 {
-				if yyn630 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Float64 == nil {
+					r.WriteMapElemValue()
+					if yyn1142 {
 						r.EncodeNil()
 					} else {
-						yy631 := *x.FptrMapFloat32Float64
-						if false {
+						if x.FptrMapUint16Float64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32float64((map[float32]float64)(yy631), e)
+							yy1145 := *x.FptrMapUint16Float64
+							if false {
+							} else {
+								h.encMapuint16float64((map[uint16]float64)(yy1145), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Float64`)
-				}
-				r.WriteMapElemValue()
-				if yyn630 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Float64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint16Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy633 := *x.FptrMapFloat32Float64
 						if false {
 						} else {
-							h.encMapfloat32float64((map[float32]float64)(yy633), e)
+							h.encMapuint16bool((map[uint16]bool)(x.FMapUint16Bool), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat32Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat32bool((map[float32]bool)(x.FMapFloat32Bool), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat32Bool\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat32Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat32Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint16Bool\"")
 					} else {
-						h.encMapfloat32bool((map[float32]bool)(x.FMapFloat32Bool), e)
+						r.EncodeSymbol(`FMapUint16Bool`)
 					}
-				}
-			}
-			var yyn638 bool
-			if x.FptrMapFloat32Bool == nil {
-				yyn638 = true
-				goto LABEL638
-			}
-		LABEL638:
-			if yyr2 || yy2arr2 {
-				if yyn638 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat32Bool == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint16Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy639 := *x.FptrMapFloat32Bool
 						if false {
 						} else {
-							h.encMapfloat32bool((map[float32]bool)(yy639), e)
+							h.encMapuint16bool((map[uint16]bool)(x.FMapUint16Bool), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat32Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat32Bool`)
+				var yyn1150 bool
+				if x.FptrMapUint16Bool == nil {
+					yyn1150 = true
+					goto LABEL1150
 				}
-				r.WriteMapElemValue()
-				if yyn638 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat32Bool == nil {
+			LABEL1150:
+				if yyr2 || yy2arr2 {
+					if yyn1150 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy641 := *x.FptrMapFloat32Bool
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint16Bool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32bool((map[float32]bool)(yy641), e)
+							yy1151 := *x.FptrMapUint16Bool
+							if false {
+							} else {
+								h.encMapuint16bool((map[uint16]bool)(yy1151), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint16Bool\"")
 					} else {
-						h.encMapfloat64Interface((map[float64]interface{})(x.FMapFloat64Intf), e)
+						r.EncodeSymbol(`FptrMapUint16Bool`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1150 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64Interface((map[float64]interface{})(x.FMapFloat64Intf), e)
+						if x.FptrMapUint16Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy1153 := *x.FptrMapUint16Bool
+							if false {
+							} else {
+								h.encMapuint16bool((map[uint16]bool)(yy1153), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn646 bool
-			if x.FptrMapFloat64Intf == nil {
-				yyn646 = true
-				goto LABEL646
-			}
-		LABEL646:
-			if yyr2 || yy2arr2 {
-				if yyn646 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Intf == nil {
+					if x.FMapUint32Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy647 := *x.FptrMapFloat64Intf
 						if false {
 						} else {
-							h.encMapfloat64Interface((map[float64]interface{})(yy647), e)
+							h.encMapuint32Interface((map[uint32]interface{})(x.FMapUint32Intf), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Intf\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Intf`)
-				}
-				r.WriteMapElemValue()
-				if yyn646 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Intf == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Intf\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Intf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy649 := *x.FptrMapFloat64Intf
 						if false {
 						} else {
-							h.encMapfloat64Interface((map[float64]interface{})(yy649), e)
+							h.encMapuint32Interface((map[uint32]interface{})(x.FMapUint32Intf), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1158 bool
+				if x.FptrMapUint32Intf == nil {
+					yyn1158 = true
+					goto LABEL1158
+				}
+			LABEL1158:
+				if yyr2 || yy2arr2 {
+					if yyn1158 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64string((map[float64]string)(x.FMapFloat64String), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint32Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy1159 := *x.FptrMapUint32Intf
+							if false {
+							} else {
+								h.encMapuint32Interface((map[uint32]interface{})(yy1159), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64String\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Intf\"")
 					} else {
-						h.encMapfloat64string((map[float64]string)(x.FMapFloat64String), e)
+						r.EncodeSymbol(`FptrMapUint32Intf`)
 					}
-				}
-			}
-			var yyn654 bool
-			if x.FptrMapFloat64String == nil {
-				yyn654 = true
-				goto LABEL654
-			}
-		LABEL654:
-			if yyr2 || yy2arr2 {
-				if yyn654 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64String == nil {
+					r.WriteMapElemValue()
+					if yyn1158 {
 						r.EncodeNil()
 					} else {
-						yy655 := *x.FptrMapFloat64String
-						if false {
+						if x.FptrMapUint32Intf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64string((map[float64]string)(yy655), e)
+							yy1161 := *x.FptrMapUint32Intf
+							if false {
+							} else {
+								h.encMapuint32Interface((map[uint32]interface{})(yy1161), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64String`)
-				}
-				r.WriteMapElemValue()
-				if yyn654 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64String == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint32String == nil {
 						r.EncodeNil()
 					} else {
-						yy657 := *x.FptrMapFloat64String
 						if false {
 						} else {
-							h.encMapfloat64string((map[float64]string)(yy657), e)
+							h.encMapuint32string((map[uint32]string)(x.FMapUint32String), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat64uint((map[float64]uint)(x.FMapFloat64Uint), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32String\"")
 					} else {
-						h.encMapfloat64uint((map[float64]uint)(x.FMapFloat64Uint), e)
+						r.EncodeSymbol(`FMapUint32String`)
 					}
-				}
-			}
-			var yyn662 bool
-			if x.FptrMapFloat64Uint == nil {
-				yyn662 = true
-				goto LABEL662
-			}
-		LABEL662:
-			if yyr2 || yy2arr2 {
-				if yyn662 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Uint == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint32String == nil {
 						r.EncodeNil()
 					} else {
-						yy663 := *x.FptrMapFloat64Uint
 						if false {
 						} else {
-							h.encMapfloat64uint((map[float64]uint)(yy663), e)
+							h.encMapuint32string((map[uint32]string)(x.FMapUint32String), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Uint`)
+				var yyn1166 bool
+				if x.FptrMapUint32String == nil {
+					yyn1166 = true
+					goto LABEL1166
 				}
-				r.WriteMapElemValue()
-				if yyn662 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Uint == nil {
+			LABEL1166:
+				if yyr2 || yy2arr2 {
+					if yyn1166 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy665 := *x.FptrMapFloat64Uint
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint32String == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64uint((map[float64]uint)(yy665), e)
+							yy1167 := *x.FptrMapUint32String
+							if false {
+							} else {
+								h.encMapuint32string((map[uint32]string)(yy1167), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Uint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32String\"")
 					} else {
-						h.encMapfloat64uint8((map[float64]uint8)(x.FMapFloat64Uint8), e)
+						r.EncodeSymbol(`FptrMapUint32String`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1166 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64uint8((map[float64]uint8)(x.FMapFloat64Uint8), e)
+						if x.FptrMapUint32String == nil {
+							r.EncodeNil()
+						} else {
+							yy1169 := *x.FptrMapUint32String
+							if false {
+							} else {
+								h.encMapuint32string((map[uint32]string)(yy1169), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn670 bool
-			if x.FptrMapFloat64Uint8 == nil {
-				yyn670 = true
-				goto LABEL670
-			}
-		LABEL670:
-			if yyr2 || yy2arr2 {
-				if yyn670 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapFloat64Uint8 == nil {
+					if x.FMapUint32Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy671 := *x.FptrMapFloat64Uint8
 						if false {
 						} else {
-							h.encMapfloat64uint8((map[float64]uint8)(yy671), e)
+							h.encMapuint32uint((map[uint32]uint)(x.FMapUint32Uint), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Uint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn670 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapFloat64Uint8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Uint\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Uint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy673 := *x.FptrMapFloat64Uint8
 						if false {
 						} else {
-							h.encMapfloat64uint8((map[float64]uint8)(yy673), e)
+							h.encMapuint32uint((map[uint32]uint)(x.FMapUint32Uint), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1174 bool
+				if x.FptrMapUint32Uint == nil {
+					yyn1174 = true
+					goto LABEL1174
+				}
+			LABEL1174:
+				if yyr2 || yy2arr2 {
+					if yyn1174 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64uint16((map[float64]uint16)(x.FMapFloat64Uint16), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint32Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy1175 := *x.FptrMapUint32Uint
+							if false {
+							} else {
+								h.encMapuint32uint((map[uint32]uint)(yy1175), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Uint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Uint\"")
 					} else {
-						h.encMapfloat64uint16((map[float64]uint16)(x.FMapFloat64Uint16), e)
+						r.EncodeSymbol(`FptrMapUint32Uint`)
+					}
+					r.WriteMapElemValue()
+					if yyn1174 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapUint32Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy1177 := *x.FptrMapUint32Uint
+							if false {
+							} else {
+								h.encMapuint32uint((map[uint32]uint)(yy1177), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn678 bool
-			if x.FptrMapFloat64Uint16 == nil {
-				yyn678 = true
-				goto LABEL678
-			}
-		LABEL678:
-			if yyr2 || yy2arr2 {
-				if yyn678 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapFloat64Uint16 == nil {
+					if x.FMapUint32Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy679 := *x.FptrMapFloat64Uint16
 						if false {
 						} else {
-							h.encMapfloat64uint16((map[float64]uint16)(yy679), e)
+							h.encMapuint32uint8((map[uint32]uint8)(x.FMapUint32Uint8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Uint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn678 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapFloat64Uint16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Uint8\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Uint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy681 := *x.FptrMapFloat64Uint16
 						if false {
 						} else {
-							h.encMapfloat64uint16((map[float64]uint16)(yy681), e)
+							h.encMapuint32uint8((map[uint32]uint8)(x.FMapUint32Uint8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1182 bool
+				if x.FptrMapUint32Uint8 == nil {
+					yyn1182 = true
+					goto LABEL1182
+				}
+			LABEL1182:
+				if yyr2 || yy2arr2 {
+					if yyn1182 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64uint32((map[float64]uint32)(x.FMapFloat64Uint32), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint32Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1183 := *x.FptrMapUint32Uint8
+							if false {
+							} else {
+								h.encMapuint32uint8((map[uint32]uint8)(yy1183), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Uint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Uint8\"")
 					} else {
-						h.encMapfloat64uint32((map[float64]uint32)(x.FMapFloat64Uint32), e)
+						r.EncodeSymbol(`FptrMapUint32Uint8`)
 					}
-				}
-			}
-			var yyn686 bool
-			if x.FptrMapFloat64Uint32 == nil {
-				yyn686 = true
-				goto LABEL686
-			}
-		LABEL686:
-			if yyr2 || yy2arr2 {
-				if yyn686 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Uint32 == nil {
+					r.WriteMapElemValue()
+					if yyn1182 {
 						r.EncodeNil()
 					} else {
-						yy687 := *x.FptrMapFloat64Uint32
-						if false {
+						if x.FptrMapUint32Uint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64uint32((map[float64]uint32)(yy687), e)
+							yy1185 := *x.FptrMapUint32Uint8
+							if false {
+							} else {
+								h.encMapuint32uint8((map[uint32]uint8)(yy1185), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Uint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn686 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Uint32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint32Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy689 := *x.FptrMapFloat64Uint32
 						if false {
 						} else {
-							h.encMapfloat64uint32((map[float64]uint32)(yy689), e)
+							h.encMapuint32uint16((map[uint32]uint16)(x.FMapUint32Uint16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat64uint64((map[float64]uint64)(x.FMapFloat64Uint64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Uint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Uint16\"")
 					} else {
-						h.encMapfloat64uint64((map[float64]uint64)(x.FMapFloat64Uint64), e)
+						r.EncodeSymbol(`FMapUint32Uint16`)
 					}
-				}
-			}
-			var yyn694 bool
-			if x.FptrMapFloat64Uint64 == nil {
-				yyn694 = true
-				goto LABEL694
-			}
-		LABEL694:
-			if yyr2 || yy2arr2 {
-				if yyn694 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Uint64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint32Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy695 := *x.FptrMapFloat64Uint64
 						if false {
 						} else {
-							h.encMapfloat64uint64((map[float64]uint64)(yy695), e)
+							h.encMapuint32uint16((map[uint32]uint16)(x.FMapUint32Uint16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Uint64`)
+				var yyn1190 bool
+				if x.FptrMapUint32Uint16 == nil {
+					yyn1190 = true
+					goto LABEL1190
 				}
-				r.WriteMapElemValue()
-				if yyn694 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Uint64 == nil {
+			LABEL1190:
+				if yyr2 || yy2arr2 {
+					if yyn1190 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy697 := *x.FptrMapFloat64Uint64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint32Uint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64uint64((map[float64]uint64)(yy697), e)
+							yy1191 := *x.FptrMapUint32Uint16
+							if false {
+							} else {
+								h.encMapuint32uint16((map[uint32]uint16)(yy1191), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Uintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Uint16\"")
 					} else {
-						h.encMapfloat64uintptr((map[float64]uintptr)(x.FMapFloat64Uintptr), e)
+						r.EncodeSymbol(`FptrMapUint32Uint16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1190 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64uintptr((map[float64]uintptr)(x.FMapFloat64Uintptr), e)
+						if x.FptrMapUint32Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1193 := *x.FptrMapUint32Uint16
+							if false {
+							} else {
+								h.encMapuint32uint16((map[uint32]uint16)(yy1193), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn702 bool
-			if x.FptrMapFloat64Uintptr == nil {
-				yyn702 = true
-				goto LABEL702
-			}
-		LABEL702:
-			if yyr2 || yy2arr2 {
-				if yyn702 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapFloat64Uintptr == nil {
+					if x.FMapUint32Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy703 := *x.FptrMapFloat64Uintptr
 						if false {
 						} else {
-							h.encMapfloat64uintptr((map[float64]uintptr)(yy703), e)
+							h.encMapuint32uint32((map[uint32]uint32)(x.FMapUint32Uint32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn702 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapFloat64Uintptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Uint32\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Uint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy705 := *x.FptrMapFloat64Uintptr
 						if false {
 						} else {
-							h.encMapfloat64uintptr((map[float64]uintptr)(yy705), e)
+							h.encMapuint32uint32((map[uint32]uint32)(x.FMapUint32Uint32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1198 bool
+				if x.FptrMapUint32Uint32 == nil {
+					yyn1198 = true
+					goto LABEL1198
+				}
+			LABEL1198:
+				if yyr2 || yy2arr2 {
+					if yyn1198 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64int((map[float64]int)(x.FMapFloat64Int), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint32Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1199 := *x.FptrMapUint32Uint32
+							if false {
+							} else {
+								h.encMapuint32uint32((map[uint32]uint32)(yy1199), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Int == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Uint32\"")
 					} else {
-						h.encMapfloat64int((map[float64]int)(x.FMapFloat64Int), e)
+						r.EncodeSymbol(`FptrMapUint32Uint32`)
 					}
-				}
-			}
-			var yyn710 bool
-			if x.FptrMapFloat64Int == nil {
-				yyn710 = true
-				goto LABEL710
-			}
-		LABEL710:
-			if yyr2 || yy2arr2 {
-				if yyn710 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Int == nil {
+					r.WriteMapElemValue()
+					if yyn1198 {
 						r.EncodeNil()
 					} else {
-						yy711 := *x.FptrMapFloat64Int
-						if false {
+						if x.FptrMapUint32Uint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64int((map[float64]int)(yy711), e)
+							yy1201 := *x.FptrMapUint32Uint32
+							if false {
+							} else {
+								h.encMapuint32uint32((map[uint32]uint32)(yy1201), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Int`)
-				}
-				r.WriteMapElemValue()
-				if yyn710 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Int == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint32Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy713 := *x.FptrMapFloat64Int
 						if false {
 						} else {
-							h.encMapfloat64int((map[float64]int)(yy713), e)
+							h.encMapuint32uint64((map[uint32]uint64)(x.FMapUint32Uint64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat64int8((map[float64]int8)(x.FMapFloat64Int8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Uint64\"")
 					} else {
-						h.encMapfloat64int8((map[float64]int8)(x.FMapFloat64Int8), e)
+						r.EncodeSymbol(`FMapUint32Uint64`)
 					}
-				}
-			}
-			var yyn718 bool
-			if x.FptrMapFloat64Int8 == nil {
-				yyn718 = true
-				goto LABEL718
-			}
-		LABEL718:
-			if yyr2 || yy2arr2 {
-				if yyn718 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Int8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint32Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy719 := *x.FptrMapFloat64Int8
 						if false {
 						} else {
-							h.encMapfloat64int8((map[float64]int8)(yy719), e)
+							h.encMapuint32uint64((map[uint32]uint64)(x.FMapUint32Uint64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Int8`)
+				var yyn1206 bool
+				if x.FptrMapUint32Uint64 == nil {
+					yyn1206 = true
+					goto LABEL1206
 				}
-				r.WriteMapElemValue()
-				if yyn718 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Int8 == nil {
+			LABEL1206:
+				if yyr2 || yy2arr2 {
+					if yyn1206 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy721 := *x.FptrMapFloat64Int8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint32Uint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64int8((map[float64]int8)(yy721), e)
+							yy1207 := *x.FptrMapUint32Uint64
+							if false {
+							} else {
+								h.encMapuint32uint64((map[uint32]uint64)(yy1207), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Int16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Uint64\"")
 					} else {
-						h.encMapfloat64int16((map[float64]int16)(x.FMapFloat64Int16), e)
+						r.EncodeSymbol(`FptrMapUint32Uint64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1206 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64int16((map[float64]int16)(x.FMapFloat64Int16), e)
+						if x.FptrMapUint32Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1209 := *x.FptrMapUint32Uint64
+							if false {
+							} else {
+								h.encMapuint32uint64((map[uint32]uint64)(yy1209), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn726 bool
-			if x.FptrMapFloat64Int16 == nil {
-				yyn726 = true
-				goto LABEL726
-			}
-		LABEL726:
-			if yyr2 || yy2arr2 {
-				if yyn726 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapFloat64Int16 == nil {
+					if x.FMapUint32Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy727 := *x.FptrMapFloat64Int16
 						if false {
 						} else {
-							h.encMapfloat64int16((map[float64]int16)(yy727), e)
+							h.encMapuint32uintptr((map[uint32]uintptr)(x.FMapUint32Uintptr), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Int16`)
-				}
-				r.WriteMapElemValue()
-				if yyn726 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapFloat64Int16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Uintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy729 := *x.FptrMapFloat64Int16
 						if false {
 						} else {
-							h.encMapfloat64int16((map[float64]int16)(yy729), e)
+							h.encMapuint32uintptr((map[uint32]uintptr)(x.FMapUint32Uintptr), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1214 bool
+				if x.FptrMapUint32Uintptr == nil {
+					yyn1214 = true
+					goto LABEL1214
+				}
+			LABEL1214:
+				if yyr2 || yy2arr2 {
+					if yyn1214 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64int32((map[float64]int32)(x.FMapFloat64Int32), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint32Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy1215 := *x.FptrMapUint32Uintptr
+							if false {
+							} else {
+								h.encMapuint32uintptr((map[uint32]uintptr)(yy1215), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Int32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Uintptr\"")
 					} else {
-						h.encMapfloat64int32((map[float64]int32)(x.FMapFloat64Int32), e)
+						r.EncodeSymbol(`FptrMapUint32Uintptr`)
 					}
-				}
-			}
-			var yyn734 bool
-			if x.FptrMapFloat64Int32 == nil {
-				yyn734 = true
-				goto LABEL734
-			}
-		LABEL734:
-			if yyr2 || yy2arr2 {
-				if yyn734 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Int32 == nil {
+					r.WriteMapElemValue()
+					if yyn1214 {
 						r.EncodeNil()
 					} else {
-						yy735 := *x.FptrMapFloat64Int32
-						if false {
+						if x.FptrMapUint32Uintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64int32((map[float64]int32)(yy735), e)
+							yy1217 := *x.FptrMapUint32Uintptr
+							if false {
+							} else {
+								h.encMapuint32uintptr((map[uint32]uintptr)(yy1217), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Int32`)
-				}
-				r.WriteMapElemValue()
-				if yyn734 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Int32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint32Int == nil {
 						r.EncodeNil()
 					} else {
-						yy737 := *x.FptrMapFloat64Int32
 						if false {
 						} else {
-							h.encMapfloat64int32((map[float64]int32)(yy737), e)
+							h.encMapuint32int((map[uint32]int)(x.FMapUint32Int), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapfloat64int64((map[float64]int64)(x.FMapFloat64Int64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Int64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Int\"")
 					} else {
-						h.encMapfloat64int64((map[float64]int64)(x.FMapFloat64Int64), e)
+						r.EncodeSymbol(`FMapUint32Int`)
 					}
-				}
-			}
-			var yyn742 bool
-			if x.FptrMapFloat64Int64 == nil {
-				yyn742 = true
-				goto LABEL742
-			}
-		LABEL742:
-			if yyr2 || yy2arr2 {
-				if yyn742 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Int64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint32Int == nil {
 						r.EncodeNil()
 					} else {
-						yy743 := *x.FptrMapFloat64Int64
 						if false {
 						} else {
-							h.encMapfloat64int64((map[float64]int64)(yy743), e)
+							h.encMapuint32int((map[uint32]int)(x.FMapUint32Int), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Int64`)
+				var yyn1222 bool
+				if x.FptrMapUint32Int == nil {
+					yyn1222 = true
+					goto LABEL1222
 				}
-				r.WriteMapElemValue()
-				if yyn742 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Int64 == nil {
+			LABEL1222:
+				if yyr2 || yy2arr2 {
+					if yyn1222 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy745 := *x.FptrMapFloat64Int64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint32Int == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64int64((map[float64]int64)(yy745), e)
+							yy1223 := *x.FptrMapUint32Int
+							if false {
+							} else {
+								h.encMapuint32int((map[uint32]int)(yy1223), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Float32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Int\"")
 					} else {
-						h.encMapfloat64float32((map[float64]float32)(x.FMapFloat64Float32), e)
+						r.EncodeSymbol(`FptrMapUint32Int`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1222 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64float32((map[float64]float32)(x.FMapFloat64Float32), e)
+						if x.FptrMapUint32Int == nil {
+							r.EncodeNil()
+						} else {
+							yy1225 := *x.FptrMapUint32Int
+							if false {
+							} else {
+								h.encMapuint32int((map[uint32]int)(yy1225), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn750 bool
-			if x.FptrMapFloat64Float32 == nil {
-				yyn750 = true
-				goto LABEL750
-			}
-		LABEL750:
-			if yyr2 || yy2arr2 {
-				if yyn750 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Float32 == nil {
+					if x.FMapUint32Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy751 := *x.FptrMapFloat64Float32
 						if false {
 						} else {
-							h.encMapfloat64float32((map[float64]float32)(yy751), e)
+							h.encMapuint32int8((map[uint32]int8)(x.FMapUint32Int8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Float32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Float32`)
-				}
-				r.WriteMapElemValue()
-				if yyn750 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Float32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Int8\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Int8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy753 := *x.FptrMapFloat64Float32
 						if false {
 						} else {
-							h.encMapfloat64float32((map[float64]float32)(yy753), e)
+							h.encMapuint32int8((map[uint32]int8)(x.FMapUint32Int8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1230 bool
+				if x.FptrMapUint32Int8 == nil {
+					yyn1230 = true
+					goto LABEL1230
+				}
+			LABEL1230:
+				if yyr2 || yy2arr2 {
+					if yyn1230 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64float64((map[float64]float64)(x.FMapFloat64Float64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint32Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1231 := *x.FptrMapUint32Int8
+							if false {
+							} else {
+								h.encMapuint32int8((map[uint32]int8)(yy1231), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Float64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Int8\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUint32Int8`)
+					}
+					r.WriteMapElemValue()
+					if yyn1230 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64float64((map[float64]float64)(x.FMapFloat64Float64), e)
+						if x.FptrMapUint32Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1233 := *x.FptrMapUint32Int8
+							if false {
+							} else {
+								h.encMapuint32int8((map[uint32]int8)(yy1233), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn758 bool
-			if x.FptrMapFloat64Float64 == nil {
-				yyn758 = true
-				goto LABEL758
-			}
-		LABEL758:
-			if yyr2 || yy2arr2 {
-				if yyn758 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Float64 == nil {
+					if x.FMapUint32Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy759 := *x.FptrMapFloat64Float64
 						if false {
 						} else {
-							h.encMapfloat64float64((map[float64]float64)(yy759), e)
+							h.encMapuint32int16((map[uint32]int16)(x.FMapUint32Int16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Float64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Float64`)
-				}
-				r.WriteMapElemValue()
-				if yyn758 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Float64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Int16\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Int16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy761 := *x.FptrMapFloat64Float64
 						if false {
 						} else {
-							h.encMapfloat64float64((map[float64]float64)(yy761), e)
+							h.encMapuint32int16((map[uint32]int16)(x.FMapUint32Int16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapFloat64Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1238 bool
+				if x.FptrMapUint32Int16 == nil {
+					yyn1238 = true
+					goto LABEL1238
+				}
+			LABEL1238:
+				if yyr2 || yy2arr2 {
+					if yyn1238 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64bool((map[float64]bool)(x.FMapFloat64Bool), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint32Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1239 := *x.FptrMapUint32Int16
+							if false {
+							} else {
+								h.encMapuint32int16((map[uint32]int16)(yy1239), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapFloat64Bool\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapFloat64Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapFloat64Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Int16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUint32Int16`)
+					}
+					r.WriteMapElemValue()
+					if yyn1238 {
+						r.EncodeNil()
 					} else {
-						h.encMapfloat64bool((map[float64]bool)(x.FMapFloat64Bool), e)
+						if x.FptrMapUint32Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1241 := *x.FptrMapUint32Int16
+							if false {
+							} else {
+								h.encMapuint32int16((map[uint32]int16)(yy1241), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn766 bool
-			if x.FptrMapFloat64Bool == nil {
-				yyn766 = true
-				goto LABEL766
-			}
-		LABEL766:
-			if yyr2 || yy2arr2 {
-				if yyn766 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.FMapUint32Int32 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapuint32int32((map[uint32]int32)(x.FMapUint32Int32), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.FptrMapFloat64Bool == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Int32\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Int32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy767 := *x.FptrMapFloat64Bool
 						if false {
 						} else {
-							h.encMapfloat64bool((map[float64]bool)(yy767), e)
+							h.encMapuint32int32((map[uint32]int32)(x.FMapUint32Int32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapFloat64Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapFloat64Bool`)
+				var yyn1246 bool
+				if x.FptrMapUint32Int32 == nil {
+					yyn1246 = true
+					goto LABEL1246
 				}
-				r.WriteMapElemValue()
-				if yyn766 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapFloat64Bool == nil {
+			LABEL1246:
+				if yyr2 || yy2arr2 {
+					if yyn1246 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy769 := *x.FptrMapFloat64Bool
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint32Int32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64bool((map[float64]bool)(yy769), e)
+							yy1247 := *x.FptrMapUint32Int32
+							if false {
+							} else {
+								h.encMapuint32int32((map[uint32]int32)(yy1247), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintIntf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintInterface((map[uint]interface{})(x.FMapUintIntf), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintIntf\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintIntf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintIntf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Int32\"")
 					} else {
-						h.encMapuintInterface((map[uint]interface{})(x.FMapUintIntf), e)
+						r.EncodeSymbol(`FptrMapUint32Int32`)
 					}
-				}
-			}
-			var yyn774 bool
-			if x.FptrMapUintIntf == nil {
-				yyn774 = true
-				goto LABEL774
-			}
-		LABEL774:
-			if yyr2 || yy2arr2 {
-				if yyn774 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintIntf == nil {
+					r.WriteMapElemValue()
+					if yyn1246 {
 						r.EncodeNil()
 					} else {
-						yy775 := *x.FptrMapUintIntf
-						if false {
+						if x.FptrMapUint32Int32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintInterface((map[uint]interface{})(yy775), e)
+							yy1249 := *x.FptrMapUint32Int32
+							if false {
+							} else {
+								h.encMapuint32int32((map[uint32]int32)(yy1249), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintIntf`)
-				}
-				r.WriteMapElemValue()
-				if yyn774 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintIntf == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint32Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy777 := *x.FptrMapUintIntf
 						if false {
 						} else {
-							h.encMapuintInterface((map[uint]interface{})(yy777), e)
+							h.encMapuint32int64((map[uint32]int64)(x.FMapUint32Int64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintString == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintstring((map[uint]string)(x.FMapUintString), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintString`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintString == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Int64\"")
 					} else {
-						h.encMapuintstring((map[uint]string)(x.FMapUintString), e)
+						r.EncodeSymbol(`FMapUint32Int64`)
 					}
-				}
-			}
-			var yyn782 bool
-			if x.FptrMapUintString == nil {
-				yyn782 = true
-				goto LABEL782
-			}
-		LABEL782:
-			if yyr2 || yy2arr2 {
-				if yyn782 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintString == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint32Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy783 := *x.FptrMapUintString
 						if false {
 						} else {
-							h.encMapuintstring((map[uint]string)(yy783), e)
+							h.encMapuint32int64((map[uint32]int64)(x.FMapUint32Int64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintString`)
+				var yyn1254 bool
+				if x.FptrMapUint32Int64 == nil {
+					yyn1254 = true
+					goto LABEL1254
 				}
-				r.WriteMapElemValue()
-				if yyn782 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintString == nil {
+			LABEL1254:
+				if yyr2 || yy2arr2 {
+					if yyn1254 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy785 := *x.FptrMapUintString
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint32Int64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintstring((map[uint]string)(yy785), e)
+							yy1255 := *x.FptrMapUint32Int64
+							if false {
+							} else {
+								h.encMapuint32int64((map[uint32]int64)(yy1255), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintUint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Int64\"")
 					} else {
-						h.encMapuintuint((map[uint]uint)(x.FMapUintUint), e)
+						r.EncodeSymbol(`FptrMapUint32Int64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintUint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintUint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1254 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintuint((map[uint]uint)(x.FMapUintUint), e)
+						if x.FptrMapUint32Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1257 := *x.FptrMapUint32Int64
+							if false {
+							} else {
+								h.encMapuint32int64((map[uint32]int64)(yy1257), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn790 bool
-			if x.FptrMapUintUint == nil {
-				yyn790 = true
-				goto LABEL790
-			}
-		LABEL790:
-			if yyr2 || yy2arr2 {
-				if yyn790 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUintUint == nil {
+					if x.FMapUint32Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy791 := *x.FptrMapUintUint
 						if false {
 						} else {
-							h.encMapuintuint((map[uint]uint)(yy791), e)
+							h.encMapuint32float32((map[uint32]float32)(x.FMapUint32Float32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintUint`)
-				}
-				r.WriteMapElemValue()
-				if yyn790 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUintUint == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Float32\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Float32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy793 := *x.FptrMapUintUint
 						if false {
 						} else {
-							h.encMapuintuint((map[uint]uint)(yy793), e)
+							h.encMapuint32float32((map[uint32]float32)(x.FMapUint32Float32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintUint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1262 bool
+				if x.FptrMapUint32Float32 == nil {
+					yyn1262 = true
+					goto LABEL1262
+				}
+			LABEL1262:
+				if yyr2 || yy2arr2 {
+					if yyn1262 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintuint8((map[uint]uint8)(x.FMapUintUint8), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint32Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1263 := *x.FptrMapUint32Float32
+							if false {
+							} else {
+								h.encMapuint32float32((map[uint32]float32)(yy1263), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintUint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintUint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Float32\"")
 					} else {
-						h.encMapuintuint8((map[uint]uint8)(x.FMapUintUint8), e)
+						r.EncodeSymbol(`FptrMapUint32Float32`)
 					}
-				}
-			}
-			var yyn798 bool
-			if x.FptrMapUintUint8 == nil {
-				yyn798 = true
-				goto LABEL798
-			}
-		LABEL798:
-			if yyr2 || yy2arr2 {
-				if yyn798 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintUint8 == nil {
+					r.WriteMapElemValue()
+					if yyn1262 {
 						r.EncodeNil()
 					} else {
-						yy799 := *x.FptrMapUintUint8
-						if false {
+						if x.FptrMapUint32Float32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintuint8((map[uint]uint8)(yy799), e)
+							yy1265 := *x.FptrMapUint32Float32
+							if false {
+							} else {
+								h.encMapuint32float32((map[uint32]float32)(yy1265), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintUint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn798 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintUint8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint32Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy801 := *x.FptrMapUintUint8
 						if false {
 						} else {
-							h.encMapuintuint8((map[uint]uint8)(yy801), e)
+							h.encMapuint32float64((map[uint32]float64)(x.FMapUint32Float64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintUint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintuint16((map[uint]uint16)(x.FMapUintUint16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintUint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintUint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Float64\"")
 					} else {
-						h.encMapuintuint16((map[uint]uint16)(x.FMapUintUint16), e)
+						r.EncodeSymbol(`FMapUint32Float64`)
 					}
-				}
-			}
-			var yyn806 bool
-			if x.FptrMapUintUint16 == nil {
-				yyn806 = true
-				goto LABEL806
-			}
-		LABEL806:
-			if yyr2 || yy2arr2 {
-				if yyn806 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintUint16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint32Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy807 := *x.FptrMapUintUint16
 						if false {
 						} else {
-							h.encMapuintuint16((map[uint]uint16)(yy807), e)
+							h.encMapuint32float64((map[uint32]float64)(x.FMapUint32Float64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintUint16`)
+				var yyn1270 bool
+				if x.FptrMapUint32Float64 == nil {
+					yyn1270 = true
+					goto LABEL1270
 				}
-				r.WriteMapElemValue()
-				if yyn806 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintUint16 == nil {
+			LABEL1270:
+				if yyr2 || yy2arr2 {
+					if yyn1270 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy809 := *x.FptrMapUintUint16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint32Float64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintuint16((map[uint]uint16)(yy809), e)
+							yy1271 := *x.FptrMapUint32Float64
+							if false {
+							} else {
+								h.encMapuint32float64((map[uint32]float64)(yy1271), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintUint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Float64\"")
 					} else {
-						h.encMapuintuint32((map[uint]uint32)(x.FMapUintUint32), e)
+						r.EncodeSymbol(`FptrMapUint32Float64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintUint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintUint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1270 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintuint32((map[uint]uint32)(x.FMapUintUint32), e)
+						if x.FptrMapUint32Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1273 := *x.FptrMapUint32Float64
+							if false {
+							} else {
+								h.encMapuint32float64((map[uint32]float64)(yy1273), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn814 bool
-			if x.FptrMapUintUint32 == nil {
-				yyn814 = true
-				goto LABEL814
-			}
-		LABEL814:
-			if yyr2 || yy2arr2 {
-				if yyn814 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUintUint32 == nil {
+					if x.FMapUint32Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy815 := *x.FptrMapUintUint32
 						if false {
 						} else {
-							h.encMapuintuint32((map[uint]uint32)(yy815), e)
+							h.encMapuint32bool((map[uint32]bool)(x.FMapUint32Bool), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintUint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn814 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUintUint32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint32Bool\"")
+					} else {
+						r.EncodeSymbol(`FMapUint32Bool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint32Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy817 := *x.FptrMapUintUint32
 						if false {
 						} else {
-							h.encMapuintuint32((map[uint]uint32)(yy817), e)
+							h.encMapuint32bool((map[uint32]bool)(x.FMapUint32Bool), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintUint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1278 bool
+				if x.FptrMapUint32Bool == nil {
+					yyn1278 = true
+					goto LABEL1278
+				}
+			LABEL1278:
+				if yyr2 || yy2arr2 {
+					if yyn1278 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintuint64((map[uint]uint64)(x.FMapUintUint64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint32Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy1279 := *x.FptrMapUint32Bool
+							if false {
+							} else {
+								h.encMapuint32bool((map[uint32]bool)(yy1279), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintUint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintUint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint32Bool\"")
 					} else {
-						h.encMapuintuint64((map[uint]uint64)(x.FMapUintUint64), e)
+						r.EncodeSymbol(`FptrMapUint32Bool`)
 					}
-				}
-			}
-			var yyn822 bool
-			if x.FptrMapUintUint64 == nil {
-				yyn822 = true
-				goto LABEL822
-			}
-		LABEL822:
-			if yyr2 || yy2arr2 {
-				if yyn822 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintUint64 == nil {
+					r.WriteMapElemValue()
+					if yyn1278 {
 						r.EncodeNil()
 					} else {
-						yy823 := *x.FptrMapUintUint64
-						if false {
+						if x.FptrMapUint32Bool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintuint64((map[uint]uint64)(yy823), e)
+							yy1281 := *x.FptrMapUint32Bool
+							if false {
+							} else {
+								h.encMapuint32bool((map[uint32]bool)(yy1281), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintUint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn822 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintUint64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint64Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy825 := *x.FptrMapUintUint64
 						if false {
 						} else {
-							h.encMapuintuint64((map[uint]uint64)(yy825), e)
+							h.encMapuint64Interface((map[uint64]interface{})(x.FMapUint64Intf), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintUintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintuintptr((map[uint]uintptr)(x.FMapUintUintptr), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintUintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintUintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Intf\"")
 					} else {
-						h.encMapuintuintptr((map[uint]uintptr)(x.FMapUintUintptr), e)
+						r.EncodeSymbol(`FMapUint64Intf`)
 					}
-				}
-			}
-			var yyn830 bool
-			if x.FptrMapUintUintptr == nil {
-				yyn830 = true
-				goto LABEL830
-			}
-		LABEL830:
-			if yyr2 || yy2arr2 {
-				if yyn830 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintUintptr == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint64Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy831 := *x.FptrMapUintUintptr
 						if false {
 						} else {
-							h.encMapuintuintptr((map[uint]uintptr)(yy831), e)
+							h.encMapuint64Interface((map[uint64]interface{})(x.FMapUint64Intf), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintUintptr`)
+				var yyn1286 bool
+				if x.FptrMapUint64Intf == nil {
+					yyn1286 = true
+					goto LABEL1286
 				}
-				r.WriteMapElemValue()
-				if yyn830 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintUintptr == nil {
+			LABEL1286:
+				if yyr2 || yy2arr2 {
+					if yyn1286 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy833 := *x.FptrMapUintUintptr
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint64Intf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintuintptr((map[uint]uintptr)(yy833), e)
+							yy1287 := *x.FptrMapUint64Intf
+							if false {
+							} else {
+								h.encMapuint64Interface((map[uint64]interface{})(yy1287), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintInt == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Intf\"")
 					} else {
-						h.encMapuintint((map[uint]int)(x.FMapUintInt), e)
+						r.EncodeSymbol(`FptrMapUint64Intf`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintInt`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1286 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintint((map[uint]int)(x.FMapUintInt), e)
+						if x.FptrMapUint64Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy1289 := *x.FptrMapUint64Intf
+							if false {
+							} else {
+								h.encMapuint64Interface((map[uint64]interface{})(yy1289), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn838 bool
-			if x.FptrMapUintInt == nil {
-				yyn838 = true
-				goto LABEL838
-			}
-		LABEL838:
-			if yyr2 || yy2arr2 {
-				if yyn838 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUintInt == nil {
+					if x.FMapUint64String == nil {
 						r.EncodeNil()
 					} else {
-						yy839 := *x.FptrMapUintInt
 						if false {
 						} else {
-							h.encMapuintint((map[uint]int)(yy839), e)
+							h.encMapuint64string((map[uint64]string)(x.FMapUint64String), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintInt`)
-				}
-				r.WriteMapElemValue()
-				if yyn838 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUintInt == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64String\"")
+					} else {
+						r.EncodeSymbol(`FMapUint64String`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint64String == nil {
 						r.EncodeNil()
 					} else {
-						yy841 := *x.FptrMapUintInt
 						if false {
 						} else {
-							h.encMapuintint((map[uint]int)(yy841), e)
+							h.encMapuint64string((map[uint64]string)(x.FMapUint64String), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintInt8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1294 bool
+				if x.FptrMapUint64String == nil {
+					yyn1294 = true
+					goto LABEL1294
+				}
+			LABEL1294:
+				if yyr2 || yy2arr2 {
+					if yyn1294 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintint8((map[uint]int8)(x.FMapUintInt8), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint64String == nil {
+							r.EncodeNil()
+						} else {
+							yy1295 := *x.FptrMapUint64String
+							if false {
+							} else {
+								h.encMapuint64string((map[uint64]string)(yy1295), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintInt8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintInt8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64String\"")
 					} else {
-						h.encMapuintint8((map[uint]int8)(x.FMapUintInt8), e)
+						r.EncodeSymbol(`FptrMapUint64String`)
 					}
-				}
-			}
-			var yyn846 bool
-			if x.FptrMapUintInt8 == nil {
-				yyn846 = true
-				goto LABEL846
-			}
-		LABEL846:
-			if yyr2 || yy2arr2 {
-				if yyn846 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintInt8 == nil {
+					r.WriteMapElemValue()
+					if yyn1294 {
 						r.EncodeNil()
 					} else {
-						yy847 := *x.FptrMapUintInt8
-						if false {
+						if x.FptrMapUint64String == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintint8((map[uint]int8)(yy847), e)
+							yy1297 := *x.FptrMapUint64String
+							if false {
+							} else {
+								h.encMapuint64string((map[uint64]string)(yy1297), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintInt8`)
-				}
-				r.WriteMapElemValue()
-				if yyn846 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintInt8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint64Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy849 := *x.FptrMapUintInt8
 						if false {
 						} else {
-							h.encMapuintint8((map[uint]int8)(yy849), e)
+							h.encMapuint64uint((map[uint64]uint)(x.FMapUint64Uint), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintint16((map[uint]int16)(x.FMapUintInt16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintInt16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintInt16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Uint\"")
 					} else {
-						h.encMapuintint16((map[uint]int16)(x.FMapUintInt16), e)
+						r.EncodeSymbol(`FMapUint64Uint`)
 					}
-				}
-			}
-			var yyn854 bool
-			if x.FptrMapUintInt16 == nil {
-				yyn854 = true
-				goto LABEL854
-			}
-		LABEL854:
-			if yyr2 || yy2arr2 {
-				if yyn854 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintInt16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint64Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy855 := *x.FptrMapUintInt16
 						if false {
 						} else {
-							h.encMapuintint16((map[uint]int16)(yy855), e)
+							h.encMapuint64uint((map[uint64]uint)(x.FMapUint64Uint), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintInt16`)
+				var yyn1302 bool
+				if x.FptrMapUint64Uint == nil {
+					yyn1302 = true
+					goto LABEL1302
 				}
-				r.WriteMapElemValue()
-				if yyn854 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintInt16 == nil {
+			LABEL1302:
+				if yyr2 || yy2arr2 {
+					if yyn1302 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy857 := *x.FptrMapUintInt16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint64Uint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintint16((map[uint]int16)(yy857), e)
+							yy1303 := *x.FptrMapUint64Uint
+							if false {
+							} else {
+								h.encMapuint64uint((map[uint64]uint)(yy1303), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintInt32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Uint\"")
 					} else {
-						h.encMapuintint32((map[uint]int32)(x.FMapUintInt32), e)
+						r.EncodeSymbol(`FptrMapUint64Uint`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintInt32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintInt32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1302 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintint32((map[uint]int32)(x.FMapUintInt32), e)
+						if x.FptrMapUint64Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy1305 := *x.FptrMapUint64Uint
+							if false {
+							} else {
+								h.encMapuint64uint((map[uint64]uint)(yy1305), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn862 bool
-			if x.FptrMapUintInt32 == nil {
-				yyn862 = true
-				goto LABEL862
-			}
-		LABEL862:
-			if yyr2 || yy2arr2 {
-				if yyn862 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintInt32 == nil {
+					if x.FMapUint64Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy863 := *x.FptrMapUintInt32
 						if false {
 						} else {
-							h.encMapuintint32((map[uint]int32)(yy863), e)
+							h.encMapuint64uint8((map[uint64]uint8)(x.FMapUint64Uint8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintInt32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintInt32`)
-				}
-				r.WriteMapElemValue()
-				if yyn862 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintInt32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Uint8\"")
+					} else {
+						r.EncodeSymbol(`FMapUint64Uint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint64Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy865 := *x.FptrMapUintInt32
 						if false {
 						} else {
-							h.encMapuintint32((map[uint]int32)(yy865), e)
+							h.encMapuint64uint8((map[uint64]uint8)(x.FMapUint64Uint8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintInt64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1310 bool
+				if x.FptrMapUint64Uint8 == nil {
+					yyn1310 = true
+					goto LABEL1310
+				}
+			LABEL1310:
+				if yyr2 || yy2arr2 {
+					if yyn1310 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintint64((map[uint]int64)(x.FMapUintInt64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint64Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1311 := *x.FptrMapUint64Uint8
+							if false {
+							} else {
+								h.encMapuint64uint8((map[uint64]uint8)(yy1311), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintInt64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintInt64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Uint8\"")
 					} else {
-						h.encMapuintint64((map[uint]int64)(x.FMapUintInt64), e)
+						r.EncodeSymbol(`FptrMapUint64Uint8`)
 					}
-				}
-			}
-			var yyn870 bool
-			if x.FptrMapUintInt64 == nil {
-				yyn870 = true
-				goto LABEL870
-			}
-		LABEL870:
-			if yyr2 || yy2arr2 {
-				if yyn870 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintInt64 == nil {
+					r.WriteMapElemValue()
+					if yyn1310 {
 						r.EncodeNil()
 					} else {
-						yy871 := *x.FptrMapUintInt64
-						if false {
+						if x.FptrMapUint64Uint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintint64((map[uint]int64)(yy871), e)
+							yy1313 := *x.FptrMapUint64Uint8
+							if false {
+							} else {
+								h.encMapuint64uint8((map[uint64]uint8)(yy1313), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintInt64`)
-				}
-				r.WriteMapElemValue()
-				if yyn870 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintInt64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint64Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy873 := *x.FptrMapUintInt64
 						if false {
 						} else {
-							h.encMapuintint64((map[uint]int64)(yy873), e)
+							h.encMapuint64uint16((map[uint64]uint16)(x.FMapUint64Uint16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintFloat32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintfloat32((map[uint]float32)(x.FMapUintFloat32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintFloat32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintFloat32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Uint16\"")
 					} else {
-						h.encMapuintfloat32((map[uint]float32)(x.FMapUintFloat32), e)
+						r.EncodeSymbol(`FMapUint64Uint16`)
 					}
-				}
-			}
-			var yyn878 bool
-			if x.FptrMapUintFloat32 == nil {
-				yyn878 = true
-				goto LABEL878
-			}
-		LABEL878:
-			if yyr2 || yy2arr2 {
-				if yyn878 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintFloat32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint64Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy879 := *x.FptrMapUintFloat32
 						if false {
 						} else {
-							h.encMapuintfloat32((map[uint]float32)(yy879), e)
+							h.encMapuint64uint16((map[uint64]uint16)(x.FMapUint64Uint16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintFloat32`)
+				var yyn1318 bool
+				if x.FptrMapUint64Uint16 == nil {
+					yyn1318 = true
+					goto LABEL1318
 				}
-				r.WriteMapElemValue()
-				if yyn878 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintFloat32 == nil {
+			LABEL1318:
+				if yyr2 || yy2arr2 {
+					if yyn1318 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy881 := *x.FptrMapUintFloat32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint64Uint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintfloat32((map[uint]float32)(yy881), e)
+							yy1319 := *x.FptrMapUint64Uint16
+							if false {
+							} else {
+								h.encMapuint64uint16((map[uint64]uint16)(yy1319), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintFloat64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Uint16\"")
 					} else {
-						h.encMapuintfloat64((map[uint]float64)(x.FMapUintFloat64), e)
+						r.EncodeSymbol(`FptrMapUint64Uint16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintFloat64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintFloat64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1318 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintfloat64((map[uint]float64)(x.FMapUintFloat64), e)
+						if x.FptrMapUint64Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1321 := *x.FptrMapUint64Uint16
+							if false {
+							} else {
+								h.encMapuint64uint16((map[uint64]uint16)(yy1321), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn886 bool
-			if x.FptrMapUintFloat64 == nil {
-				yyn886 = true
-				goto LABEL886
-			}
-		LABEL886:
-			if yyr2 || yy2arr2 {
-				if yyn886 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUintFloat64 == nil {
+					if x.FMapUint64Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy887 := *x.FptrMapUintFloat64
 						if false {
 						} else {
-							h.encMapuintfloat64((map[uint]float64)(yy887), e)
+							h.encMapuint64uint32((map[uint64]uint32)(x.FMapUint64Uint32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintFloat64`)
-				}
-				r.WriteMapElemValue()
-				if yyn886 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUintFloat64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Uint32\"")
+					} else {
+						r.EncodeSymbol(`FMapUint64Uint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint64Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy889 := *x.FptrMapUintFloat64
 						if false {
 						} else {
-							h.encMapuintfloat64((map[uint]float64)(yy889), e)
+							h.encMapuint64uint32((map[uint64]uint32)(x.FMapUint64Uint32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintBool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1326 bool
+				if x.FptrMapUint64Uint32 == nil {
+					yyn1326 = true
+					goto LABEL1326
+				}
+			LABEL1326:
+				if yyr2 || yy2arr2 {
+					if yyn1326 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintbool((map[uint]bool)(x.FMapUintBool), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint64Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1327 := *x.FptrMapUint64Uint32
+							if false {
+							} else {
+								h.encMapuint64uint32((map[uint64]uint32)(yy1327), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintBool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintBool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Uint32\"")
 					} else {
-						h.encMapuintbool((map[uint]bool)(x.FMapUintBool), e)
+						r.EncodeSymbol(`FptrMapUint64Uint32`)
 					}
-				}
-			}
-			var yyn894 bool
-			if x.FptrMapUintBool == nil {
-				yyn894 = true
-				goto LABEL894
-			}
-		LABEL894:
-			if yyr2 || yy2arr2 {
-				if yyn894 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintBool == nil {
+					r.WriteMapElemValue()
+					if yyn1326 {
 						r.EncodeNil()
 					} else {
-						yy895 := *x.FptrMapUintBool
-						if false {
+						if x.FptrMapUint64Uint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintbool((map[uint]bool)(yy895), e)
+							yy1329 := *x.FptrMapUint64Uint32
+							if false {
+							} else {
+								h.encMapuint64uint32((map[uint64]uint32)(yy1329), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintBool`)
-				}
-				r.WriteMapElemValue()
-				if yyn894 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintBool == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint64Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy897 := *x.FptrMapUintBool
 						if false {
 						} else {
-							h.encMapuintbool((map[uint]bool)(yy897), e)
+							h.encMapuint64uint64((map[uint64]uint64)(x.FMapUint64Uint64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint8Interface((map[uint8]interface{})(x.FMapUint8Intf), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Uint64\"")
 					} else {
-						h.encMapuint8Interface((map[uint8]interface{})(x.FMapUint8Intf), e)
+						r.EncodeSymbol(`FMapUint64Uint64`)
 					}
-				}
-			}
-			var yyn902 bool
-			if x.FptrMapUint8Intf == nil {
-				yyn902 = true
-				goto LABEL902
-			}
-		LABEL902:
-			if yyr2 || yy2arr2 {
-				if yyn902 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Intf == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint64Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy903 := *x.FptrMapUint8Intf
 						if false {
 						} else {
-							h.encMapuint8Interface((map[uint8]interface{})(yy903), e)
+							h.encMapuint64uint64((map[uint64]uint64)(x.FMapUint64Uint64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Intf`)
+				var yyn1334 bool
+				if x.FptrMapUint64Uint64 == nil {
+					yyn1334 = true
+					goto LABEL1334
 				}
-				r.WriteMapElemValue()
-				if yyn902 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Intf == nil {
+			LABEL1334:
+				if yyr2 || yy2arr2 {
+					if yyn1334 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy905 := *x.FptrMapUint8Intf
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint64Uint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8Interface((map[uint8]interface{})(yy905), e)
+							yy1335 := *x.FptrMapUint64Uint64
+							if false {
+							} else {
+								h.encMapuint64uint64((map[uint64]uint64)(yy1335), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8String == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Uint64\"")
 					} else {
-						h.encMapuint8string((map[uint8]string)(x.FMapUint8String), e)
+						r.EncodeSymbol(`FptrMapUint64Uint64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1334 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint8string((map[uint8]string)(x.FMapUint8String), e)
+						if x.FptrMapUint64Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1337 := *x.FptrMapUint64Uint64
+							if false {
+							} else {
+								h.encMapuint64uint64((map[uint64]uint64)(yy1337), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn910 bool
-			if x.FptrMapUint8String == nil {
-				yyn910 = true
-				goto LABEL910
-			}
-		LABEL910:
-			if yyr2 || yy2arr2 {
-				if yyn910 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint8String == nil {
+					if x.FMapUint64Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy911 := *x.FptrMapUint8String
 						if false {
 						} else {
-							h.encMapuint8string((map[uint8]string)(yy911), e)
+							h.encMapuint64uintptr((map[uint64]uintptr)(x.FMapUint64Uintptr), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8String`)
-				}
-				r.WriteMapElemValue()
-				if yyn910 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint8String == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Uintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapUint64Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint64Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy913 := *x.FptrMapUint8String
 						if false {
 						} else {
-							h.encMapuint8string((map[uint8]string)(yy913), e)
+							h.encMapuint64uintptr((map[uint64]uintptr)(x.FMapUint64Uintptr), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1342 bool
+				if x.FptrMapUint64Uintptr == nil {
+					yyn1342 = true
+					goto LABEL1342
+				}
+			LABEL1342:
+				if yyr2 || yy2arr2 {
+					if yyn1342 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint8uint((map[uint8]uint)(x.FMapUint8Uint), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint64Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy1343 := *x.FptrMapUint64Uintptr
+							if false {
+							} else {
+								h.encMapuint64uintptr((map[uint64]uintptr)(yy1343), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Uintptr\"")
 					} else {
-						h.encMapuint8uint((map[uint8]uint)(x.FMapUint8Uint), e)
+						r.EncodeSymbol(`FptrMapUint64Uintptr`)
 					}
-				}
-			}
-			var yyn918 bool
-			if x.FptrMapUint8Uint == nil {
-				yyn918 = true
-				goto LABEL918
-			}
-		LABEL918:
-			if yyr2 || yy2arr2 {
-				if yyn918 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Uint == nil {
+					r.WriteMapElemValue()
+					if yyn1342 {
 						r.EncodeNil()
 					} else {
-						yy919 := *x.FptrMapUint8Uint
-						if false {
+						if x.FptrMapUint64Uintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8uint((map[uint8]uint)(yy919), e)
+							yy1345 := *x.FptrMapUint64Uintptr
+							if false {
+							} else {
+								h.encMapuint64uintptr((map[uint64]uintptr)(yy1345), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Uint`)
-				}
-				r.WriteMapElemValue()
-				if yyn918 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Uint == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint64Int == nil {
 						r.EncodeNil()
 					} else {
-						yy921 := *x.FptrMapUint8Uint
 						if false {
 						} else {
-							h.encMapuint8uint((map[uint8]uint)(yy921), e)
+							h.encMapuint64int((map[uint64]int)(x.FMapUint64Int), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint8uint8((map[uint8]uint8)(x.FMapUint8Uint8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Uint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Int\"")
 					} else {
-						h.encMapuint8uint8((map[uint8]uint8)(x.FMapUint8Uint8), e)
+						r.EncodeSymbol(`FMapUint64Int`)
 					}
-				}
-			}
-			var yyn926 bool
-			if x.FptrMapUint8Uint8 == nil {
-				yyn926 = true
-				goto LABEL926
-			}
-		LABEL926:
-			if yyr2 || yy2arr2 {
-				if yyn926 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Uint8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint64Int == nil {
 						r.EncodeNil()
 					} else {
-						yy927 := *x.FptrMapUint8Uint8
 						if false {
 						} else {
-							h.encMapuint8uint8((map[uint8]uint8)(yy927), e)
+							h.encMapuint64int((map[uint64]int)(x.FMapUint64Int), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Uint8`)
+				var yyn1350 bool
+				if x.FptrMapUint64Int == nil {
+					yyn1350 = true
+					goto LABEL1350
 				}
-				r.WriteMapElemValue()
-				if yyn926 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Uint8 == nil {
+			LABEL1350:
+				if yyr2 || yy2arr2 {
+					if yyn1350 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy929 := *x.FptrMapUint8Uint8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint64Int == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8uint8((map[uint8]uint8)(yy929), e)
+							yy1351 := *x.FptrMapUint64Int
+							if false {
+							} else {
+								h.encMapuint64int((map[uint64]int)(yy1351), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Uint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Int\"")
 					} else {
-						h.encMapuint8uint16((map[uint8]uint16)(x.FMapUint8Uint16), e)
+						r.EncodeSymbol(`FptrMapUint64Int`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1350 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint8uint16((map[uint8]uint16)(x.FMapUint8Uint16), e)
+						if x.FptrMapUint64Int == nil {
+							r.EncodeNil()
+						} else {
+							yy1353 := *x.FptrMapUint64Int
+							if false {
+							} else {
+								h.encMapuint64int((map[uint64]int)(yy1353), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn934 bool
-			if x.FptrMapUint8Uint16 == nil {
-				yyn934 = true
-				goto LABEL934
-			}
-		LABEL934:
-			if yyr2 || yy2arr2 {
-				if yyn934 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint8Uint16 == nil {
+					if x.FMapUint64Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy935 := *x.FptrMapUint8Uint16
 						if false {
 						} else {
-							h.encMapuint8uint16((map[uint8]uint16)(yy935), e)
+							h.encMapuint64int8((map[uint64]int8)(x.FMapUint64Int8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Uint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn934 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint8Uint16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Int8\"")
+					} else {
+						r.EncodeSymbol(`FMapUint64Int8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint64Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy937 := *x.FptrMapUint8Uint16
 						if false {
 						} else {
-							h.encMapuint8uint16((map[uint8]uint16)(yy937), e)
+							h.encMapuint64int8((map[uint64]int8)(x.FMapUint64Int8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1358 bool
+				if x.FptrMapUint64Int8 == nil {
+					yyn1358 = true
+					goto LABEL1358
+				}
+			LABEL1358:
+				if yyr2 || yy2arr2 {
+					if yyn1358 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint8uint32((map[uint8]uint32)(x.FMapUint8Uint32), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint64Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1359 := *x.FptrMapUint64Int8
+							if false {
+							} else {
+								h.encMapuint64int8((map[uint64]int8)(yy1359), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Uint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Int8\"")
 					} else {
-						h.encMapuint8uint32((map[uint8]uint32)(x.FMapUint8Uint32), e)
+						r.EncodeSymbol(`FptrMapUint64Int8`)
 					}
-				}
-			}
-			var yyn942 bool
-			if x.FptrMapUint8Uint32 == nil {
-				yyn942 = true
-				goto LABEL942
-			}
-		LABEL942:
-			if yyr2 || yy2arr2 {
-				if yyn942 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Uint32 == nil {
+					r.WriteMapElemValue()
+					if yyn1358 {
 						r.EncodeNil()
 					} else {
-						yy943 := *x.FptrMapUint8Uint32
-						if false {
+						if x.FptrMapUint64Int8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8uint32((map[uint8]uint32)(yy943), e)
+							yy1361 := *x.FptrMapUint64Int8
+							if false {
+							} else {
+								h.encMapuint64int8((map[uint64]int8)(yy1361), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Uint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn942 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Uint32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint64Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy945 := *x.FptrMapUint8Uint32
 						if false {
 						} else {
-							h.encMapuint8uint32((map[uint8]uint32)(yy945), e)
+							h.encMapuint64int16((map[uint64]int16)(x.FMapUint64Int16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint8uint64((map[uint8]uint64)(x.FMapUint8Uint64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Uint64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Int16\"")
 					} else {
-						h.encMapuint8uint64((map[uint8]uint64)(x.FMapUint8Uint64), e)
+						r.EncodeSymbol(`FMapUint64Int16`)
 					}
-				}
-			}
-			var yyn950 bool
-			if x.FptrMapUint8Uint64 == nil {
-				yyn950 = true
-				goto LABEL950
-			}
-		LABEL950:
-			if yyr2 || yy2arr2 {
-				if yyn950 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Uint64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint64Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy951 := *x.FptrMapUint8Uint64
 						if false {
 						} else {
-							h.encMapuint8uint64((map[uint8]uint64)(yy951), e)
+							h.encMapuint64int16((map[uint64]int16)(x.FMapUint64Int16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Uint64`)
+				var yyn1366 bool
+				if x.FptrMapUint64Int16 == nil {
+					yyn1366 = true
+					goto LABEL1366
 				}
-				r.WriteMapElemValue()
-				if yyn950 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Uint64 == nil {
+			LABEL1366:
+				if yyr2 || yy2arr2 {
+					if yyn1366 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy953 := *x.FptrMapUint8Uint64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint64Int16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8uint64((map[uint8]uint64)(yy953), e)
+							yy1367 := *x.FptrMapUint64Int16
+							if false {
+							} else {
+								h.encMapuint64int16((map[uint64]int16)(yy1367), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Uintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Int16\"")
 					} else {
-						h.encMapuint8uintptr((map[uint8]uintptr)(x.FMapUint8Uintptr), e)
+						r.EncodeSymbol(`FptrMapUint64Int16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1366 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint8uintptr((map[uint8]uintptr)(x.FMapUint8Uintptr), e)
+						if x.FptrMapUint64Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1369 := *x.FptrMapUint64Int16
+							if false {
+							} else {
+								h.encMapuint64int16((map[uint64]int16)(yy1369), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn958 bool
-			if x.FptrMapUint8Uintptr == nil {
-				yyn958 = true
-				goto LABEL958
-			}
-		LABEL958:
-			if yyr2 || yy2arr2 {
-				if yyn958 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Uintptr == nil {
+					if x.FMapUint64Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy959 := *x.FptrMapUint8Uintptr
 						if false {
 						} else {
-							h.encMapuint8uintptr((map[uint8]uintptr)(yy959), e)
+							h.encMapuint64int32((map[uint64]int32)(x.FMapUint64Int32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Uintptr\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn958 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Uintptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Int32\"")
+					} else {
+						r.EncodeSymbol(`FMapUint64Int32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint64Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy961 := *x.FptrMapUint8Uintptr
 						if false {
 						} else {
-							h.encMapuint8uintptr((map[uint8]uintptr)(yy961), e)
+							h.encMapuint64int32((map[uint64]int32)(x.FMapUint64Int32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1374 bool
+				if x.FptrMapUint64Int32 == nil {
+					yyn1374 = true
+					goto LABEL1374
+				}
+			LABEL1374:
+				if yyr2 || yy2arr2 {
+					if yyn1374 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint8int((map[uint8]int)(x.FMapUint8Int), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint64Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1375 := *x.FptrMapUint64Int32
+							if false {
+							} else {
+								h.encMapuint64int32((map[uint64]int32)(yy1375), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Int\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Int32\"")
 					} else {
-						h.encMapuint8int((map[uint8]int)(x.FMapUint8Int), e)
+						r.EncodeSymbol(`FptrMapUint64Int32`)
 					}
-				}
-			}
-			var yyn966 bool
-			if x.FptrMapUint8Int == nil {
-				yyn966 = true
-				goto LABEL966
-			}
-		LABEL966:
-			if yyr2 || yy2arr2 {
-				if yyn966 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Int == nil {
+					r.WriteMapElemValue()
+					if yyn1374 {
 						r.EncodeNil()
 					} else {
-						yy967 := *x.FptrMapUint8Int
-						if false {
+						if x.FptrMapUint64Int32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8int((map[uint8]int)(yy967), e)
+							yy1377 := *x.FptrMapUint64Int32
+							if false {
+							} else {
+								h.encMapuint64int32((map[uint64]int32)(yy1377), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Int`)
-				}
-				r.WriteMapElemValue()
-				if yyn966 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Int == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint64Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy969 := *x.FptrMapUint8Int
 						if false {
 						} else {
-							h.encMapuint8int((map[uint8]int)(yy969), e)
+							h.encMapuint64int64((map[uint64]int64)(x.FMapUint64Int64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint8int8((map[uint8]int8)(x.FMapUint8Int8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Int64\"")
 					} else {
-						h.encMapuint8int8((map[uint8]int8)(x.FMapUint8Int8), e)
+						r.EncodeSymbol(`FMapUint64Int64`)
 					}
-				}
-			}
-			var yyn974 bool
-			if x.FptrMapUint8Int8 == nil {
-				yyn974 = true
-				goto LABEL974
-			}
-		LABEL974:
-			if yyr2 || yy2arr2 {
-				if yyn974 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Int8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint64Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy975 := *x.FptrMapUint8Int8
 						if false {
 						} else {
-							h.encMapuint8int8((map[uint8]int8)(yy975), e)
+							h.encMapuint64int64((map[uint64]int64)(x.FMapUint64Int64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Int8`)
+				var yyn1382 bool
+				if x.FptrMapUint64Int64 == nil {
+					yyn1382 = true
+					goto LABEL1382
 				}
-				r.WriteMapElemValue()
-				if yyn974 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Int8 == nil {
+			LABEL1382:
+				if yyr2 || yy2arr2 {
+					if yyn1382 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy977 := *x.FptrMapUint8Int8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint64Int64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8int8((map[uint8]int8)(yy977), e)
+							yy1383 := *x.FptrMapUint64Int64
+							if false {
+							} else {
+								h.encMapuint64int64((map[uint64]int64)(yy1383), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Int16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Int64\"")
 					} else {
-						h.encMapuint8int16((map[uint8]int16)(x.FMapUint8Int16), e)
+						r.EncodeSymbol(`FptrMapUint64Int64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1382 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint8int16((map[uint8]int16)(x.FMapUint8Int16), e)
+						if x.FptrMapUint64Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1385 := *x.FptrMapUint64Int64
+							if false {
+							} else {
+								h.encMapuint64int64((map[uint64]int64)(yy1385), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn982 bool
-			if x.FptrMapUint8Int16 == nil {
-				yyn982 = true
-				goto LABEL982
-			}
-		LABEL982:
-			if yyr2 || yy2arr2 {
-				if yyn982 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint8Int16 == nil {
+					if x.FMapUint64Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy983 := *x.FptrMapUint8Int16
 						if false {
 						} else {
-							h.encMapuint8int16((map[uint8]int16)(yy983), e)
+							h.encMapuint64float32((map[uint64]float32)(x.FMapUint64Float32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Int16`)
-				}
-				r.WriteMapElemValue()
-				if yyn982 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint8Int16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Float32\"")
+					} else {
+						r.EncodeSymbol(`FMapUint64Float32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint64Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy985 := *x.FptrMapUint8Int16
 						if false {
 						} else {
-							h.encMapuint8int16((map[uint8]int16)(yy985), e)
+							h.encMapuint64float32((map[uint64]float32)(x.FMapUint64Float32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1390 bool
+				if x.FptrMapUint64Float32 == nil {
+					yyn1390 = true
+					goto LABEL1390
+				}
+			LABEL1390:
+				if yyr2 || yy2arr2 {
+					if yyn1390 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint8int32((map[uint8]int32)(x.FMapUint8Int32), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint64Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1391 := *x.FptrMapUint64Float32
+							if false {
+							} else {
+								h.encMapuint64float32((map[uint64]float32)(yy1391), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Int32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Float32\"")
 					} else {
-						h.encMapuint8int32((map[uint8]int32)(x.FMapUint8Int32), e)
+						r.EncodeSymbol(`FptrMapUint64Float32`)
+					}
+					r.WriteMapElemValue()
+					if yyn1390 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapUint64Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1393 := *x.FptrMapUint64Float32
+							if false {
+							} else {
+								h.encMapuint64float32((map[uint64]float32)(yy1393), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn990 bool
-			if x.FptrMapUint8Int32 == nil {
-				yyn990 = true
-				goto LABEL990
-			}
-		LABEL990:
-			if yyr2 || yy2arr2 {
-				if yyn990 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint8Int32 == nil {
+					if x.FMapUint64Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy991 := *x.FptrMapUint8Int32
 						if false {
 						} else {
-							h.encMapuint8int32((map[uint8]int32)(yy991), e)
+							h.encMapuint64float64((map[uint64]float64)(x.FMapUint64Float64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Int32`)
-				}
-				r.WriteMapElemValue()
-				if yyn990 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint8Int32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Float64\"")
+					} else {
+						r.EncodeSymbol(`FMapUint64Float64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUint64Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy993 := *x.FptrMapUint8Int32
 						if false {
 						} else {
-							h.encMapuint8int32((map[uint8]int32)(yy993), e)
+							h.encMapuint64float64((map[uint64]float64)(x.FMapUint64Float64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1398 bool
+				if x.FptrMapUint64Float64 == nil {
+					yyn1398 = true
+					goto LABEL1398
+				}
+			LABEL1398:
+				if yyr2 || yy2arr2 {
+					if yyn1398 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint8int64((map[uint8]int64)(x.FMapUint8Int64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUint64Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1399 := *x.FptrMapUint64Float64
+							if false {
+							} else {
+								h.encMapuint64float64((map[uint64]float64)(yy1399), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Int64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Float64\"")
 					} else {
-						h.encMapuint8int64((map[uint8]int64)(x.FMapUint8Int64), e)
+						r.EncodeSymbol(`FptrMapUint64Float64`)
 					}
-				}
-			}
-			var yyn998 bool
-			if x.FptrMapUint8Int64 == nil {
-				yyn998 = true
-				goto LABEL998
-			}
-		LABEL998:
-			if yyr2 || yy2arr2 {
-				if yyn998 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Int64 == nil {
+					r.WriteMapElemValue()
+					if yyn1398 {
 						r.EncodeNil()
 					} else {
-						yy999 := *x.FptrMapUint8Int64
-						if false {
+						if x.FptrMapUint64Float64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8int64((map[uint8]int64)(yy999), e)
+							yy1401 := *x.FptrMapUint64Float64
+							if false {
+							} else {
+								h.encMapuint64float64((map[uint64]float64)(yy1401), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Int64`)
-				}
-				r.WriteMapElemValue()
-				if yyn998 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Int64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUint64Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy1001 := *x.FptrMapUint8Int64
 						if false {
 						} else {
-							h.encMapuint8int64((map[uint8]int64)(yy1001), e)
+							h.encMapuint64bool((map[uint64]bool)(x.FMapUint64Bool), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint8float32((map[uint8]float32)(x.FMapUint8Float32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Float32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUint64Bool\"")
 					} else {
-						h.encMapuint8float32((map[uint8]float32)(x.FMapUint8Float32), e)
+						r.EncodeSymbol(`FMapUint64Bool`)
 					}
-				}
-			}
-			var yyn1006 bool
-			if x.FptrMapUint8Float32 == nil {
-				yyn1006 = true
-				goto LABEL1006
-			}
-		LABEL1006:
-			if yyr2 || yy2arr2 {
-				if yyn1006 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Float32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUint64Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy1007 := *x.FptrMapUint8Float32
 						if false {
 						} else {
-							h.encMapuint8float32((map[uint8]float32)(yy1007), e)
+							h.encMapuint64bool((map[uint64]bool)(x.FMapUint64Bool), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Float32`)
+				var yyn1406 bool
+				if x.FptrMapUint64Bool == nil {
+					yyn1406 = true
+					goto LABEL1406
 				}
-				r.WriteMapElemValue()
-				if yyn1006 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Float32 == nil {
+			LABEL1406:
+				if yyr2 || yy2arr2 {
+					if yyn1406 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1009 := *x.FptrMapUint8Float32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUint64Bool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8float32((map[uint8]float32)(yy1009), e)
+							yy1407 := *x.FptrMapUint64Bool
+							if false {
+							} else {
+								h.encMapuint64bool((map[uint64]bool)(yy1407), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Float64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUint64Bool\"")
 					} else {
-						h.encMapuint8float64((map[uint8]float64)(x.FMapUint8Float64), e)
+						r.EncodeSymbol(`FptrMapUint64Bool`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1406 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint8float64((map[uint8]float64)(x.FMapUint8Float64), e)
+						if x.FptrMapUint64Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy1409 := *x.FptrMapUint64Bool
+							if false {
+							} else {
+								h.encMapuint64bool((map[uint64]bool)(yy1409), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1014 bool
-			if x.FptrMapUint8Float64 == nil {
-				yyn1014 = true
-				goto LABEL1014
-			}
-		LABEL1014:
-			if yyr2 || yy2arr2 {
-				if yyn1014 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint8Float64 == nil {
+					if x.FMapUintptrIntf == nil {
 						r.EncodeNil()
 					} else {
-						yy1015 := *x.FptrMapUint8Float64
 						if false {
 						} else {
-							h.encMapuint8float64((map[uint8]float64)(yy1015), e)
+							h.encMapuintptrInterface((map[uintptr]interface{})(x.FMapUintptrIntf), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Float64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1014 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint8Float64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrIntf\"")
+					} else {
+						r.EncodeSymbol(`FMapUintptrIntf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintptrIntf == nil {
 						r.EncodeNil()
 					} else {
-						yy1017 := *x.FptrMapUint8Float64
 						if false {
 						} else {
-							h.encMapuint8float64((map[uint8]float64)(yy1017), e)
+							h.encMapuintptrInterface((map[uintptr]interface{})(x.FMapUintptrIntf), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint8Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1414 bool
+				if x.FptrMapUintptrIntf == nil {
+					yyn1414 = true
+					goto LABEL1414
+				}
+			LABEL1414:
+				if yyr2 || yy2arr2 {
+					if yyn1414 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint8bool((map[uint8]bool)(x.FMapUint8Bool), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintptrIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy1415 := *x.FptrMapUintptrIntf
+							if false {
+							} else {
+								h.encMapuintptrInterface((map[uintptr]interface{})(yy1415), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint8Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint8Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint8Bool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrIntf\"")
 					} else {
-						h.encMapuint8bool((map[uint8]bool)(x.FMapUint8Bool), e)
+						r.EncodeSymbol(`FptrMapUintptrIntf`)
 					}
-				}
-			}
-			var yyn1022 bool
-			if x.FptrMapUint8Bool == nil {
-				yyn1022 = true
-				goto LABEL1022
-			}
-		LABEL1022:
-			if yyr2 || yy2arr2 {
-				if yyn1022 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint8Bool == nil {
+					r.WriteMapElemValue()
+					if yyn1414 {
 						r.EncodeNil()
 					} else {
-						yy1023 := *x.FptrMapUint8Bool
-						if false {
+						if x.FptrMapUintptrIntf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint8bool((map[uint8]bool)(yy1023), e)
+							yy1417 := *x.FptrMapUintptrIntf
+							if false {
+							} else {
+								h.encMapuintptrInterface((map[uintptr]interface{})(yy1417), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint8Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint8Bool`)
-				}
-				r.WriteMapElemValue()
-				if yyn1022 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint8Bool == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintptrString == nil {
 						r.EncodeNil()
 					} else {
-						yy1025 := *x.FptrMapUint8Bool
 						if false {
 						} else {
-							h.encMapuint8bool((map[uint8]bool)(yy1025), e)
+							h.encMapuintptrstring((map[uintptr]string)(x.FMapUintptrString), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint16Interface((map[uint16]interface{})(x.FMapUint16Intf), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrString\"")
 					} else {
-						h.encMapuint16Interface((map[uint16]interface{})(x.FMapUint16Intf), e)
+						r.EncodeSymbol(`FMapUintptrString`)
 					}
-				}
-			}
-			var yyn1030 bool
-			if x.FptrMapUint16Intf == nil {
-				yyn1030 = true
-				goto LABEL1030
-			}
-		LABEL1030:
-			if yyr2 || yy2arr2 {
-				if yyn1030 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Intf == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintptrString == nil {
 						r.EncodeNil()
 					} else {
-						yy1031 := *x.FptrMapUint16Intf
 						if false {
 						} else {
-							h.encMapuint16Interface((map[uint16]interface{})(yy1031), e)
+							h.encMapuintptrstring((map[uintptr]string)(x.FMapUintptrString), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Intf`)
+				var yyn1422 bool
+				if x.FptrMapUintptrString == nil {
+					yyn1422 = true
+					goto LABEL1422
 				}
-				r.WriteMapElemValue()
-				if yyn1030 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Intf == nil {
+			LABEL1422:
+				if yyr2 || yy2arr2 {
+					if yyn1422 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1033 := *x.FptrMapUint16Intf
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintptrString == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16Interface((map[uint16]interface{})(yy1033), e)
+							yy1423 := *x.FptrMapUintptrString
+							if false {
+							} else {
+								h.encMapuintptrstring((map[uintptr]string)(yy1423), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16String == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrString\"")
 					} else {
-						h.encMapuint16string((map[uint16]string)(x.FMapUint16String), e)
+						r.EncodeSymbol(`FptrMapUintptrString`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1422 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint16string((map[uint16]string)(x.FMapUint16String), e)
+						if x.FptrMapUintptrString == nil {
+							r.EncodeNil()
+						} else {
+							yy1425 := *x.FptrMapUintptrString
+							if false {
+							} else {
+								h.encMapuintptrstring((map[uintptr]string)(yy1425), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1038 bool
-			if x.FptrMapUint16String == nil {
-				yyn1038 = true
-				goto LABEL1038
-			}
-		LABEL1038:
-			if yyr2 || yy2arr2 {
-				if yyn1038 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint16String == nil {
+					if x.FMapUintptrUint == nil {
 						r.EncodeNil()
 					} else {
-						yy1039 := *x.FptrMapUint16String
 						if false {
 						} else {
-							h.encMapuint16string((map[uint16]string)(yy1039), e)
+							h.encMapuintptruint((map[uintptr]uint)(x.FMapUintptrUint), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16String`)
-				}
-				r.WriteMapElemValue()
-				if yyn1038 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint16String == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrUint\"")
+					} else {
+						r.EncodeSymbol(`FMapUintptrUint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintptrUint == nil {
 						r.EncodeNil()
 					} else {
-						yy1041 := *x.FptrMapUint16String
 						if false {
 						} else {
-							h.encMapuint16string((map[uint16]string)(yy1041), e)
+							h.encMapuintptruint((map[uintptr]uint)(x.FMapUintptrUint), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1430 bool
+				if x.FptrMapUintptrUint == nil {
+					yyn1430 = true
+					goto LABEL1430
+				}
+			LABEL1430:
+				if yyr2 || yy2arr2 {
+					if yyn1430 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint16uint((map[uint16]uint)(x.FMapUint16Uint), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintptrUint == nil {
+							r.EncodeNil()
+						} else {
+							yy1431 := *x.FptrMapUintptrUint
+							if false {
+							} else {
+								h.encMapuintptruint((map[uintptr]uint)(yy1431), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrUint\"")
 					} else {
-						h.encMapuint16uint((map[uint16]uint)(x.FMapUint16Uint), e)
+						r.EncodeSymbol(`FptrMapUintptrUint`)
 					}
-				}
-			}
-			var yyn1046 bool
-			if x.FptrMapUint16Uint == nil {
-				yyn1046 = true
-				goto LABEL1046
-			}
-		LABEL1046:
-			if yyr2 || yy2arr2 {
-				if yyn1046 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Uint == nil {
+					r.WriteMapElemValue()
+					if yyn1430 {
 						r.EncodeNil()
 					} else {
-						yy1047 := *x.FptrMapUint16Uint
-						if false {
+						if x.FptrMapUintptrUint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16uint((map[uint16]uint)(yy1047), e)
+							yy1433 := *x.FptrMapUintptrUint
+							if false {
+							} else {
+								h.encMapuintptruint((map[uintptr]uint)(yy1433), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Uint`)
-				}
-				r.WriteMapElemValue()
-				if yyn1046 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Uint == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintptrUint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1049 := *x.FptrMapUint16Uint
 						if false {
 						} else {
-							h.encMapuint16uint((map[uint16]uint)(yy1049), e)
+							h.encMapuintptruint8((map[uintptr]uint8)(x.FMapUintptrUint8), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint16uint8((map[uint16]uint8)(x.FMapUint16Uint8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Uint8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrUint8\"")
 					} else {
-						h.encMapuint16uint8((map[uint16]uint8)(x.FMapUint16Uint8), e)
+						r.EncodeSymbol(`FMapUintptrUint8`)
 					}
-				}
-			}
-			var yyn1054 bool
-			if x.FptrMapUint16Uint8 == nil {
-				yyn1054 = true
-				goto LABEL1054
-			}
-		LABEL1054:
-			if yyr2 || yy2arr2 {
-				if yyn1054 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Uint8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintptrUint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1055 := *x.FptrMapUint16Uint8
 						if false {
 						} else {
-							h.encMapuint16uint8((map[uint16]uint8)(yy1055), e)
+							h.encMapuintptruint8((map[uintptr]uint8)(x.FMapUintptrUint8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Uint8`)
+				var yyn1438 bool
+				if x.FptrMapUintptrUint8 == nil {
+					yyn1438 = true
+					goto LABEL1438
 				}
-				r.WriteMapElemValue()
-				if yyn1054 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Uint8 == nil {
+			LABEL1438:
+				if yyr2 || yy2arr2 {
+					if yyn1438 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1057 := *x.FptrMapUint16Uint8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintptrUint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16uint8((map[uint16]uint8)(yy1057), e)
+							yy1439 := *x.FptrMapUintptrUint8
+							if false {
+							} else {
+								h.encMapuintptruint8((map[uintptr]uint8)(yy1439), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Uint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrUint8\"")
 					} else {
-						h.encMapuint16uint16((map[uint16]uint16)(x.FMapUint16Uint16), e)
+						r.EncodeSymbol(`FptrMapUintptrUint8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1438 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint16uint16((map[uint16]uint16)(x.FMapUint16Uint16), e)
+						if x.FptrMapUintptrUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1441 := *x.FptrMapUintptrUint8
+							if false {
+							} else {
+								h.encMapuintptruint8((map[uintptr]uint8)(yy1441), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1062 bool
-			if x.FptrMapUint16Uint16 == nil {
-				yyn1062 = true
-				goto LABEL1062
-			}
-		LABEL1062:
-			if yyr2 || yy2arr2 {
-				if yyn1062 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Uint16 == nil {
+					if x.FMapUintptrUint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1063 := *x.FptrMapUint16Uint16
 						if false {
 						} else {
-							h.encMapuint16uint16((map[uint16]uint16)(yy1063), e)
+							h.encMapuintptruint16((map[uintptr]uint16)(x.FMapUintptrUint16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Uint16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Uint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn1062 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Uint16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrUint16\"")
+					} else {
+						r.EncodeSymbol(`FMapUintptrUint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintptrUint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1065 := *x.FptrMapUint16Uint16
 						if false {
 						} else {
-							h.encMapuint16uint16((map[uint16]uint16)(yy1065), e)
+							h.encMapuintptruint16((map[uintptr]uint16)(x.FMapUintptrUint16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1446 bool
+				if x.FptrMapUintptrUint16 == nil {
+					yyn1446 = true
+					goto LABEL1446
+				}
+			LABEL1446:
+				if yyr2 || yy2arr2 {
+					if yyn1446 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint16uint32((map[uint16]uint32)(x.FMapUint16Uint32), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintptrUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1447 := *x.FptrMapUintptrUint16
+							if false {
+							} else {
+								h.encMapuintptruint16((map[uintptr]uint16)(yy1447), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Uint32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrUint16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUintptrUint16`)
+					}
+					r.WriteMapElemValue()
+					if yyn1446 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint16uint32((map[uint16]uint32)(x.FMapUint16Uint32), e)
+						if x.FptrMapUintptrUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1449 := *x.FptrMapUintptrUint16
+							if false {
+							} else {
+								h.encMapuintptruint16((map[uintptr]uint16)(yy1449), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1070 bool
-			if x.FptrMapUint16Uint32 == nil {
-				yyn1070 = true
-				goto LABEL1070
-			}
-		LABEL1070:
-			if yyr2 || yy2arr2 {
-				if yyn1070 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Uint32 == nil {
+					if x.FMapUintptrUint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1071 := *x.FptrMapUint16Uint32
 						if false {
 						} else {
-							h.encMapuint16uint32((map[uint16]uint32)(yy1071), e)
+							h.encMapuintptruint32((map[uintptr]uint32)(x.FMapUintptrUint32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Uint32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Uint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1070 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Uint32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrUint32\"")
+					} else {
+						r.EncodeSymbol(`FMapUintptrUint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintptrUint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1073 := *x.FptrMapUint16Uint32
 						if false {
 						} else {
-							h.encMapuint16uint32((map[uint16]uint32)(yy1073), e)
+							h.encMapuintptruint32((map[uintptr]uint32)(x.FMapUintptrUint32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1454 bool
+				if x.FptrMapUintptrUint32 == nil {
+					yyn1454 = true
+					goto LABEL1454
+				}
+			LABEL1454:
+				if yyr2 || yy2arr2 {
+					if yyn1454 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint16uint64((map[uint16]uint64)(x.FMapUint16Uint64), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintptrUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1455 := *x.FptrMapUintptrUint32
+							if false {
+							} else {
+								h.encMapuintptruint32((map[uintptr]uint32)(yy1455), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Uint64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrUint32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapUintptrUint32`)
+					}
+					r.WriteMapElemValue()
+					if yyn1454 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint16uint64((map[uint16]uint64)(x.FMapUint16Uint64), e)
+						if x.FptrMapUintptrUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1457 := *x.FptrMapUintptrUint32
+							if false {
+							} else {
+								h.encMapuintptruint32((map[uintptr]uint32)(yy1457), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1078 bool
-			if x.FptrMapUint16Uint64 == nil {
-				yyn1078 = true
-				goto LABEL1078
-			}
-		LABEL1078:
-			if yyr2 || yy2arr2 {
-				if yyn1078 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.FMapUintptrUint64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapuintptruint64((map[uintptr]uint64)(x.FMapUintptrUint64), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Uint64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrUint64\"")
+					} else {
+						r.EncodeSymbol(`FMapUintptrUint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintptrUint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1079 := *x.FptrMapUint16Uint64
 						if false {
 						} else {
-							h.encMapuint16uint64((map[uint16]uint64)(yy1079), e)
+							h.encMapuintptruint64((map[uintptr]uint64)(x.FMapUintptrUint64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Uint64`)
+				var yyn1462 bool
+				if x.FptrMapUintptrUint64 == nil {
+					yyn1462 = true
+					goto LABEL1462
 				}
-				r.WriteMapElemValue()
-				if yyn1078 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Uint64 == nil {
+			LABEL1462:
+				if yyr2 || yy2arr2 {
+					if yyn1462 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1081 := *x.FptrMapUint16Uint64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintptrUint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16uint64((map[uint16]uint64)(yy1081), e)
+							yy1463 := *x.FptrMapUintptrUint64
+							if false {
+							} else {
+								h.encMapuintptruint64((map[uintptr]uint64)(yy1463), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint16uintptr((map[uint16]uintptr)(x.FMapUint16Uintptr), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Uintptr\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrUint64\"")
 					} else {
-						h.encMapuint16uintptr((map[uint16]uintptr)(x.FMapUint16Uintptr), e)
+						r.EncodeSymbol(`FptrMapUintptrUint64`)
 					}
-				}
-			}
-			var yyn1086 bool
-			if x.FptrMapUint16Uintptr == nil {
-				yyn1086 = true
-				goto LABEL1086
-			}
-		LABEL1086:
-			if yyr2 || yy2arr2 {
-				if yyn1086 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Uintptr == nil {
+					r.WriteMapElemValue()
+					if yyn1462 {
 						r.EncodeNil()
 					} else {
-						yy1087 := *x.FptrMapUint16Uintptr
-						if false {
+						if x.FptrMapUintptrUint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16uintptr((map[uint16]uintptr)(yy1087), e)
+							yy1465 := *x.FptrMapUintptrUint64
+							if false {
+							} else {
+								h.encMapuintptruint64((map[uintptr]uint64)(yy1465), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn1086 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Uintptr == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintptrUintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1089 := *x.FptrMapUint16Uintptr
 						if false {
 						} else {
-							h.encMapuint16uintptr((map[uint16]uintptr)(yy1089), e)
+							h.encMapuintptruintptr((map[uintptr]uintptr)(x.FMapUintptrUintptr), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint16int((map[uint16]int)(x.FMapUint16Int), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Int == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrUintptr\"")
 					} else {
-						h.encMapuint16int((map[uint16]int)(x.FMapUint16Int), e)
+						r.EncodeSymbol(`FMapUintptrUintptr`)
 					}
-				}
-			}
-			var yyn1094 bool
-			if x.FptrMapUint16Int == nil {
-				yyn1094 = true
-				goto LABEL1094
-			}
-		LABEL1094:
-			if yyr2 || yy2arr2 {
-				if yyn1094 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Int == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintptrUintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1095 := *x.FptrMapUint16Int
 						if false {
 						} else {
-							h.encMapuint16int((map[uint16]int)(yy1095), e)
+							h.encMapuintptruintptr((map[uintptr]uintptr)(x.FMapUintptrUintptr), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Int`)
+				var yyn1470 bool
+				if x.FptrMapUintptrUintptr == nil {
+					yyn1470 = true
+					goto LABEL1470
 				}
-				r.WriteMapElemValue()
-				if yyn1094 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Int == nil {
+			LABEL1470:
+				if yyr2 || yy2arr2 {
+					if yyn1470 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1097 := *x.FptrMapUint16Int
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintptrUintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16int((map[uint16]int)(yy1097), e)
+							yy1471 := *x.FptrMapUintptrUintptr
+							if false {
+							} else {
+								h.encMapuintptruintptr((map[uintptr]uintptr)(yy1471), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrUintptr\"")
 					} else {
-						h.encMapuint16int8((map[uint16]int8)(x.FMapUint16Int8), e)
+						r.EncodeSymbol(`FptrMapUintptrUintptr`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1470 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint16int8((map[uint16]int8)(x.FMapUint16Int8), e)
+						if x.FptrMapUintptrUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy1473 := *x.FptrMapUintptrUintptr
+							if false {
+							} else {
+								h.encMapuintptruintptr((map[uintptr]uintptr)(yy1473), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1102 bool
-			if x.FptrMapUint16Int8 == nil {
-				yyn1102 = true
-				goto LABEL1102
-			}
-		LABEL1102:
-			if yyr2 || yy2arr2 {
-				if yyn1102 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint16Int8 == nil {
+					if x.FMapUintptrInt == nil {
 						r.EncodeNil()
 					} else {
-						yy1103 := *x.FptrMapUint16Int8
 						if false {
 						} else {
-							h.encMapuint16int8((map[uint16]int8)(yy1103), e)
+							h.encMapuintptrint((map[uintptr]int)(x.FMapUintptrInt), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Int8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1102 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint16Int8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrInt\"")
+					} else {
+						r.EncodeSymbol(`FMapUintptrInt`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintptrInt == nil {
 						r.EncodeNil()
 					} else {
-						yy1105 := *x.FptrMapUint16Int8
 						if false {
 						} else {
-							h.encMapuint16int8((map[uint16]int8)(yy1105), e)
+							h.encMapuintptrint((map[uintptr]int)(x.FMapUintptrInt), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1478 bool
+				if x.FptrMapUintptrInt == nil {
+					yyn1478 = true
+					goto LABEL1478
+				}
+			LABEL1478:
+				if yyr2 || yy2arr2 {
+					if yyn1478 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint16int16((map[uint16]int16)(x.FMapUint16Int16), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintptrInt == nil {
+							r.EncodeNil()
+						} else {
+							yy1479 := *x.FptrMapUintptrInt
+							if false {
+							} else {
+								h.encMapuintptrint((map[uintptr]int)(yy1479), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Int16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrInt\"")
 					} else {
-						h.encMapuint16int16((map[uint16]int16)(x.FMapUint16Int16), e)
+						r.EncodeSymbol(`FptrMapUintptrInt`)
 					}
-				}
-			}
-			var yyn1110 bool
-			if x.FptrMapUint16Int16 == nil {
-				yyn1110 = true
-				goto LABEL1110
-			}
-		LABEL1110:
-			if yyr2 || yy2arr2 {
-				if yyn1110 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Int16 == nil {
+					r.WriteMapElemValue()
+					if yyn1478 {
 						r.EncodeNil()
 					} else {
-						yy1111 := *x.FptrMapUint16Int16
-						if false {
+						if x.FptrMapUintptrInt == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16int16((map[uint16]int16)(yy1111), e)
+							yy1481 := *x.FptrMapUintptrInt
+							if false {
+							} else {
+								h.encMapuintptrint((map[uintptr]int)(yy1481), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Int16`)
-				}
-				r.WriteMapElemValue()
-				if yyn1110 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Int16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintptrInt8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1113 := *x.FptrMapUint16Int16
 						if false {
 						} else {
-							h.encMapuint16int16((map[uint16]int16)(yy1113), e)
+							h.encMapuintptrint8((map[uintptr]int8)(x.FMapUintptrInt8), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint16int32((map[uint16]int32)(x.FMapUint16Int32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Int32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrInt8\"")
 					} else {
-						h.encMapuint16int32((map[uint16]int32)(x.FMapUint16Int32), e)
+						r.EncodeSymbol(`FMapUintptrInt8`)
 					}
-				}
-			}
-			var yyn1118 bool
-			if x.FptrMapUint16Int32 == nil {
-				yyn1118 = true
-				goto LABEL1118
-			}
-		LABEL1118:
-			if yyr2 || yy2arr2 {
-				if yyn1118 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Int32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintptrInt8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1119 := *x.FptrMapUint16Int32
 						if false {
 						} else {
-							h.encMapuint16int32((map[uint16]int32)(yy1119), e)
+							h.encMapuintptrint8((map[uintptr]int8)(x.FMapUintptrInt8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Int32`)
+				var yyn1486 bool
+				if x.FptrMapUintptrInt8 == nil {
+					yyn1486 = true
+					goto LABEL1486
 				}
-				r.WriteMapElemValue()
-				if yyn1118 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Int32 == nil {
+			LABEL1486:
+				if yyr2 || yy2arr2 {
+					if yyn1486 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1121 := *x.FptrMapUint16Int32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintptrInt8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16int32((map[uint16]int32)(yy1121), e)
+							yy1487 := *x.FptrMapUintptrInt8
+							if false {
+							} else {
+								h.encMapuintptrint8((map[uintptr]int8)(yy1487), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Int64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrInt8\"")
 					} else {
-						h.encMapuint16int64((map[uint16]int64)(x.FMapUint16Int64), e)
+						r.EncodeSymbol(`FptrMapUintptrInt8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1486 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint16int64((map[uint16]int64)(x.FMapUint16Int64), e)
+						if x.FptrMapUintptrInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1489 := *x.FptrMapUintptrInt8
+							if false {
+							} else {
+								h.encMapuintptrint8((map[uintptr]int8)(yy1489), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1126 bool
-			if x.FptrMapUint16Int64 == nil {
-				yyn1126 = true
-				goto LABEL1126
-			}
-		LABEL1126:
-			if yyr2 || yy2arr2 {
-				if yyn1126 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint16Int64 == nil {
+					if x.FMapUintptrInt16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1127 := *x.FptrMapUint16Int64
 						if false {
 						} else {
-							h.encMapuint16int64((map[uint16]int64)(yy1127), e)
+							h.encMapuintptrint16((map[uintptr]int16)(x.FMapUintptrInt16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Int64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1126 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint16Int64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrInt16\"")
+					} else {
+						r.EncodeSymbol(`FMapUintptrInt16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintptrInt16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1129 := *x.FptrMapUint16Int64
 						if false {
 						} else {
-							h.encMapuint16int64((map[uint16]int64)(yy1129), e)
+							h.encMapuintptrint16((map[uintptr]int16)(x.FMapUintptrInt16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1494 bool
+				if x.FptrMapUintptrInt16 == nil {
+					yyn1494 = true
+					goto LABEL1494
+				}
+			LABEL1494:
+				if yyr2 || yy2arr2 {
+					if yyn1494 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint16float32((map[uint16]float32)(x.FMapUint16Float32), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintptrInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1495 := *x.FptrMapUintptrInt16
+							if false {
+							} else {
+								h.encMapuintptrint16((map[uintptr]int16)(yy1495), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Float32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrInt16\"")
 					} else {
-						h.encMapuint16float32((map[uint16]float32)(x.FMapUint16Float32), e)
+						r.EncodeSymbol(`FptrMapUintptrInt16`)
 					}
-				}
-			}
-			var yyn1134 bool
-			if x.FptrMapUint16Float32 == nil {
-				yyn1134 = true
-				goto LABEL1134
-			}
-		LABEL1134:
-			if yyr2 || yy2arr2 {
-				if yyn1134 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Float32 == nil {
+					r.WriteMapElemValue()
+					if yyn1494 {
 						r.EncodeNil()
 					} else {
-						yy1135 := *x.FptrMapUint16Float32
-						if false {
+						if x.FptrMapUintptrInt16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16float32((map[uint16]float32)(yy1135), e)
+							yy1497 := *x.FptrMapUintptrInt16
+							if false {
+							} else {
+								h.encMapuintptrint16((map[uintptr]int16)(yy1497), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Float32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1134 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Float32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintptrInt32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1137 := *x.FptrMapUint16Float32
 						if false {
 						} else {
-							h.encMapuint16float32((map[uint16]float32)(yy1137), e)
+							h.encMapuintptrint32((map[uintptr]int32)(x.FMapUintptrInt32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint16float64((map[uint16]float64)(x.FMapUint16Float64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Float64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrInt32\"")
 					} else {
-						h.encMapuint16float64((map[uint16]float64)(x.FMapUint16Float64), e)
+						r.EncodeSymbol(`FMapUintptrInt32`)
 					}
-				}
-			}
-			var yyn1142 bool
-			if x.FptrMapUint16Float64 == nil {
-				yyn1142 = true
-				goto LABEL1142
-			}
-		LABEL1142:
-			if yyr2 || yy2arr2 {
-				if yyn1142 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint16Float64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintptrInt32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1143 := *x.FptrMapUint16Float64
 						if false {
 						} else {
-							h.encMapuint16float64((map[uint16]float64)(yy1143), e)
+							h.encMapuintptrint32((map[uintptr]int32)(x.FMapUintptrInt32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Float64`)
+				var yyn1502 bool
+				if x.FptrMapUintptrInt32 == nil {
+					yyn1502 = true
+					goto LABEL1502
 				}
-				r.WriteMapElemValue()
-				if yyn1142 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint16Float64 == nil {
+			LABEL1502:
+				if yyr2 || yy2arr2 {
+					if yyn1502 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1145 := *x.FptrMapUint16Float64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintptrInt32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint16float64((map[uint16]float64)(yy1145), e)
+							yy1503 := *x.FptrMapUintptrInt32
+							if false {
+							} else {
+								h.encMapuintptrint32((map[uintptr]int32)(yy1503), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint16Bool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrInt32\"")
 					} else {
-						h.encMapuint16bool((map[uint16]bool)(x.FMapUint16Bool), e)
+						r.EncodeSymbol(`FptrMapUintptrInt32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint16Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint16Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint16Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1502 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint16bool((map[uint16]bool)(x.FMapUint16Bool), e)
+						if x.FptrMapUintptrInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1505 := *x.FptrMapUintptrInt32
+							if false {
+							} else {
+								h.encMapuintptrint32((map[uintptr]int32)(yy1505), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1150 bool
-			if x.FptrMapUint16Bool == nil {
-				yyn1150 = true
-				goto LABEL1150
-			}
-		LABEL1150:
-			if yyr2 || yy2arr2 {
-				if yyn1150 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint16Bool == nil {
+					if x.FMapUintptrInt64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1151 := *x.FptrMapUint16Bool
 						if false {
 						} else {
-							h.encMapuint16bool((map[uint16]bool)(yy1151), e)
+							h.encMapuintptrint64((map[uintptr]int64)(x.FMapUintptrInt64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint16Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint16Bool`)
-				}
-				r.WriteMapElemValue()
-				if yyn1150 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint16Bool == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrInt64\"")
+					} else {
+						r.EncodeSymbol(`FMapUintptrInt64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintptrInt64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1153 := *x.FptrMapUint16Bool
 						if false {
 						} else {
-							h.encMapuint16bool((map[uint16]bool)(yy1153), e)
+							h.encMapuintptrint64((map[uintptr]int64)(x.FMapUintptrInt64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1510 bool
+				if x.FptrMapUintptrInt64 == nil {
+					yyn1510 = true
+					goto LABEL1510
+				}
+			LABEL1510:
+				if yyr2 || yy2arr2 {
+					if yyn1510 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint32Interface((map[uint32]interface{})(x.FMapUint32Intf), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintptrInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1511 := *x.FptrMapUintptrInt64
+							if false {
+							} else {
+								h.encMapuintptrint64((map[uintptr]int64)(yy1511), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrInt64\"")
 					} else {
-						h.encMapuint32Interface((map[uint32]interface{})(x.FMapUint32Intf), e)
+						r.EncodeSymbol(`FptrMapUintptrInt64`)
 					}
-				}
-			}
-			var yyn1158 bool
-			if x.FptrMapUint32Intf == nil {
-				yyn1158 = true
-				goto LABEL1158
-			}
-		LABEL1158:
-			if yyr2 || yy2arr2 {
-				if yyn1158 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Intf == nil {
+					r.WriteMapElemValue()
+					if yyn1510 {
 						r.EncodeNil()
 					} else {
-						yy1159 := *x.FptrMapUint32Intf
-						if false {
+						if x.FptrMapUintptrInt64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32Interface((map[uint32]interface{})(yy1159), e)
+							yy1513 := *x.FptrMapUintptrInt64
+							if false {
+							} else {
+								h.encMapuintptrint64((map[uintptr]int64)(yy1513), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Intf`)
-				}
-				r.WriteMapElemValue()
-				if yyn1158 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Intf == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintptrFloat32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1161 := *x.FptrMapUint32Intf
 						if false {
 						} else {
-							h.encMapuint32Interface((map[uint32]interface{})(yy1161), e)
+							h.encMapuintptrfloat32((map[uintptr]float32)(x.FMapUintptrFloat32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint32string((map[uint32]string)(x.FMapUint32String), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32String\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrFloat32\"")
 					} else {
-						h.encMapuint32string((map[uint32]string)(x.FMapUint32String), e)
+						r.EncodeSymbol(`FMapUintptrFloat32`)
 					}
-				}
-			}
-			var yyn1166 bool
-			if x.FptrMapUint32String == nil {
-				yyn1166 = true
-				goto LABEL1166
-			}
-		LABEL1166:
-			if yyr2 || yy2arr2 {
-				if yyn1166 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32String == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintptrFloat32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1167 := *x.FptrMapUint32String
 						if false {
 						} else {
-							h.encMapuint32string((map[uint32]string)(yy1167), e)
+							h.encMapuintptrfloat32((map[uintptr]float32)(x.FMapUintptrFloat32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32String`)
+				var yyn1518 bool
+				if x.FptrMapUintptrFloat32 == nil {
+					yyn1518 = true
+					goto LABEL1518
 				}
-				r.WriteMapElemValue()
-				if yyn1166 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32String == nil {
+			LABEL1518:
+				if yyr2 || yy2arr2 {
+					if yyn1518 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1169 := *x.FptrMapUint32String
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintptrFloat32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32string((map[uint32]string)(yy1169), e)
+							yy1519 := *x.FptrMapUintptrFloat32
+							if false {
+							} else {
+								h.encMapuintptrfloat32((map[uintptr]float32)(yy1519), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrFloat32\"")
 					} else {
-						h.encMapuint32uint((map[uint32]uint)(x.FMapUint32Uint), e)
+						r.EncodeSymbol(`FptrMapUintptrFloat32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1518 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint32uint((map[uint32]uint)(x.FMapUint32Uint), e)
+						if x.FptrMapUintptrFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1521 := *x.FptrMapUintptrFloat32
+							if false {
+							} else {
+								h.encMapuintptrfloat32((map[uintptr]float32)(yy1521), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1174 bool
-			if x.FptrMapUint32Uint == nil {
-				yyn1174 = true
-				goto LABEL1174
-			}
-		LABEL1174:
-			if yyr2 || yy2arr2 {
-				if yyn1174 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Uint == nil {
+					if x.FMapUintptrFloat64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1175 := *x.FptrMapUint32Uint
 						if false {
 						} else {
-							h.encMapuint32uint((map[uint32]uint)(yy1175), e)
+							h.encMapuintptrfloat64((map[uintptr]float64)(x.FMapUintptrFloat64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Uint\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Uint`)
-				}
-				r.WriteMapElemValue()
-				if yyn1174 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Uint == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrFloat64\"")
+					} else {
+						r.EncodeSymbol(`FMapUintptrFloat64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapUintptrFloat64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1177 := *x.FptrMapUint32Uint
 						if false {
 						} else {
-							h.encMapuint32uint((map[uint32]uint)(yy1177), e)
+							h.encMapuintptrfloat64((map[uintptr]float64)(x.FMapUintptrFloat64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1526 bool
+				if x.FptrMapUintptrFloat64 == nil {
+					yyn1526 = true
+					goto LABEL1526
+				}
+			LABEL1526:
+				if yyr2 || yy2arr2 {
+					if yyn1526 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint32uint8((map[uint32]uint8)(x.FMapUint32Uint8), e)
+						r.WriteArrayElem()
+						if x.FptrMapUintptrFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1527 := *x.FptrMapUintptrFloat64
+							if false {
+							} else {
+								h.encMapuintptrfloat64((map[uintptr]float64)(yy1527), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Uint8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrFloat64\"")
 					} else {
-						h.encMapuint32uint8((map[uint32]uint8)(x.FMapUint32Uint8), e)
+						r.EncodeSymbol(`FptrMapUintptrFloat64`)
 					}
-				}
-			}
-			var yyn1182 bool
-			if x.FptrMapUint32Uint8 == nil {
-				yyn1182 = true
-				goto LABEL1182
-			}
-		LABEL1182:
-			if yyr2 || yy2arr2 {
-				if yyn1182 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Uint8 == nil {
+					r.WriteMapElemValue()
+					if yyn1526 {
 						r.EncodeNil()
 					} else {
-						yy1183 := *x.FptrMapUint32Uint8
-						if false {
+						if x.FptrMapUintptrFloat64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32uint8((map[uint32]uint8)(yy1183), e)
+							yy1529 := *x.FptrMapUintptrFloat64
+							if false {
+							} else {
+								h.encMapuintptrfloat64((map[uintptr]float64)(yy1529), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Uint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1182 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Uint8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapUintptrBool == nil {
 						r.EncodeNil()
 					} else {
-						yy1185 := *x.FptrMapUint32Uint8
 						if false {
 						} else {
-							h.encMapuint32uint8((map[uint32]uint8)(yy1185), e)
+							h.encMapuintptrbool((map[uintptr]bool)(x.FMapUintptrBool), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint32uint16((map[uint32]uint16)(x.FMapUint32Uint16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Uint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapUintptrBool\"")
 					} else {
-						h.encMapuint32uint16((map[uint32]uint16)(x.FMapUint32Uint16), e)
+						r.EncodeSymbol(`FMapUintptrBool`)
 					}
-				}
-			}
-			var yyn1190 bool
-			if x.FptrMapUint32Uint16 == nil {
-				yyn1190 = true
-				goto LABEL1190
-			}
-		LABEL1190:
-			if yyr2 || yy2arr2 {
-				if yyn1190 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Uint16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapUintptrBool == nil {
 						r.EncodeNil()
 					} else {
-						yy1191 := *x.FptrMapUint32Uint16
 						if false {
 						} else {
-							h.encMapuint32uint16((map[uint32]uint16)(yy1191), e)
+							h.encMapuintptrbool((map[uintptr]bool)(x.FMapUintptrBool), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Uint16`)
+				var yyn1534 bool
+				if x.FptrMapUintptrBool == nil {
+					yyn1534 = true
+					goto LABEL1534
 				}
-				r.WriteMapElemValue()
-				if yyn1190 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Uint16 == nil {
+			LABEL1534:
+				if yyr2 || yy2arr2 {
+					if yyn1534 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1193 := *x.FptrMapUint32Uint16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapUintptrBool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32uint16((map[uint32]uint16)(yy1193), e)
+							yy1535 := *x.FptrMapUintptrBool
+							if false {
+							} else {
+								h.encMapuintptrbool((map[uintptr]bool)(yy1535), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Uint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapUintptrBool\"")
 					} else {
-						h.encMapuint32uint32((map[uint32]uint32)(x.FMapUint32Uint32), e)
+						r.EncodeSymbol(`FptrMapUintptrBool`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1534 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint32uint32((map[uint32]uint32)(x.FMapUint32Uint32), e)
+						if x.FptrMapUintptrBool == nil {
+							r.EncodeNil()
+						} else {
+							yy1537 := *x.FptrMapUintptrBool
+							if false {
+							} else {
+								h.encMapuintptrbool((map[uintptr]bool)(yy1537), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1198 bool
-			if x.FptrMapUint32Uint32 == nil {
-				yyn1198 = true
-				goto LABEL1198
-			}
-		LABEL1198:
-			if yyr2 || yy2arr2 {
-				if yyn1198 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint32Uint32 == nil {
+					if x.FMapIntIntf == nil {
 						r.EncodeNil()
 					} else {
-						yy1199 := *x.FptrMapUint32Uint32
 						if false {
 						} else {
-							h.encMapuint32uint32((map[uint32]uint32)(yy1199), e)
+							h.encMapintInterface((map[int]interface{})(x.FMapIntIntf), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Uint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1198 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint32Uint32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntIntf\"")
+					} else {
+						r.EncodeSymbol(`FMapIntIntf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntIntf == nil {
 						r.EncodeNil()
 					} else {
-						yy1201 := *x.FptrMapUint32Uint32
 						if false {
 						} else {
-							h.encMapuint32uint32((map[uint32]uint32)(yy1201), e)
+							h.encMapintInterface((map[int]interface{})(x.FMapIntIntf), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1542 bool
+				if x.FptrMapIntIntf == nil {
+					yyn1542 = true
+					goto LABEL1542
+				}
+			LABEL1542:
+				if yyr2 || yy2arr2 {
+					if yyn1542 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint32uint64((map[uint32]uint64)(x.FMapUint32Uint64), e)
+						r.WriteArrayElem()
+						if x.FptrMapIntIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy1543 := *x.FptrMapIntIntf
+							if false {
+							} else {
+								h.encMapintInterface((map[int]interface{})(yy1543), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Uint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntIntf\"")
 					} else {
-						h.encMapuint32uint64((map[uint32]uint64)(x.FMapUint32Uint64), e)
+						r.EncodeSymbol(`FptrMapIntIntf`)
 					}
-				}
-			}
-			var yyn1206 bool
-			if x.FptrMapUint32Uint64 == nil {
-				yyn1206 = true
-				goto LABEL1206
-			}
-		LABEL1206:
-			if yyr2 || yy2arr2 {
-				if yyn1206 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Uint64 == nil {
+					r.WriteMapElemValue()
+					if yyn1542 {
 						r.EncodeNil()
 					} else {
-						yy1207 := *x.FptrMapUint32Uint64
-						if false {
+						if x.FptrMapIntIntf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32uint64((map[uint32]uint64)(yy1207), e)
+							yy1545 := *x.FptrMapIntIntf
+							if false {
+							} else {
+								h.encMapintInterface((map[int]interface{})(yy1545), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Uint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1206 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Uint64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntString == nil {
 						r.EncodeNil()
 					} else {
-						yy1209 := *x.FptrMapUint32Uint64
 						if false {
 						} else {
-							h.encMapuint32uint64((map[uint32]uint64)(yy1209), e)
+							h.encMapintstring((map[int]string)(x.FMapIntString), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint32uintptr((map[uint32]uintptr)(x.FMapUint32Uintptr), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Uintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntString\"")
 					} else {
-						h.encMapuint32uintptr((map[uint32]uintptr)(x.FMapUint32Uintptr), e)
+						r.EncodeSymbol(`FMapIntString`)
 					}
-				}
-			}
-			var yyn1214 bool
-			if x.FptrMapUint32Uintptr == nil {
-				yyn1214 = true
-				goto LABEL1214
-			}
-		LABEL1214:
-			if yyr2 || yy2arr2 {
-				if yyn1214 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Uintptr == nil {
+					r.WriteMapElemValue()
+					if x.FMapIntString == nil {
 						r.EncodeNil()
 					} else {
-						yy1215 := *x.FptrMapUint32Uintptr
 						if false {
 						} else {
-							h.encMapuint32uintptr((map[uint32]uintptr)(yy1215), e)
+							h.encMapintstring((map[int]string)(x.FMapIntString), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Uintptr`)
+				var yyn1550 bool
+				if x.FptrMapIntString == nil {
+					yyn1550 = true
+					goto LABEL1550
 				}
-				r.WriteMapElemValue()
-				if yyn1214 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Uintptr == nil {
+			LABEL1550:
+				if yyr2 || yy2arr2 {
+					if yyn1550 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1217 := *x.FptrMapUint32Uintptr
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapIntString == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32uintptr((map[uint32]uintptr)(yy1217), e)
+							yy1551 := *x.FptrMapIntString
+							if false {
+							} else {
+								h.encMapintstring((map[int]string)(yy1551), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Int == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntString\"")
 					} else {
-						h.encMapuint32int((map[uint32]int)(x.FMapUint32Int), e)
+						r.EncodeSymbol(`FptrMapIntString`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1550 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint32int((map[uint32]int)(x.FMapUint32Int), e)
+						if x.FptrMapIntString == nil {
+							r.EncodeNil()
+						} else {
+							yy1553 := *x.FptrMapIntString
+							if false {
+							} else {
+								h.encMapintstring((map[int]string)(yy1553), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1222 bool
-			if x.FptrMapUint32Int == nil {
-				yyn1222 = true
-				goto LABEL1222
-			}
-		LABEL1222:
-			if yyr2 || yy2arr2 {
-				if yyn1222 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint32Int == nil {
+					if x.FMapIntUint == nil {
 						r.EncodeNil()
 					} else {
-						yy1223 := *x.FptrMapUint32Int
 						if false {
 						} else {
-							h.encMapuint32int((map[uint32]int)(yy1223), e)
+							h.encMapintuint((map[int]uint)(x.FMapIntUint), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Int`)
-				}
-				r.WriteMapElemValue()
-				if yyn1222 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint32Int == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntUint\"")
+					} else {
+						r.EncodeSymbol(`FMapIntUint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntUint == nil {
 						r.EncodeNil()
 					} else {
-						yy1225 := *x.FptrMapUint32Int
 						if false {
 						} else {
-							h.encMapuint32int((map[uint32]int)(yy1225), e)
+							h.encMapintuint((map[int]uint)(x.FMapIntUint), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1558 bool
+				if x.FptrMapIntUint == nil {
+					yyn1558 = true
+					goto LABEL1558
+				}
+			LABEL1558:
+				if yyr2 || yy2arr2 {
+					if yyn1558 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint32int8((map[uint32]int8)(x.FMapUint32Int8), e)
+						r.WriteArrayElem()
+						if x.FptrMapIntUint == nil {
+							r.EncodeNil()
+						} else {
+							yy1559 := *x.FptrMapIntUint
+							if false {
+							} else {
+								h.encMapintuint((map[int]uint)(yy1559), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntUint\"")
 					} else {
-						h.encMapuint32int8((map[uint32]int8)(x.FMapUint32Int8), e)
+						r.EncodeSymbol(`FptrMapIntUint`)
 					}
-				}
-			}
-			var yyn1230 bool
-			if x.FptrMapUint32Int8 == nil {
-				yyn1230 = true
-				goto LABEL1230
-			}
-		LABEL1230:
-			if yyr2 || yy2arr2 {
-				if yyn1230 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Int8 == nil {
+					r.WriteMapElemValue()
+					if yyn1558 {
 						r.EncodeNil()
 					} else {
-						yy1231 := *x.FptrMapUint32Int8
-						if false {
+						if x.FptrMapIntUint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32int8((map[uint32]int8)(yy1231), e)
+							yy1561 := *x.FptrMapIntUint
+							if false {
+							} else {
+								h.encMapintuint((map[int]uint)(yy1561), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Int8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1230 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Int8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntUint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1233 := *x.FptrMapUint32Int8
 						if false {
 						} else {
-							h.encMapuint32int8((map[uint32]int8)(yy1233), e)
+							h.encMapintuint8((map[int]uint8)(x.FMapIntUint8), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint32int16((map[uint32]int16)(x.FMapUint32Int16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Int16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntUint8\"")
 					} else {
-						h.encMapuint32int16((map[uint32]int16)(x.FMapUint32Int16), e)
+						r.EncodeSymbol(`FMapIntUint8`)
 					}
-				}
-			}
-			var yyn1238 bool
-			if x.FptrMapUint32Int16 == nil {
-				yyn1238 = true
-				goto LABEL1238
-			}
-		LABEL1238:
-			if yyr2 || yy2arr2 {
-				if yyn1238 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Int16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapIntUint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1239 := *x.FptrMapUint32Int16
 						if false {
 						} else {
-							h.encMapuint32int16((map[uint32]int16)(yy1239), e)
+							h.encMapintuint8((map[int]uint8)(x.FMapIntUint8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Int16`)
+				var yyn1566 bool
+				if x.FptrMapIntUint8 == nil {
+					yyn1566 = true
+					goto LABEL1566
 				}
-				r.WriteMapElemValue()
-				if yyn1238 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Int16 == nil {
+			LABEL1566:
+				if yyr2 || yy2arr2 {
+					if yyn1566 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1241 := *x.FptrMapUint32Int16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapIntUint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32int16((map[uint32]int16)(yy1241), e)
+							yy1567 := *x.FptrMapIntUint8
+							if false {
+							} else {
+								h.encMapintuint8((map[int]uint8)(yy1567), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Int32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntUint8\"")
 					} else {
-						h.encMapuint32int32((map[uint32]int32)(x.FMapUint32Int32), e)
+						r.EncodeSymbol(`FptrMapIntUint8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1566 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint32int32((map[uint32]int32)(x.FMapUint32Int32), e)
+						if x.FptrMapIntUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1569 := *x.FptrMapIntUint8
+							if false {
+							} else {
+								h.encMapintuint8((map[int]uint8)(yy1569), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1246 bool
-			if x.FptrMapUint32Int32 == nil {
-				yyn1246 = true
-				goto LABEL1246
-			}
-		LABEL1246:
-			if yyr2 || yy2arr2 {
-				if yyn1246 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint32Int32 == nil {
+					if x.FMapIntUint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1247 := *x.FptrMapUint32Int32
 						if false {
 						} else {
-							h.encMapuint32int32((map[uint32]int32)(yy1247), e)
+							h.encMapintuint16((map[int]uint16)(x.FMapIntUint16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Int32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1246 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint32Int32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntUint16\"")
+					} else {
+						r.EncodeSymbol(`FMapIntUint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntUint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1249 := *x.FptrMapUint32Int32
 						if false {
 						} else {
-							h.encMapuint32int32((map[uint32]int32)(yy1249), e)
+							h.encMapintuint16((map[int]uint16)(x.FMapIntUint16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1574 bool
+				if x.FptrMapIntUint16 == nil {
+					yyn1574 = true
+					goto LABEL1574
+				}
+			LABEL1574:
+				if yyr2 || yy2arr2 {
+					if yyn1574 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint32int64((map[uint32]int64)(x.FMapUint32Int64), e)
+						r.WriteArrayElem()
+						if x.FptrMapIntUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1575 := *x.FptrMapIntUint16
+							if false {
+							} else {
+								h.encMapintuint16((map[int]uint16)(yy1575), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Int64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntUint16\"")
 					} else {
-						h.encMapuint32int64((map[uint32]int64)(x.FMapUint32Int64), e)
+						r.EncodeSymbol(`FptrMapIntUint16`)
 					}
-				}
-			}
-			var yyn1254 bool
-			if x.FptrMapUint32Int64 == nil {
-				yyn1254 = true
-				goto LABEL1254
-			}
-		LABEL1254:
-			if yyr2 || yy2arr2 {
-				if yyn1254 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Int64 == nil {
+					r.WriteMapElemValue()
+					if yyn1574 {
 						r.EncodeNil()
 					} else {
-						yy1255 := *x.FptrMapUint32Int64
-						if false {
+						if x.FptrMapIntUint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32int64((map[uint32]int64)(yy1255), e)
+							yy1577 := *x.FptrMapIntUint16
+							if false {
+							} else {
+								h.encMapintuint16((map[int]uint16)(yy1577), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Int64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1254 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Int64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntUint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1257 := *x.FptrMapUint32Int64
 						if false {
 						} else {
-							h.encMapuint32int64((map[uint32]int64)(yy1257), e)
+							h.encMapintuint32((map[int]uint32)(x.FMapIntUint32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint32float32((map[uint32]float32)(x.FMapUint32Float32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Float32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntUint32\"")
 					} else {
-						h.encMapuint32float32((map[uint32]float32)(x.FMapUint32Float32), e)
+						r.EncodeSymbol(`FMapIntUint32`)
 					}
-				}
-			}
-			var yyn1262 bool
-			if x.FptrMapUint32Float32 == nil {
-				yyn1262 = true
-				goto LABEL1262
-			}
-		LABEL1262:
-			if yyr2 || yy2arr2 {
-				if yyn1262 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Float32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapIntUint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1263 := *x.FptrMapUint32Float32
 						if false {
 						} else {
-							h.encMapuint32float32((map[uint32]float32)(yy1263), e)
+							h.encMapintuint32((map[int]uint32)(x.FMapIntUint32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Float32`)
+				var yyn1582 bool
+				if x.FptrMapIntUint32 == nil {
+					yyn1582 = true
+					goto LABEL1582
 				}
-				r.WriteMapElemValue()
-				if yyn1262 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Float32 == nil {
+			LABEL1582:
+				if yyr2 || yy2arr2 {
+					if yyn1582 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1265 := *x.FptrMapUint32Float32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapIntUint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32float32((map[uint32]float32)(yy1265), e)
+							yy1583 := *x.FptrMapIntUint32
+							if false {
+							} else {
+								h.encMapintuint32((map[int]uint32)(yy1583), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Float64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntUint32\"")
 					} else {
-						h.encMapuint32float64((map[uint32]float64)(x.FMapUint32Float64), e)
+						r.EncodeSymbol(`FptrMapIntUint32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1582 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint32float64((map[uint32]float64)(x.FMapUint32Float64), e)
+						if x.FptrMapIntUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1585 := *x.FptrMapIntUint32
+							if false {
+							} else {
+								h.encMapintuint32((map[int]uint32)(yy1585), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1270 bool
-			if x.FptrMapUint32Float64 == nil {
-				yyn1270 = true
-				goto LABEL1270
-			}
-		LABEL1270:
-			if yyr2 || yy2arr2 {
-				if yyn1270 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Float64 == nil {
+					if x.FMapIntUint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1271 := *x.FptrMapUint32Float64
 						if false {
 						} else {
-							h.encMapuint32float64((map[uint32]float64)(yy1271), e)
+							h.encMapintuint64((map[int]uint64)(x.FMapIntUint64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Float64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Float64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1270 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Float64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntUint64\"")
+					} else {
+						r.EncodeSymbol(`FMapIntUint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntUint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1273 := *x.FptrMapUint32Float64
 						if false {
 						} else {
-							h.encMapuint32float64((map[uint32]float64)(yy1273), e)
+							h.encMapintuint64((map[int]uint64)(x.FMapIntUint64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint32Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1590 bool
+				if x.FptrMapIntUint64 == nil {
+					yyn1590 = true
+					goto LABEL1590
+				}
+			LABEL1590:
+				if yyr2 || yy2arr2 {
+					if yyn1590 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint32bool((map[uint32]bool)(x.FMapUint32Bool), e)
+						r.WriteArrayElem()
+						if x.FptrMapIntUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1591 := *x.FptrMapIntUint64
+							if false {
+							} else {
+								h.encMapintuint64((map[int]uint64)(yy1591), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint32Bool\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint32Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint32Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntUint64\"")
 					} else {
-						h.encMapuint32bool((map[uint32]bool)(x.FMapUint32Bool), e)
+						r.EncodeSymbol(`FptrMapIntUint64`)
 					}
-				}
-			}
-			var yyn1278 bool
-			if x.FptrMapUint32Bool == nil {
-				yyn1278 = true
-				goto LABEL1278
-			}
-		LABEL1278:
-			if yyr2 || yy2arr2 {
-				if yyn1278 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint32Bool == nil {
+					r.WriteMapElemValue()
+					if yyn1590 {
 						r.EncodeNil()
 					} else {
-						yy1279 := *x.FptrMapUint32Bool
-						if false {
+						if x.FptrMapIntUint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint32bool((map[uint32]bool)(yy1279), e)
+							yy1593 := *x.FptrMapIntUint64
+							if false {
+							} else {
+								h.encMapintuint64((map[int]uint64)(yy1593), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint32Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint32Bool`)
-				}
-				r.WriteMapElemValue()
-				if yyn1278 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint32Bool == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntUintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1281 := *x.FptrMapUint32Bool
 						if false {
 						} else {
-							h.encMapuint32bool((map[uint32]bool)(yy1281), e)
+							h.encMapintuintptr((map[int]uintptr)(x.FMapIntUintptr), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint64Interface((map[uint64]interface{})(x.FMapUint64Intf), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntUintptr\"")
 					} else {
-						h.encMapuint64Interface((map[uint64]interface{})(x.FMapUint64Intf), e)
+						r.EncodeSymbol(`FMapIntUintptr`)
 					}
-				}
-			}
-			var yyn1286 bool
-			if x.FptrMapUint64Intf == nil {
-				yyn1286 = true
-				goto LABEL1286
-			}
-		LABEL1286:
-			if yyr2 || yy2arr2 {
-				if yyn1286 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Intf == nil {
+					r.WriteMapElemValue()
+					if x.FMapIntUintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1287 := *x.FptrMapUint64Intf
 						if false {
 						} else {
-							h.encMapuint64Interface((map[uint64]interface{})(yy1287), e)
+							h.encMapintuintptr((map[int]uintptr)(x.FMapIntUintptr), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Intf`)
+				var yyn1598 bool
+				if x.FptrMapIntUintptr == nil {
+					yyn1598 = true
+					goto LABEL1598
 				}
-				r.WriteMapElemValue()
-				if yyn1286 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Intf == nil {
+			LABEL1598:
+				if yyr2 || yy2arr2 {
+					if yyn1598 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1289 := *x.FptrMapUint64Intf
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapIntUintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64Interface((map[uint64]interface{})(yy1289), e)
+							yy1599 := *x.FptrMapIntUintptr
+							if false {
+							} else {
+								h.encMapintuintptr((map[int]uintptr)(yy1599), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64String == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntUintptr\"")
 					} else {
-						h.encMapuint64string((map[uint64]string)(x.FMapUint64String), e)
+						r.EncodeSymbol(`FptrMapIntUintptr`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1598 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint64string((map[uint64]string)(x.FMapUint64String), e)
+						if x.FptrMapIntUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy1601 := *x.FptrMapIntUintptr
+							if false {
+							} else {
+								h.encMapintuintptr((map[int]uintptr)(yy1601), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1294 bool
-			if x.FptrMapUint64String == nil {
-				yyn1294 = true
-				goto LABEL1294
-			}
-		LABEL1294:
-			if yyr2 || yy2arr2 {
-				if yyn1294 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint64String == nil {
+					if x.FMapIntInt == nil {
 						r.EncodeNil()
 					} else {
-						yy1295 := *x.FptrMapUint64String
 						if false {
 						} else {
-							h.encMapuint64string((map[uint64]string)(yy1295), e)
+							h.encMapintint((map[int]int)(x.FMapIntInt), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64String`)
-				}
-				r.WriteMapElemValue()
-				if yyn1294 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint64String == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntInt\"")
+					} else {
+						r.EncodeSymbol(`FMapIntInt`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntInt == nil {
 						r.EncodeNil()
 					} else {
-						yy1297 := *x.FptrMapUint64String
 						if false {
 						} else {
-							h.encMapuint64string((map[uint64]string)(yy1297), e)
+							h.encMapintint((map[int]int)(x.FMapIntInt), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1606 bool
+				if x.FptrMapIntInt == nil {
+					yyn1606 = true
+					goto LABEL1606
+				}
+			LABEL1606:
+				if yyr2 || yy2arr2 {
+					if yyn1606 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint64uint((map[uint64]uint)(x.FMapUint64Uint), e)
+						r.WriteArrayElem()
+						if x.FptrMapIntInt == nil {
+							r.EncodeNil()
+						} else {
+							yy1607 := *x.FptrMapIntInt
+							if false {
+							} else {
+								h.encMapintint((map[int]int)(yy1607), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntInt\"")
 					} else {
-						h.encMapuint64uint((map[uint64]uint)(x.FMapUint64Uint), e)
+						r.EncodeSymbol(`FptrMapIntInt`)
+					}
+					r.WriteMapElemValue()
+					if yyn1606 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapIntInt == nil {
+							r.EncodeNil()
+						} else {
+							yy1609 := *x.FptrMapIntInt
+							if false {
+							} else {
+								h.encMapintint((map[int]int)(yy1609), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1302 bool
-			if x.FptrMapUint64Uint == nil {
-				yyn1302 = true
-				goto LABEL1302
-			}
-		LABEL1302:
-			if yyr2 || yy2arr2 {
-				if yyn1302 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint64Uint == nil {
+					if x.FMapIntInt8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1303 := *x.FptrMapUint64Uint
 						if false {
 						} else {
-							h.encMapuint64uint((map[uint64]uint)(yy1303), e)
+							h.encMapintint8((map[int]int8)(x.FMapIntInt8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Uint`)
-				}
-				r.WriteMapElemValue()
-				if yyn1302 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint64Uint == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntInt8\"")
+					} else {
+						r.EncodeSymbol(`FMapIntInt8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntInt8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1305 := *x.FptrMapUint64Uint
 						if false {
 						} else {
-							h.encMapuint64uint((map[uint64]uint)(yy1305), e)
+							h.encMapintint8((map[int]int8)(x.FMapIntInt8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1614 bool
+				if x.FptrMapIntInt8 == nil {
+					yyn1614 = true
+					goto LABEL1614
+				}
+			LABEL1614:
+				if yyr2 || yy2arr2 {
+					if yyn1614 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint64uint8((map[uint64]uint8)(x.FMapUint64Uint8), e)
+						r.WriteArrayElem()
+						if x.FptrMapIntInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1615 := *x.FptrMapIntInt8
+							if false {
+							} else {
+								h.encMapintint8((map[int]int8)(yy1615), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Uint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntInt8\"")
 					} else {
-						h.encMapuint64uint8((map[uint64]uint8)(x.FMapUint64Uint8), e)
+						r.EncodeSymbol(`FptrMapIntInt8`)
 					}
-				}
-			}
-			var yyn1310 bool
-			if x.FptrMapUint64Uint8 == nil {
-				yyn1310 = true
-				goto LABEL1310
-			}
-		LABEL1310:
-			if yyr2 || yy2arr2 {
-				if yyn1310 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Uint8 == nil {
+					r.WriteMapElemValue()
+					if yyn1614 {
 						r.EncodeNil()
 					} else {
-						yy1311 := *x.FptrMapUint64Uint8
-						if false {
+						if x.FptrMapIntInt8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64uint8((map[uint64]uint8)(yy1311), e)
+							yy1617 := *x.FptrMapIntInt8
+							if false {
+							} else {
+								h.encMapintint8((map[int]int8)(yy1617), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Uint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1310 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Uint8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntInt16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1313 := *x.FptrMapUint64Uint8
 						if false {
 						} else {
-							h.encMapuint64uint8((map[uint64]uint8)(yy1313), e)
+							h.encMapintint16((map[int]int16)(x.FMapIntInt16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint64uint16((map[uint64]uint16)(x.FMapUint64Uint16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Uint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntInt16\"")
 					} else {
-						h.encMapuint64uint16((map[uint64]uint16)(x.FMapUint64Uint16), e)
+						r.EncodeSymbol(`FMapIntInt16`)
 					}
-				}
-			}
-			var yyn1318 bool
-			if x.FptrMapUint64Uint16 == nil {
-				yyn1318 = true
-				goto LABEL1318
-			}
-		LABEL1318:
-			if yyr2 || yy2arr2 {
-				if yyn1318 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Uint16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapIntInt16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1319 := *x.FptrMapUint64Uint16
 						if false {
 						} else {
-							h.encMapuint64uint16((map[uint64]uint16)(yy1319), e)
+							h.encMapintint16((map[int]int16)(x.FMapIntInt16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Uint16`)
+				var yyn1622 bool
+				if x.FptrMapIntInt16 == nil {
+					yyn1622 = true
+					goto LABEL1622
 				}
-				r.WriteMapElemValue()
-				if yyn1318 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Uint16 == nil {
+			LABEL1622:
+				if yyr2 || yy2arr2 {
+					if yyn1622 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1321 := *x.FptrMapUint64Uint16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapIntInt16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64uint16((map[uint64]uint16)(yy1321), e)
+							yy1623 := *x.FptrMapIntInt16
+							if false {
+							} else {
+								h.encMapintint16((map[int]int16)(yy1623), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Uint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntInt16\"")
 					} else {
-						h.encMapuint64uint32((map[uint64]uint32)(x.FMapUint64Uint32), e)
+						r.EncodeSymbol(`FptrMapIntInt16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1622 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint64uint32((map[uint64]uint32)(x.FMapUint64Uint32), e)
+						if x.FptrMapIntInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1625 := *x.FptrMapIntInt16
+							if false {
+							} else {
+								h.encMapintint16((map[int]int16)(yy1625), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1326 bool
-			if x.FptrMapUint64Uint32 == nil {
-				yyn1326 = true
-				goto LABEL1326
-			}
-		LABEL1326:
-			if yyr2 || yy2arr2 {
-				if yyn1326 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint64Uint32 == nil {
+					if x.FMapIntInt32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1327 := *x.FptrMapUint64Uint32
 						if false {
 						} else {
-							h.encMapuint64uint32((map[uint64]uint32)(yy1327), e)
+							h.encMapintint32((map[int]int32)(x.FMapIntInt32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Uint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1326 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint64Uint32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntInt32\"")
+					} else {
+						r.EncodeSymbol(`FMapIntInt32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntInt32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1329 := *x.FptrMapUint64Uint32
 						if false {
 						} else {
-							h.encMapuint64uint32((map[uint64]uint32)(yy1329), e)
+							h.encMapintint32((map[int]int32)(x.FMapIntInt32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1630 bool
+				if x.FptrMapIntInt32 == nil {
+					yyn1630 = true
+					goto LABEL1630
+				}
+			LABEL1630:
+				if yyr2 || yy2arr2 {
+					if yyn1630 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint64uint64((map[uint64]uint64)(x.FMapUint64Uint64), e)
+						r.WriteArrayElem()
+						if x.FptrMapIntInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1631 := *x.FptrMapIntInt32
+							if false {
+							} else {
+								h.encMapintint32((map[int]int32)(yy1631), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Uint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntInt32\"")
 					} else {
-						h.encMapuint64uint64((map[uint64]uint64)(x.FMapUint64Uint64), e)
+						r.EncodeSymbol(`FptrMapIntInt32`)
 					}
-				}
-			}
-			var yyn1334 bool
-			if x.FptrMapUint64Uint64 == nil {
-				yyn1334 = true
-				goto LABEL1334
-			}
-		LABEL1334:
-			if yyr2 || yy2arr2 {
-				if yyn1334 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Uint64 == nil {
+					r.WriteMapElemValue()
+					if yyn1630 {
 						r.EncodeNil()
 					} else {
-						yy1335 := *x.FptrMapUint64Uint64
-						if false {
+						if x.FptrMapIntInt32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64uint64((map[uint64]uint64)(yy1335), e)
+							yy1633 := *x.FptrMapIntInt32
+							if false {
+							} else {
+								h.encMapintint32((map[int]int32)(yy1633), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Uint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1334 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Uint64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntInt64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1337 := *x.FptrMapUint64Uint64
 						if false {
 						} else {
-							h.encMapuint64uint64((map[uint64]uint64)(yy1337), e)
+							h.encMapintint64((map[int]int64)(x.FMapIntInt64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint64uintptr((map[uint64]uintptr)(x.FMapUint64Uintptr), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Uintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntInt64\"")
 					} else {
-						h.encMapuint64uintptr((map[uint64]uintptr)(x.FMapUint64Uintptr), e)
+						r.EncodeSymbol(`FMapIntInt64`)
 					}
-				}
-			}
-			var yyn1342 bool
-			if x.FptrMapUint64Uintptr == nil {
-				yyn1342 = true
-				goto LABEL1342
-			}
-		LABEL1342:
-			if yyr2 || yy2arr2 {
-				if yyn1342 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Uintptr == nil {
+					r.WriteMapElemValue()
+					if x.FMapIntInt64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1343 := *x.FptrMapUint64Uintptr
 						if false {
 						} else {
-							h.encMapuint64uintptr((map[uint64]uintptr)(yy1343), e)
+							h.encMapintint64((map[int]int64)(x.FMapIntInt64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Uintptr`)
+				var yyn1638 bool
+				if x.FptrMapIntInt64 == nil {
+					yyn1638 = true
+					goto LABEL1638
 				}
-				r.WriteMapElemValue()
-				if yyn1342 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Uintptr == nil {
+			LABEL1638:
+				if yyr2 || yy2arr2 {
+					if yyn1638 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1345 := *x.FptrMapUint64Uintptr
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapIntInt64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64uintptr((map[uint64]uintptr)(yy1345), e)
+							yy1639 := *x.FptrMapIntInt64
+							if false {
+							} else {
+								h.encMapintint64((map[int]int64)(yy1639), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Int == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntInt64\"")
 					} else {
-						h.encMapuint64int((map[uint64]int)(x.FMapUint64Int), e)
+						r.EncodeSymbol(`FptrMapIntInt64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1638 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint64int((map[uint64]int)(x.FMapUint64Int), e)
+						if x.FptrMapIntInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1641 := *x.FptrMapIntInt64
+							if false {
+							} else {
+								h.encMapintint64((map[int]int64)(yy1641), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1350 bool
-			if x.FptrMapUint64Int == nil {
-				yyn1350 = true
-				goto LABEL1350
-			}
-		LABEL1350:
-			if yyr2 || yy2arr2 {
-				if yyn1350 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUint64Int == nil {
+					if x.FMapIntFloat32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1351 := *x.FptrMapUint64Int
 						if false {
 						} else {
-							h.encMapuint64int((map[uint64]int)(yy1351), e)
+							h.encMapintfloat32((map[int]float32)(x.FMapIntFloat32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Int`)
-				}
-				r.WriteMapElemValue()
-				if yyn1350 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUint64Int == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntFloat32\"")
+					} else {
+						r.EncodeSymbol(`FMapIntFloat32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntFloat32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1353 := *x.FptrMapUint64Int
 						if false {
 						} else {
-							h.encMapuint64int((map[uint64]int)(yy1353), e)
+							h.encMapintfloat32((map[int]float32)(x.FMapIntFloat32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1646 bool
+				if x.FptrMapIntFloat32 == nil {
+					yyn1646 = true
+					goto LABEL1646
+				}
+			LABEL1646:
+				if yyr2 || yy2arr2 {
+					if yyn1646 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint64int8((map[uint64]int8)(x.FMapUint64Int8), e)
+						r.WriteArrayElem()
+						if x.FptrMapIntFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1647 := *x.FptrMapIntFloat32
+							if false {
+							} else {
+								h.encMapintfloat32((map[int]float32)(yy1647), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntFloat32\"")
 					} else {
-						h.encMapuint64int8((map[uint64]int8)(x.FMapUint64Int8), e)
+						r.EncodeSymbol(`FptrMapIntFloat32`)
 					}
-				}
-			}
-			var yyn1358 bool
-			if x.FptrMapUint64Int8 == nil {
-				yyn1358 = true
-				goto LABEL1358
-			}
-		LABEL1358:
-			if yyr2 || yy2arr2 {
-				if yyn1358 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Int8 == nil {
+					r.WriteMapElemValue()
+					if yyn1646 {
 						r.EncodeNil()
 					} else {
-						yy1359 := *x.FptrMapUint64Int8
-						if false {
+						if x.FptrMapIntFloat32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64int8((map[uint64]int8)(yy1359), e)
+							yy1649 := *x.FptrMapIntFloat32
+							if false {
+							} else {
+								h.encMapintfloat32((map[int]float32)(yy1649), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Int8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1358 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Int8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapIntFloat64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1361 := *x.FptrMapUint64Int8
 						if false {
 						} else {
-							h.encMapuint64int8((map[uint64]int8)(yy1361), e)
+							h.encMapintfloat64((map[int]float64)(x.FMapIntFloat64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint64int16((map[uint64]int16)(x.FMapUint64Int16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Int16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntFloat64\"")
 					} else {
-						h.encMapuint64int16((map[uint64]int16)(x.FMapUint64Int16), e)
+						r.EncodeSymbol(`FMapIntFloat64`)
 					}
-				}
-			}
-			var yyn1366 bool
-			if x.FptrMapUint64Int16 == nil {
-				yyn1366 = true
-				goto LABEL1366
-			}
-		LABEL1366:
-			if yyr2 || yy2arr2 {
-				if yyn1366 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Int16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapIntFloat64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1367 := *x.FptrMapUint64Int16
 						if false {
 						} else {
-							h.encMapuint64int16((map[uint64]int16)(yy1367), e)
+							h.encMapintfloat64((map[int]float64)(x.FMapIntFloat64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Int16`)
+				var yyn1654 bool
+				if x.FptrMapIntFloat64 == nil {
+					yyn1654 = true
+					goto LABEL1654
 				}
-				r.WriteMapElemValue()
-				if yyn1366 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Int16 == nil {
+			LABEL1654:
+				if yyr2 || yy2arr2 {
+					if yyn1654 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1369 := *x.FptrMapUint64Int16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapIntFloat64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64int16((map[uint64]int16)(yy1369), e)
+							yy1655 := *x.FptrMapIntFloat64
+							if false {
+							} else {
+								h.encMapintfloat64((map[int]float64)(yy1655), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Int32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntFloat64\"")
 					} else {
-						h.encMapuint64int32((map[uint64]int32)(x.FMapUint64Int32), e)
+						r.EncodeSymbol(`FptrMapIntFloat64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1654 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint64int32((map[uint64]int32)(x.FMapUint64Int32), e)
+						if x.FptrMapIntFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1657 := *x.FptrMapIntFloat64
+							if false {
+							} else {
+								h.encMapintfloat64((map[int]float64)(yy1657), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1374 bool
-			if x.FptrMapUint64Int32 == nil {
-				yyn1374 = true
-				goto LABEL1374
-			}
-		LABEL1374:
-			if yyr2 || yy2arr2 {
-				if yyn1374 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Int32 == nil {
+					if x.FMapIntBool == nil {
 						r.EncodeNil()
 					} else {
-						yy1375 := *x.FptrMapUint64Int32
 						if false {
 						} else {
-							h.encMapuint64int32((map[uint64]int32)(yy1375), e)
+							h.encMapintbool((map[int]bool)(x.FMapIntBool), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Int32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Int32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1374 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Int32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapIntBool\"")
+					} else {
+						r.EncodeSymbol(`FMapIntBool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapIntBool == nil {
 						r.EncodeNil()
 					} else {
-						yy1377 := *x.FptrMapUint64Int32
 						if false {
 						} else {
-							h.encMapuint64int32((map[uint64]int32)(yy1377), e)
+							h.encMapintbool((map[int]bool)(x.FMapIntBool), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1662 bool
+				if x.FptrMapIntBool == nil {
+					yyn1662 = true
+					goto LABEL1662
+				}
+			LABEL1662:
+				if yyr2 || yy2arr2 {
+					if yyn1662 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint64int64((map[uint64]int64)(x.FMapUint64Int64), e)
+						r.WriteArrayElem()
+						if x.FptrMapIntBool == nil {
+							r.EncodeNil()
+						} else {
+							yy1663 := *x.FptrMapIntBool
+							if false {
+							} else {
+								h.encMapintbool((map[int]bool)(yy1663), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Int64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapIntBool\"")
+					} else {
+						r.EncodeSymbol(`FptrMapIntBool`)
+					}
+					r.WriteMapElemValue()
+					if yyn1662 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint64int64((map[uint64]int64)(x.FMapUint64Int64), e)
+						if x.FptrMapIntBool == nil {
+							r.EncodeNil()
+						} else {
+							yy1665 := *x.FptrMapIntBool
+							if false {
+							} else {
+								h.encMapintbool((map[int]bool)(yy1665), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1382 bool
-			if x.FptrMapUint64Int64 == nil {
-				yyn1382 = true
-				goto LABEL1382
-			}
-		LABEL1382:
-			if yyr2 || yy2arr2 {
-				if yyn1382 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Int64 == nil {
+					if x.FMapInt8Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy1383 := *x.FptrMapUint64Int64
 						if false {
 						} else {
-							h.encMapuint64int64((map[uint64]int64)(yy1383), e)
+							h.encMapint8Interface((map[int8]interface{})(x.FMapInt8Intf), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Int64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Int64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1382 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Int64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Intf\"")
+					} else {
+						r.EncodeSymbol(`FMapInt8Intf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt8Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy1385 := *x.FptrMapUint64Int64
 						if false {
 						} else {
-							h.encMapuint64int64((map[uint64]int64)(yy1385), e)
+							h.encMapint8Interface((map[int8]interface{})(x.FMapInt8Intf), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1670 bool
+				if x.FptrMapInt8Intf == nil {
+					yyn1670 = true
+					goto LABEL1670
+				}
+			LABEL1670:
+				if yyr2 || yy2arr2 {
+					if yyn1670 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuint64float32((map[uint64]float32)(x.FMapUint64Float32), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt8Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy1671 := *x.FptrMapInt8Intf
+							if false {
+							} else {
+								h.encMapint8Interface((map[int8]interface{})(yy1671), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Float32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Intf\"")
+					} else {
+						r.EncodeSymbol(`FptrMapInt8Intf`)
+					}
+					r.WriteMapElemValue()
+					if yyn1670 {
+						r.EncodeNil()
 					} else {
-						h.encMapuint64float32((map[uint64]float32)(x.FMapUint64Float32), e)
+						if x.FptrMapInt8Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy1673 := *x.FptrMapInt8Intf
+							if false {
+							} else {
+								h.encMapint8Interface((map[int8]interface{})(yy1673), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1390 bool
-			if x.FptrMapUint64Float32 == nil {
-				yyn1390 = true
-				goto LABEL1390
-			}
-		LABEL1390:
-			if yyr2 || yy2arr2 {
-				if yyn1390 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.FMapInt8String == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapint8string((map[int8]string)(x.FMapInt8String), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Float32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8String\"")
+					} else {
+						r.EncodeSymbol(`FMapInt8String`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt8String == nil {
 						r.EncodeNil()
 					} else {
-						yy1391 := *x.FptrMapUint64Float32
 						if false {
 						} else {
-							h.encMapuint64float32((map[uint64]float32)(yy1391), e)
+							h.encMapint8string((map[int8]string)(x.FMapInt8String), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Float32`)
+				var yyn1678 bool
+				if x.FptrMapInt8String == nil {
+					yyn1678 = true
+					goto LABEL1678
 				}
-				r.WriteMapElemValue()
-				if yyn1390 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Float32 == nil {
+			LABEL1678:
+				if yyr2 || yy2arr2 {
+					if yyn1678 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1393 := *x.FptrMapUint64Float32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt8String == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64float32((map[uint64]float32)(yy1393), e)
+							yy1679 := *x.FptrMapInt8String
+							if false {
+							} else {
+								h.encMapint8string((map[int8]string)(yy1679), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint64float64((map[uint64]float64)(x.FMapUint64Float64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Float64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8String\"")
 					} else {
-						h.encMapuint64float64((map[uint64]float64)(x.FMapUint64Float64), e)
+						r.EncodeSymbol(`FptrMapInt8String`)
 					}
-				}
-			}
-			var yyn1398 bool
-			if x.FptrMapUint64Float64 == nil {
-				yyn1398 = true
-				goto LABEL1398
-			}
-		LABEL1398:
-			if yyr2 || yy2arr2 {
-				if yyn1398 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Float64 == nil {
+					r.WriteMapElemValue()
+					if yyn1678 {
 						r.EncodeNil()
 					} else {
-						yy1399 := *x.FptrMapUint64Float64
-						if false {
+						if x.FptrMapInt8String == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64float64((map[uint64]float64)(yy1399), e)
+							yy1681 := *x.FptrMapInt8String
+							if false {
+							} else {
+								h.encMapint8string((map[int8]string)(yy1681), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Float64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1398 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Float64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt8Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy1401 := *x.FptrMapUint64Float64
 						if false {
 						} else {
-							h.encMapuint64float64((map[uint64]float64)(yy1401), e)
+							h.encMapint8uint((map[int8]uint)(x.FMapInt8Uint), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUint64Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuint64bool((map[uint64]bool)(x.FMapUint64Bool), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUint64Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUint64Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUint64Bool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Uint\"")
 					} else {
-						h.encMapuint64bool((map[uint64]bool)(x.FMapUint64Bool), e)
+						r.EncodeSymbol(`FMapInt8Uint`)
 					}
-				}
-			}
-			var yyn1406 bool
-			if x.FptrMapUint64Bool == nil {
-				yyn1406 = true
-				goto LABEL1406
-			}
-		LABEL1406:
-			if yyr2 || yy2arr2 {
-				if yyn1406 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUint64Bool == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt8Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy1407 := *x.FptrMapUint64Bool
 						if false {
 						} else {
-							h.encMapuint64bool((map[uint64]bool)(yy1407), e)
+							h.encMapint8uint((map[int8]uint)(x.FMapInt8Uint), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUint64Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUint64Bool`)
+				var yyn1686 bool
+				if x.FptrMapInt8Uint == nil {
+					yyn1686 = true
+					goto LABEL1686
 				}
-				r.WriteMapElemValue()
-				if yyn1406 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUint64Bool == nil {
+			LABEL1686:
+				if yyr2 || yy2arr2 {
+					if yyn1686 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1409 := *x.FptrMapUint64Bool
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt8Uint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64bool((map[uint64]bool)(yy1409), e)
+							yy1687 := *x.FptrMapInt8Uint
+							if false {
+							} else {
+								h.encMapint8uint((map[int8]uint)(yy1687), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrIntf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Uint\"")
 					} else {
-						h.encMapuintptrInterface((map[uintptr]interface{})(x.FMapUintptrIntf), e)
+						r.EncodeSymbol(`FptrMapInt8Uint`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrIntf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrIntf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1686 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintptrInterface((map[uintptr]interface{})(x.FMapUintptrIntf), e)
+						if x.FptrMapInt8Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy1689 := *x.FptrMapInt8Uint
+							if false {
+							} else {
+								h.encMapint8uint((map[int8]uint)(yy1689), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1414 bool
-			if x.FptrMapUintptrIntf == nil {
-				yyn1414 = true
-				goto LABEL1414
-			}
-		LABEL1414:
-			if yyr2 || yy2arr2 {
-				if yyn1414 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUintptrIntf == nil {
+					if x.FMapInt8Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1415 := *x.FptrMapUintptrIntf
 						if false {
 						} else {
-							h.encMapuintptrInterface((map[uintptr]interface{})(yy1415), e)
+							h.encMapint8uint8((map[int8]uint8)(x.FMapInt8Uint8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrIntf`)
-				}
-				r.WriteMapElemValue()
-				if yyn1414 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUintptrIntf == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Uint8\"")
+					} else {
+						r.EncodeSymbol(`FMapInt8Uint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt8Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1417 := *x.FptrMapUintptrIntf
 						if false {
 						} else {
-							h.encMapuintptrInterface((map[uintptr]interface{})(yy1417), e)
+							h.encMapint8uint8((map[int8]uint8)(x.FMapInt8Uint8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrString == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1694 bool
+				if x.FptrMapInt8Uint8 == nil {
+					yyn1694 = true
+					goto LABEL1694
+				}
+			LABEL1694:
+				if yyr2 || yy2arr2 {
+					if yyn1694 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintptrstring((map[uintptr]string)(x.FMapUintptrString), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt8Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1695 := *x.FptrMapInt8Uint8
+							if false {
+							} else {
+								h.encMapint8uint8((map[int8]uint8)(yy1695), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrString`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrString == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Uint8\"")
 					} else {
-						h.encMapuintptrstring((map[uintptr]string)(x.FMapUintptrString), e)
+						r.EncodeSymbol(`FptrMapInt8Uint8`)
 					}
-				}
-			}
-			var yyn1422 bool
-			if x.FptrMapUintptrString == nil {
-				yyn1422 = true
-				goto LABEL1422
-			}
-		LABEL1422:
-			if yyr2 || yy2arr2 {
-				if yyn1422 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrString == nil {
+					r.WriteMapElemValue()
+					if yyn1694 {
 						r.EncodeNil()
 					} else {
-						yy1423 := *x.FptrMapUintptrString
-						if false {
+						if x.FptrMapInt8Uint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptrstring((map[uintptr]string)(yy1423), e)
+							yy1697 := *x.FptrMapInt8Uint8
+							if false {
+							} else {
+								h.encMapint8uint8((map[int8]uint8)(yy1697), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrString`)
-				}
-				r.WriteMapElemValue()
-				if yyn1422 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrString == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt8Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1425 := *x.FptrMapUintptrString
 						if false {
 						} else {
-							h.encMapuintptrstring((map[uintptr]string)(yy1425), e)
+							h.encMapint8uint16((map[int8]uint16)(x.FMapInt8Uint16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrUint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintptruint((map[uintptr]uint)(x.FMapUintptrUint), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrUint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrUint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Uint16\"")
 					} else {
-						h.encMapuintptruint((map[uintptr]uint)(x.FMapUintptrUint), e)
+						r.EncodeSymbol(`FMapInt8Uint16`)
 					}
-				}
-			}
-			var yyn1430 bool
-			if x.FptrMapUintptrUint == nil {
-				yyn1430 = true
-				goto LABEL1430
-			}
-		LABEL1430:
-			if yyr2 || yy2arr2 {
-				if yyn1430 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrUint == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt8Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1431 := *x.FptrMapUintptrUint
 						if false {
 						} else {
-							h.encMapuintptruint((map[uintptr]uint)(yy1431), e)
+							h.encMapint8uint16((map[int8]uint16)(x.FMapInt8Uint16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrUint`)
+				var yyn1702 bool
+				if x.FptrMapInt8Uint16 == nil {
+					yyn1702 = true
+					goto LABEL1702
 				}
-				r.WriteMapElemValue()
-				if yyn1430 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrUint == nil {
+			LABEL1702:
+				if yyr2 || yy2arr2 {
+					if yyn1702 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1433 := *x.FptrMapUintptrUint
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt8Uint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptruint((map[uintptr]uint)(yy1433), e)
+							yy1703 := *x.FptrMapInt8Uint16
+							if false {
+							} else {
+								h.encMapint8uint16((map[int8]uint16)(yy1703), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrUint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Uint16\"")
 					} else {
-						h.encMapuintptruint8((map[uintptr]uint8)(x.FMapUintptrUint8), e)
+						r.EncodeSymbol(`FptrMapInt8Uint16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrUint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrUint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1702 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintptruint8((map[uintptr]uint8)(x.FMapUintptrUint8), e)
+						if x.FptrMapInt8Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1705 := *x.FptrMapInt8Uint16
+							if false {
+							} else {
+								h.encMapint8uint16((map[int8]uint16)(yy1705), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1438 bool
-			if x.FptrMapUintptrUint8 == nil {
-				yyn1438 = true
-				goto LABEL1438
-			}
-		LABEL1438:
-			if yyr2 || yy2arr2 {
-				if yyn1438 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUintptrUint8 == nil {
+					if x.FMapInt8Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1439 := *x.FptrMapUintptrUint8
 						if false {
 						} else {
-							h.encMapuintptruint8((map[uintptr]uint8)(yy1439), e)
+							h.encMapint8uint32((map[int8]uint32)(x.FMapInt8Uint32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrUint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1438 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUintptrUint8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Uint32\"")
+					} else {
+						r.EncodeSymbol(`FMapInt8Uint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt8Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1441 := *x.FptrMapUintptrUint8
 						if false {
 						} else {
-							h.encMapuintptruint8((map[uintptr]uint8)(yy1441), e)
+							h.encMapint8uint32((map[int8]uint32)(x.FMapInt8Uint32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrUint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1710 bool
+				if x.FptrMapInt8Uint32 == nil {
+					yyn1710 = true
+					goto LABEL1710
+				}
+			LABEL1710:
+				if yyr2 || yy2arr2 {
+					if yyn1710 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintptruint16((map[uintptr]uint16)(x.FMapUintptrUint16), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt8Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1711 := *x.FptrMapInt8Uint32
+							if false {
+							} else {
+								h.encMapint8uint32((map[int8]uint32)(yy1711), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrUint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrUint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Uint32\"")
 					} else {
-						h.encMapuintptruint16((map[uintptr]uint16)(x.FMapUintptrUint16), e)
+						r.EncodeSymbol(`FptrMapInt8Uint32`)
 					}
-				}
-			}
-			var yyn1446 bool
-			if x.FptrMapUintptrUint16 == nil {
-				yyn1446 = true
-				goto LABEL1446
-			}
-		LABEL1446:
-			if yyr2 || yy2arr2 {
-				if yyn1446 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrUint16 == nil {
+					r.WriteMapElemValue()
+					if yyn1710 {
 						r.EncodeNil()
 					} else {
-						yy1447 := *x.FptrMapUintptrUint16
-						if false {
+						if x.FptrMapInt8Uint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptruint16((map[uintptr]uint16)(yy1447), e)
+							yy1713 := *x.FptrMapInt8Uint32
+							if false {
+							} else {
+								h.encMapint8uint32((map[int8]uint32)(yy1713), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrUint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn1446 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrUint16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt8Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1449 := *x.FptrMapUintptrUint16
 						if false {
 						} else {
-							h.encMapuintptruint16((map[uintptr]uint16)(yy1449), e)
+							h.encMapint8uint64((map[int8]uint64)(x.FMapInt8Uint64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrUint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintptruint32((map[uintptr]uint32)(x.FMapUintptrUint32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrUint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrUint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Uint64\"")
 					} else {
-						h.encMapuintptruint32((map[uintptr]uint32)(x.FMapUintptrUint32), e)
+						r.EncodeSymbol(`FMapInt8Uint64`)
 					}
-				}
-			}
-			var yyn1454 bool
-			if x.FptrMapUintptrUint32 == nil {
-				yyn1454 = true
-				goto LABEL1454
-			}
-		LABEL1454:
-			if yyr2 || yy2arr2 {
-				if yyn1454 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrUint32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt8Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1455 := *x.FptrMapUintptrUint32
 						if false {
 						} else {
-							h.encMapuintptruint32((map[uintptr]uint32)(yy1455), e)
+							h.encMapint8uint64((map[int8]uint64)(x.FMapInt8Uint64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrUint32`)
+				var yyn1718 bool
+				if x.FptrMapInt8Uint64 == nil {
+					yyn1718 = true
+					goto LABEL1718
 				}
-				r.WriteMapElemValue()
-				if yyn1454 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrUint32 == nil {
+			LABEL1718:
+				if yyr2 || yy2arr2 {
+					if yyn1718 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1457 := *x.FptrMapUintptrUint32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt8Uint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptruint32((map[uintptr]uint32)(yy1457), e)
+							yy1719 := *x.FptrMapInt8Uint64
+							if false {
+							} else {
+								h.encMapint8uint64((map[int8]uint64)(yy1719), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrUint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Uint64\"")
 					} else {
-						h.encMapuintptruint64((map[uintptr]uint64)(x.FMapUintptrUint64), e)
+						r.EncodeSymbol(`FptrMapInt8Uint64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrUint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrUint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1718 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintptruint64((map[uintptr]uint64)(x.FMapUintptrUint64), e)
+						if x.FptrMapInt8Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1721 := *x.FptrMapInt8Uint64
+							if false {
+							} else {
+								h.encMapint8uint64((map[int8]uint64)(yy1721), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1462 bool
-			if x.FptrMapUintptrUint64 == nil {
-				yyn1462 = true
-				goto LABEL1462
-			}
-		LABEL1462:
-			if yyr2 || yy2arr2 {
-				if yyn1462 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUintptrUint64 == nil {
+					if x.FMapInt8Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1463 := *x.FptrMapUintptrUint64
 						if false {
 						} else {
-							h.encMapuintptruint64((map[uintptr]uint64)(yy1463), e)
+							h.encMapint8uintptr((map[int8]uintptr)(x.FMapInt8Uintptr), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrUint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1462 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUintptrUint64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Uintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapInt8Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt8Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1465 := *x.FptrMapUintptrUint64
 						if false {
 						} else {
-							h.encMapuintptruint64((map[uintptr]uint64)(yy1465), e)
+							h.encMapint8uintptr((map[int8]uintptr)(x.FMapInt8Uintptr), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrUintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1726 bool
+				if x.FptrMapInt8Uintptr == nil {
+					yyn1726 = true
+					goto LABEL1726
+				}
+			LABEL1726:
+				if yyr2 || yy2arr2 {
+					if yyn1726 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintptruintptr((map[uintptr]uintptr)(x.FMapUintptrUintptr), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt8Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy1727 := *x.FptrMapInt8Uintptr
+							if false {
+							} else {
+								h.encMapint8uintptr((map[int8]uintptr)(yy1727), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrUintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrUintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Uintptr\"")
 					} else {
-						h.encMapuintptruintptr((map[uintptr]uintptr)(x.FMapUintptrUintptr), e)
+						r.EncodeSymbol(`FptrMapInt8Uintptr`)
 					}
-				}
-			}
-			var yyn1470 bool
-			if x.FptrMapUintptrUintptr == nil {
-				yyn1470 = true
-				goto LABEL1470
-			}
-		LABEL1470:
-			if yyr2 || yy2arr2 {
-				if yyn1470 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrUintptr == nil {
+					r.WriteMapElemValue()
+					if yyn1726 {
 						r.EncodeNil()
 					} else {
-						yy1471 := *x.FptrMapUintptrUintptr
-						if false {
+						if x.FptrMapInt8Uintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptruintptr((map[uintptr]uintptr)(yy1471), e)
+							yy1729 := *x.FptrMapInt8Uintptr
+							if false {
+							} else {
+								h.encMapint8uintptr((map[int8]uintptr)(yy1729), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrUintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn1470 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrUintptr == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt8Int == nil {
 						r.EncodeNil()
 					} else {
-						yy1473 := *x.FptrMapUintptrUintptr
 						if false {
 						} else {
-							h.encMapuintptruintptr((map[uintptr]uintptr)(yy1473), e)
+							h.encMapint8int((map[int8]int)(x.FMapInt8Int), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintptrint((map[uintptr]int)(x.FMapUintptrInt), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrInt\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrInt`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Int\"")
 					} else {
-						h.encMapuintptrint((map[uintptr]int)(x.FMapUintptrInt), e)
+						r.EncodeSymbol(`FMapInt8Int`)
 					}
-				}
-			}
-			var yyn1478 bool
-			if x.FptrMapUintptrInt == nil {
-				yyn1478 = true
-				goto LABEL1478
-			}
-		LABEL1478:
-			if yyr2 || yy2arr2 {
-				if yyn1478 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrInt == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt8Int == nil {
 						r.EncodeNil()
 					} else {
-						yy1479 := *x.FptrMapUintptrInt
 						if false {
 						} else {
-							h.encMapuintptrint((map[uintptr]int)(yy1479), e)
+							h.encMapint8int((map[int8]int)(x.FMapInt8Int), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrInt`)
+				var yyn1734 bool
+				if x.FptrMapInt8Int == nil {
+					yyn1734 = true
+					goto LABEL1734
 				}
-				r.WriteMapElemValue()
-				if yyn1478 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrInt == nil {
+			LABEL1734:
+				if yyr2 || yy2arr2 {
+					if yyn1734 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1481 := *x.FptrMapUintptrInt
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt8Int == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptrint((map[uintptr]int)(yy1481), e)
+							yy1735 := *x.FptrMapInt8Int
+							if false {
+							} else {
+								h.encMapint8int((map[int8]int)(yy1735), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrInt8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Int\"")
 					} else {
-						h.encMapuintptrint8((map[uintptr]int8)(x.FMapUintptrInt8), e)
+						r.EncodeSymbol(`FptrMapInt8Int`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrInt8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrInt8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1734 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintptrint8((map[uintptr]int8)(x.FMapUintptrInt8), e)
+						if x.FptrMapInt8Int == nil {
+							r.EncodeNil()
+						} else {
+							yy1737 := *x.FptrMapInt8Int
+							if false {
+							} else {
+								h.encMapint8int((map[int8]int)(yy1737), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1486 bool
-			if x.FptrMapUintptrInt8 == nil {
-				yyn1486 = true
-				goto LABEL1486
-			}
-		LABEL1486:
-			if yyr2 || yy2arr2 {
-				if yyn1486 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrInt8 == nil {
+					if x.FMapInt8Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1487 := *x.FptrMapUintptrInt8
 						if false {
 						} else {
-							h.encMapuintptrint8((map[uintptr]int8)(yy1487), e)
+							h.encMapint8int8((map[int8]int8)(x.FMapInt8Int8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrInt8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrInt8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1486 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrInt8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Int8\"")
+					} else {
+						r.EncodeSymbol(`FMapInt8Int8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt8Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1489 := *x.FptrMapUintptrInt8
 						if false {
 						} else {
-							h.encMapuintptrint8((map[uintptr]int8)(yy1489), e)
+							h.encMapint8int8((map[int8]int8)(x.FMapInt8Int8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1742 bool
+				if x.FptrMapInt8Int8 == nil {
+					yyn1742 = true
+					goto LABEL1742
+				}
+			LABEL1742:
+				if yyr2 || yy2arr2 {
+					if yyn1742 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintptrint16((map[uintptr]int16)(x.FMapUintptrInt16), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt8Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1743 := *x.FptrMapInt8Int8
+							if false {
+							} else {
+								h.encMapint8int8((map[int8]int8)(yy1743), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrInt16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrInt16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Int8\"")
 					} else {
-						h.encMapuintptrint16((map[uintptr]int16)(x.FMapUintptrInt16), e)
+						r.EncodeSymbol(`FptrMapInt8Int8`)
 					}
-				}
-			}
-			var yyn1494 bool
-			if x.FptrMapUintptrInt16 == nil {
-				yyn1494 = true
-				goto LABEL1494
-			}
-		LABEL1494:
-			if yyr2 || yy2arr2 {
-				if yyn1494 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrInt16 == nil {
+					r.WriteMapElemValue()
+					if yyn1742 {
 						r.EncodeNil()
 					} else {
-						yy1495 := *x.FptrMapUintptrInt16
-						if false {
+						if x.FptrMapInt8Int8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptrint16((map[uintptr]int16)(yy1495), e)
+							yy1745 := *x.FptrMapInt8Int8
+							if false {
+							} else {
+								h.encMapint8int8((map[int8]int8)(yy1745), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrInt16`)
-				}
-				r.WriteMapElemValue()
-				if yyn1494 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrInt16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt8Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1497 := *x.FptrMapUintptrInt16
 						if false {
 						} else {
-							h.encMapuintptrint16((map[uintptr]int16)(yy1497), e)
+							h.encMapint8int16((map[int8]int16)(x.FMapInt8Int16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrInt32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintptrint32((map[uintptr]int32)(x.FMapUintptrInt32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrInt32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrInt32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Int16\"")
 					} else {
-						h.encMapuintptrint32((map[uintptr]int32)(x.FMapUintptrInt32), e)
+						r.EncodeSymbol(`FMapInt8Int16`)
 					}
-				}
-			}
-			var yyn1502 bool
-			if x.FptrMapUintptrInt32 == nil {
-				yyn1502 = true
-				goto LABEL1502
-			}
-		LABEL1502:
-			if yyr2 || yy2arr2 {
-				if yyn1502 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrInt32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt8Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1503 := *x.FptrMapUintptrInt32
 						if false {
 						} else {
-							h.encMapuintptrint32((map[uintptr]int32)(yy1503), e)
+							h.encMapint8int16((map[int8]int16)(x.FMapInt8Int16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrInt32`)
+				var yyn1750 bool
+				if x.FptrMapInt8Int16 == nil {
+					yyn1750 = true
+					goto LABEL1750
 				}
-				r.WriteMapElemValue()
-				if yyn1502 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrInt32 == nil {
+			LABEL1750:
+				if yyr2 || yy2arr2 {
+					if yyn1750 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1505 := *x.FptrMapUintptrInt32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt8Int16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptrint32((map[uintptr]int32)(yy1505), e)
+							yy1751 := *x.FptrMapInt8Int16
+							if false {
+							} else {
+								h.encMapint8int16((map[int8]int16)(yy1751), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrInt64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Int16\"")
 					} else {
-						h.encMapuintptrint64((map[uintptr]int64)(x.FMapUintptrInt64), e)
+						r.EncodeSymbol(`FptrMapInt8Int16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrInt64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1750 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintptrint64((map[uintptr]int64)(x.FMapUintptrInt64), e)
+						if x.FptrMapInt8Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1753 := *x.FptrMapInt8Int16
+							if false {
+							} else {
+								h.encMapint8int16((map[int8]int16)(yy1753), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1510 bool
-			if x.FptrMapUintptrInt64 == nil {
-				yyn1510 = true
-				goto LABEL1510
-			}
-		LABEL1510:
-			if yyr2 || yy2arr2 {
-				if yyn1510 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUintptrInt64 == nil {
+					if x.FMapInt8Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1511 := *x.FptrMapUintptrInt64
 						if false {
 						} else {
-							h.encMapuintptrint64((map[uintptr]int64)(yy1511), e)
+							h.encMapint8int32((map[int8]int32)(x.FMapInt8Int32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrInt64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1510 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUintptrInt64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Int32\"")
+					} else {
+						r.EncodeSymbol(`FMapInt8Int32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt8Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1513 := *x.FptrMapUintptrInt64
 						if false {
 						} else {
-							h.encMapuintptrint64((map[uintptr]int64)(yy1513), e)
+							h.encMapint8int32((map[int8]int32)(x.FMapInt8Int32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrFloat32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1758 bool
+				if x.FptrMapInt8Int32 == nil {
+					yyn1758 = true
+					goto LABEL1758
+				}
+			LABEL1758:
+				if yyr2 || yy2arr2 {
+					if yyn1758 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapuintptrfloat32((map[uintptr]float32)(x.FMapUintptrFloat32), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt8Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1759 := *x.FptrMapInt8Int32
+							if false {
+							} else {
+								h.encMapint8int32((map[int8]int32)(yy1759), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrFloat32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrFloat32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Int32\"")
 					} else {
-						h.encMapuintptrfloat32((map[uintptr]float32)(x.FMapUintptrFloat32), e)
+						r.EncodeSymbol(`FptrMapInt8Int32`)
 					}
-				}
-			}
-			var yyn1518 bool
-			if x.FptrMapUintptrFloat32 == nil {
-				yyn1518 = true
-				goto LABEL1518
-			}
-		LABEL1518:
-			if yyr2 || yy2arr2 {
-				if yyn1518 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrFloat32 == nil {
+					r.WriteMapElemValue()
+					if yyn1758 {
 						r.EncodeNil()
 					} else {
-						yy1519 := *x.FptrMapUintptrFloat32
-						if false {
+						if x.FptrMapInt8Int32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptrfloat32((map[uintptr]float32)(yy1519), e)
+							yy1761 := *x.FptrMapInt8Int32
+							if false {
+							} else {
+								h.encMapint8int32((map[int8]int32)(yy1761), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrFloat32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1518 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrFloat32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt8Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1521 := *x.FptrMapUintptrFloat32
 						if false {
 						} else {
-							h.encMapuintptrfloat32((map[uintptr]float32)(yy1521), e)
+							h.encMapint8int64((map[int8]int64)(x.FMapInt8Int64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrFloat64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapuintptrfloat64((map[uintptr]float64)(x.FMapUintptrFloat64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrFloat64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrFloat64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Int64\"")
 					} else {
-						h.encMapuintptrfloat64((map[uintptr]float64)(x.FMapUintptrFloat64), e)
+						r.EncodeSymbol(`FMapInt8Int64`)
 					}
-				}
-			}
-			var yyn1526 bool
-			if x.FptrMapUintptrFloat64 == nil {
-				yyn1526 = true
-				goto LABEL1526
-			}
-		LABEL1526:
-			if yyr2 || yy2arr2 {
-				if yyn1526 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapUintptrFloat64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt8Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1527 := *x.FptrMapUintptrFloat64
 						if false {
 						} else {
-							h.encMapuintptrfloat64((map[uintptr]float64)(yy1527), e)
+							h.encMapint8int64((map[int8]int64)(x.FMapInt8Int64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrFloat64`)
+				var yyn1766 bool
+				if x.FptrMapInt8Int64 == nil {
+					yyn1766 = true
+					goto LABEL1766
 				}
-				r.WriteMapElemValue()
-				if yyn1526 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapUintptrFloat64 == nil {
+			LABEL1766:
+				if yyr2 || yy2arr2 {
+					if yyn1766 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1529 := *x.FptrMapUintptrFloat64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt8Int64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuintptrfloat64((map[uintptr]float64)(yy1529), e)
+							yy1767 := *x.FptrMapInt8Int64
+							if false {
+							} else {
+								h.encMapint8int64((map[int8]int64)(yy1767), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapUintptrBool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Int64\"")
 					} else {
-						h.encMapuintptrbool((map[uintptr]bool)(x.FMapUintptrBool), e)
+						r.EncodeSymbol(`FptrMapInt8Int64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapUintptrBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapUintptrBool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapUintptrBool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1766 {
+						r.EncodeNil()
 					} else {
-						h.encMapuintptrbool((map[uintptr]bool)(x.FMapUintptrBool), e)
+						if x.FptrMapInt8Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1769 := *x.FptrMapInt8Int64
+							if false {
+							} else {
+								h.encMapint8int64((map[int8]int64)(yy1769), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1534 bool
-			if x.FptrMapUintptrBool == nil {
-				yyn1534 = true
-				goto LABEL1534
-			}
-		LABEL1534:
-			if yyr2 || yy2arr2 {
-				if yyn1534 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapUintptrBool == nil {
+					if x.FMapInt8Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1535 := *x.FptrMapUintptrBool
 						if false {
 						} else {
-							h.encMapuintptrbool((map[uintptr]bool)(yy1535), e)
+							h.encMapint8float32((map[int8]float32)(x.FMapInt8Float32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapUintptrBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapUintptrBool`)
-				}
-				r.WriteMapElemValue()
-				if yyn1534 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapUintptrBool == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Float32\"")
+					} else {
+						r.EncodeSymbol(`FMapInt8Float32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt8Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1537 := *x.FptrMapUintptrBool
 						if false {
 						} else {
-							h.encMapuintptrbool((map[uintptr]bool)(yy1537), e)
+							h.encMapint8float32((map[int8]float32)(x.FMapInt8Float32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntIntf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1774 bool
+				if x.FptrMapInt8Float32 == nil {
+					yyn1774 = true
+					goto LABEL1774
+				}
+			LABEL1774:
+				if yyr2 || yy2arr2 {
+					if yyn1774 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapintInterface((map[int]interface{})(x.FMapIntIntf), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt8Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1775 := *x.FptrMapInt8Float32
+							if false {
+							} else {
+								h.encMapint8float32((map[int8]float32)(yy1775), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntIntf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntIntf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Float32\"")
 					} else {
-						h.encMapintInterface((map[int]interface{})(x.FMapIntIntf), e)
+						r.EncodeSymbol(`FptrMapInt8Float32`)
 					}
-				}
-			}
-			var yyn1542 bool
-			if x.FptrMapIntIntf == nil {
-				yyn1542 = true
-				goto LABEL1542
-			}
-		LABEL1542:
-			if yyr2 || yy2arr2 {
-				if yyn1542 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntIntf == nil {
+					r.WriteMapElemValue()
+					if yyn1774 {
 						r.EncodeNil()
 					} else {
-						yy1543 := *x.FptrMapIntIntf
-						if false {
+						if x.FptrMapInt8Float32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintInterface((map[int]interface{})(yy1543), e)
+							yy1777 := *x.FptrMapInt8Float32
+							if false {
+							} else {
+								h.encMapint8float32((map[int8]float32)(yy1777), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntIntf`)
-				}
-				r.WriteMapElemValue()
-				if yyn1542 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntIntf == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt8Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1545 := *x.FptrMapIntIntf
 						if false {
 						} else {
-							h.encMapintInterface((map[int]interface{})(yy1545), e)
+							h.encMapint8float64((map[int8]float64)(x.FMapInt8Float64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntString == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapintstring((map[int]string)(x.FMapIntString), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntString`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntString == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Float64\"")
 					} else {
-						h.encMapintstring((map[int]string)(x.FMapIntString), e)
+						r.EncodeSymbol(`FMapInt8Float64`)
 					}
-				}
-			}
-			var yyn1550 bool
-			if x.FptrMapIntString == nil {
-				yyn1550 = true
-				goto LABEL1550
-			}
-		LABEL1550:
-			if yyr2 || yy2arr2 {
-				if yyn1550 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntString == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt8Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1551 := *x.FptrMapIntString
 						if false {
 						} else {
-							h.encMapintstring((map[int]string)(yy1551), e)
+							h.encMapint8float64((map[int8]float64)(x.FMapInt8Float64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntString`)
+				var yyn1782 bool
+				if x.FptrMapInt8Float64 == nil {
+					yyn1782 = true
+					goto LABEL1782
 				}
-				r.WriteMapElemValue()
-				if yyn1550 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntString == nil {
+			LABEL1782:
+				if yyr2 || yy2arr2 {
+					if yyn1782 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1553 := *x.FptrMapIntString
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt8Float64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintstring((map[int]string)(yy1553), e)
+							yy1783 := *x.FptrMapInt8Float64
+							if false {
+							} else {
+								h.encMapint8float64((map[int8]float64)(yy1783), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntUint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Float64\"")
 					} else {
-						h.encMapintuint((map[int]uint)(x.FMapIntUint), e)
+						r.EncodeSymbol(`FptrMapInt8Float64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntUint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntUint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1782 {
+						r.EncodeNil()
 					} else {
-						h.encMapintuint((map[int]uint)(x.FMapIntUint), e)
+						if x.FptrMapInt8Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1785 := *x.FptrMapInt8Float64
+							if false {
+							} else {
+								h.encMapint8float64((map[int8]float64)(yy1785), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1558 bool
-			if x.FptrMapIntUint == nil {
-				yyn1558 = true
-				goto LABEL1558
-			}
-		LABEL1558:
-			if yyr2 || yy2arr2 {
-				if yyn1558 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntUint == nil {
+					if x.FMapInt8Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy1559 := *x.FptrMapIntUint
 						if false {
 						} else {
-							h.encMapintuint((map[int]uint)(yy1559), e)
+							h.encMapint8bool((map[int8]bool)(x.FMapInt8Bool), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntUint`)
-				}
-				r.WriteMapElemValue()
-				if yyn1558 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntUint == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt8Bool\"")
+					} else {
+						r.EncodeSymbol(`FMapInt8Bool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt8Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy1561 := *x.FptrMapIntUint
 						if false {
 						} else {
-							h.encMapintuint((map[int]uint)(yy1561), e)
+							h.encMapint8bool((map[int8]bool)(x.FMapInt8Bool), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntUint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1790 bool
+				if x.FptrMapInt8Bool == nil {
+					yyn1790 = true
+					goto LABEL1790
+				}
+			LABEL1790:
+				if yyr2 || yy2arr2 {
+					if yyn1790 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapintuint8((map[int]uint8)(x.FMapIntUint8), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt8Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy1791 := *x.FptrMapInt8Bool
+							if false {
+							} else {
+								h.encMapint8bool((map[int8]bool)(yy1791), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntUint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntUint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt8Bool\"")
 					} else {
-						h.encMapintuint8((map[int]uint8)(x.FMapIntUint8), e)
+						r.EncodeSymbol(`FptrMapInt8Bool`)
 					}
-				}
-			}
-			var yyn1566 bool
-			if x.FptrMapIntUint8 == nil {
-				yyn1566 = true
-				goto LABEL1566
-			}
-		LABEL1566:
-			if yyr2 || yy2arr2 {
-				if yyn1566 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntUint8 == nil {
+					r.WriteMapElemValue()
+					if yyn1790 {
 						r.EncodeNil()
 					} else {
-						yy1567 := *x.FptrMapIntUint8
-						if false {
+						if x.FptrMapInt8Bool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintuint8((map[int]uint8)(yy1567), e)
+							yy1793 := *x.FptrMapInt8Bool
+							if false {
+							} else {
+								h.encMapint8bool((map[int8]bool)(yy1793), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntUint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1566 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntUint8 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt16Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy1569 := *x.FptrMapIntUint8
 						if false {
 						} else {
-							h.encMapintuint8((map[int]uint8)(yy1569), e)
+							h.encMapint16Interface((map[int16]interface{})(x.FMapInt16Intf), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntUint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapintuint16((map[int]uint16)(x.FMapIntUint16), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntUint16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntUint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntUint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Intf\"")
 					} else {
-						h.encMapintuint16((map[int]uint16)(x.FMapIntUint16), e)
+						r.EncodeSymbol(`FMapInt16Intf`)
 					}
-				}
-			}
-			var yyn1574 bool
-			if x.FptrMapIntUint16 == nil {
-				yyn1574 = true
-				goto LABEL1574
-			}
-		LABEL1574:
-			if yyr2 || yy2arr2 {
-				if yyn1574 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntUint16 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt16Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy1575 := *x.FptrMapIntUint16
 						if false {
 						} else {
-							h.encMapintuint16((map[int]uint16)(yy1575), e)
+							h.encMapint16Interface((map[int16]interface{})(x.FMapInt16Intf), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntUint16`)
+				var yyn1798 bool
+				if x.FptrMapInt16Intf == nil {
+					yyn1798 = true
+					goto LABEL1798
 				}
-				r.WriteMapElemValue()
-				if yyn1574 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntUint16 == nil {
+			LABEL1798:
+				if yyr2 || yy2arr2 {
+					if yyn1798 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1577 := *x.FptrMapIntUint16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt16Intf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintuint16((map[int]uint16)(yy1577), e)
+							yy1799 := *x.FptrMapInt16Intf
+							if false {
+							} else {
+								h.encMapint16Interface((map[int16]interface{})(yy1799), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntUint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Intf\"")
 					} else {
-						h.encMapintuint32((map[int]uint32)(x.FMapIntUint32), e)
+						r.EncodeSymbol(`FptrMapInt16Intf`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntUint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntUint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1798 {
+						r.EncodeNil()
 					} else {
-						h.encMapintuint32((map[int]uint32)(x.FMapIntUint32), e)
+						if x.FptrMapInt16Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy1801 := *x.FptrMapInt16Intf
+							if false {
+							} else {
+								h.encMapint16Interface((map[int16]interface{})(yy1801), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1582 bool
-			if x.FptrMapIntUint32 == nil {
-				yyn1582 = true
-				goto LABEL1582
-			}
-		LABEL1582:
-			if yyr2 || yy2arr2 {
-				if yyn1582 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntUint32 == nil {
+					if x.FMapInt16String == nil {
 						r.EncodeNil()
 					} else {
-						yy1583 := *x.FptrMapIntUint32
 						if false {
 						} else {
-							h.encMapintuint32((map[int]uint32)(yy1583), e)
+							h.encMapint16string((map[int16]string)(x.FMapInt16String), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntUint32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntUint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1582 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntUint32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16String\"")
+					} else {
+						r.EncodeSymbol(`FMapInt16String`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt16String == nil {
 						r.EncodeNil()
 					} else {
-						yy1585 := *x.FptrMapIntUint32
 						if false {
 						} else {
-							h.encMapintuint32((map[int]uint32)(yy1585), e)
+							h.encMapint16string((map[int16]string)(x.FMapInt16String), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntUint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1806 bool
+				if x.FptrMapInt16String == nil {
+					yyn1806 = true
+					goto LABEL1806
+				}
+			LABEL1806:
+				if yyr2 || yy2arr2 {
+					if yyn1806 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapintuint64((map[int]uint64)(x.FMapIntUint64), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt16String == nil {
+							r.EncodeNil()
+						} else {
+							yy1807 := *x.FptrMapInt16String
+							if false {
+							} else {
+								h.encMapint16string((map[int16]string)(yy1807), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntUint64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntUint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntUint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16String\"")
 					} else {
-						h.encMapintuint64((map[int]uint64)(x.FMapIntUint64), e)
+						r.EncodeSymbol(`FptrMapInt16String`)
 					}
-				}
-			}
-			var yyn1590 bool
-			if x.FptrMapIntUint64 == nil {
-				yyn1590 = true
-				goto LABEL1590
-			}
-		LABEL1590:
-			if yyr2 || yy2arr2 {
-				if yyn1590 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntUint64 == nil {
+					r.WriteMapElemValue()
+					if yyn1806 {
 						r.EncodeNil()
 					} else {
-						yy1591 := *x.FptrMapIntUint64
-						if false {
+						if x.FptrMapInt16String == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintuint64((map[int]uint64)(yy1591), e)
+							yy1809 := *x.FptrMapInt16String
+							if false {
+							} else {
+								h.encMapint16string((map[int16]string)(yy1809), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntUint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1590 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntUint64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt16Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy1593 := *x.FptrMapIntUint64
 						if false {
 						} else {
-							h.encMapintuint64((map[int]uint64)(yy1593), e)
+							h.encMapint16uint((map[int16]uint)(x.FMapInt16Uint), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntUintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapintuintptr((map[int]uintptr)(x.FMapIntUintptr), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntUintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntUintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Uint\"")
 					} else {
-						h.encMapintuintptr((map[int]uintptr)(x.FMapIntUintptr), e)
+						r.EncodeSymbol(`FMapInt16Uint`)
 					}
-				}
-			}
-			var yyn1598 bool
-			if x.FptrMapIntUintptr == nil {
-				yyn1598 = true
-				goto LABEL1598
-			}
-		LABEL1598:
-			if yyr2 || yy2arr2 {
-				if yyn1598 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntUintptr == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt16Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy1599 := *x.FptrMapIntUintptr
 						if false {
 						} else {
-							h.encMapintuintptr((map[int]uintptr)(yy1599), e)
+							h.encMapint16uint((map[int16]uint)(x.FMapInt16Uint), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntUintptr`)
+				var yyn1814 bool
+				if x.FptrMapInt16Uint == nil {
+					yyn1814 = true
+					goto LABEL1814
 				}
-				r.WriteMapElemValue()
-				if yyn1598 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntUintptr == nil {
+			LABEL1814:
+				if yyr2 || yy2arr2 {
+					if yyn1814 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1601 := *x.FptrMapIntUintptr
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt16Uint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintuintptr((map[int]uintptr)(yy1601), e)
+							yy1815 := *x.FptrMapInt16Uint
+							if false {
+							} else {
+								h.encMapint16uint((map[int16]uint)(yy1815), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntInt == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Uint\"")
 					} else {
-						h.encMapintint((map[int]int)(x.FMapIntInt), e)
+						r.EncodeSymbol(`FptrMapInt16Uint`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntInt`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1814 {
+						r.EncodeNil()
 					} else {
-						h.encMapintint((map[int]int)(x.FMapIntInt), e)
+						if x.FptrMapInt16Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy1817 := *x.FptrMapInt16Uint
+							if false {
+							} else {
+								h.encMapint16uint((map[int16]uint)(yy1817), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1606 bool
-			if x.FptrMapIntInt == nil {
-				yyn1606 = true
-				goto LABEL1606
-			}
-		LABEL1606:
-			if yyr2 || yy2arr2 {
-				if yyn1606 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntInt == nil {
+					if x.FMapInt16Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1607 := *x.FptrMapIntInt
 						if false {
 						} else {
-							h.encMapintint((map[int]int)(yy1607), e)
+							h.encMapint16uint8((map[int16]uint8)(x.FMapInt16Uint8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntInt`)
-				}
-				r.WriteMapElemValue()
-				if yyn1606 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntInt == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Uint8\"")
+					} else {
+						r.EncodeSymbol(`FMapInt16Uint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt16Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1609 := *x.FptrMapIntInt
 						if false {
 						} else {
-							h.encMapintint((map[int]int)(yy1609), e)
+							h.encMapint16uint8((map[int16]uint8)(x.FMapInt16Uint8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntInt8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1822 bool
+				if x.FptrMapInt16Uint8 == nil {
+					yyn1822 = true
+					goto LABEL1822
+				}
+			LABEL1822:
+				if yyr2 || yy2arr2 {
+					if yyn1822 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapintint8((map[int]int8)(x.FMapIntInt8), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt16Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1823 := *x.FptrMapInt16Uint8
+							if false {
+							} else {
+								h.encMapint16uint8((map[int16]uint8)(yy1823), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntInt8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntInt8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Uint8\"")
 					} else {
-						h.encMapintint8((map[int]int8)(x.FMapIntInt8), e)
+						r.EncodeSymbol(`FptrMapInt16Uint8`)
+					}
+					r.WriteMapElemValue()
+					if yyn1822 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapInt16Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1825 := *x.FptrMapInt16Uint8
+							if false {
+							} else {
+								h.encMapint16uint8((map[int16]uint8)(yy1825), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1614 bool
-			if x.FptrMapIntInt8 == nil {
-				yyn1614 = true
-				goto LABEL1614
-			}
-		LABEL1614:
-			if yyr2 || yy2arr2 {
-				if yyn1614 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntInt8 == nil {
+					if x.FMapInt16Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1615 := *x.FptrMapIntInt8
 						if false {
 						} else {
-							h.encMapintint8((map[int]int8)(yy1615), e)
+							h.encMapint16uint16((map[int16]uint16)(x.FMapInt16Uint16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntInt8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1614 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntInt8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Uint16\"")
+					} else {
+						r.EncodeSymbol(`FMapInt16Uint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt16Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1617 := *x.FptrMapIntInt8
 						if false {
 						} else {
-							h.encMapintint8((map[int]int8)(yy1617), e)
+							h.encMapint16uint16((map[int16]uint16)(x.FMapInt16Uint16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1830 bool
+				if x.FptrMapInt16Uint16 == nil {
+					yyn1830 = true
+					goto LABEL1830
+				}
+			LABEL1830:
+				if yyr2 || yy2arr2 {
+					if yyn1830 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapintint16((map[int]int16)(x.FMapIntInt16), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt16Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1831 := *x.FptrMapInt16Uint16
+							if false {
+							} else {
+								h.encMapint16uint16((map[int16]uint16)(yy1831), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntInt16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntInt16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Uint16\"")
 					} else {
-						h.encMapintint16((map[int]int16)(x.FMapIntInt16), e)
+						r.EncodeSymbol(`FptrMapInt16Uint16`)
 					}
-				}
-			}
-			var yyn1622 bool
-			if x.FptrMapIntInt16 == nil {
-				yyn1622 = true
-				goto LABEL1622
-			}
-		LABEL1622:
-			if yyr2 || yy2arr2 {
-				if yyn1622 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntInt16 == nil {
+					r.WriteMapElemValue()
+					if yyn1830 {
 						r.EncodeNil()
 					} else {
-						yy1623 := *x.FptrMapIntInt16
-						if false {
+						if x.FptrMapInt16Uint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintint16((map[int]int16)(yy1623), e)
+							yy1833 := *x.FptrMapInt16Uint16
+							if false {
+							} else {
+								h.encMapint16uint16((map[int16]uint16)(yy1833), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntInt16`)
-				}
-				r.WriteMapElemValue()
-				if yyn1622 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntInt16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt16Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1625 := *x.FptrMapIntInt16
 						if false {
 						} else {
-							h.encMapintint16((map[int]int16)(yy1625), e)
+							h.encMapint16uint32((map[int16]uint32)(x.FMapInt16Uint32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntInt32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapintint32((map[int]int32)(x.FMapIntInt32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntInt32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntInt32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Uint32\"")
 					} else {
-						h.encMapintint32((map[int]int32)(x.FMapIntInt32), e)
+						r.EncodeSymbol(`FMapInt16Uint32`)
 					}
-				}
-			}
-			var yyn1630 bool
-			if x.FptrMapIntInt32 == nil {
-				yyn1630 = true
-				goto LABEL1630
-			}
-		LABEL1630:
-			if yyr2 || yy2arr2 {
-				if yyn1630 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntInt32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt16Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1631 := *x.FptrMapIntInt32
 						if false {
 						} else {
-							h.encMapintint32((map[int]int32)(yy1631), e)
+							h.encMapint16uint32((map[int16]uint32)(x.FMapInt16Uint32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntInt32`)
+				var yyn1838 bool
+				if x.FptrMapInt16Uint32 == nil {
+					yyn1838 = true
+					goto LABEL1838
 				}
-				r.WriteMapElemValue()
-				if yyn1630 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntInt32 == nil {
+			LABEL1838:
+				if yyr2 || yy2arr2 {
+					if yyn1838 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1633 := *x.FptrMapIntInt32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt16Uint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintint32((map[int]int32)(yy1633), e)
+							yy1839 := *x.FptrMapInt16Uint32
+							if false {
+							} else {
+								h.encMapint16uint32((map[int16]uint32)(yy1839), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntInt64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Uint32\"")
 					} else {
-						h.encMapintint64((map[int]int64)(x.FMapIntInt64), e)
+						r.EncodeSymbol(`FptrMapInt16Uint32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntInt64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1838 {
+						r.EncodeNil()
 					} else {
-						h.encMapintint64((map[int]int64)(x.FMapIntInt64), e)
+						if x.FptrMapInt16Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1841 := *x.FptrMapInt16Uint32
+							if false {
+							} else {
+								h.encMapint16uint32((map[int16]uint32)(yy1841), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1638 bool
-			if x.FptrMapIntInt64 == nil {
-				yyn1638 = true
-				goto LABEL1638
-			}
-		LABEL1638:
-			if yyr2 || yy2arr2 {
-				if yyn1638 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntInt64 == nil {
+					if x.FMapInt16Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1639 := *x.FptrMapIntInt64
 						if false {
 						} else {
-							h.encMapintint64((map[int]int64)(yy1639), e)
+							h.encMapint16uint64((map[int16]uint64)(x.FMapInt16Uint64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntInt64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1638 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntInt64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Uint64\"")
+					} else {
+						r.EncodeSymbol(`FMapInt16Uint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt16Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1641 := *x.FptrMapIntInt64
 						if false {
 						} else {
-							h.encMapintint64((map[int]int64)(yy1641), e)
+							h.encMapint16uint64((map[int16]uint64)(x.FMapInt16Uint64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntFloat32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1846 bool
+				if x.FptrMapInt16Uint64 == nil {
+					yyn1846 = true
+					goto LABEL1846
+				}
+			LABEL1846:
+				if yyr2 || yy2arr2 {
+					if yyn1846 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapintfloat32((map[int]float32)(x.FMapIntFloat32), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt16Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1847 := *x.FptrMapInt16Uint64
+							if false {
+							} else {
+								h.encMapint16uint64((map[int16]uint64)(yy1847), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntFloat32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntFloat32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Uint64\"")
 					} else {
-						h.encMapintfloat32((map[int]float32)(x.FMapIntFloat32), e)
+						r.EncodeSymbol(`FptrMapInt16Uint64`)
 					}
-				}
-			}
-			var yyn1646 bool
-			if x.FptrMapIntFloat32 == nil {
-				yyn1646 = true
-				goto LABEL1646
-			}
-		LABEL1646:
-			if yyr2 || yy2arr2 {
-				if yyn1646 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntFloat32 == nil {
+					r.WriteMapElemValue()
+					if yyn1846 {
 						r.EncodeNil()
 					} else {
-						yy1647 := *x.FptrMapIntFloat32
-						if false {
+						if x.FptrMapInt16Uint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintfloat32((map[int]float32)(yy1647), e)
+							yy1849 := *x.FptrMapInt16Uint64
+							if false {
+							} else {
+								h.encMapint16uint64((map[int16]uint64)(yy1849), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntFloat32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1646 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntFloat32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt16Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1649 := *x.FptrMapIntFloat32
 						if false {
 						} else {
-							h.encMapintfloat32((map[int]float32)(yy1649), e)
+							h.encMapint16uintptr((map[int16]uintptr)(x.FMapInt16Uintptr), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntFloat64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapintfloat64((map[int]float64)(x.FMapIntFloat64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntFloat64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntFloat64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Uintptr\"")
 					} else {
-						h.encMapintfloat64((map[int]float64)(x.FMapIntFloat64), e)
+						r.EncodeSymbol(`FMapInt16Uintptr`)
 					}
-				}
-			}
-			var yyn1654 bool
-			if x.FptrMapIntFloat64 == nil {
-				yyn1654 = true
-				goto LABEL1654
-			}
-		LABEL1654:
-			if yyr2 || yy2arr2 {
-				if yyn1654 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapIntFloat64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt16Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1655 := *x.FptrMapIntFloat64
 						if false {
 						} else {
-							h.encMapintfloat64((map[int]float64)(yy1655), e)
+							h.encMapint16uintptr((map[int16]uintptr)(x.FMapInt16Uintptr), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntFloat64`)
+				var yyn1854 bool
+				if x.FptrMapInt16Uintptr == nil {
+					yyn1854 = true
+					goto LABEL1854
 				}
-				r.WriteMapElemValue()
-				if yyn1654 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapIntFloat64 == nil {
+			LABEL1854:
+				if yyr2 || yy2arr2 {
+					if yyn1854 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1657 := *x.FptrMapIntFloat64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt16Uintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintfloat64((map[int]float64)(yy1657), e)
+							yy1855 := *x.FptrMapInt16Uintptr
+							if false {
+							} else {
+								h.encMapint16uintptr((map[int16]uintptr)(yy1855), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapIntBool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Uintptr\"")
 					} else {
-						h.encMapintbool((map[int]bool)(x.FMapIntBool), e)
+						r.EncodeSymbol(`FptrMapInt16Uintptr`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapIntBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapIntBool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapIntBool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1854 {
+						r.EncodeNil()
 					} else {
-						h.encMapintbool((map[int]bool)(x.FMapIntBool), e)
+						if x.FptrMapInt16Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy1857 := *x.FptrMapInt16Uintptr
+							if false {
+							} else {
+								h.encMapint16uintptr((map[int16]uintptr)(yy1857), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1662 bool
-			if x.FptrMapIntBool == nil {
-				yyn1662 = true
-				goto LABEL1662
-			}
-		LABEL1662:
-			if yyr2 || yy2arr2 {
-				if yyn1662 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapIntBool == nil {
+					if x.FMapInt16Int == nil {
 						r.EncodeNil()
 					} else {
-						yy1663 := *x.FptrMapIntBool
 						if false {
 						} else {
-							h.encMapintbool((map[int]bool)(yy1663), e)
+							h.encMapint16int((map[int16]int)(x.FMapInt16Int), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapIntBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapIntBool`)
-				}
-				r.WriteMapElemValue()
-				if yyn1662 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapIntBool == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Int\"")
+					} else {
+						r.EncodeSymbol(`FMapInt16Int`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt16Int == nil {
 						r.EncodeNil()
 					} else {
-						yy1665 := *x.FptrMapIntBool
 						if false {
 						} else {
-							h.encMapintbool((map[int]bool)(yy1665), e)
+							h.encMapint16int((map[int16]int)(x.FMapInt16Int), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1862 bool
+				if x.FptrMapInt16Int == nil {
+					yyn1862 = true
+					goto LABEL1862
+				}
+			LABEL1862:
+				if yyr2 || yy2arr2 {
+					if yyn1862 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint8Interface((map[int8]interface{})(x.FMapInt8Intf), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt16Int == nil {
+							r.EncodeNil()
+						} else {
+							yy1863 := *x.FptrMapInt16Int
+							if false {
+							} else {
+								h.encMapint16int((map[int16]int)(yy1863), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Int\"")
 					} else {
-						h.encMapint8Interface((map[int8]interface{})(x.FMapInt8Intf), e)
+						r.EncodeSymbol(`FptrMapInt16Int`)
 					}
-				}
-			}
-			var yyn1670 bool
-			if x.FptrMapInt8Intf == nil {
-				yyn1670 = true
-				goto LABEL1670
-			}
-		LABEL1670:
-			if yyr2 || yy2arr2 {
-				if yyn1670 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Intf == nil {
+					r.WriteMapElemValue()
+					if yyn1862 {
 						r.EncodeNil()
 					} else {
-						yy1671 := *x.FptrMapInt8Intf
-						if false {
+						if x.FptrMapInt16Int == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8Interface((map[int8]interface{})(yy1671), e)
+							yy1865 := *x.FptrMapInt16Int
+							if false {
+							} else {
+								h.encMapint16int((map[int16]int)(yy1865), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Intf`)
-				}
-				r.WriteMapElemValue()
-				if yyn1670 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Intf == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt16Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1673 := *x.FptrMapInt8Intf
 						if false {
 						} else {
-							h.encMapint8Interface((map[int8]interface{})(yy1673), e)
+							h.encMapint16int8((map[int16]int8)(x.FMapInt16Int8), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint8string((map[int8]string)(x.FMapInt8String), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8String\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Int8\"")
 					} else {
-						h.encMapint8string((map[int8]string)(x.FMapInt8String), e)
+						r.EncodeSymbol(`FMapInt16Int8`)
 					}
-				}
-			}
-			var yyn1678 bool
-			if x.FptrMapInt8String == nil {
-				yyn1678 = true
-				goto LABEL1678
-			}
-		LABEL1678:
-			if yyr2 || yy2arr2 {
-				if yyn1678 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8String == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt16Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1679 := *x.FptrMapInt8String
 						if false {
 						} else {
-							h.encMapint8string((map[int8]string)(yy1679), e)
+							h.encMapint16int8((map[int16]int8)(x.FMapInt16Int8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8String`)
+				var yyn1870 bool
+				if x.FptrMapInt16Int8 == nil {
+					yyn1870 = true
+					goto LABEL1870
 				}
-				r.WriteMapElemValue()
-				if yyn1678 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8String == nil {
+			LABEL1870:
+				if yyr2 || yy2arr2 {
+					if yyn1870 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1681 := *x.FptrMapInt8String
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt16Int8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8string((map[int8]string)(yy1681), e)
+							yy1871 := *x.FptrMapInt16Int8
+							if false {
+							} else {
+								h.encMapint16int8((map[int16]int8)(yy1871), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Int8\"")
 					} else {
-						h.encMapint8uint((map[int8]uint)(x.FMapInt8Uint), e)
+						r.EncodeSymbol(`FptrMapInt16Int8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1870 {
+						r.EncodeNil()
 					} else {
-						h.encMapint8uint((map[int8]uint)(x.FMapInt8Uint), e)
+						if x.FptrMapInt16Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1873 := *x.FptrMapInt16Int8
+							if false {
+							} else {
+								h.encMapint16int8((map[int16]int8)(yy1873), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1686 bool
-			if x.FptrMapInt8Uint == nil {
-				yyn1686 = true
-				goto LABEL1686
-			}
-		LABEL1686:
-			if yyr2 || yy2arr2 {
-				if yyn1686 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Uint == nil {
+					if x.FMapInt16Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1687 := *x.FptrMapInt8Uint
 						if false {
 						} else {
-							h.encMapint8uint((map[int8]uint)(yy1687), e)
+							h.encMapint16int16((map[int16]int16)(x.FMapInt16Int16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Uint\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Uint`)
-				}
-				r.WriteMapElemValue()
-				if yyn1686 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Uint == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Int16\"")
+					} else {
+						r.EncodeSymbol(`FMapInt16Int16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt16Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1689 := *x.FptrMapInt8Uint
 						if false {
 						} else {
-							h.encMapint8uint((map[int8]uint)(yy1689), e)
+							h.encMapint16int16((map[int16]int16)(x.FMapInt16Int16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1878 bool
+				if x.FptrMapInt16Int16 == nil {
+					yyn1878 = true
+					goto LABEL1878
+				}
+			LABEL1878:
+				if yyr2 || yy2arr2 {
+					if yyn1878 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint8uint8((map[int8]uint8)(x.FMapInt8Uint8), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt16Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1879 := *x.FptrMapInt16Int16
+							if false {
+							} else {
+								h.encMapint16int16((map[int16]int16)(yy1879), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Uint8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Int16\"")
+					} else {
+						r.EncodeSymbol(`FptrMapInt16Int16`)
+					}
+					r.WriteMapElemValue()
+					if yyn1878 {
+						r.EncodeNil()
 					} else {
-						h.encMapint8uint8((map[int8]uint8)(x.FMapInt8Uint8), e)
+						if x.FptrMapInt16Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1881 := *x.FptrMapInt16Int16
+							if false {
+							} else {
+								h.encMapint16int16((map[int16]int16)(yy1881), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1694 bool
-			if x.FptrMapInt8Uint8 == nil {
-				yyn1694 = true
-				goto LABEL1694
-			}
-		LABEL1694:
-			if yyr2 || yy2arr2 {
-				if yyn1694 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Uint8 == nil {
+					if x.FMapInt16Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1695 := *x.FptrMapInt8Uint8
 						if false {
 						} else {
-							h.encMapint8uint8((map[int8]uint8)(yy1695), e)
+							h.encMapint16int32((map[int16]int32)(x.FMapInt16Int32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Uint8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Uint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1694 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Uint8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Int32\"")
+					} else {
+						r.EncodeSymbol(`FMapInt16Int32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt16Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1697 := *x.FptrMapInt8Uint8
 						if false {
 						} else {
-							h.encMapint8uint8((map[int8]uint8)(yy1697), e)
+							h.encMapint16int32((map[int16]int32)(x.FMapInt16Int32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1886 bool
+				if x.FptrMapInt16Int32 == nil {
+					yyn1886 = true
+					goto LABEL1886
+				}
+			LABEL1886:
+				if yyr2 || yy2arr2 {
+					if yyn1886 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint8uint16((map[int8]uint16)(x.FMapInt8Uint16), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt16Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1887 := *x.FptrMapInt16Int32
+							if false {
+							} else {
+								h.encMapint16int32((map[int16]int32)(yy1887), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Uint16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Int32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapInt16Int32`)
+					}
+					r.WriteMapElemValue()
+					if yyn1886 {
+						r.EncodeNil()
 					} else {
-						h.encMapint8uint16((map[int8]uint16)(x.FMapInt8Uint16), e)
+						if x.FptrMapInt16Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1889 := *x.FptrMapInt16Int32
+							if false {
+							} else {
+								h.encMapint16int32((map[int16]int32)(yy1889), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1702 bool
-			if x.FptrMapInt8Uint16 == nil {
-				yyn1702 = true
-				goto LABEL1702
-			}
-		LABEL1702:
-			if yyr2 || yy2arr2 {
-				if yyn1702 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.FMapInt16Int64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapint16int64((map[int16]int64)(x.FMapInt16Int64), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Uint16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Int64\"")
+					} else {
+						r.EncodeSymbol(`FMapInt16Int64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt16Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1703 := *x.FptrMapInt8Uint16
 						if false {
 						} else {
-							h.encMapint8uint16((map[int8]uint16)(yy1703), e)
+							h.encMapint16int64((map[int16]int64)(x.FMapInt16Int64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Uint16`)
+				var yyn1894 bool
+				if x.FptrMapInt16Int64 == nil {
+					yyn1894 = true
+					goto LABEL1894
 				}
-				r.WriteMapElemValue()
-				if yyn1702 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Uint16 == nil {
+			LABEL1894:
+				if yyr2 || yy2arr2 {
+					if yyn1894 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1705 := *x.FptrMapInt8Uint16
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt16Int64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8uint16((map[int8]uint16)(yy1705), e)
+							yy1895 := *x.FptrMapInt16Int64
+							if false {
+							} else {
+								h.encMapint16int64((map[int16]int64)(yy1895), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint8uint32((map[int8]uint32)(x.FMapInt8Uint32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Uint32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Int64\"")
 					} else {
-						h.encMapint8uint32((map[int8]uint32)(x.FMapInt8Uint32), e)
+						r.EncodeSymbol(`FptrMapInt16Int64`)
 					}
-				}
-			}
-			var yyn1710 bool
-			if x.FptrMapInt8Uint32 == nil {
-				yyn1710 = true
-				goto LABEL1710
-			}
-		LABEL1710:
-			if yyr2 || yy2arr2 {
-				if yyn1710 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Uint32 == nil {
+					r.WriteMapElemValue()
+					if yyn1894 {
 						r.EncodeNil()
 					} else {
-						yy1711 := *x.FptrMapInt8Uint32
-						if false {
+						if x.FptrMapInt16Int64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8uint32((map[int8]uint32)(yy1711), e)
+							yy1897 := *x.FptrMapInt16Int64
+							if false {
+							} else {
+								h.encMapint16int64((map[int16]int64)(yy1897), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Uint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1710 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Uint32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt16Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1713 := *x.FptrMapInt8Uint32
 						if false {
 						} else {
-							h.encMapint8uint32((map[int8]uint32)(yy1713), e)
+							h.encMapint16float32((map[int16]float32)(x.FMapInt16Float32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint8uint64((map[int8]uint64)(x.FMapInt8Uint64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Uint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Float32\"")
 					} else {
-						h.encMapint8uint64((map[int8]uint64)(x.FMapInt8Uint64), e)
+						r.EncodeSymbol(`FMapInt16Float32`)
 					}
-				}
-			}
-			var yyn1718 bool
-			if x.FptrMapInt8Uint64 == nil {
-				yyn1718 = true
-				goto LABEL1718
-			}
-		LABEL1718:
-			if yyr2 || yy2arr2 {
-				if yyn1718 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Uint64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt16Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1719 := *x.FptrMapInt8Uint64
 						if false {
 						} else {
-							h.encMapint8uint64((map[int8]uint64)(yy1719), e)
+							h.encMapint16float32((map[int16]float32)(x.FMapInt16Float32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Uint64`)
+				var yyn1902 bool
+				if x.FptrMapInt16Float32 == nil {
+					yyn1902 = true
+					goto LABEL1902
 				}
-				r.WriteMapElemValue()
-				if yyn1718 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Uint64 == nil {
+			LABEL1902:
+				if yyr2 || yy2arr2 {
+					if yyn1902 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1721 := *x.FptrMapInt8Uint64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt16Float32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8uint64((map[int8]uint64)(yy1721), e)
+							yy1903 := *x.FptrMapInt16Float32
+							if false {
+							} else {
+								h.encMapint16float32((map[int16]float32)(yy1903), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Uintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Float32\"")
 					} else {
-						h.encMapint8uintptr((map[int8]uintptr)(x.FMapInt8Uintptr), e)
+						r.EncodeSymbol(`FptrMapInt16Float32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1902 {
+						r.EncodeNil()
 					} else {
-						h.encMapint8uintptr((map[int8]uintptr)(x.FMapInt8Uintptr), e)
+						if x.FptrMapInt16Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1905 := *x.FptrMapInt16Float32
+							if false {
+							} else {
+								h.encMapint16float32((map[int16]float32)(yy1905), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1726 bool
-			if x.FptrMapInt8Uintptr == nil {
-				yyn1726 = true
-				goto LABEL1726
-			}
-		LABEL1726:
-			if yyr2 || yy2arr2 {
-				if yyn1726 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt8Uintptr == nil {
+					if x.FMapInt16Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1727 := *x.FptrMapInt8Uintptr
 						if false {
 						} else {
-							h.encMapint8uintptr((map[int8]uintptr)(yy1727), e)
+							h.encMapint16float64((map[int16]float64)(x.FMapInt16Float64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn1726 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt8Uintptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Float64\"")
+					} else {
+						r.EncodeSymbol(`FMapInt16Float64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt16Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1729 := *x.FptrMapInt8Uintptr
 						if false {
 						} else {
-							h.encMapint8uintptr((map[int8]uintptr)(yy1729), e)
+							h.encMapint16float64((map[int16]float64)(x.FMapInt16Float64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1910 bool
+				if x.FptrMapInt16Float64 == nil {
+					yyn1910 = true
+					goto LABEL1910
+				}
+			LABEL1910:
+				if yyr2 || yy2arr2 {
+					if yyn1910 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint8int((map[int8]int)(x.FMapInt8Int), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt16Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1911 := *x.FptrMapInt16Float64
+							if false {
+							} else {
+								h.encMapint16float64((map[int16]float64)(yy1911), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Int == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Float64\"")
 					} else {
-						h.encMapint8int((map[int8]int)(x.FMapInt8Int), e)
+						r.EncodeSymbol(`FptrMapInt16Float64`)
 					}
-				}
-			}
-			var yyn1734 bool
-			if x.FptrMapInt8Int == nil {
-				yyn1734 = true
-				goto LABEL1734
-			}
-		LABEL1734:
-			if yyr2 || yy2arr2 {
-				if yyn1734 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Int == nil {
+					r.WriteMapElemValue()
+					if yyn1910 {
 						r.EncodeNil()
 					} else {
-						yy1735 := *x.FptrMapInt8Int
-						if false {
+						if x.FptrMapInt16Float64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8int((map[int8]int)(yy1735), e)
+							yy1913 := *x.FptrMapInt16Float64
+							if false {
+							} else {
+								h.encMapint16float64((map[int16]float64)(yy1913), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Int`)
-				}
-				r.WriteMapElemValue()
-				if yyn1734 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Int == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt16Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy1737 := *x.FptrMapInt8Int
 						if false {
 						} else {
-							h.encMapint8int((map[int8]int)(yy1737), e)
+							h.encMapint16bool((map[int16]bool)(x.FMapInt16Bool), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint8int8((map[int8]int8)(x.FMapInt8Int8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt16Bool\"")
 					} else {
-						h.encMapint8int8((map[int8]int8)(x.FMapInt8Int8), e)
+						r.EncodeSymbol(`FMapInt16Bool`)
 					}
-				}
-			}
-			var yyn1742 bool
-			if x.FptrMapInt8Int8 == nil {
-				yyn1742 = true
-				goto LABEL1742
-			}
-		LABEL1742:
-			if yyr2 || yy2arr2 {
-				if yyn1742 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Int8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt16Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy1743 := *x.FptrMapInt8Int8
 						if false {
 						} else {
-							h.encMapint8int8((map[int8]int8)(yy1743), e)
+							h.encMapint16bool((map[int16]bool)(x.FMapInt16Bool), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Int8`)
+				var yyn1918 bool
+				if x.FptrMapInt16Bool == nil {
+					yyn1918 = true
+					goto LABEL1918
 				}
-				r.WriteMapElemValue()
-				if yyn1742 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Int8 == nil {
+			LABEL1918:
+				if yyr2 || yy2arr2 {
+					if yyn1918 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1745 := *x.FptrMapInt8Int8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt16Bool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8int8((map[int8]int8)(yy1745), e)
+							yy1919 := *x.FptrMapInt16Bool
+							if false {
+							} else {
+								h.encMapint16bool((map[int16]bool)(yy1919), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Int16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt16Bool\"")
 					} else {
-						h.encMapint8int16((map[int8]int16)(x.FMapInt8Int16), e)
+						r.EncodeSymbol(`FptrMapInt16Bool`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1918 {
+						r.EncodeNil()
 					} else {
-						h.encMapint8int16((map[int8]int16)(x.FMapInt8Int16), e)
+						if x.FptrMapInt16Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy1921 := *x.FptrMapInt16Bool
+							if false {
+							} else {
+								h.encMapint16bool((map[int16]bool)(yy1921), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1750 bool
-			if x.FptrMapInt8Int16 == nil {
-				yyn1750 = true
-				goto LABEL1750
-			}
-		LABEL1750:
-			if yyr2 || yy2arr2 {
-				if yyn1750 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt8Int16 == nil {
+					if x.FMapInt32Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy1751 := *x.FptrMapInt8Int16
 						if false {
 						} else {
-							h.encMapint8int16((map[int8]int16)(yy1751), e)
+							h.encMapint32Interface((map[int32]interface{})(x.FMapInt32Intf), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Int16`)
-				}
-				r.WriteMapElemValue()
-				if yyn1750 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt8Int16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Intf\"")
+					} else {
+						r.EncodeSymbol(`FMapInt32Intf`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt32Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy1753 := *x.FptrMapInt8Int16
 						if false {
 						} else {
-							h.encMapint8int16((map[int8]int16)(yy1753), e)
+							h.encMapint32Interface((map[int32]interface{})(x.FMapInt32Intf), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1926 bool
+				if x.FptrMapInt32Intf == nil {
+					yyn1926 = true
+					goto LABEL1926
+				}
+			LABEL1926:
+				if yyr2 || yy2arr2 {
+					if yyn1926 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint8int32((map[int8]int32)(x.FMapInt8Int32), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt32Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy1927 := *x.FptrMapInt32Intf
+							if false {
+							} else {
+								h.encMapint32Interface((map[int32]interface{})(yy1927), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Int32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Intf\"")
 					} else {
-						h.encMapint8int32((map[int8]int32)(x.FMapInt8Int32), e)
+						r.EncodeSymbol(`FptrMapInt32Intf`)
 					}
-				}
-			}
-			var yyn1758 bool
-			if x.FptrMapInt8Int32 == nil {
-				yyn1758 = true
-				goto LABEL1758
-			}
-		LABEL1758:
-			if yyr2 || yy2arr2 {
-				if yyn1758 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Int32 == nil {
+					r.WriteMapElemValue()
+					if yyn1926 {
 						r.EncodeNil()
 					} else {
-						yy1759 := *x.FptrMapInt8Int32
-						if false {
+						if x.FptrMapInt32Intf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8int32((map[int8]int32)(yy1759), e)
+							yy1929 := *x.FptrMapInt32Intf
+							if false {
+							} else {
+								h.encMapint32Interface((map[int32]interface{})(yy1929), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Int32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1758 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Int32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt32String == nil {
 						r.EncodeNil()
 					} else {
-						yy1761 := *x.FptrMapInt8Int32
 						if false {
 						} else {
-							h.encMapint8int32((map[int8]int32)(yy1761), e)
+							h.encMapint32string((map[int32]string)(x.FMapInt32String), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint8int64((map[int8]int64)(x.FMapInt8Int64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Int64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32String\"")
 					} else {
-						h.encMapint8int64((map[int8]int64)(x.FMapInt8Int64), e)
+						r.EncodeSymbol(`FMapInt32String`)
 					}
-				}
-			}
-			var yyn1766 bool
-			if x.FptrMapInt8Int64 == nil {
-				yyn1766 = true
-				goto LABEL1766
-			}
-		LABEL1766:
-			if yyr2 || yy2arr2 {
-				if yyn1766 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Int64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt32String == nil {
 						r.EncodeNil()
 					} else {
-						yy1767 := *x.FptrMapInt8Int64
 						if false {
 						} else {
-							h.encMapint8int64((map[int8]int64)(yy1767), e)
+							h.encMapint32string((map[int32]string)(x.FMapInt32String), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Int64`)
+				var yyn1934 bool
+				if x.FptrMapInt32String == nil {
+					yyn1934 = true
+					goto LABEL1934
 				}
-				r.WriteMapElemValue()
-				if yyn1766 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Int64 == nil {
+			LABEL1934:
+				if yyr2 || yy2arr2 {
+					if yyn1934 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1769 := *x.FptrMapInt8Int64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt32String == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8int64((map[int8]int64)(yy1769), e)
+							yy1935 := *x.FptrMapInt32String
+							if false {
+							} else {
+								h.encMapint32string((map[int32]string)(yy1935), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Float32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32String\"")
 					} else {
-						h.encMapint8float32((map[int8]float32)(x.FMapInt8Float32), e)
+						r.EncodeSymbol(`FptrMapInt32String`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1934 {
+						r.EncodeNil()
 					} else {
-						h.encMapint8float32((map[int8]float32)(x.FMapInt8Float32), e)
+						if x.FptrMapInt32String == nil {
+							r.EncodeNil()
+						} else {
+							yy1937 := *x.FptrMapInt32String
+							if false {
+							} else {
+								h.encMapint32string((map[int32]string)(yy1937), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1774 bool
-			if x.FptrMapInt8Float32 == nil {
-				yyn1774 = true
-				goto LABEL1774
-			}
-		LABEL1774:
-			if yyr2 || yy2arr2 {
-				if yyn1774 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt8Float32 == nil {
+					if x.FMapInt32Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy1775 := *x.FptrMapInt8Float32
 						if false {
 						} else {
-							h.encMapint8float32((map[int8]float32)(yy1775), e)
+							h.encMapint32uint((map[int32]uint)(x.FMapInt32Uint), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Float32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1774 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt8Float32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Uint\"")
+					} else {
+						r.EncodeSymbol(`FMapInt32Uint`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt32Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy1777 := *x.FptrMapInt8Float32
 						if false {
 						} else {
-							h.encMapint8float32((map[int8]float32)(yy1777), e)
+							h.encMapint32uint((map[int32]uint)(x.FMapInt32Uint), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1942 bool
+				if x.FptrMapInt32Uint == nil {
+					yyn1942 = true
+					goto LABEL1942
+				}
+			LABEL1942:
+				if yyr2 || yy2arr2 {
+					if yyn1942 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint8float64((map[int8]float64)(x.FMapInt8Float64), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt32Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy1943 := *x.FptrMapInt32Uint
+							if false {
+							} else {
+								h.encMapint32uint((map[int32]uint)(yy1943), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Float64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Uint\"")
 					} else {
-						h.encMapint8float64((map[int8]float64)(x.FMapInt8Float64), e)
+						r.EncodeSymbol(`FptrMapInt32Uint`)
 					}
-				}
-			}
-			var yyn1782 bool
-			if x.FptrMapInt8Float64 == nil {
-				yyn1782 = true
-				goto LABEL1782
-			}
-		LABEL1782:
-			if yyr2 || yy2arr2 {
-				if yyn1782 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Float64 == nil {
+					r.WriteMapElemValue()
+					if yyn1942 {
 						r.EncodeNil()
 					} else {
-						yy1783 := *x.FptrMapInt8Float64
-						if false {
+						if x.FptrMapInt32Uint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8float64((map[int8]float64)(yy1783), e)
+							yy1945 := *x.FptrMapInt32Uint
+							if false {
+							} else {
+								h.encMapint32uint((map[int32]uint)(yy1945), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Float64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1782 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Float64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt32Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1785 := *x.FptrMapInt8Float64
 						if false {
 						} else {
-							h.encMapint8float64((map[int8]float64)(yy1785), e)
+							h.encMapint32uint8((map[int32]uint8)(x.FMapInt32Uint8), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt8Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint8bool((map[int8]bool)(x.FMapInt8Bool), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt8Bool\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt8Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt8Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Uint8\"")
 					} else {
-						h.encMapint8bool((map[int8]bool)(x.FMapInt8Bool), e)
+						r.EncodeSymbol(`FMapInt32Uint8`)
 					}
-				}
-			}
-			var yyn1790 bool
-			if x.FptrMapInt8Bool == nil {
-				yyn1790 = true
-				goto LABEL1790
-			}
-		LABEL1790:
-			if yyr2 || yy2arr2 {
-				if yyn1790 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt8Bool == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt32Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1791 := *x.FptrMapInt8Bool
 						if false {
 						} else {
-							h.encMapint8bool((map[int8]bool)(yy1791), e)
+							h.encMapint32uint8((map[int32]uint8)(x.FMapInt32Uint8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt8Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt8Bool`)
+				var yyn1950 bool
+				if x.FptrMapInt32Uint8 == nil {
+					yyn1950 = true
+					goto LABEL1950
 				}
-				r.WriteMapElemValue()
-				if yyn1790 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt8Bool == nil {
+			LABEL1950:
+				if yyr2 || yy2arr2 {
+					if yyn1950 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1793 := *x.FptrMapInt8Bool
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt32Uint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint8bool((map[int8]bool)(yy1793), e)
+							yy1951 := *x.FptrMapInt32Uint8
+							if false {
+							} else {
+								h.encMapint32uint8((map[int32]uint8)(yy1951), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Uint8\"")
 					} else {
-						h.encMapint16Interface((map[int16]interface{})(x.FMapInt16Intf), e)
+						r.EncodeSymbol(`FptrMapInt32Uint8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1950 {
+						r.EncodeNil()
 					} else {
-						h.encMapint16Interface((map[int16]interface{})(x.FMapInt16Intf), e)
+						if x.FptrMapInt32Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy1953 := *x.FptrMapInt32Uint8
+							if false {
+							} else {
+								h.encMapint32uint8((map[int32]uint8)(yy1953), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1798 bool
-			if x.FptrMapInt16Intf == nil {
-				yyn1798 = true
-				goto LABEL1798
-			}
-		LABEL1798:
-			if yyr2 || yy2arr2 {
-				if yyn1798 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Intf == nil {
+					if x.FMapInt32Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1799 := *x.FptrMapInt16Intf
 						if false {
 						} else {
-							h.encMapint16Interface((map[int16]interface{})(yy1799), e)
+							h.encMapint32uint16((map[int32]uint16)(x.FMapInt32Uint16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Intf\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Intf`)
-				}
-				r.WriteMapElemValue()
-				if yyn1798 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Intf == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Uint16\"")
+					} else {
+						r.EncodeSymbol(`FMapInt32Uint16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt32Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1801 := *x.FptrMapInt16Intf
 						if false {
 						} else {
-							h.encMapint16Interface((map[int16]interface{})(yy1801), e)
+							h.encMapint32uint16((map[int32]uint16)(x.FMapInt32Uint16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1958 bool
+				if x.FptrMapInt32Uint16 == nil {
+					yyn1958 = true
+					goto LABEL1958
+				}
+			LABEL1958:
+				if yyr2 || yy2arr2 {
+					if yyn1958 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint16string((map[int16]string)(x.FMapInt16String), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt32Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy1959 := *x.FptrMapInt32Uint16
+							if false {
+							} else {
+								h.encMapint32uint16((map[int32]uint16)(yy1959), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16String\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Uint16\"")
 					} else {
-						h.encMapint16string((map[int16]string)(x.FMapInt16String), e)
+						r.EncodeSymbol(`FptrMapInt32Uint16`)
 					}
-				}
-			}
-			var yyn1806 bool
-			if x.FptrMapInt16String == nil {
-				yyn1806 = true
-				goto LABEL1806
-			}
-		LABEL1806:
-			if yyr2 || yy2arr2 {
-				if yyn1806 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16String == nil {
+					r.WriteMapElemValue()
+					if yyn1958 {
 						r.EncodeNil()
 					} else {
-						yy1807 := *x.FptrMapInt16String
-						if false {
+						if x.FptrMapInt32Uint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16string((map[int16]string)(yy1807), e)
+							yy1961 := *x.FptrMapInt32Uint16
+							if false {
+							} else {
+								h.encMapint32uint16((map[int32]uint16)(yy1961), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16String`)
-				}
-				r.WriteMapElemValue()
-				if yyn1806 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16String == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt32Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1809 := *x.FptrMapInt16String
 						if false {
 						} else {
-							h.encMapint16string((map[int16]string)(yy1809), e)
+							h.encMapint32uint32((map[int32]uint32)(x.FMapInt32Uint32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint16uint((map[int16]uint)(x.FMapInt16Uint), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Uint32\"")
 					} else {
-						h.encMapint16uint((map[int16]uint)(x.FMapInt16Uint), e)
+						r.EncodeSymbol(`FMapInt32Uint32`)
 					}
-				}
-			}
-			var yyn1814 bool
-			if x.FptrMapInt16Uint == nil {
-				yyn1814 = true
-				goto LABEL1814
-			}
-		LABEL1814:
-			if yyr2 || yy2arr2 {
-				if yyn1814 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Uint == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt32Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1815 := *x.FptrMapInt16Uint
 						if false {
 						} else {
-							h.encMapint16uint((map[int16]uint)(yy1815), e)
+							h.encMapint32uint32((map[int32]uint32)(x.FMapInt32Uint32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Uint`)
+				var yyn1966 bool
+				if x.FptrMapInt32Uint32 == nil {
+					yyn1966 = true
+					goto LABEL1966
 				}
-				r.WriteMapElemValue()
-				if yyn1814 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Uint == nil {
+			LABEL1966:
+				if yyr2 || yy2arr2 {
+					if yyn1966 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1817 := *x.FptrMapInt16Uint
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt32Uint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16uint((map[int16]uint)(yy1817), e)
+							yy1967 := *x.FptrMapInt32Uint32
+							if false {
+							} else {
+								h.encMapint32uint32((map[int32]uint32)(yy1967), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Uint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Uint32\"")
 					} else {
-						h.encMapint16uint8((map[int16]uint8)(x.FMapInt16Uint8), e)
+						r.EncodeSymbol(`FptrMapInt32Uint32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1966 {
+						r.EncodeNil()
 					} else {
-						h.encMapint16uint8((map[int16]uint8)(x.FMapInt16Uint8), e)
+						if x.FptrMapInt32Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy1969 := *x.FptrMapInt32Uint32
+							if false {
+							} else {
+								h.encMapint32uint32((map[int32]uint32)(yy1969), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1822 bool
-			if x.FptrMapInt16Uint8 == nil {
-				yyn1822 = true
-				goto LABEL1822
-			}
-		LABEL1822:
-			if yyr2 || yy2arr2 {
-				if yyn1822 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt16Uint8 == nil {
+					if x.FMapInt32Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1823 := *x.FptrMapInt16Uint8
 						if false {
 						} else {
-							h.encMapint16uint8((map[int16]uint8)(yy1823), e)
+							h.encMapint32uint64((map[int32]uint64)(x.FMapInt32Uint64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Uint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1822 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt16Uint8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Uint64\"")
+					} else {
+						r.EncodeSymbol(`FMapInt32Uint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt32Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1825 := *x.FptrMapInt16Uint8
 						if false {
 						} else {
-							h.encMapint16uint8((map[int16]uint8)(yy1825), e)
+							h.encMapint32uint64((map[int32]uint64)(x.FMapInt32Uint64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1974 bool
+				if x.FptrMapInt32Uint64 == nil {
+					yyn1974 = true
+					goto LABEL1974
+				}
+			LABEL1974:
+				if yyr2 || yy2arr2 {
+					if yyn1974 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint16uint16((map[int16]uint16)(x.FMapInt16Uint16), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt32Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy1975 := *x.FptrMapInt32Uint64
+							if false {
+							} else {
+								h.encMapint32uint64((map[int32]uint64)(yy1975), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Uint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Uint64\"")
 					} else {
-						h.encMapint16uint16((map[int16]uint16)(x.FMapInt16Uint16), e)
+						r.EncodeSymbol(`FptrMapInt32Uint64`)
 					}
-				}
-			}
-			var yyn1830 bool
-			if x.FptrMapInt16Uint16 == nil {
-				yyn1830 = true
-				goto LABEL1830
-			}
-		LABEL1830:
-			if yyr2 || yy2arr2 {
-				if yyn1830 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Uint16 == nil {
+					r.WriteMapElemValue()
+					if yyn1974 {
 						r.EncodeNil()
 					} else {
-						yy1831 := *x.FptrMapInt16Uint16
-						if false {
+						if x.FptrMapInt32Uint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16uint16((map[int16]uint16)(yy1831), e)
+							yy1977 := *x.FptrMapInt32Uint64
+							if false {
+							} else {
+								h.encMapint32uint64((map[int32]uint64)(yy1977), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Uint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn1830 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Uint16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt32Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1833 := *x.FptrMapInt16Uint16
 						if false {
 						} else {
-							h.encMapint16uint16((map[int16]uint16)(yy1833), e)
+							h.encMapint32uintptr((map[int32]uintptr)(x.FMapInt32Uintptr), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint16uint32((map[int16]uint32)(x.FMapInt16Uint32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Uint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Uintptr\"")
 					} else {
-						h.encMapint16uint32((map[int16]uint32)(x.FMapInt16Uint32), e)
+						r.EncodeSymbol(`FMapInt32Uintptr`)
 					}
-				}
-			}
-			var yyn1838 bool
-			if x.FptrMapInt16Uint32 == nil {
-				yyn1838 = true
-				goto LABEL1838
-			}
-		LABEL1838:
-			if yyr2 || yy2arr2 {
-				if yyn1838 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Uint32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt32Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy1839 := *x.FptrMapInt16Uint32
 						if false {
 						} else {
-							h.encMapint16uint32((map[int16]uint32)(yy1839), e)
+							h.encMapint32uintptr((map[int32]uintptr)(x.FMapInt32Uintptr), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Uint32`)
+				var yyn1982 bool
+				if x.FptrMapInt32Uintptr == nil {
+					yyn1982 = true
+					goto LABEL1982
 				}
-				r.WriteMapElemValue()
-				if yyn1838 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Uint32 == nil {
+			LABEL1982:
+				if yyr2 || yy2arr2 {
+					if yyn1982 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1841 := *x.FptrMapInt16Uint32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt32Uintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16uint32((map[int16]uint32)(yy1841), e)
+							yy1983 := *x.FptrMapInt32Uintptr
+							if false {
+							} else {
+								h.encMapint32uintptr((map[int32]uintptr)(yy1983), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Uint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Uintptr\"")
 					} else {
-						h.encMapint16uint64((map[int16]uint64)(x.FMapInt16Uint64), e)
+						r.EncodeSymbol(`FptrMapInt32Uintptr`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1982 {
+						r.EncodeNil()
 					} else {
-						h.encMapint16uint64((map[int16]uint64)(x.FMapInt16Uint64), e)
+						if x.FptrMapInt32Uintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy1985 := *x.FptrMapInt32Uintptr
+							if false {
+							} else {
+								h.encMapint32uintptr((map[int32]uintptr)(yy1985), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1846 bool
-			if x.FptrMapInt16Uint64 == nil {
-				yyn1846 = true
-				goto LABEL1846
-			}
-		LABEL1846:
-			if yyr2 || yy2arr2 {
-				if yyn1846 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt16Uint64 == nil {
+					if x.FMapInt32Int == nil {
 						r.EncodeNil()
 					} else {
-						yy1847 := *x.FptrMapInt16Uint64
 						if false {
 						} else {
-							h.encMapint16uint64((map[int16]uint64)(yy1847), e)
+							h.encMapint32int((map[int32]int)(x.FMapInt32Int), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Uint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1846 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt16Uint64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Int\"")
+					} else {
+						r.EncodeSymbol(`FMapInt32Int`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt32Int == nil {
 						r.EncodeNil()
 					} else {
-						yy1849 := *x.FptrMapInt16Uint64
 						if false {
 						} else {
-							h.encMapint16uint64((map[int16]uint64)(yy1849), e)
+							h.encMapint32int((map[int32]int)(x.FMapInt32Int), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn1990 bool
+				if x.FptrMapInt32Int == nil {
+					yyn1990 = true
+					goto LABEL1990
+				}
+			LABEL1990:
+				if yyr2 || yy2arr2 {
+					if yyn1990 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint16uintptr((map[int16]uintptr)(x.FMapInt16Uintptr), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt32Int == nil {
+							r.EncodeNil()
+						} else {
+							yy1991 := *x.FptrMapInt32Int
+							if false {
+							} else {
+								h.encMapint32int((map[int32]int)(yy1991), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Uintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Int\"")
 					} else {
-						h.encMapint16uintptr((map[int16]uintptr)(x.FMapInt16Uintptr), e)
+						r.EncodeSymbol(`FptrMapInt32Int`)
 					}
-				}
-			}
-			var yyn1854 bool
-			if x.FptrMapInt16Uintptr == nil {
-				yyn1854 = true
-				goto LABEL1854
-			}
-		LABEL1854:
-			if yyr2 || yy2arr2 {
-				if yyn1854 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Uintptr == nil {
+					r.WriteMapElemValue()
+					if yyn1990 {
 						r.EncodeNil()
 					} else {
-						yy1855 := *x.FptrMapInt16Uintptr
-						if false {
+						if x.FptrMapInt32Int == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16uintptr((map[int16]uintptr)(yy1855), e)
+							yy1993 := *x.FptrMapInt32Int
+							if false {
+							} else {
+								h.encMapint32int((map[int32]int)(yy1993), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn1854 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Uintptr == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt32Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1857 := *x.FptrMapInt16Uintptr
 						if false {
 						} else {
-							h.encMapint16uintptr((map[int16]uintptr)(yy1857), e)
+							h.encMapint32int8((map[int32]int8)(x.FMapInt32Int8), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint16int((map[int16]int)(x.FMapInt16Int), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Int == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Int8\"")
 					} else {
-						h.encMapint16int((map[int16]int)(x.FMapInt16Int), e)
+						r.EncodeSymbol(`FMapInt32Int8`)
 					}
-				}
-			}
-			var yyn1862 bool
-			if x.FptrMapInt16Int == nil {
-				yyn1862 = true
-				goto LABEL1862
-			}
-		LABEL1862:
-			if yyr2 || yy2arr2 {
-				if yyn1862 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Int == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt32Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1863 := *x.FptrMapInt16Int
 						if false {
 						} else {
-							h.encMapint16int((map[int16]int)(yy1863), e)
+							h.encMapint32int8((map[int32]int8)(x.FMapInt32Int8), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Int`)
+				var yyn1998 bool
+				if x.FptrMapInt32Int8 == nil {
+					yyn1998 = true
+					goto LABEL1998
 				}
-				r.WriteMapElemValue()
-				if yyn1862 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Int == nil {
+			LABEL1998:
+				if yyr2 || yy2arr2 {
+					if yyn1998 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1865 := *x.FptrMapInt16Int
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt32Int8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16int((map[int16]int)(yy1865), e)
+							yy1999 := *x.FptrMapInt32Int8
+							if false {
+							} else {
+								h.encMapint32int8((map[int32]int8)(yy1999), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Int8\"")
 					} else {
-						h.encMapint16int8((map[int16]int8)(x.FMapInt16Int8), e)
+						r.EncodeSymbol(`FptrMapInt32Int8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn1998 {
+						r.EncodeNil()
 					} else {
-						h.encMapint16int8((map[int16]int8)(x.FMapInt16Int8), e)
+						if x.FptrMapInt32Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy2001 := *x.FptrMapInt32Int8
+							if false {
+							} else {
+								h.encMapint32int8((map[int32]int8)(yy2001), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1870 bool
-			if x.FptrMapInt16Int8 == nil {
-				yyn1870 = true
-				goto LABEL1870
-			}
-		LABEL1870:
-			if yyr2 || yy2arr2 {
-				if yyn1870 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt16Int8 == nil {
+					if x.FMapInt32Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1871 := *x.FptrMapInt16Int8
 						if false {
 						} else {
-							h.encMapint16int8((map[int16]int8)(yy1871), e)
+							h.encMapint32int16((map[int32]int16)(x.FMapInt32Int16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Int8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1870 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt16Int8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Int16\"")
+					} else {
+						r.EncodeSymbol(`FMapInt32Int16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt32Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1873 := *x.FptrMapInt16Int8
 						if false {
 						} else {
-							h.encMapint16int8((map[int16]int8)(yy1873), e)
+							h.encMapint32int16((map[int32]int16)(x.FMapInt32Int16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2006 bool
+				if x.FptrMapInt32Int16 == nil {
+					yyn2006 = true
+					goto LABEL2006
+				}
+			LABEL2006:
+				if yyr2 || yy2arr2 {
+					if yyn2006 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint16int16((map[int16]int16)(x.FMapInt16Int16), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt32Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy2007 := *x.FptrMapInt32Int16
+							if false {
+							} else {
+								h.encMapint32int16((map[int32]int16)(yy2007), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Int16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Int16\"")
 					} else {
-						h.encMapint16int16((map[int16]int16)(x.FMapInt16Int16), e)
+						r.EncodeSymbol(`FptrMapInt32Int16`)
 					}
-				}
-			}
-			var yyn1878 bool
-			if x.FptrMapInt16Int16 == nil {
-				yyn1878 = true
-				goto LABEL1878
-			}
-		LABEL1878:
-			if yyr2 || yy2arr2 {
-				if yyn1878 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Int16 == nil {
+					r.WriteMapElemValue()
+					if yyn2006 {
 						r.EncodeNil()
 					} else {
-						yy1879 := *x.FptrMapInt16Int16
-						if false {
+						if x.FptrMapInt32Int16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16int16((map[int16]int16)(yy1879), e)
+							yy2009 := *x.FptrMapInt32Int16
+							if false {
+							} else {
+								h.encMapint32int16((map[int32]int16)(yy2009), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Int16`)
-				}
-				r.WriteMapElemValue()
-				if yyn1878 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Int16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt32Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1881 := *x.FptrMapInt16Int16
 						if false {
 						} else {
-							h.encMapint16int16((map[int16]int16)(yy1881), e)
+							h.encMapint32int32((map[int32]int32)(x.FMapInt32Int32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint16int32((map[int16]int32)(x.FMapInt16Int32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Int32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Int32\"")
 					} else {
-						h.encMapint16int32((map[int16]int32)(x.FMapInt16Int32), e)
+						r.EncodeSymbol(`FMapInt32Int32`)
 					}
-				}
-			}
-			var yyn1886 bool
-			if x.FptrMapInt16Int32 == nil {
-				yyn1886 = true
-				goto LABEL1886
-			}
-		LABEL1886:
-			if yyr2 || yy2arr2 {
-				if yyn1886 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Int32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt32Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1887 := *x.FptrMapInt16Int32
 						if false {
 						} else {
-							h.encMapint16int32((map[int16]int32)(yy1887), e)
+							h.encMapint32int32((map[int32]int32)(x.FMapInt32Int32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Int32`)
+				var yyn2014 bool
+				if x.FptrMapInt32Int32 == nil {
+					yyn2014 = true
+					goto LABEL2014
 				}
-				r.WriteMapElemValue()
-				if yyn1886 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Int32 == nil {
+			LABEL2014:
+				if yyr2 || yy2arr2 {
+					if yyn2014 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1889 := *x.FptrMapInt16Int32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt32Int32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16int32((map[int16]int32)(yy1889), e)
+							yy2015 := *x.FptrMapInt32Int32
+							if false {
+							} else {
+								h.encMapint32int32((map[int32]int32)(yy2015), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Int64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Int32\"")
 					} else {
-						h.encMapint16int64((map[int16]int64)(x.FMapInt16Int64), e)
+						r.EncodeSymbol(`FptrMapInt32Int32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2014 {
+						r.EncodeNil()
 					} else {
-						h.encMapint16int64((map[int16]int64)(x.FMapInt16Int64), e)
+						if x.FptrMapInt32Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy2017 := *x.FptrMapInt32Int32
+							if false {
+							} else {
+								h.encMapint32int32((map[int32]int32)(yy2017), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1894 bool
-			if x.FptrMapInt16Int64 == nil {
-				yyn1894 = true
-				goto LABEL1894
-			}
-		LABEL1894:
-			if yyr2 || yy2arr2 {
-				if yyn1894 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Int64 == nil {
+					if x.FMapInt32Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1895 := *x.FptrMapInt16Int64
 						if false {
 						} else {
-							h.encMapint16int64((map[int16]int64)(yy1895), e)
+							h.encMapint32int64((map[int32]int64)(x.FMapInt32Int64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Int64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Int64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1894 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Int64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Int64\"")
+					} else {
+						r.EncodeSymbol(`FMapInt32Int64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt32Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1897 := *x.FptrMapInt16Int64
 						if false {
 						} else {
-							h.encMapint16int64((map[int16]int64)(yy1897), e)
+							h.encMapint32int64((map[int32]int64)(x.FMapInt32Int64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2022 bool
+				if x.FptrMapInt32Int64 == nil {
+					yyn2022 = true
+					goto LABEL2022
+				}
+			LABEL2022:
+				if yyr2 || yy2arr2 {
+					if yyn2022 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint16float32((map[int16]float32)(x.FMapInt16Float32), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt32Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2023 := *x.FptrMapInt32Int64
+							if false {
+							} else {
+								h.encMapint32int64((map[int32]int64)(yy2023), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Float32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Int64\"")
 					} else {
-						h.encMapint16float32((map[int16]float32)(x.FMapInt16Float32), e)
+						r.EncodeSymbol(`FptrMapInt32Int64`)
 					}
-				}
-			}
-			var yyn1902 bool
-			if x.FptrMapInt16Float32 == nil {
-				yyn1902 = true
-				goto LABEL1902
-			}
-		LABEL1902:
-			if yyr2 || yy2arr2 {
-				if yyn1902 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Float32 == nil {
+					r.WriteMapElemValue()
+					if yyn2022 {
 						r.EncodeNil()
 					} else {
-						yy1903 := *x.FptrMapInt16Float32
-						if false {
+						if x.FptrMapInt32Int64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16float32((map[int16]float32)(yy1903), e)
+							yy2025 := *x.FptrMapInt32Int64
+							if false {
+							} else {
+								h.encMapint32int64((map[int32]int64)(yy2025), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Float32`)
-				}
-				r.WriteMapElemValue()
-				if yyn1902 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Float32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt32Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1905 := *x.FptrMapInt16Float32
 						if false {
 						} else {
-							h.encMapint16float32((map[int16]float32)(yy1905), e)
+							h.encMapint32float32((map[int32]float32)(x.FMapInt32Float32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint16float64((map[int16]float64)(x.FMapInt16Float64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Float64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Float32\"")
 					} else {
-						h.encMapint16float64((map[int16]float64)(x.FMapInt16Float64), e)
+						r.EncodeSymbol(`FMapInt32Float32`)
 					}
-				}
-			}
-			var yyn1910 bool
-			if x.FptrMapInt16Float64 == nil {
-				yyn1910 = true
-				goto LABEL1910
-			}
-		LABEL1910:
-			if yyr2 || yy2arr2 {
-				if yyn1910 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt16Float64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt32Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1911 := *x.FptrMapInt16Float64
 						if false {
 						} else {
-							h.encMapint16float64((map[int16]float64)(yy1911), e)
+							h.encMapint32float32((map[int32]float32)(x.FMapInt32Float32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Float64`)
+				var yyn2030 bool
+				if x.FptrMapInt32Float32 == nil {
+					yyn2030 = true
+					goto LABEL2030
 				}
-				r.WriteMapElemValue()
-				if yyn1910 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt16Float64 == nil {
+			LABEL2030:
+				if yyr2 || yy2arr2 {
+					if yyn2030 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1913 := *x.FptrMapInt16Float64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt32Float32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint16float64((map[int16]float64)(yy1913), e)
+							yy2031 := *x.FptrMapInt32Float32
+							if false {
+							} else {
+								h.encMapint32float32((map[int32]float32)(yy2031), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt16Bool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Float32\"")
 					} else {
-						h.encMapint16bool((map[int16]bool)(x.FMapInt16Bool), e)
+						r.EncodeSymbol(`FptrMapInt32Float32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt16Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt16Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt16Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2030 {
+						r.EncodeNil()
 					} else {
-						h.encMapint16bool((map[int16]bool)(x.FMapInt16Bool), e)
+						if x.FptrMapInt32Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy2033 := *x.FptrMapInt32Float32
+							if false {
+							} else {
+								h.encMapint32float32((map[int32]float32)(yy2033), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1918 bool
-			if x.FptrMapInt16Bool == nil {
-				yyn1918 = true
-				goto LABEL1918
-			}
-		LABEL1918:
-			if yyr2 || yy2arr2 {
-				if yyn1918 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt16Bool == nil {
+					if x.FMapInt32Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1919 := *x.FptrMapInt16Bool
 						if false {
 						} else {
-							h.encMapint16bool((map[int16]bool)(yy1919), e)
+							h.encMapint32float64((map[int32]float64)(x.FMapInt32Float64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt16Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt16Bool`)
-				}
-				r.WriteMapElemValue()
-				if yyn1918 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt16Bool == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Float64\"")
+					} else {
+						r.EncodeSymbol(`FMapInt32Float64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt32Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy1921 := *x.FptrMapInt16Bool
 						if false {
 						} else {
-							h.encMapint16bool((map[int16]bool)(yy1921), e)
+							h.encMapint32float64((map[int32]float64)(x.FMapInt32Float64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2038 bool
+				if x.FptrMapInt32Float64 == nil {
+					yyn2038 = true
+					goto LABEL2038
+				}
+			LABEL2038:
+				if yyr2 || yy2arr2 {
+					if yyn2038 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint32Interface((map[int32]interface{})(x.FMapInt32Intf), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt32Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2039 := *x.FptrMapInt32Float64
+							if false {
+							} else {
+								h.encMapint32float64((map[int32]float64)(yy2039), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Float64\"")
 					} else {
-						h.encMapint32Interface((map[int32]interface{})(x.FMapInt32Intf), e)
+						r.EncodeSymbol(`FptrMapInt32Float64`)
+					}
+					r.WriteMapElemValue()
+					if yyn2038 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapInt32Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2041 := *x.FptrMapInt32Float64
+							if false {
+							} else {
+								h.encMapint32float64((map[int32]float64)(yy2041), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1926 bool
-			if x.FptrMapInt32Intf == nil {
-				yyn1926 = true
-				goto LABEL1926
-			}
-		LABEL1926:
-			if yyr2 || yy2arr2 {
-				if yyn1926 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt32Intf == nil {
+					if x.FMapInt32Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy1927 := *x.FptrMapInt32Intf
 						if false {
 						} else {
-							h.encMapint32Interface((map[int32]interface{})(yy1927), e)
+							h.encMapint32bool((map[int32]bool)(x.FMapInt32Bool), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Intf`)
-				}
-				r.WriteMapElemValue()
-				if yyn1926 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt32Intf == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt32Bool\"")
+					} else {
+						r.EncodeSymbol(`FMapInt32Bool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt32Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy1929 := *x.FptrMapInt32Intf
 						if false {
 						} else {
-							h.encMapint32Interface((map[int32]interface{})(yy1929), e)
+							h.encMapint32bool((map[int32]bool)(x.FMapInt32Bool), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2046 bool
+				if x.FptrMapInt32Bool == nil {
+					yyn2046 = true
+					goto LABEL2046
+				}
+			LABEL2046:
+				if yyr2 || yy2arr2 {
+					if yyn2046 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint32string((map[int32]string)(x.FMapInt32String), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt32Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy2047 := *x.FptrMapInt32Bool
+							if false {
+							} else {
+								h.encMapint32bool((map[int32]bool)(yy2047), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32String == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt32Bool\"")
 					} else {
-						h.encMapint32string((map[int32]string)(x.FMapInt32String), e)
+						r.EncodeSymbol(`FptrMapInt32Bool`)
 					}
-				}
-			}
-			var yyn1934 bool
-			if x.FptrMapInt32String == nil {
-				yyn1934 = true
-				goto LABEL1934
-			}
-		LABEL1934:
-			if yyr2 || yy2arr2 {
-				if yyn1934 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32String == nil {
+					r.WriteMapElemValue()
+					if yyn2046 {
 						r.EncodeNil()
 					} else {
-						yy1935 := *x.FptrMapInt32String
-						if false {
+						if x.FptrMapInt32Bool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32string((map[int32]string)(yy1935), e)
+							yy2049 := *x.FptrMapInt32Bool
+							if false {
+							} else {
+								h.encMapint32bool((map[int32]bool)(yy2049), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32String`)
-				}
-				r.WriteMapElemValue()
-				if yyn1934 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32String == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt64Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy1937 := *x.FptrMapInt32String
 						if false {
 						} else {
-							h.encMapint32string((map[int32]string)(yy1937), e)
+							h.encMapint64Interface((map[int64]interface{})(x.FMapInt64Intf), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint32uint((map[int32]uint)(x.FMapInt32Uint), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Intf\"")
 					} else {
-						h.encMapint32uint((map[int32]uint)(x.FMapInt32Uint), e)
+						r.EncodeSymbol(`FMapInt64Intf`)
 					}
-				}
-			}
-			var yyn1942 bool
-			if x.FptrMapInt32Uint == nil {
-				yyn1942 = true
-				goto LABEL1942
-			}
-		LABEL1942:
-			if yyr2 || yy2arr2 {
-				if yyn1942 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Uint == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt64Intf == nil {
 						r.EncodeNil()
 					} else {
-						yy1943 := *x.FptrMapInt32Uint
 						if false {
 						} else {
-							h.encMapint32uint((map[int32]uint)(yy1943), e)
+							h.encMapint64Interface((map[int64]interface{})(x.FMapInt64Intf), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Uint`)
+				var yyn2054 bool
+				if x.FptrMapInt64Intf == nil {
+					yyn2054 = true
+					goto LABEL2054
 				}
-				r.WriteMapElemValue()
-				if yyn1942 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Uint == nil {
+			LABEL2054:
+				if yyr2 || yy2arr2 {
+					if yyn2054 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1945 := *x.FptrMapInt32Uint
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt64Intf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32uint((map[int32]uint)(yy1945), e)
+							yy2055 := *x.FptrMapInt64Intf
+							if false {
+							} else {
+								h.encMapint64Interface((map[int64]interface{})(yy2055), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Uint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Intf\"")
 					} else {
-						h.encMapint32uint8((map[int32]uint8)(x.FMapInt32Uint8), e)
+						r.EncodeSymbol(`FptrMapInt64Intf`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2054 {
+						r.EncodeNil()
 					} else {
-						h.encMapint32uint8((map[int32]uint8)(x.FMapInt32Uint8), e)
+						if x.FptrMapInt64Intf == nil {
+							r.EncodeNil()
+						} else {
+							yy2057 := *x.FptrMapInt64Intf
+							if false {
+							} else {
+								h.encMapint64Interface((map[int64]interface{})(yy2057), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1950 bool
-			if x.FptrMapInt32Uint8 == nil {
-				yyn1950 = true
-				goto LABEL1950
-			}
-		LABEL1950:
-			if yyr2 || yy2arr2 {
-				if yyn1950 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt32Uint8 == nil {
+					if x.FMapInt64String == nil {
 						r.EncodeNil()
 					} else {
-						yy1951 := *x.FptrMapInt32Uint8
 						if false {
 						} else {
-							h.encMapint32uint8((map[int32]uint8)(yy1951), e)
+							h.encMapint64string((map[int64]string)(x.FMapInt64String), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Uint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1950 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt32Uint8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64String\"")
+					} else {
+						r.EncodeSymbol(`FMapInt64String`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt64String == nil {
 						r.EncodeNil()
 					} else {
-						yy1953 := *x.FptrMapInt32Uint8
 						if false {
 						} else {
-							h.encMapint32uint8((map[int32]uint8)(yy1953), e)
+							h.encMapint64string((map[int64]string)(x.FMapInt64String), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2062 bool
+				if x.FptrMapInt64String == nil {
+					yyn2062 = true
+					goto LABEL2062
+				}
+			LABEL2062:
+				if yyr2 || yy2arr2 {
+					if yyn2062 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint32uint16((map[int32]uint16)(x.FMapInt32Uint16), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt64String == nil {
+							r.EncodeNil()
+						} else {
+							yy2063 := *x.FptrMapInt64String
+							if false {
+							} else {
+								h.encMapint64string((map[int64]string)(yy2063), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Uint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64String\"")
 					} else {
-						h.encMapint32uint16((map[int32]uint16)(x.FMapInt32Uint16), e)
+						r.EncodeSymbol(`FptrMapInt64String`)
 					}
-				}
-			}
-			var yyn1958 bool
-			if x.FptrMapInt32Uint16 == nil {
-				yyn1958 = true
-				goto LABEL1958
-			}
-		LABEL1958:
-			if yyr2 || yy2arr2 {
-				if yyn1958 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Uint16 == nil {
+					r.WriteMapElemValue()
+					if yyn2062 {
 						r.EncodeNil()
 					} else {
-						yy1959 := *x.FptrMapInt32Uint16
-						if false {
+						if x.FptrMapInt64String == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32uint16((map[int32]uint16)(yy1959), e)
+							yy2065 := *x.FptrMapInt64String
+							if false {
+							} else {
+								h.encMapint64string((map[int64]string)(yy2065), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Uint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn1958 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Uint16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt64Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy1961 := *x.FptrMapInt32Uint16
 						if false {
 						} else {
-							h.encMapint32uint16((map[int32]uint16)(yy1961), e)
+							h.encMapint64uint((map[int64]uint)(x.FMapInt64Uint), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint32uint32((map[int32]uint32)(x.FMapInt32Uint32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Uint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Uint\"")
 					} else {
-						h.encMapint32uint32((map[int32]uint32)(x.FMapInt32Uint32), e)
+						r.EncodeSymbol(`FMapInt64Uint`)
 					}
-				}
-			}
-			var yyn1966 bool
-			if x.FptrMapInt32Uint32 == nil {
-				yyn1966 = true
-				goto LABEL1966
-			}
-		LABEL1966:
-			if yyr2 || yy2arr2 {
-				if yyn1966 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Uint32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt64Uint == nil {
 						r.EncodeNil()
 					} else {
-						yy1967 := *x.FptrMapInt32Uint32
 						if false {
 						} else {
-							h.encMapint32uint32((map[int32]uint32)(yy1967), e)
+							h.encMapint64uint((map[int64]uint)(x.FMapInt64Uint), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Uint32`)
+				var yyn2070 bool
+				if x.FptrMapInt64Uint == nil {
+					yyn2070 = true
+					goto LABEL2070
 				}
-				r.WriteMapElemValue()
-				if yyn1966 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Uint32 == nil {
+			LABEL2070:
+				if yyr2 || yy2arr2 {
+					if yyn2070 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1969 := *x.FptrMapInt32Uint32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt64Uint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32uint32((map[int32]uint32)(yy1969), e)
+							yy2071 := *x.FptrMapInt64Uint
+							if false {
+							} else {
+								h.encMapint64uint((map[int64]uint)(yy2071), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Uint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Uint\"")
 					} else {
-						h.encMapint32uint64((map[int32]uint64)(x.FMapInt32Uint64), e)
+						r.EncodeSymbol(`FptrMapInt64Uint`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2070 {
+						r.EncodeNil()
 					} else {
-						h.encMapint32uint64((map[int32]uint64)(x.FMapInt32Uint64), e)
+						if x.FptrMapInt64Uint == nil {
+							r.EncodeNil()
+						} else {
+							yy2073 := *x.FptrMapInt64Uint
+							if false {
+							} else {
+								h.encMapint64uint((map[int64]uint)(yy2073), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1974 bool
-			if x.FptrMapInt32Uint64 == nil {
-				yyn1974 = true
-				goto LABEL1974
-			}
-		LABEL1974:
-			if yyr2 || yy2arr2 {
-				if yyn1974 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt32Uint64 == nil {
+					if x.FMapInt64Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1975 := *x.FptrMapInt32Uint64
 						if false {
 						} else {
-							h.encMapint32uint64((map[int32]uint64)(yy1975), e)
+							h.encMapint64uint8((map[int64]uint8)(x.FMapInt64Uint8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Uint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn1974 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt32Uint64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Uint8\"")
+					} else {
+						r.EncodeSymbol(`FMapInt64Uint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt64Uint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy1977 := *x.FptrMapInt32Uint64
 						if false {
 						} else {
-							h.encMapint32uint64((map[int32]uint64)(yy1977), e)
+							h.encMapint64uint8((map[int64]uint8)(x.FMapInt64Uint8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2078 bool
+				if x.FptrMapInt64Uint8 == nil {
+					yyn2078 = true
+					goto LABEL2078
+				}
+			LABEL2078:
+				if yyr2 || yy2arr2 {
+					if yyn2078 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint32uintptr((map[int32]uintptr)(x.FMapInt32Uintptr), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt64Uint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy2079 := *x.FptrMapInt64Uint8
+							if false {
+							} else {
+								h.encMapint64uint8((map[int64]uint8)(yy2079), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Uintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Uint8\"")
 					} else {
-						h.encMapint32uintptr((map[int32]uintptr)(x.FMapInt32Uintptr), e)
+						r.EncodeSymbol(`FptrMapInt64Uint8`)
 					}
-				}
-			}
-			var yyn1982 bool
-			if x.FptrMapInt32Uintptr == nil {
-				yyn1982 = true
-				goto LABEL1982
-			}
-		LABEL1982:
-			if yyr2 || yy2arr2 {
-				if yyn1982 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Uintptr == nil {
+					r.WriteMapElemValue()
+					if yyn2078 {
 						r.EncodeNil()
 					} else {
-						yy1983 := *x.FptrMapInt32Uintptr
-						if false {
+						if x.FptrMapInt64Uint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32uintptr((map[int32]uintptr)(yy1983), e)
+							yy2081 := *x.FptrMapInt64Uint8
+							if false {
+							} else {
+								h.encMapint64uint8((map[int64]uint8)(yy2081), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn1982 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Uintptr == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt64Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1985 := *x.FptrMapInt32Uintptr
 						if false {
 						} else {
-							h.encMapint32uintptr((map[int32]uintptr)(yy1985), e)
+							h.encMapint64uint16((map[int64]uint16)(x.FMapInt64Uint16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint32int((map[int32]int)(x.FMapInt32Int), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Int\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Uint16\"")
 					} else {
-						h.encMapint32int((map[int32]int)(x.FMapInt32Int), e)
+						r.EncodeSymbol(`FMapInt64Uint16`)
 					}
-				}
-			}
-			var yyn1990 bool
-			if x.FptrMapInt32Int == nil {
-				yyn1990 = true
-				goto LABEL1990
-			}
-		LABEL1990:
-			if yyr2 || yy2arr2 {
-				if yyn1990 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Int == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt64Uint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy1991 := *x.FptrMapInt32Int
 						if false {
 						} else {
-							h.encMapint32int((map[int32]int)(yy1991), e)
+							h.encMapint64uint16((map[int64]uint16)(x.FMapInt64Uint16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Int`)
+				var yyn2086 bool
+				if x.FptrMapInt64Uint16 == nil {
+					yyn2086 = true
+					goto LABEL2086
 				}
-				r.WriteMapElemValue()
-				if yyn1990 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Int == nil {
+			LABEL2086:
+				if yyr2 || yy2arr2 {
+					if yyn2086 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy1993 := *x.FptrMapInt32Int
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt64Uint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32int((map[int32]int)(yy1993), e)
+							yy2087 := *x.FptrMapInt64Uint16
+							if false {
+							} else {
+								h.encMapint64uint16((map[int64]uint16)(yy2087), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Uint16\"")
 					} else {
-						h.encMapint32int8((map[int32]int8)(x.FMapInt32Int8), e)
+						r.EncodeSymbol(`FptrMapInt64Uint16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2086 {
+						r.EncodeNil()
 					} else {
-						h.encMapint32int8((map[int32]int8)(x.FMapInt32Int8), e)
+						if x.FptrMapInt64Uint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy2089 := *x.FptrMapInt64Uint16
+							if false {
+							} else {
+								h.encMapint64uint16((map[int64]uint16)(yy2089), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn1998 bool
-			if x.FptrMapInt32Int8 == nil {
-				yyn1998 = true
-				goto LABEL1998
-			}
-		LABEL1998:
-			if yyr2 || yy2arr2 {
-				if yyn1998 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Int8 == nil {
+					if x.FMapInt64Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy1999 := *x.FptrMapInt32Int8
 						if false {
 						} else {
-							h.encMapint32int8((map[int32]int8)(yy1999), e)
+							h.encMapint64uint32((map[int64]uint32)(x.FMapInt64Uint32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Int8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Int8`)
-				}
-				r.WriteMapElemValue()
-				if yyn1998 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Int8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Uint32\"")
+					} else {
+						r.EncodeSymbol(`FMapInt64Uint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt64Uint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2001 := *x.FptrMapInt32Int8
 						if false {
 						} else {
-							h.encMapint32int8((map[int32]int8)(yy2001), e)
+							h.encMapint64uint32((map[int64]uint32)(x.FMapInt64Uint32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2094 bool
+				if x.FptrMapInt64Uint32 == nil {
+					yyn2094 = true
+					goto LABEL2094
+				}
+			LABEL2094:
+				if yyr2 || yy2arr2 {
+					if yyn2094 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint32int16((map[int32]int16)(x.FMapInt32Int16), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt64Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy2095 := *x.FptrMapInt64Uint32
+							if false {
+							} else {
+								h.encMapint64uint32((map[int64]uint32)(yy2095), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Int16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Uint32\"")
+					} else {
+						r.EncodeSymbol(`FptrMapInt64Uint32`)
+					}
+					r.WriteMapElemValue()
+					if yyn2094 {
+						r.EncodeNil()
 					} else {
-						h.encMapint32int16((map[int32]int16)(x.FMapInt32Int16), e)
+						if x.FptrMapInt64Uint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy2097 := *x.FptrMapInt64Uint32
+							if false {
+							} else {
+								h.encMapint64uint32((map[int64]uint32)(yy2097), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2006 bool
-			if x.FptrMapInt32Int16 == nil {
-				yyn2006 = true
-				goto LABEL2006
-			}
-		LABEL2006:
-			if yyr2 || yy2arr2 {
-				if yyn2006 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Int16 == nil {
+					if x.FMapInt64Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2007 := *x.FptrMapInt32Int16
 						if false {
 						} else {
-							h.encMapint32int16((map[int32]int16)(yy2007), e)
+							h.encMapint64uint64((map[int64]uint64)(x.FMapInt64Uint64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Int16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Int16`)
-				}
-				r.WriteMapElemValue()
-				if yyn2006 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Int16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Uint64\"")
+					} else {
+						r.EncodeSymbol(`FMapInt64Uint64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt64Uint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2009 := *x.FptrMapInt32Int16
 						if false {
 						} else {
-							h.encMapint32int16((map[int32]int16)(yy2009), e)
+							h.encMapint64uint64((map[int64]uint64)(x.FMapInt64Uint64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2102 bool
+				if x.FptrMapInt64Uint64 == nil {
+					yyn2102 = true
+					goto LABEL2102
+				}
+			LABEL2102:
+				if yyr2 || yy2arr2 {
+					if yyn2102 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint32int32((map[int32]int32)(x.FMapInt32Int32), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt64Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2103 := *x.FptrMapInt64Uint64
+							if false {
+							} else {
+								h.encMapint64uint64((map[int64]uint64)(yy2103), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Int32\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Uint64\"")
+					} else {
+						r.EncodeSymbol(`FptrMapInt64Uint64`)
+					}
+					r.WriteMapElemValue()
+					if yyn2102 {
+						r.EncodeNil()
 					} else {
-						h.encMapint32int32((map[int32]int32)(x.FMapInt32Int32), e)
+						if x.FptrMapInt64Uint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2105 := *x.FptrMapInt64Uint64
+							if false {
+							} else {
+								h.encMapint64uint64((map[int64]uint64)(yy2105), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2014 bool
-			if x.FptrMapInt32Int32 == nil {
-				yyn2014 = true
-				goto LABEL2014
-			}
-		LABEL2014:
-			if yyr2 || yy2arr2 {
-				if yyn2014 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.FMapInt64Uintptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapint64uintptr((map[int64]uintptr)(x.FMapInt64Uintptr), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Int32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Uintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapInt64Uintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt64Uintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy2015 := *x.FptrMapInt32Int32
 						if false {
 						} else {
-							h.encMapint32int32((map[int32]int32)(yy2015), e)
+							h.encMapint64uintptr((map[int64]uintptr)(x.FMapInt64Uintptr), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Int32`)
+				var yyn2110 bool
+				if x.FptrMapInt64Uintptr == nil {
+					yyn2110 = true
+					goto LABEL2110
 				}
-				r.WriteMapElemValue()
-				if yyn2014 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Int32 == nil {
+			LABEL2110:
+				if yyr2 || yy2arr2 {
+					if yyn2110 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2017 := *x.FptrMapInt32Int32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt64Uintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32int32((map[int32]int32)(yy2017), e)
+							yy2111 := *x.FptrMapInt64Uintptr
+							if false {
+							} else {
+								h.encMapint64uintptr((map[int64]uintptr)(yy2111), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint32int64((map[int32]int64)(x.FMapInt32Int64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Int64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Uintptr\"")
 					} else {
-						h.encMapint32int64((map[int32]int64)(x.FMapInt32Int64), e)
+						r.EncodeSymbol(`FptrMapInt64Uintptr`)
 					}
-				}
-			}
-			var yyn2022 bool
-			if x.FptrMapInt32Int64 == nil {
-				yyn2022 = true
-				goto LABEL2022
-			}
-		LABEL2022:
-			if yyr2 || yy2arr2 {
-				if yyn2022 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Int64 == nil {
+					r.WriteMapElemValue()
+					if yyn2110 {
 						r.EncodeNil()
 					} else {
-						yy2023 := *x.FptrMapInt32Int64
-						if false {
+						if x.FptrMapInt64Uintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32int64((map[int32]int64)(yy2023), e)
+							yy2113 := *x.FptrMapInt64Uintptr
+							if false {
+							} else {
+								h.encMapint64uintptr((map[int64]uintptr)(yy2113), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Int64`)
-				}
-				r.WriteMapElemValue()
-				if yyn2022 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Int64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt64Int == nil {
 						r.EncodeNil()
 					} else {
-						yy2025 := *x.FptrMapInt32Int64
 						if false {
 						} else {
-							h.encMapint32int64((map[int32]int64)(yy2025), e)
+							h.encMapint64int((map[int64]int)(x.FMapInt64Int), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint32float32((map[int32]float32)(x.FMapInt32Float32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Float32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Int\"")
 					} else {
-						h.encMapint32float32((map[int32]float32)(x.FMapInt32Float32), e)
+						r.EncodeSymbol(`FMapInt64Int`)
 					}
-				}
-			}
-			var yyn2030 bool
-			if x.FptrMapInt32Float32 == nil {
-				yyn2030 = true
-				goto LABEL2030
-			}
-		LABEL2030:
-			if yyr2 || yy2arr2 {
-				if yyn2030 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Float32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt64Int == nil {
 						r.EncodeNil()
 					} else {
-						yy2031 := *x.FptrMapInt32Float32
 						if false {
 						} else {
-							h.encMapint32float32((map[int32]float32)(yy2031), e)
+							h.encMapint64int((map[int64]int)(x.FMapInt64Int), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Float32`)
+				var yyn2118 bool
+				if x.FptrMapInt64Int == nil {
+					yyn2118 = true
+					goto LABEL2118
 				}
-				r.WriteMapElemValue()
-				if yyn2030 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Float32 == nil {
+			LABEL2118:
+				if yyr2 || yy2arr2 {
+					if yyn2118 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2033 := *x.FptrMapInt32Float32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt64Int == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32float32((map[int32]float32)(yy2033), e)
+							yy2119 := *x.FptrMapInt64Int
+							if false {
+							} else {
+								h.encMapint64int((map[int64]int)(yy2119), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Float64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Int\"")
 					} else {
-						h.encMapint32float64((map[int32]float64)(x.FMapInt32Float64), e)
+						r.EncodeSymbol(`FptrMapInt64Int`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2118 {
+						r.EncodeNil()
 					} else {
-						h.encMapint32float64((map[int32]float64)(x.FMapInt32Float64), e)
+						if x.FptrMapInt64Int == nil {
+							r.EncodeNil()
+						} else {
+							yy2121 := *x.FptrMapInt64Int
+							if false {
+							} else {
+								h.encMapint64int((map[int64]int)(yy2121), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2038 bool
-			if x.FptrMapInt32Float64 == nil {
-				yyn2038 = true
-				goto LABEL2038
-			}
-		LABEL2038:
-			if yyr2 || yy2arr2 {
-				if yyn2038 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt32Float64 == nil {
+					if x.FMapInt64Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy2039 := *x.FptrMapInt32Float64
 						if false {
 						} else {
-							h.encMapint32float64((map[int32]float64)(yy2039), e)
+							h.encMapint64int8((map[int64]int8)(x.FMapInt64Int8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Float64`)
-				}
-				r.WriteMapElemValue()
-				if yyn2038 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt32Float64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Int8\"")
+					} else {
+						r.EncodeSymbol(`FMapInt64Int8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt64Int8 == nil {
 						r.EncodeNil()
 					} else {
-						yy2041 := *x.FptrMapInt32Float64
 						if false {
 						} else {
-							h.encMapint32float64((map[int32]float64)(yy2041), e)
+							h.encMapint64int8((map[int64]int8)(x.FMapInt64Int8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt32Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2126 bool
+				if x.FptrMapInt64Int8 == nil {
+					yyn2126 = true
+					goto LABEL2126
+				}
+			LABEL2126:
+				if yyr2 || yy2arr2 {
+					if yyn2126 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint32bool((map[int32]bool)(x.FMapInt32Bool), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt64Int8 == nil {
+							r.EncodeNil()
+						} else {
+							yy2127 := *x.FptrMapInt64Int8
+							if false {
+							} else {
+								h.encMapint64int8((map[int64]int8)(yy2127), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt32Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt32Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt32Bool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Int8\"")
 					} else {
-						h.encMapint32bool((map[int32]bool)(x.FMapInt32Bool), e)
+						r.EncodeSymbol(`FptrMapInt64Int8`)
 					}
-				}
-			}
-			var yyn2046 bool
-			if x.FptrMapInt32Bool == nil {
-				yyn2046 = true
-				goto LABEL2046
-			}
-		LABEL2046:
-			if yyr2 || yy2arr2 {
-				if yyn2046 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt32Bool == nil {
+					r.WriteMapElemValue()
+					if yyn2126 {
 						r.EncodeNil()
 					} else {
-						yy2047 := *x.FptrMapInt32Bool
-						if false {
+						if x.FptrMapInt64Int8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint32bool((map[int32]bool)(yy2047), e)
+							yy2129 := *x.FptrMapInt64Int8
+							if false {
+							} else {
+								h.encMapint64int8((map[int64]int8)(yy2129), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt32Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt32Bool`)
-				}
-				r.WriteMapElemValue()
-				if yyn2046 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt32Bool == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt64Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy2049 := *x.FptrMapInt32Bool
 						if false {
 						} else {
-							h.encMapint32bool((map[int32]bool)(yy2049), e)
+							h.encMapint64int16((map[int64]int16)(x.FMapInt64Int16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Intf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint64Interface((map[int64]interface{})(x.FMapInt64Intf), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Intf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Intf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Int16\"")
 					} else {
-						h.encMapint64Interface((map[int64]interface{})(x.FMapInt64Intf), e)
+						r.EncodeSymbol(`FMapInt64Int16`)
 					}
-				}
-			}
-			var yyn2054 bool
-			if x.FptrMapInt64Intf == nil {
-				yyn2054 = true
-				goto LABEL2054
-			}
-		LABEL2054:
-			if yyr2 || yy2arr2 {
-				if yyn2054 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Intf == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt64Int16 == nil {
 						r.EncodeNil()
 					} else {
-						yy2055 := *x.FptrMapInt64Intf
 						if false {
 						} else {
-							h.encMapint64Interface((map[int64]interface{})(yy2055), e)
+							h.encMapint64int16((map[int64]int16)(x.FMapInt64Int16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Intf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Intf`)
+				var yyn2134 bool
+				if x.FptrMapInt64Int16 == nil {
+					yyn2134 = true
+					goto LABEL2134
 				}
-				r.WriteMapElemValue()
-				if yyn2054 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Intf == nil {
+			LABEL2134:
+				if yyr2 || yy2arr2 {
+					if yyn2134 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2057 := *x.FptrMapInt64Intf
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt64Int16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64Interface((map[int64]interface{})(yy2057), e)
+							yy2135 := *x.FptrMapInt64Int16
+							if false {
+							} else {
+								h.encMapint64int16((map[int64]int16)(yy2135), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64String == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Int16\"")
 					} else {
-						h.encMapint64string((map[int64]string)(x.FMapInt64String), e)
+						r.EncodeSymbol(`FptrMapInt64Int16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64String`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64String == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2134 {
+						r.EncodeNil()
 					} else {
-						h.encMapint64string((map[int64]string)(x.FMapInt64String), e)
+						if x.FptrMapInt64Int16 == nil {
+							r.EncodeNil()
+						} else {
+							yy2137 := *x.FptrMapInt64Int16
+							if false {
+							} else {
+								h.encMapint64int16((map[int64]int16)(yy2137), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2062 bool
-			if x.FptrMapInt64String == nil {
-				yyn2062 = true
-				goto LABEL2062
-			}
-		LABEL2062:
-			if yyr2 || yy2arr2 {
-				if yyn2062 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt64String == nil {
+					if x.FMapInt64Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2063 := *x.FptrMapInt64String
 						if false {
 						} else {
-							h.encMapint64string((map[int64]string)(yy2063), e)
+							h.encMapint64int32((map[int64]int32)(x.FMapInt64Int32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64String\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64String`)
-				}
-				r.WriteMapElemValue()
-				if yyn2062 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt64String == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Int32\"")
+					} else {
+						r.EncodeSymbol(`FMapInt64Int32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt64Int32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2065 := *x.FptrMapInt64String
 						if false {
 						} else {
-							h.encMapint64string((map[int64]string)(yy2065), e)
+							h.encMapint64int32((map[int64]int32)(x.FMapInt64Int32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Uint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2142 bool
+				if x.FptrMapInt64Int32 == nil {
+					yyn2142 = true
+					goto LABEL2142
+				}
+			LABEL2142:
+				if yyr2 || yy2arr2 {
+					if yyn2142 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint64uint((map[int64]uint)(x.FMapInt64Uint), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt64Int32 == nil {
+							r.EncodeNil()
+						} else {
+							yy2143 := *x.FptrMapInt64Int32
+							if false {
+							} else {
+								h.encMapint64int32((map[int64]int32)(yy2143), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Uint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Uint == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Int32\"")
 					} else {
-						h.encMapint64uint((map[int64]uint)(x.FMapInt64Uint), e)
+						r.EncodeSymbol(`FptrMapInt64Int32`)
 					}
-				}
-			}
-			var yyn2070 bool
-			if x.FptrMapInt64Uint == nil {
-				yyn2070 = true
-				goto LABEL2070
-			}
-		LABEL2070:
-			if yyr2 || yy2arr2 {
-				if yyn2070 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Uint == nil {
+					r.WriteMapElemValue()
+					if yyn2142 {
 						r.EncodeNil()
 					} else {
-						yy2071 := *x.FptrMapInt64Uint
-						if false {
+						if x.FptrMapInt64Int32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64uint((map[int64]uint)(yy2071), e)
+							yy2145 := *x.FptrMapInt64Int32
+							if false {
+							} else {
+								h.encMapint64int32((map[int64]int32)(yy2145), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Uint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Uint`)
-				}
-				r.WriteMapElemValue()
-				if yyn2070 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Uint == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt64Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2073 := *x.FptrMapInt64Uint
 						if false {
 						} else {
-							h.encMapint64uint((map[int64]uint)(yy2073), e)
+							h.encMapint64int64((map[int64]int64)(x.FMapInt64Int64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Uint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint64uint8((map[int64]uint8)(x.FMapInt64Uint8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Uint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Uint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Int64\"")
 					} else {
-						h.encMapint64uint8((map[int64]uint8)(x.FMapInt64Uint8), e)
+						r.EncodeSymbol(`FMapInt64Int64`)
 					}
-				}
-			}
-			var yyn2078 bool
-			if x.FptrMapInt64Uint8 == nil {
-				yyn2078 = true
-				goto LABEL2078
-			}
-		LABEL2078:
-			if yyr2 || yy2arr2 {
-				if yyn2078 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Uint8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt64Int64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2079 := *x.FptrMapInt64Uint8
 						if false {
 						} else {
-							h.encMapint64uint8((map[int64]uint8)(yy2079), e)
+							h.encMapint64int64((map[int64]int64)(x.FMapInt64Int64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Uint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Uint8`)
+				var yyn2150 bool
+				if x.FptrMapInt64Int64 == nil {
+					yyn2150 = true
+					goto LABEL2150
 				}
-				r.WriteMapElemValue()
-				if yyn2078 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Uint8 == nil {
+			LABEL2150:
+				if yyr2 || yy2arr2 {
+					if yyn2150 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2081 := *x.FptrMapInt64Uint8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt64Int64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64uint8((map[int64]uint8)(yy2081), e)
+							yy2151 := *x.FptrMapInt64Int64
+							if false {
+							} else {
+								h.encMapint64int64((map[int64]int64)(yy2151), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Uint16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Int64\"")
 					} else {
-						h.encMapint64uint16((map[int64]uint16)(x.FMapInt64Uint16), e)
+						r.EncodeSymbol(`FptrMapInt64Int64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Uint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Uint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2150 {
+						r.EncodeNil()
 					} else {
-						h.encMapint64uint16((map[int64]uint16)(x.FMapInt64Uint16), e)
+						if x.FptrMapInt64Int64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2153 := *x.FptrMapInt64Int64
+							if false {
+							} else {
+								h.encMapint64int64((map[int64]int64)(yy2153), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2086 bool
-			if x.FptrMapInt64Uint16 == nil {
-				yyn2086 = true
-				goto LABEL2086
-			}
-		LABEL2086:
-			if yyr2 || yy2arr2 {
-				if yyn2086 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt64Uint16 == nil {
+					if x.FMapInt64Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2087 := *x.FptrMapInt64Uint16
 						if false {
 						} else {
-							h.encMapint64uint16((map[int64]uint16)(yy2087), e)
+							h.encMapint64float32((map[int64]float32)(x.FMapInt64Float32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Uint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Uint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn2086 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt64Uint16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Float32\"")
+					} else {
+						r.EncodeSymbol(`FMapInt64Float32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt64Float32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2089 := *x.FptrMapInt64Uint16
 						if false {
 						} else {
-							h.encMapint64uint16((map[int64]uint16)(yy2089), e)
+							h.encMapint64float32((map[int64]float32)(x.FMapInt64Float32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Uint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2158 bool
+				if x.FptrMapInt64Float32 == nil {
+					yyn2158 = true
+					goto LABEL2158
+				}
+			LABEL2158:
+				if yyr2 || yy2arr2 {
+					if yyn2158 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint64uint32((map[int64]uint32)(x.FMapInt64Uint32), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt64Float32 == nil {
+							r.EncodeNil()
+						} else {
+							yy2159 := *x.FptrMapInt64Float32
+							if false {
+							} else {
+								h.encMapint64float32((map[int64]float32)(yy2159), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Uint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Uint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Float32\"")
 					} else {
-						h.encMapint64uint32((map[int64]uint32)(x.FMapInt64Uint32), e)
+						r.EncodeSymbol(`FptrMapInt64Float32`)
 					}
-				}
-			}
-			var yyn2094 bool
-			if x.FptrMapInt64Uint32 == nil {
-				yyn2094 = true
-				goto LABEL2094
-			}
-		LABEL2094:
-			if yyr2 || yy2arr2 {
-				if yyn2094 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Uint32 == nil {
+					r.WriteMapElemValue()
+					if yyn2158 {
 						r.EncodeNil()
 					} else {
-						yy2095 := *x.FptrMapInt64Uint32
-						if false {
+						if x.FptrMapInt64Float32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64uint32((map[int64]uint32)(yy2095), e)
+							yy2161 := *x.FptrMapInt64Float32
+							if false {
+							} else {
+								h.encMapint64float32((map[int64]float32)(yy2161), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Uint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Uint32`)
-				}
-				r.WriteMapElemValue()
-				if yyn2094 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Uint32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapInt64Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2097 := *x.FptrMapInt64Uint32
 						if false {
 						} else {
-							h.encMapint64uint32((map[int64]uint32)(yy2097), e)
+							h.encMapint64float64((map[int64]float64)(x.FMapInt64Float64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint64uint64((map[int64]uint64)(x.FMapInt64Uint64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Uint64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Uint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Uint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Float64\"")
 					} else {
-						h.encMapint64uint64((map[int64]uint64)(x.FMapInt64Uint64), e)
+						r.EncodeSymbol(`FMapInt64Float64`)
 					}
-				}
-			}
-			var yyn2102 bool
-			if x.FptrMapInt64Uint64 == nil {
-				yyn2102 = true
-				goto LABEL2102
-			}
-		LABEL2102:
-			if yyr2 || yy2arr2 {
-				if yyn2102 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Uint64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapInt64Float64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2103 := *x.FptrMapInt64Uint64
 						if false {
 						} else {
-							h.encMapint64uint64((map[int64]uint64)(yy2103), e)
+							h.encMapint64float64((map[int64]float64)(x.FMapInt64Float64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Uint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Uint64`)
+				var yyn2166 bool
+				if x.FptrMapInt64Float64 == nil {
+					yyn2166 = true
+					goto LABEL2166
 				}
-				r.WriteMapElemValue()
-				if yyn2102 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Uint64 == nil {
+			LABEL2166:
+				if yyr2 || yy2arr2 {
+					if yyn2166 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2105 := *x.FptrMapInt64Uint64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapInt64Float64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64uint64((map[int64]uint64)(yy2105), e)
+							yy2167 := *x.FptrMapInt64Float64
+							if false {
+							} else {
+								h.encMapint64float64((map[int64]float64)(yy2167), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Uintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Float64\"")
 					} else {
-						h.encMapint64uintptr((map[int64]uintptr)(x.FMapInt64Uintptr), e)
+						r.EncodeSymbol(`FptrMapInt64Float64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Uintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Uintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2166 {
+						r.EncodeNil()
 					} else {
-						h.encMapint64uintptr((map[int64]uintptr)(x.FMapInt64Uintptr), e)
+						if x.FptrMapInt64Float64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2169 := *x.FptrMapInt64Float64
+							if false {
+							} else {
+								h.encMapint64float64((map[int64]float64)(yy2169), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2110 bool
-			if x.FptrMapInt64Uintptr == nil {
-				yyn2110 = true
-				goto LABEL2110
-			}
-		LABEL2110:
-			if yyr2 || yy2arr2 {
-				if yyn2110 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Uintptr == nil {
+					if x.FMapInt64Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy2111 := *x.FptrMapInt64Uintptr
 						if false {
 						} else {
-							h.encMapint64uintptr((map[int64]uintptr)(yy2111), e)
+							h.encMapint64bool((map[int64]bool)(x.FMapInt64Bool), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Uintptr\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Uintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn2110 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Uintptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapInt64Bool\"")
+					} else {
+						r.EncodeSymbol(`FMapInt64Bool`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapInt64Bool == nil {
 						r.EncodeNil()
 					} else {
-						yy2113 := *x.FptrMapInt64Uintptr
 						if false {
 						} else {
-							h.encMapint64uintptr((map[int64]uintptr)(yy2113), e)
+							h.encMapint64bool((map[int64]bool)(x.FMapInt64Bool), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2174 bool
+				if x.FptrMapInt64Bool == nil {
+					yyn2174 = true
+					goto LABEL2174
+				}
+			LABEL2174:
+				if yyr2 || yy2arr2 {
+					if yyn2174 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint64int((map[int64]int)(x.FMapInt64Int), e)
+						r.WriteArrayElem()
+						if x.FptrMapInt64Bool == nil {
+							r.EncodeNil()
+						} else {
+							yy2175 := *x.FptrMapInt64Bool
+							if false {
+							} else {
+								h.encMapint64bool((map[int64]bool)(yy2175), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Int\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Int`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Int == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapInt64Bool\"")
 					} else {
-						h.encMapint64int((map[int64]int)(x.FMapInt64Int), e)
+						r.EncodeSymbol(`FptrMapInt64Bool`)
 					}
-				}
-			}
-			var yyn2118 bool
-			if x.FptrMapInt64Int == nil {
-				yyn2118 = true
-				goto LABEL2118
-			}
-		LABEL2118:
-			if yyr2 || yy2arr2 {
-				if yyn2118 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Int == nil {
+					r.WriteMapElemValue()
+					if yyn2174 {
 						r.EncodeNil()
 					} else {
-						yy2119 := *x.FptrMapInt64Int
-						if false {
+						if x.FptrMapInt64Bool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64int((map[int64]int)(yy2119), e)
+							yy2177 := *x.FptrMapInt64Bool
+							if false {
+							} else {
+								h.encMapint64bool((map[int64]bool)(yy2177), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Int\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Int`)
-				}
-				r.WriteMapElemValue()
-				if yyn2118 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Int == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapBoolIntf == nil {
 						r.EncodeNil()
 					} else {
-						yy2121 := *x.FptrMapInt64Int
 						if false {
 						} else {
-							h.encMapint64int((map[int64]int)(yy2121), e)
+							h.encMapboolInterface((map[bool]interface{})(x.FMapBoolIntf), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Int8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint64int8((map[int64]int8)(x.FMapInt64Int8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Int8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Int8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolIntf\"")
 					} else {
-						h.encMapint64int8((map[int64]int8)(x.FMapInt64Int8), e)
+						r.EncodeSymbol(`FMapBoolIntf`)
 					}
-				}
-			}
-			var yyn2126 bool
-			if x.FptrMapInt64Int8 == nil {
-				yyn2126 = true
-				goto LABEL2126
-			}
-		LABEL2126:
-			if yyr2 || yy2arr2 {
-				if yyn2126 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Int8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapBoolIntf == nil {
 						r.EncodeNil()
 					} else {
-						yy2127 := *x.FptrMapInt64Int8
 						if false {
 						} else {
-							h.encMapint64int8((map[int64]int8)(yy2127), e)
+							h.encMapboolInterface((map[bool]interface{})(x.FMapBoolIntf), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Int8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Int8`)
+				var yyn2182 bool
+				if x.FptrMapBoolIntf == nil {
+					yyn2182 = true
+					goto LABEL2182
 				}
-				r.WriteMapElemValue()
-				if yyn2126 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Int8 == nil {
+			LABEL2182:
+				if yyr2 || yy2arr2 {
+					if yyn2182 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2129 := *x.FptrMapInt64Int8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapBoolIntf == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64int8((map[int64]int8)(yy2129), e)
+							yy2183 := *x.FptrMapBoolIntf
+							if false {
+							} else {
+								h.encMapboolInterface((map[bool]interface{})(yy2183), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Int16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolIntf\"")
 					} else {
-						h.encMapint64int16((map[int64]int16)(x.FMapInt64Int16), e)
+						r.EncodeSymbol(`FptrMapBoolIntf`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Int16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Int16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2182 {
+						r.EncodeNil()
 					} else {
-						h.encMapint64int16((map[int64]int16)(x.FMapInt64Int16), e)
+						if x.FptrMapBoolIntf == nil {
+							r.EncodeNil()
+						} else {
+							yy2185 := *x.FptrMapBoolIntf
+							if false {
+							} else {
+								h.encMapboolInterface((map[bool]interface{})(yy2185), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2134 bool
-			if x.FptrMapInt64Int16 == nil {
-				yyn2134 = true
-				goto LABEL2134
-			}
-		LABEL2134:
-			if yyr2 || yy2arr2 {
-				if yyn2134 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt64Int16 == nil {
+					if x.FMapBoolString == nil {
 						r.EncodeNil()
 					} else {
-						yy2135 := *x.FptrMapInt64Int16
 						if false {
 						} else {
-							h.encMapint64int16((map[int64]int16)(yy2135), e)
+							h.encMapboolstring((map[bool]string)(x.FMapBoolString), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Int16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Int16`)
-				}
-				r.WriteMapElemValue()
-				if yyn2134 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt64Int16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolString\"")
+					} else {
+						r.EncodeSymbol(`FMapBoolString`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapBoolString == nil {
 						r.EncodeNil()
 					} else {
-						yy2137 := *x.FptrMapInt64Int16
 						if false {
 						} else {
-							h.encMapint64int16((map[int64]int16)(yy2137), e)
+							h.encMapboolstring((map[bool]string)(x.FMapBoolString), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Int32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2190 bool
+				if x.FptrMapBoolString == nil {
+					yyn2190 = true
+					goto LABEL2190
+				}
+			LABEL2190:
+				if yyr2 || yy2arr2 {
+					if yyn2190 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint64int32((map[int64]int32)(x.FMapInt64Int32), e)
+						r.WriteArrayElem()
+						if x.FptrMapBoolString == nil {
+							r.EncodeNil()
+						} else {
+							yy2191 := *x.FptrMapBoolString
+							if false {
+							} else {
+								h.encMapboolstring((map[bool]string)(yy2191), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Int32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Int32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolString\"")
 					} else {
-						h.encMapint64int32((map[int64]int32)(x.FMapInt64Int32), e)
+						r.EncodeSymbol(`FptrMapBoolString`)
 					}
-				}
-			}
-			var yyn2142 bool
-			if x.FptrMapInt64Int32 == nil {
-				yyn2142 = true
-				goto LABEL2142
-			}
-		LABEL2142:
-			if yyr2 || yy2arr2 {
-				if yyn2142 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Int32 == nil {
+					r.WriteMapElemValue()
+					if yyn2190 {
 						r.EncodeNil()
 					} else {
-						yy2143 := *x.FptrMapInt64Int32
-						if false {
+						if x.FptrMapBoolString == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64int32((map[int64]int32)(yy2143), e)
+							yy2193 := *x.FptrMapBoolString
+							if false {
+							} else {
+								h.encMapboolstring((map[bool]string)(yy2193), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Int32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Int32`)
-				}
-				r.WriteMapElemValue()
-				if yyn2142 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Int32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapBoolUint == nil {
 						r.EncodeNil()
 					} else {
-						yy2145 := *x.FptrMapInt64Int32
 						if false {
 						} else {
-							h.encMapint64int32((map[int64]int32)(yy2145), e)
+							h.encMapbooluint((map[bool]uint)(x.FMapBoolUint), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Int64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint64int64((map[int64]int64)(x.FMapInt64Int64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Int64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Int64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolUint\"")
 					} else {
-						h.encMapint64int64((map[int64]int64)(x.FMapInt64Int64), e)
+						r.EncodeSymbol(`FMapBoolUint`)
 					}
-				}
-			}
-			var yyn2150 bool
-			if x.FptrMapInt64Int64 == nil {
-				yyn2150 = true
-				goto LABEL2150
-			}
-		LABEL2150:
-			if yyr2 || yy2arr2 {
-				if yyn2150 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Int64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapBoolUint == nil {
 						r.EncodeNil()
 					} else {
-						yy2151 := *x.FptrMapInt64Int64
 						if false {
 						} else {
-							h.encMapint64int64((map[int64]int64)(yy2151), e)
+							h.encMapbooluint((map[bool]uint)(x.FMapBoolUint), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Int64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Int64`)
+				var yyn2198 bool
+				if x.FptrMapBoolUint == nil {
+					yyn2198 = true
+					goto LABEL2198
 				}
-				r.WriteMapElemValue()
-				if yyn2150 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Int64 == nil {
+			LABEL2198:
+				if yyr2 || yy2arr2 {
+					if yyn2198 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2153 := *x.FptrMapInt64Int64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapBoolUint == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64int64((map[int64]int64)(yy2153), e)
+							yy2199 := *x.FptrMapBoolUint
+							if false {
+							} else {
+								h.encMapbooluint((map[bool]uint)(yy2199), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Float32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolUint\"")
 					} else {
-						h.encMapint64float32((map[int64]float32)(x.FMapInt64Float32), e)
+						r.EncodeSymbol(`FptrMapBoolUint`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Float32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Float32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2198 {
+						r.EncodeNil()
 					} else {
-						h.encMapint64float32((map[int64]float32)(x.FMapInt64Float32), e)
+						if x.FptrMapBoolUint == nil {
+							r.EncodeNil()
+						} else {
+							yy2201 := *x.FptrMapBoolUint
+							if false {
+							} else {
+								h.encMapbooluint((map[bool]uint)(yy2201), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2158 bool
-			if x.FptrMapInt64Float32 == nil {
-				yyn2158 = true
-				goto LABEL2158
-			}
-		LABEL2158:
-			if yyr2 || yy2arr2 {
-				if yyn2158 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapInt64Float32 == nil {
+					if x.FMapBoolUint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy2159 := *x.FptrMapInt64Float32
 						if false {
 						} else {
-							h.encMapint64float32((map[int64]float32)(yy2159), e)
+							h.encMapbooluint8((map[bool]uint8)(x.FMapBoolUint8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Float32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Float32`)
-				}
-				r.WriteMapElemValue()
-				if yyn2158 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapInt64Float32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolUint8\"")
+					} else {
+						r.EncodeSymbol(`FMapBoolUint8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapBoolUint8 == nil {
 						r.EncodeNil()
 					} else {
-						yy2161 := *x.FptrMapInt64Float32
 						if false {
 						} else {
-							h.encMapint64float32((map[int64]float32)(yy2161), e)
+							h.encMapbooluint8((map[bool]uint8)(x.FMapBoolUint8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Float64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2206 bool
+				if x.FptrMapBoolUint8 == nil {
+					yyn2206 = true
+					goto LABEL2206
+				}
+			LABEL2206:
+				if yyr2 || yy2arr2 {
+					if yyn2206 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapint64float64((map[int64]float64)(x.FMapInt64Float64), e)
+						r.WriteArrayElem()
+						if x.FptrMapBoolUint8 == nil {
+							r.EncodeNil()
+						} else {
+							yy2207 := *x.FptrMapBoolUint8
+							if false {
+							} else {
+								h.encMapbooluint8((map[bool]uint8)(yy2207), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Float64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Float64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolUint8\"")
 					} else {
-						h.encMapint64float64((map[int64]float64)(x.FMapInt64Float64), e)
+						r.EncodeSymbol(`FptrMapBoolUint8`)
 					}
-				}
-			}
-			var yyn2166 bool
-			if x.FptrMapInt64Float64 == nil {
-				yyn2166 = true
-				goto LABEL2166
-			}
-		LABEL2166:
-			if yyr2 || yy2arr2 {
-				if yyn2166 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Float64 == nil {
+					r.WriteMapElemValue()
+					if yyn2206 {
 						r.EncodeNil()
 					} else {
-						yy2167 := *x.FptrMapInt64Float64
-						if false {
+						if x.FptrMapBoolUint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64float64((map[int64]float64)(yy2167), e)
+							yy2209 := *x.FptrMapBoolUint8
+							if false {
+							} else {
+								h.encMapbooluint8((map[bool]uint8)(yy2209), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Float64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Float64`)
-				}
-				r.WriteMapElemValue()
-				if yyn2166 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Float64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapBoolUint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy2169 := *x.FptrMapInt64Float64
 						if false {
 						} else {
-							h.encMapint64float64((map[int64]float64)(yy2169), e)
+							h.encMapbooluint16((map[bool]uint16)(x.FMapBoolUint16), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapInt64Bool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapint64bool((map[int64]bool)(x.FMapInt64Bool), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapInt64Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapInt64Bool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapInt64Bool == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolUint16\"")
 					} else {
-						h.encMapint64bool((map[int64]bool)(x.FMapInt64Bool), e)
+						r.EncodeSymbol(`FMapBoolUint16`)
 					}
-				}
-			}
-			var yyn2174 bool
-			if x.FptrMapInt64Bool == nil {
-				yyn2174 = true
-				goto LABEL2174
-			}
-		LABEL2174:
-			if yyr2 || yy2arr2 {
-				if yyn2174 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapInt64Bool == nil {
+					r.WriteMapElemValue()
+					if x.FMapBoolUint16 == nil {
 						r.EncodeNil()
 					} else {
-						yy2175 := *x.FptrMapInt64Bool
 						if false {
 						} else {
-							h.encMapint64bool((map[int64]bool)(yy2175), e)
+							h.encMapbooluint16((map[bool]uint16)(x.FMapBoolUint16), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapInt64Bool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapInt64Bool`)
+				var yyn2214 bool
+				if x.FptrMapBoolUint16 == nil {
+					yyn2214 = true
+					goto LABEL2214
 				}
-				r.WriteMapElemValue()
-				if yyn2174 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapInt64Bool == nil {
+			LABEL2214:
+				if yyr2 || yy2arr2 {
+					if yyn2214 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2177 := *x.FptrMapInt64Bool
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapBoolUint16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapint64bool((map[int64]bool)(yy2177), e)
+							yy2215 := *x.FptrMapBoolUint16
+							if false {
+							} else {
+								h.encMapbooluint16((map[bool]uint16)(yy2215), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolIntf == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolUint16\"")
 					} else {
-						h.encMapboolInterface((map[bool]interface{})(x.FMapBoolIntf), e)
+						r.EncodeSymbol(`FptrMapBoolUint16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolIntf`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolIntf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2214 {
+						r.EncodeNil()
 					} else {
-						h.encMapboolInterface((map[bool]interface{})(x.FMapBoolIntf), e)
+						if x.FptrMapBoolUint16 == nil {
+							r.EncodeNil()
+						} else {
+							yy2217 := *x.FptrMapBoolUint16
+							if false {
+							} else {
+								h.encMapbooluint16((map[bool]uint16)(yy2217), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2182 bool
-			if x.FptrMapBoolIntf == nil {
-				yyn2182 = true
-				goto LABEL2182
-			}
-		LABEL2182:
-			if yyr2 || yy2arr2 {
-				if yyn2182 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapBoolIntf == nil {
+					if x.FMapBoolUint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2183 := *x.FptrMapBoolIntf
 						if false {
 						} else {
-							h.encMapboolInterface((map[bool]interface{})(yy2183), e)
+							h.encMapbooluint32((map[bool]uint32)(x.FMapBoolUint32), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolIntf\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolIntf`)
-				}
-				r.WriteMapElemValue()
-				if yyn2182 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapBoolIntf == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolUint32\"")
+					} else {
+						r.EncodeSymbol(`FMapBoolUint32`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapBoolUint32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2185 := *x.FptrMapBoolIntf
 						if false {
 						} else {
-							h.encMapboolInterface((map[bool]interface{})(yy2185), e)
+							h.encMapbooluint32((map[bool]uint32)(x.FMapBoolUint32), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolString == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2222 bool
+				if x.FptrMapBoolUint32 == nil {
+					yyn2222 = true
+					goto LABEL2222
+				}
+			LABEL2222:
+				if yyr2 || yy2arr2 {
+					if yyn2222 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapboolstring((map[bool]string)(x.FMapBoolString), e)
+						r.WriteArrayElem()
+						if x.FptrMapBoolUint32 == nil {
+							r.EncodeNil()
+						} else {
+							yy2223 := *x.FptrMapBoolUint32
+							if false {
+							} else {
+								h.encMapbooluint32((map[bool]uint32)(yy2223), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolString`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolString == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolUint32\"")
 					} else {
-						h.encMapboolstring((map[bool]string)(x.FMapBoolString), e)
+						r.EncodeSymbol(`FptrMapBoolUint32`)
 					}
-				}
-			}
-			var yyn2190 bool
-			if x.FptrMapBoolString == nil {
-				yyn2190 = true
-				goto LABEL2190
-			}
-		LABEL2190:
-			if yyr2 || yy2arr2 {
-				if yyn2190 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolString == nil {
+					r.WriteMapElemValue()
+					if yyn2222 {
 						r.EncodeNil()
 					} else {
-						yy2191 := *x.FptrMapBoolString
-						if false {
+						if x.FptrMapBoolUint32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapboolstring((map[bool]string)(yy2191), e)
+							yy2225 := *x.FptrMapBoolUint32
+							if false {
+							} else {
+								h.encMapbooluint32((map[bool]uint32)(yy2225), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolString`)
-				}
-				r.WriteMapElemValue()
-				if yyn2190 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolString == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapBoolUint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2193 := *x.FptrMapBoolString
 						if false {
 						} else {
-							h.encMapboolstring((map[bool]string)(yy2193), e)
+							h.encMapbooluint64((map[bool]uint64)(x.FMapBoolUint64), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolUint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapbooluint((map[bool]uint)(x.FMapBoolUint), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolUint\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolUint`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolUint == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolUint64\"")
 					} else {
-						h.encMapbooluint((map[bool]uint)(x.FMapBoolUint), e)
+						r.EncodeSymbol(`FMapBoolUint64`)
 					}
-				}
-			}
-			var yyn2198 bool
-			if x.FptrMapBoolUint == nil {
-				yyn2198 = true
-				goto LABEL2198
-			}
-		LABEL2198:
-			if yyr2 || yy2arr2 {
-				if yyn2198 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolUint == nil {
+					r.WriteMapElemValue()
+					if x.FMapBoolUint64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2199 := *x.FptrMapBoolUint
 						if false {
 						} else {
-							h.encMapbooluint((map[bool]uint)(yy2199), e)
+							h.encMapbooluint64((map[bool]uint64)(x.FMapBoolUint64), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolUint\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolUint`)
+				var yyn2230 bool
+				if x.FptrMapBoolUint64 == nil {
+					yyn2230 = true
+					goto LABEL2230
 				}
-				r.WriteMapElemValue()
-				if yyn2198 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolUint == nil {
+			LABEL2230:
+				if yyr2 || yy2arr2 {
+					if yyn2230 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2201 := *x.FptrMapBoolUint
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapBoolUint64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapbooluint((map[bool]uint)(yy2201), e)
+							yy2231 := *x.FptrMapBoolUint64
+							if false {
+							} else {
+								h.encMapbooluint64((map[bool]uint64)(yy2231), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolUint8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolUint64\"")
 					} else {
-						h.encMapbooluint8((map[bool]uint8)(x.FMapBoolUint8), e)
+						r.EncodeSymbol(`FptrMapBoolUint64`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolUint8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolUint8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolUint8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2230 {
+						r.EncodeNil()
 					} else {
-						h.encMapbooluint8((map[bool]uint8)(x.FMapBoolUint8), e)
+						if x.FptrMapBoolUint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2233 := *x.FptrMapBoolUint64
+							if false {
+							} else {
+								h.encMapbooluint64((map[bool]uint64)(yy2233), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2206 bool
-			if x.FptrMapBoolUint8 == nil {
-				yyn2206 = true
-				goto LABEL2206
-			}
-		LABEL2206:
-			if yyr2 || yy2arr2 {
-				if yyn2206 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolUint8 == nil {
+					if x.FMapBoolUintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy2207 := *x.FptrMapBoolUint8
 						if false {
 						} else {
-							h.encMapbooluint8((map[bool]uint8)(yy2207), e)
+							h.encMapbooluintptr((map[bool]uintptr)(x.FMapBoolUintptr), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolUint8\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolUint8`)
-				}
-				r.WriteMapElemValue()
-				if yyn2206 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolUint8 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolUintptr\"")
+					} else {
+						r.EncodeSymbol(`FMapBoolUintptr`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapBoolUintptr == nil {
 						r.EncodeNil()
 					} else {
-						yy2209 := *x.FptrMapBoolUint8
 						if false {
 						} else {
-							h.encMapbooluint8((map[bool]uint8)(yy2209), e)
+							h.encMapbooluintptr((map[bool]uintptr)(x.FMapBoolUintptr), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolUint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2238 bool
+				if x.FptrMapBoolUintptr == nil {
+					yyn2238 = true
+					goto LABEL2238
+				}
+			LABEL2238:
+				if yyr2 || yy2arr2 {
+					if yyn2238 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapbooluint16((map[bool]uint16)(x.FMapBoolUint16), e)
+						r.WriteArrayElem()
+						if x.FptrMapBoolUintptr == nil {
+							r.EncodeNil()
+						} else {
+							yy2239 := *x.FptrMapBoolUintptr
+							if false {
+							} else {
+								h.encMapbooluintptr((map[bool]uintptr)(yy2239), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolUint16\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolUint16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolUint16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolUintptr\"")
 					} else {
-						h.encMapbooluint16((map[bool]uint16)(x.FMapBoolUint16), e)
+						r.EncodeSymbol(`FptrMapBoolUintptr`)
 					}
-				}
-			}
-			var yyn2214 bool
-			if x.FptrMapBoolUint16 == nil {
-				yyn2214 = true
-				goto LABEL2214
-			}
-		LABEL2214:
-			if yyr2 || yy2arr2 {
-				if yyn2214 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolUint16 == nil {
+					r.WriteMapElemValue()
+					if yyn2238 {
 						r.EncodeNil()
 					} else {
-						yy2215 := *x.FptrMapBoolUint16
-						if false {
+						if x.FptrMapBoolUintptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapbooluint16((map[bool]uint16)(yy2215), e)
+							yy2241 := *x.FptrMapBoolUintptr
+							if false {
+							} else {
+								h.encMapbooluintptr((map[bool]uintptr)(yy2241), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolUint16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolUint16`)
-				}
-				r.WriteMapElemValue()
-				if yyn2214 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolUint16 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapBoolInt == nil {
 						r.EncodeNil()
 					} else {
-						yy2217 := *x.FptrMapBoolUint16
 						if false {
 						} else {
-							h.encMapbooluint16((map[bool]uint16)(yy2217), e)
+							h.encMapboolint((map[bool]int)(x.FMapBoolInt), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolUint32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapbooluint32((map[bool]uint32)(x.FMapBoolUint32), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolUint32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolUint32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolInt\"")
 					} else {
-						h.encMapbooluint32((map[bool]uint32)(x.FMapBoolUint32), e)
+						r.EncodeSymbol(`FMapBoolInt`)
 					}
-				}
-			}
-			var yyn2222 bool
-			if x.FptrMapBoolUint32 == nil {
-				yyn2222 = true
-				goto LABEL2222
-			}
-		LABEL2222:
-			if yyr2 || yy2arr2 {
-				if yyn2222 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolUint32 == nil {
+					r.WriteMapElemValue()
+					if x.FMapBoolInt == nil {
 						r.EncodeNil()
 					} else {
-						yy2223 := *x.FptrMapBoolUint32
 						if false {
 						} else {
-							h.encMapbooluint32((map[bool]uint32)(yy2223), e)
+							h.encMapboolint((map[bool]int)(x.FMapBoolInt), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolUint32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolUint32`)
+				var yyn2246 bool
+				if x.FptrMapBoolInt == nil {
+					yyn2246 = true
+					goto LABEL2246
 				}
-				r.WriteMapElemValue()
-				if yyn2222 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolUint32 == nil {
+			LABEL2246:
+				if yyr2 || yy2arr2 {
+					if yyn2246 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2225 := *x.FptrMapBoolUint32
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapBoolInt == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapbooluint32((map[bool]uint32)(yy2225), e)
+							yy2247 := *x.FptrMapBoolInt
+							if false {
+							} else {
+								h.encMapboolint((map[bool]int)(yy2247), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolUint64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolInt\"")
 					} else {
-						h.encMapbooluint64((map[bool]uint64)(x.FMapBoolUint64), e)
+						r.EncodeSymbol(`FptrMapBoolInt`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolUint64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolUint64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2246 {
+						r.EncodeNil()
 					} else {
-						h.encMapbooluint64((map[bool]uint64)(x.FMapBoolUint64), e)
+						if x.FptrMapBoolInt == nil {
+							r.EncodeNil()
+						} else {
+							yy2249 := *x.FptrMapBoolInt
+							if false {
+							} else {
+								h.encMapboolint((map[bool]int)(yy2249), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2230 bool
-			if x.FptrMapBoolUint64 == nil {
-				yyn2230 = true
-				goto LABEL2230
-			}
-		LABEL2230:
-			if yyr2 || yy2arr2 {
-				if yyn2230 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapBoolUint64 == nil {
+					if x.FMapBoolInt8 == nil {
 						r.EncodeNil()
 					} else {
-						yy2231 := *x.FptrMapBoolUint64
 						if false {
 						} else {
-							h.encMapbooluint64((map[bool]uint64)(yy2231), e)
+							h.encMapboolint8((map[bool]int8)(x.FMapBoolInt8), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolUint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolUint64`)
-				}
-				r.WriteMapElemValue()
-				if yyn2230 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapBoolUint64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolInt8\"")
+					} else {
+						r.EncodeSymbol(`FMapBoolInt8`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapBoolInt8 == nil {
 						r.EncodeNil()
 					} else {
-						yy2233 := *x.FptrMapBoolUint64
 						if false {
 						} else {
-							h.encMapbooluint64((map[bool]uint64)(yy2233), e)
+							h.encMapboolint8((map[bool]int8)(x.FMapBoolInt8), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolUintptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2254 bool
+				if x.FptrMapBoolInt8 == nil {
+					yyn2254 = true
+					goto LABEL2254
+				}
+			LABEL2254:
+				if yyr2 || yy2arr2 {
+					if yyn2254 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapbooluintptr((map[bool]uintptr)(x.FMapBoolUintptr), e)
+						r.WriteArrayElem()
+						if x.FptrMapBoolInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy2255 := *x.FptrMapBoolInt8
+							if false {
+							} else {
+								h.encMapboolint8((map[bool]int8)(yy2255), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolUintptr`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolUintptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolInt8\"")
 					} else {
-						h.encMapbooluintptr((map[bool]uintptr)(x.FMapBoolUintptr), e)
+						r.EncodeSymbol(`FptrMapBoolInt8`)
+					}
+					r.WriteMapElemValue()
+					if yyn2254 {
+						r.EncodeNil()
+					} else {
+						if x.FptrMapBoolInt8 == nil {
+							r.EncodeNil()
+						} else {
+							yy2257 := *x.FptrMapBoolInt8
+							if false {
+							} else {
+								h.encMapboolint8((map[bool]int8)(yy2257), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2238 bool
-			if x.FptrMapBoolUintptr == nil {
-				yyn2238 = true
-				goto LABEL2238
-			}
-		LABEL2238:
-			if yyr2 || yy2arr2 {
-				if yyn2238 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapBoolUintptr == nil {
+					if x.FMapBoolInt16 == nil {
 						r.EncodeNil()
 					} else {
-						yy2239 := *x.FptrMapBoolUintptr
 						if false {
 						} else {
-							h.encMapbooluintptr((map[bool]uintptr)(yy2239), e)
+							h.encMapboolint16((map[bool]int16)(x.FMapBoolInt16), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolUintptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolUintptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn2238 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapBoolUintptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolInt16\"")
+					} else {
+						r.EncodeSymbol(`FMapBoolInt16`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapBoolInt16 == nil {
 						r.EncodeNil()
 					} else {
-						yy2241 := *x.FptrMapBoolUintptr
 						if false {
 						} else {
-							h.encMapbooluintptr((map[bool]uintptr)(yy2241), e)
+							h.encMapboolint16((map[bool]int16)(x.FMapBoolInt16), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolInt == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2262 bool
+				if x.FptrMapBoolInt16 == nil {
+					yyn2262 = true
+					goto LABEL2262
+				}
+			LABEL2262:
+				if yyr2 || yy2arr2 {
+					if yyn2262 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapboolint((map[bool]int)(x.FMapBoolInt), e)
+						r.WriteArrayElem()
+						if x.FptrMapBoolInt16 == nil {
+							r.EncodeNil()
+						} else {
+							yy2263 := *x.FptrMapBoolInt16
+							if false {
+							} else {
+								h.encMapboolint16((map[bool]int16)(yy2263), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolInt`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolInt == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolInt16\"")
 					} else {
-						h.encMapboolint((map[bool]int)(x.FMapBoolInt), e)
+						r.EncodeSymbol(`FptrMapBoolInt16`)
 					}
-				}
-			}
-			var yyn2246 bool
-			if x.FptrMapBoolInt == nil {
-				yyn2246 = true
-				goto LABEL2246
-			}
-		LABEL2246:
-			if yyr2 || yy2arr2 {
-				if yyn2246 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolInt == nil {
+					r.WriteMapElemValue()
+					if yyn2262 {
 						r.EncodeNil()
 					} else {
-						yy2247 := *x.FptrMapBoolInt
-						if false {
+						if x.FptrMapBoolInt16 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapboolint((map[bool]int)(yy2247), e)
+							yy2265 := *x.FptrMapBoolInt16
+							if false {
+							} else {
+								h.encMapboolint16((map[bool]int16)(yy2265), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolInt\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolInt`)
-				}
-				r.WriteMapElemValue()
-				if yyn2246 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolInt == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapBoolInt32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2249 := *x.FptrMapBoolInt
 						if false {
 						} else {
-							h.encMapboolint((map[bool]int)(yy2249), e)
+							h.encMapboolint32((map[bool]int32)(x.FMapBoolInt32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolInt8 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapboolint8((map[bool]int8)(x.FMapBoolInt8), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolInt8`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolInt8 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolInt32\"")
 					} else {
-						h.encMapboolint8((map[bool]int8)(x.FMapBoolInt8), e)
+						r.EncodeSymbol(`FMapBoolInt32`)
 					}
-				}
-			}
-			var yyn2254 bool
-			if x.FptrMapBoolInt8 == nil {
-				yyn2254 = true
-				goto LABEL2254
-			}
-		LABEL2254:
-			if yyr2 || yy2arr2 {
-				if yyn2254 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolInt8 == nil {
+					r.WriteMapElemValue()
+					if x.FMapBoolInt32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2255 := *x.FptrMapBoolInt8
 						if false {
 						} else {
-							h.encMapboolint8((map[bool]int8)(yy2255), e)
+							h.encMapboolint32((map[bool]int32)(x.FMapBoolInt32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolInt8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolInt8`)
+				var yyn2270 bool
+				if x.FptrMapBoolInt32 == nil {
+					yyn2270 = true
+					goto LABEL2270
 				}
-				r.WriteMapElemValue()
-				if yyn2254 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolInt8 == nil {
+			LABEL2270:
+				if yyr2 || yy2arr2 {
+					if yyn2270 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2257 := *x.FptrMapBoolInt8
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapBoolInt32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapboolint8((map[bool]int8)(yy2257), e)
+							yy2271 := *x.FptrMapBoolInt32
+							if false {
+							} else {
+								h.encMapboolint32((map[bool]int32)(yy2271), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolInt16 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolInt32\"")
 					} else {
-						h.encMapboolint16((map[bool]int16)(x.FMapBoolInt16), e)
+						r.EncodeSymbol(`FptrMapBoolInt32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolInt16`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolInt16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2270 {
+						r.EncodeNil()
 					} else {
-						h.encMapboolint16((map[bool]int16)(x.FMapBoolInt16), e)
+						if x.FptrMapBoolInt32 == nil {
+							r.EncodeNil()
+						} else {
+							yy2273 := *x.FptrMapBoolInt32
+							if false {
+							} else {
+								h.encMapboolint32((map[bool]int32)(yy2273), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2262 bool
-			if x.FptrMapBoolInt16 == nil {
-				yyn2262 = true
-				goto LABEL2262
-			}
-		LABEL2262:
-			if yyr2 || yy2arr2 {
-				if yyn2262 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapBoolInt16 == nil {
+					if x.FMapBoolInt64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2263 := *x.FptrMapBoolInt16
 						if false {
 						} else {
-							h.encMapboolint16((map[bool]int16)(yy2263), e)
+							h.encMapboolint64((map[bool]int64)(x.FMapBoolInt64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolInt16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolInt16`)
-				}
-				r.WriteMapElemValue()
-				if yyn2262 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapBoolInt16 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolInt64\"")
+					} else {
+						r.EncodeSymbol(`FMapBoolInt64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapBoolInt64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2265 := *x.FptrMapBoolInt16
 						if false {
 						} else {
-							h.encMapboolint16((map[bool]int16)(yy2265), e)
+							h.encMapboolint64((map[bool]int64)(x.FMapBoolInt64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolInt32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2278 bool
+				if x.FptrMapBoolInt64 == nil {
+					yyn2278 = true
+					goto LABEL2278
+				}
+			LABEL2278:
+				if yyr2 || yy2arr2 {
+					if yyn2278 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapboolint32((map[bool]int32)(x.FMapBoolInt32), e)
+						r.WriteArrayElem()
+						if x.FptrMapBoolInt64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2279 := *x.FptrMapBoolInt64
+							if false {
+							} else {
+								h.encMapboolint64((map[bool]int64)(yy2279), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolInt32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolInt32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolInt64\"")
 					} else {
-						h.encMapboolint32((map[bool]int32)(x.FMapBoolInt32), e)
+						r.EncodeSymbol(`FptrMapBoolInt64`)
 					}
-				}
-			}
-			var yyn2270 bool
-			if x.FptrMapBoolInt32 == nil {
-				yyn2270 = true
-				goto LABEL2270
-			}
-		LABEL2270:
-			if yyr2 || yy2arr2 {
-				if yyn2270 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolInt32 == nil {
+					r.WriteMapElemValue()
+					if yyn2278 {
 						r.EncodeNil()
 					} else {
-						yy2271 := *x.FptrMapBoolInt32
-						if false {
+						if x.FptrMapBoolInt64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapboolint32((map[bool]int32)(yy2271), e)
+							yy2281 := *x.FptrMapBoolInt64
+							if false {
+							} else {
+								h.encMapboolint64((map[bool]int64)(yy2281), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolInt32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolInt32`)
-				}
-				r.WriteMapElemValue()
-				if yyn2270 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolInt32 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapBoolFloat32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2273 := *x.FptrMapBoolInt32
 						if false {
 						} else {
-							h.encMapboolint32((map[bool]int32)(yy2273), e)
+							h.encMapboolfloat32((map[bool]float32)(x.FMapBoolFloat32), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolInt64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapboolint64((map[bool]int64)(x.FMapBoolInt64), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolInt64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolFloat32\"")
 					} else {
-						h.encMapboolint64((map[bool]int64)(x.FMapBoolInt64), e)
+						r.EncodeSymbol(`FMapBoolFloat32`)
 					}
-				}
-			}
-			var yyn2278 bool
-			if x.FptrMapBoolInt64 == nil {
-				yyn2278 = true
-				goto LABEL2278
-			}
-		LABEL2278:
-			if yyr2 || yy2arr2 {
-				if yyn2278 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolInt64 == nil {
+					r.WriteMapElemValue()
+					if x.FMapBoolFloat32 == nil {
 						r.EncodeNil()
 					} else {
-						yy2279 := *x.FptrMapBoolInt64
 						if false {
 						} else {
-							h.encMapboolint64((map[bool]int64)(yy2279), e)
+							h.encMapboolfloat32((map[bool]float32)(x.FMapBoolFloat32), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolInt64`)
+				var yyn2286 bool
+				if x.FptrMapBoolFloat32 == nil {
+					yyn2286 = true
+					goto LABEL2286
 				}
-				r.WriteMapElemValue()
-				if yyn2278 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolInt64 == nil {
+			LABEL2286:
+				if yyr2 || yy2arr2 {
+					if yyn2286 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy2281 := *x.FptrMapBoolInt64
-						if false {
+						r.WriteArrayElem()
+						if x.FptrMapBoolFloat32 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapboolint64((map[bool]int64)(yy2281), e)
+							yy2287 := *x.FptrMapBoolFloat32
+							if false {
+							} else {
+								h.encMapboolfloat32((map[bool]float32)(yy2287), e)
+							}
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolFloat32 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolFloat32\"")
 					} else {
-						h.encMapboolfloat32((map[bool]float32)(x.FMapBoolFloat32), e)
+						r.EncodeSymbol(`FptrMapBoolFloat32`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolFloat32`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolFloat32 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn2286 {
+						r.EncodeNil()
 					} else {
-						h.encMapboolfloat32((map[bool]float32)(x.FMapBoolFloat32), e)
+						if x.FptrMapBoolFloat32 == nil {
+							r.EncodeNil()
+						} else {
+							yy2289 := *x.FptrMapBoolFloat32
+							if false {
+							} else {
+								h.encMapboolfloat32((map[bool]float32)(yy2289), e)
+							}
+						}
 					}
 				}
-			}
-			var yyn2286 bool
-			if x.FptrMapBoolFloat32 == nil {
-				yyn2286 = true
-				goto LABEL2286
-			}
-		LABEL2286:
-			if yyr2 || yy2arr2 {
-				if yyn2286 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.FptrMapBoolFloat32 == nil {
+					if x.FMapBoolFloat64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2287 := *x.FptrMapBoolFloat32
 						if false {
 						} else {
-							h.encMapboolfloat32((map[bool]float32)(yy2287), e)
+							h.encMapboolfloat64((map[bool]float64)(x.FMapBoolFloat64), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolFloat32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolFloat32`)
-				}
-				r.WriteMapElemValue()
-				if yyn2286 {
-					r.EncodeNil()
 				} else {
-					if x.FptrMapBoolFloat32 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolFloat64\"")
+					} else {
+						r.EncodeSymbol(`FMapBoolFloat64`)
+					}
+					r.WriteMapElemValue()
+					if x.FMapBoolFloat64 == nil {
 						r.EncodeNil()
 					} else {
-						yy2289 := *x.FptrMapBoolFloat32
 						if false {
 						} else {
-							h.encMapboolfloat32((map[bool]float32)(yy2289), e)
+							h.encMapboolfloat64((map[bool]float64)(x.FMapBoolFloat64), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolFloat64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn2294 bool
+				if x.FptrMapBoolFloat64 == nil {
+					yyn2294 = true
+					goto LABEL2294
+				}
+			LABEL2294:
+				if yyr2 || yy2arr2 {
+					if yyn2294 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapboolfloat64((map[bool]float64)(x.FMapBoolFloat64), e)
+						r.WriteArrayElem()
+						if x.FptrMapBoolFloat64 == nil {
+							r.EncodeNil()
+						} else {
+							yy2295 := *x.FptrMapBoolFloat64
+							if false {
+							} else {
+								h.encMapboolfloat64((map[bool]float64)(yy2295), e)
+							}
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolFloat64`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolFloat64 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolFloat64\"")
 					} else {
-						h.encMapboolfloat64((map[bool]float64)(x.FMapBoolFloat64), e)
+						r.EncodeSymbol(`FptrMapBoolFloat64`)
 					}
-				}
-			}
-			var yyn2294 bool
-			if x.FptrMapBoolFloat64 == nil {
-				yyn2294 = true
-				goto LABEL2294
-			}
-		LABEL2294:
-			if yyr2 || yy2arr2 {
-				if yyn2294 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolFloat64 == nil {
+					r.WriteMapElemValue()
+					if yyn2294 {
 						r.EncodeNil()
 					} else {
-						yy2295 := *x.FptrMapBoolFloat64
-						if false {
+						if x.FptrMapBoolFloat64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapboolfloat64((map[bool]float64)(yy2295), e)
+							yy2297 := *x.FptrMapBoolFloat64
+							if false {
+							} else {
+								h.encMapboolfloat64((map[bool]float64)(yy2297), e)
+							}
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolFloat64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolFloat64`)
-				}
-				r.WriteMapElemValue()
-				if yyn2294 {
-					r.EncodeNil()
-				} else {
-					if x.FptrMapBoolFloat64 == nil {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.FMapBoolBool == nil {
 						r.EncodeNil()
 					} else {
-						yy2297 := *x.FptrMapBoolFloat64
 						if false {
 						} else {
-							h.encMapboolfloat64((map[bool]float64)(yy2297), e)
+							h.encMapboolbool((map[bool]bool)(x.FMapBoolBool), e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.FMapBoolBool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapboolbool((map[bool]bool)(x.FMapBoolBool), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FMapBoolBool\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FMapBoolBool`)
-				}
-				r.WriteMapElemValue()
-				if x.FMapBoolBool == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FMapBoolBool\"")
 					} else {
-						h.encMapboolbool((map[bool]bool)(x.FMapBoolBool), e)
+						r.EncodeSymbol(`FMapBoolBool`)
 					}
-				}
-			}
-			var yyn2302 bool
-			if x.FptrMapBoolBool == nil {
-				yyn2302 = true
-				goto LABEL2302
-			}
-		LABEL2302:
-			if yyr2 || yy2arr2 {
-				if yyn2302 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.FptrMapBoolBool == nil {
+					r.WriteMapElemValue()
+					if x.FMapBoolBool == nil {
 						r.EncodeNil()
 					} else {
-						yy2303 := *x.FptrMapBoolBool
 						if false {
 						} else {
-							h.encMapboolbool((map[bool]bool)(yy2303), e)
+							h.encMapboolbool((map[bool]bool)(x.FMapBoolBool), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"FptrMapBoolBool\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `FptrMapBoolBool`)
+				var yyn2302 bool
+				if x.FptrMapBoolBool == nil {
+					yyn2302 = true
+					goto LABEL2302
 				}
-				r.WriteMapElemValue()
-				if yyn2302 {
-					r.EncodeNil()
+			LABEL2302:
+				if yyr2 || yy2arr2 {
+					if yyn2302 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.FptrMapBoolBool == nil {
+							r.EncodeNil()
+						} else {
+							yy2303 := *x.FptrMapBoolBool
+							if false {
+							} else {
+								h.encMapboolbool((map[bool]bool)(yy2303), e)
+							}
+						}
+					}
 				} else {
-					if x.FptrMapBoolBool == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"FptrMapBoolBool\"")
+					} else {
+						r.EncodeSymbol(`FptrMapBoolBool`)
+					}
+					r.WriteMapElemValue()
+					if yyn2302 {
 						r.EncodeNil()
 					} else {
-						yy2305 := *x.FptrMapBoolBool
-						if false {
+						if x.FptrMapBoolBool == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapboolbool((map[bool]bool)(yy2305), e)
+							yy2305 := *x.FptrMapBoolBool
+							if false {
+							} else {
+								h.encMapboolbool((map[bool]bool)(yy2305), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
@@ -40106,164 +40110,168 @@ func (x *TestMammoth2Wrapper) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(8)
-			} else {
-				r.WriteMapStart(8)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy4 := &x.V
-				yy4.CodecEncodeSelf(e)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"V\"")
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(8)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `V`)
+					r.WriteMapStart(8)
 				}
-				r.WriteMapElemValue()
-				yy6 := &x.V
-				yy6.CodecEncodeSelf(e)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				x.T.CodecEncodeSelf(e)
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"T\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy4 := &x.V
+					yy4.CodecEncodeSelf(e)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `T`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"V\"")
+					} else {
+						r.EncodeSymbol(`V`)
+					}
+					r.WriteMapElemValue()
+					yy6 := &x.V
+					yy6.CodecEncodeSelf(e)
 				}
-				r.WriteMapElemValue()
-				x.T.CodecEncodeSelf(e)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				x.B.CodecEncodeSelf(e)
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"B\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					x.T.CodecEncodeSelf(e)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `B`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"T\"")
+					} else {
+						r.EncodeSymbol(`T`)
+					}
+					r.WriteMapElemValue()
+					x.T.CodecEncodeSelf(e)
 				}
-				r.WriteMapElemValue()
-				x.B.CodecEncodeSelf(e)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				x.J.CodecEncodeSelf(e)
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"J\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					x.B.CodecEncodeSelf(e)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `J`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
+					} else {
+						r.EncodeSymbol(`B`)
+					}
+					r.WriteMapElemValue()
+					x.B.CodecEncodeSelf(e)
 				}
-				r.WriteMapElemValue()
-				x.J.CodecEncodeSelf(e)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy18 := &x.C
-				yy18.CodecEncodeSelf(e)
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"C\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					x.J.CodecEncodeSelf(e)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `C`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"J\"")
+					} else {
+						r.EncodeSymbol(`J`)
+					}
+					r.WriteMapElemValue()
+					x.J.CodecEncodeSelf(e)
 				}
-				r.WriteMapElemValue()
-				yy20 := &x.C
-				yy20.CodecEncodeSelf(e)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.M == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy18 := &x.C
+					yy18.CodecEncodeSelf(e)
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"C\"")
 					} else {
-						h.encMaptestMammoth2BasicTestMammoth2((map[testMammoth2Basic]TestMammoth2)(x.M), e)
+						r.EncodeSymbol(`C`)
 					}
+					r.WriteMapElemValue()
+					yy20 := &x.C
+					yy20.CodecEncodeSelf(e)
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"M\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.M == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMaptestMammoth2BasicTestMammoth2((map[testMammoth2Basic]TestMammoth2)(x.M), e)
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `M`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"M\"")
+					} else {
+						r.EncodeSymbol(`M`)
+					}
+					r.WriteMapElemValue()
+					if x.M == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMaptestMammoth2BasicTestMammoth2((map[testMammoth2Basic]TestMammoth2)(x.M), e)
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if x.M == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.L == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceTestMammoth2(([]TestMammoth2)(x.L), e)
+						}
+					}
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"L\"")
+					} else {
+						r.EncodeSymbol(`L`)
+					}
+					r.WriteMapElemValue()
+					if x.L == nil {
+						r.EncodeNil()
 					} else {
-						h.encMaptestMammoth2BasicTestMammoth2((map[testMammoth2Basic]TestMammoth2)(x.M), e)
+						if false {
+						} else {
+							h.encSliceTestMammoth2(([]TestMammoth2)(x.L), e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.L == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy29 := &x.A
 					if false {
 					} else {
-						h.encSliceTestMammoth2(([]TestMammoth2)(x.L), e)
+						h.encArray4int64((*[4]int64)(yy29), e)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"L\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `L`)
-				}
-				r.WriteMapElemValue()
-				if x.L == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A\"")
+					} else {
+						r.EncodeSymbol(`A`)
+					}
+					r.WriteMapElemValue()
+					yy31 := &x.A
 					if false {
 					} else {
-						h.encSliceTestMammoth2(([]TestMammoth2)(x.L), e)
+						h.encArray4int64((*[4]int64)(yy31), e)
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy29 := &x.A
-				if false {
-				} else {
-					h.encArray4int64((*[4]int64)(yy29), e)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"A\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819781, `A`)
-				}
-				r.WriteMapElemValue()
-				yy31 := &x.A
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					h.encArray4int64((*[4]int64)(yy31), e)
+					r.WriteMapEnd()
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
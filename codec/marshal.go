@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import "bytes"
+
+// Marshal returns the encoding of v per the Handle h.
+//
+// It is a shorthand for NewEncoderBytes(&b, h).Encode(v), which draws
+// an Encoder from a pool kept on the Handle, instead of creating one per call.
+func Marshal[T any](h Handle, v T) ([]byte, error) {
+	return AppendMarshal(nil, h, v)
+}
+
+// AppendMarshal appends the encoding of v per the Handle h to dst,
+// and returns the extended slice.
+//
+// On error, the returned slice holds dst followed by whatever was
+// encoded before the error occurred.
+func AppendMarshal[T any](dst []byte, h Handle, v T) (b []byte, err error) {
+	e := pooledEncoder(h)
+	b = dst
+	e.ResetBytes(&b)
+	if dst != nil {
+		e.wb.b = dst // ResetBytes writes from the start of the slice
+	}
+	err = e.Encode(v)
+	releaseEncoder(h, e)
+	if dst == nil && len(b) < cap(b)/2 {
+		// the Encoder starts from a buffer of its default size, which a small
+		// encoding should not keep alive
+		b = bytes.Clone(b)
+	}
+	return
+}
+
+// Unmarshal decodes b per the Handle h into a new value of type T, and returns it.
+//
+// It is a shorthand for NewDecoderBytes(b, h).Decode(&v), which draws
+// a Decoder from a pool kept on the Handle, instead of creating one per call.
+//
+// As with Decode, only the first value in b is decoded, and the returned value
+// may reference b if ZeroCopy is set.
+func Unmarshal[T any](h Handle, b []byte) (v T, err error) {
+	err = UnmarshalInto(h, b, &v)
+	return
+}
+
+// UnmarshalInto decodes b per the Handle h into the value pointed to by v.
+//
+// Unlike Unmarshal, it decodes into an existing value e.g. to reuse
+// the slices and maps it holds, or to update only the fields in the stream.
+func UnmarshalInto[T any](h Handle, b []byte, v *T) (err error) {
+	d := pooledDecoder(h)
+	if b == nil {
+		b = zeroByteSlice // ResetBytes ignores a nil slice
+	}
+	d.ResetBytes(b)
+	err = d.Decode(v)
+	releaseDecoder(h, d)
+	return
+}
+
+// pooledEncoder returns an Encoder from the pool on h, or a new one if it is empty.
+func pooledEncoder(h Handle) *Encoder {
+	if e, _ := basicHandle(h).encs.Get().(*Encoder); e != nil {
+		return e
+	}
+	return newEncoder(h)
+}
+
+// releaseEncoder returns e to the pool on h, having released its pooled resources
+// (which an Encode does not do if ExplicitRelease is set), and dropped the
+// reference to the output.
+func releaseEncoder(h Handle, e *Encoder) {
+	e.Release()
+	e.wb.reset(nil, nil)
+	basicHandle(h).encs.Put(e)
+}
+
+// pooledDecoder returns a Decoder from the pool on h, or a new one if it is empty.
+func pooledDecoder(h Handle) *Decoder {
+	if d, _ := basicHandle(h).decs.Get().(*Decoder); d != nil {
+		return d
+	}
+	return newDecoder(h)
+}
+
+// releaseDecoder returns d to the pool on h, having released its pooled resources
+// (which a Decode does not do if ExplicitRelease is set), and dropped the
+// reference to the input.
+func releaseDecoder(h Handle, d *Decoder) {
+	d.Release()
+	d.rb.reset(nil)
+	clear(d.path)
+	basicHandle(h).decs.Put(d)
+}
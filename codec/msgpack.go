@@ -25,6 +25,7 @@ import (
 	"math"
 	"net/rpc"
 	"reflect"
+	"sync"
 	"time"
 )
 
@@ -82,6 +83,28 @@ const (
 var mpTimeExtTag int8 = -1
 var mpTimeExtTagU = uint8(mpTimeExtTag)
 
+// MsgpackSymbolDefineTag and MsgpackSymbolRefTag are the extension tags reserved for
+// symbols (see AsSymbols).
+//
+// The first occurrence of a symbol in a top-level value is written as an extension
+// with tag MsgpackSymbolDefineTag, whose data is the string. Symbols are implicitly
+// numbered from 0, in the order they are defined. Later occurrences are written as an
+// extension with tag MsgpackSymbolRefTag, whose data is that number as a 1 or 2 byte
+// big-endian integer.
+const (
+	MsgpackSymbolDefineTag = 126
+	MsgpackSymbolRefTag    = 127
+)
+
+const (
+	// msgpackSymbolMinLen is the minimum length of a string encoded as a symbol.
+	// A reference to a shorter one would not be any smaller than the string.
+	msgpackSymbolMinLen = 4
+
+	// msgpackSymbolsMax is the maximum number of symbols defined in a top-level value.
+	msgpackSymbolsMax = 1 << 16
+)
+
 // var mpdesc = map[byte]string{
 // 	mpPosFixNumMin: "PosFixNumMin",
 // 	mpPosFixNumMax: "PosFixNumMax",
@@ -167,7 +190,8 @@ func mpdesc(bd byte) string {
 // in sequence in the slice.
 //
 // The Codec then passes it AS-IS to the rpc service (without wrapping it in an
-// array of 1 element).
+// array of 1 element). A Go service served with MsgpackSpecRpcOptions.MultiArgs
+// receives them as the fields of its argument struct.
 type MsgpackSpecRpcMultiArgs []interface{}
 
 // A MsgpackContainer type specifies the different types of msgpackContainers.
@@ -206,6 +230,8 @@ type msgpackEncDriver struct {
 	h *MsgpackHandle
 	x [8]byte
 	// _ [3]uint64 // padding
+
+	syms map[string]int // symbols defined in the current top-level value, to their id
 }
 
 func (e *msgpackEncDriver) EncodeNil() {
@@ -403,6 +429,37 @@ func (e *msgpackEncDriver) EncodeStringEnc(c charEncoding, s string) {
 	}
 }
 
+func (e *msgpackEncDriver) EncodeSymbol(flag AsSymbolFlag, s string) {
+	if e.h.AsSymbols&flag == 0 || !e.h.WriteExt || len(s) < msgpackSymbolMinLen {
+		e.EncodeStringEnc(cUTF8, s)
+		return
+	}
+	if id, ok := e.syms[s]; ok {
+		if id < 256 {
+			e.w.writen2(mpFixExt1, MsgpackSymbolRefTag)
+			e.w.writen1(uint8(id))
+		} else {
+			e.w.writen2(mpFixExt2, MsgpackSymbolRefTag)
+			bigenHelper{e.x[:2], e.w}.writeUint16(uint16(id))
+		}
+		return
+	}
+	if len(e.syms) == msgpackSymbolsMax {
+		e.EncodeStringEnc(cUTF8, s)
+		return
+	}
+	if e.syms == nil {
+		e.syms = make(map[string]int)
+	}
+	e.syms[s] = len(e.syms)
+	e.encodeExtPreamble(MsgpackSymbolDefineTag, len(s))
+	e.w.writestr(s)
+}
+
+func (e *msgpackEncDriver) atEndOfEncode() {
+	clear(e.syms)
+}
+
 func (e *msgpackEncDriver) EncodeStringBytes(c charEncoding, bs []byte) {
 	if bs == nil {
 		e.EncodeNil()
@@ -464,6 +521,9 @@ type msgpackDecDriver struct {
 	// decNoSeparator
 	decDriverNoopContainerReader
 	// _ [3]uint64 // padding
+
+	syms  [][]byte // symbols defined in the current top-level value, by id
+	nsyms uint     // number of symbol definitions and references read
 }
 
 // Note: This returns either a primitive (int, bool, etc) for non-containers,
@@ -556,6 +616,10 @@ func (d *msgpackDecDriver) DecodeNaked() {
 			if n.u == uint64(mpTimeExtTagU) {
 				n.v = valueTypeTime
 				n.t = d.decodeTime(clen)
+			} else if (n.u == MsgpackSymbolDefineTag || n.u == MsgpackSymbolRefTag) && d.h.AsSymbols != AsSymbolNone &&
+				d.h.getExtForTag(n.u) == nil {
+				n.v = valueTypeString
+				n.s = string(d.decodeSymbol(clen, uint8(n.u)))
 			} else if d.br {
 				n.l = d.r.readx(uint(clen))
 			} else {
@@ -603,7 +667,7 @@ func (d *msgpackDecDriver) DecodeInt64() (i int64) {
 		case d.bd >= mpNegFixNumMin && d.bd <= mpNegFixNumMax:
 			i = int64(int8(d.bd))
 		default:
-			d.d.errorf("cannot decode signed integer: %s: %x/%s", msgBadDesc, d.bd, mpdesc(d.bd))
+			d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot decode signed integer: %s: %x/%s", msgBadDesc, d.bd, mpdesc(d.bd))
 			return
 		}
 	}
@@ -629,28 +693,28 @@ func (d *msgpackDecDriver) DecodeUint64() (ui uint64) {
 		if i := int64(int8(d.r.readn1())); i >= 0 {
 			ui = uint64(i)
 		} else {
-			d.d.errorf("assigning negative signed value: %v, to unsigned type", i)
+			d.d.errorIs(ErrOverflow, valueTypeInt, "assigning negative signed value: %v, to unsigned type", i)
 			return
 		}
 	case mpInt16:
 		if i := int64(int16(bigen.Uint16(d.r.readx(2)))); i >= 0 {
 			ui = uint64(i)
 		} else {
-			d.d.errorf("assigning negative signed value: %v, to unsigned type", i)
+			d.d.errorIs(ErrOverflow, valueTypeInt, "assigning negative signed value: %v, to unsigned type", i)
 			return
 		}
 	case mpInt32:
 		if i := int64(int32(bigen.Uint32(d.r.readx(4)))); i >= 0 {
 			ui = uint64(i)
 		} else {
-			d.d.errorf("assigning negative signed value: %v, to unsigned type", i)
+			d.d.errorIs(ErrOverflow, valueTypeInt, "assigning negative signed value: %v, to unsigned type", i)
 			return
 		}
 	case mpInt64:
 		if i := int64(bigen.Uint64(d.r.readx(8))); i >= 0 {
 			ui = uint64(i)
 		} else {
-			d.d.errorf("assigning negative signed value: %v, to unsigned type", i)
+			d.d.errorIs(ErrOverflow, valueTypeInt, "assigning negative signed value: %v, to unsigned type", i)
 			return
 		}
 	default:
@@ -658,10 +722,10 @@ func (d *msgpackDecDriver) DecodeUint64() (ui uint64) {
 		case d.bd >= mpPosFixNumMin && d.bd <= mpPosFixNumMax:
 			ui = uint64(d.bd)
 		case d.bd >= mpNegFixNumMin && d.bd <= mpNegFixNumMax:
-			d.d.errorf("assigning negative signed value: %v, to unsigned type", int(d.bd))
+			d.d.errorIs(ErrOverflow, valueTypeInt, "assigning negative signed value: %v, to unsigned type", int(d.bd))
 			return
 		default:
-			d.d.errorf("cannot decode unsigned integer: %s: %x/%s", msgBadDesc, d.bd, mpdesc(d.bd))
+			d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot decode unsigned integer: %s: %x/%s", msgBadDesc, d.bd, mpdesc(d.bd))
 			return
 		}
 	}
@@ -695,7 +759,7 @@ func (d *msgpackDecDriver) DecodeBool() (b bool) {
 	} else if d.bd == mpTrue || d.bd == 1 {
 		b = true
 	} else {
-		d.d.errorf("cannot decode bool: %s: %x/%s", msgBadDesc, d.bd, mpdesc(d.bd))
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot decode bool: %s: %x/%s", msgBadDesc, d.bd, mpdesc(d.bd))
 		return
 	}
 	d.bdRead = false
@@ -723,12 +787,22 @@ func (d *msgpackDecDriver) DecodeBytes(bs []byte, zerocopy bool) (bsOut []byte)
 		}
 		bsOut, _ = fastpathTV.DecSliceUint8V(bs, true, d.d)
 		return
+	} else if d.h.AsSymbols != AsSymbolNone && ((bd >= mpFixExt1 && bd <= mpFixExt16) || (bd >= mpExt8 && bd <= mpExt32)) {
+		clen = d.readExtLen()
+		bsOut = d.decodeSymbol(clen, d.r.readn1())
+		if !zerocopy {
+			bsOut = append(bs[:0], bsOut...)
+		}
+		return
 	} else {
-		d.d.errorf("invalid byte descriptor for decoding bytes, got: 0x%x", d.bd)
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "invalid byte descriptor for decoding bytes, got: 0x%x", d.bd)
 		return
 	}
 
 	d.bdRead = false
+	if d.d.limits {
+		d.d.limitBytesLen(clen)
+	}
 	if zerocopy {
 		if d.br {
 			return d.r.readx(uint(clen))
@@ -747,6 +821,105 @@ func (d *msgpackDecDriver) DecodeStringAsBytes() (s []byte) {
 	return d.DecodeBytes(d.d.b[:], true)
 }
 
+// decodeSymbol reads the data of a symbol definition or reference (see AsSymbols),
+// given its length and tag, and returns the string for the symbol.
+// The returned bytes are owned by the symbol table, and must not be modified.
+func (d *msgpackDecDriver) decodeSymbol(clen int, xtag byte) (bs []byte) {
+	d.nsyms++
+	switch xtag {
+	case MsgpackSymbolDefineTag:
+		if len(d.syms) == msgpackSymbolsMax {
+			d.d.errorf("cannot define symbol: more than %d symbols in value", msgpackSymbolsMax)
+			return
+		}
+		if d.br {
+			bs = d.r.readx(uint(clen))
+		} else {
+			bs = decByteSlice(d.r, clen, d.d.h.MaxInitLen, nil)
+		}
+		d.syms = append(d.syms, bs)
+	case MsgpackSymbolRefTag:
+		var id int
+		switch clen {
+		case 1:
+			id = int(d.r.readn1())
+		case 2:
+			id = int(bigen.Uint16(d.r.readx(2)))
+		default:
+			d.d.errorf("invalid symbol reference: expecting 1 or 2 bytes, got %d", clen)
+			return
+		}
+		if id >= len(d.syms) {
+			d.d.errorf("invalid symbol reference: symbol %d is not defined", id)
+			return
+		}
+		bs = d.syms[id]
+	default:
+		d.d.errorIs(ErrTypeMismatch, valueTypeExt, "cannot decode string: found extension with tag %d", xtag)
+		return
+	}
+	d.bdRead = false
+	return
+}
+
+// resetSymbols forgets all symbols, at the start of a top-level value.
+func (d *msgpackDecDriver) resetSymbols() {
+	clear(d.syms)
+	d.syms = d.syms[:0]
+}
+
+func (d *msgpackDecDriver) numSymbolsRead() uint {
+	return d.nsyms
+}
+
+// appendSymbolsExpanded appends the values in b, which have already been read
+// (so are well-formed and their symbols defined), to dst, writing each symbol as a str.
+func (d *msgpackDecDriver) appendSymbolsExpanded(dst, b []byte) []byte {
+	// each item is a header followed by its contents, except containers,
+	// whose items follow in turn. So the items can be walked in order.
+	for i := 0; i < len(b); {
+		bd := b[i]
+		_, hl, n := msgpackDumpFormat(bd)
+		h := b[i+1 : i+1+hl]
+		start := i
+		i += 1 + hl
+		if desc := mpdesc(bd); desc == "map" || desc == "array" || n == 0 {
+			dst = append(dst, b[start:i]...)
+			continue
+		}
+		ln, tag := msgpackDumpLen(bd, h, n)
+		n = int(ln) // b is well-formed, so ln is within it
+		i += n
+		isExt := (bd >= mpFixExt1 && bd <= mpFixExt16) || (bd >= mpExt8 && bd <= mpExt32)
+		if !isExt || (tag != MsgpackSymbolDefineTag && tag != MsgpackSymbolRefTag) {
+			dst = append(dst, b[start:i]...)
+			continue
+		}
+		s := b[i-n : i]
+		if tag == MsgpackSymbolRefTag {
+			var id uint16
+			if n == 1 {
+				id = uint16(s[0])
+			} else {
+				id = bigen.Uint16(s)
+			}
+			s = d.syms[id]
+		}
+		switch l := len(s); {
+		case l < 32:
+			dst = append(dst, mpFixStrMin|byte(l))
+		case l < 256:
+			dst = append(dst, mpStr8, byte(l))
+		case l < 65536:
+			dst = append(dst, mpStr16, byte(l>>8), byte(l))
+		default:
+			dst = append(dst, mpStr32, byte(l>>24), byte(l>>16), byte(l>>8), byte(l))
+		}
+		dst = append(dst, s...)
+	}
+	return dst
+}
+
 func (d *msgpackDecDriver) readNextBd() {
 	d.bd = d.r.readn1()
 	d.bdRead = true
@@ -795,6 +968,29 @@ func (d *msgpackDecDriver) ContainerType() (vt valueType) {
 	return valueTypeUnset
 }
 
+func (d *msgpackDecDriver) nextValueType() (vt valueType) {
+	if !d.bdRead {
+		d.readNextBd()
+	}
+	switch bd := d.bd; {
+	case bd == mpFalse, bd == mpTrue:
+		return valueTypeBool
+	case bd == mpFloat, bd == mpDouble:
+		return valueTypeFloat
+	case bd >= mpUint8 && bd <= mpUint64:
+		if d.h.SignedInteger {
+			return valueTypeInt
+		}
+		return valueTypeUint
+	case bd >= mpInt8 && bd <= mpInt64, bd <= mpPosFixNumMax, bd >= mpNegFixNumMin:
+		return valueTypeInt
+	case bd >= mpExt8 && bd <= mpExt32, bd >= mpFixExt1 && bd <= mpFixExt16:
+		// the timestamp extension is only distinguishable once its tag is read
+		return valueTypeExt
+	}
+	return d.ContainerType()
+}
+
 func (d *msgpackDecDriver) TryDecodeAsNil() (v bool) {
 	if !d.bdRead {
 		d.readNextBd()
@@ -819,25 +1015,33 @@ func (d *msgpackDecDriver) readContainerLen(ct msgpackContainerType) (clen int)
 	} else if (ct.bFixMin & bd) == ct.bFixMin {
 		clen = int(ct.bFixMin ^ bd)
 	} else {
-		d.d.errorf("cannot read container length: %s: hex: %x, decimal: %d", msgBadDesc, bd, bd)
+		d.d.errorIs(ErrTypeMismatch, d.nextValueType(), "cannot read container length: %s: hex: %x, decimal: %d", msgBadDesc, bd, bd)
 		return
 	}
 	d.bdRead = false
 	return
 }
 
-func (d *msgpackDecDriver) ReadMapStart() int {
+func (d *msgpackDecDriver) ReadMapStart() (length int) {
 	if !d.bdRead {
 		d.readNextBd()
 	}
-	return d.readContainerLen(msgpackContainerMap)
+	length = d.readContainerLen(msgpackContainerMap)
+	if d.d.limits {
+		d.d.limitContainerLen(length, true)
+	}
+	return
 }
 
-func (d *msgpackDecDriver) ReadArrayStart() int {
+func (d *msgpackDecDriver) ReadArrayStart() (length int) {
 	if !d.bdRead {
 		d.readNextBd()
 	}
-	return d.readContainerLen(msgpackContainerList)
+	length = d.readContainerLen(msgpackContainerList)
+	if d.d.limits {
+		d.d.limitContainerLen(length, false)
+	}
+	return
 }
 
 func (d *msgpackDecDriver) readExtLen() (clen int) {
@@ -864,6 +1068,9 @@ func (d *msgpackDecDriver) readExtLen() (clen int) {
 		d.d.errorf("decoding ext bytes: found unexpected byte: %x", d.bd)
 		return
 	}
+	if d.d.limits {
+		d.d.limitBytesLen(clen)
+	}
 	return
 }
 
@@ -977,6 +1184,11 @@ func (d *msgpackDecDriver) decodeExtV(verifyTag bool, tag byte) (xtag byte, xbs
 //--------------------------------------------------
 
 // MsgpackHandle is a Handle for the Msgpack Schema-Free Encoding Format.
+//
+// If AsSymbols and WriteExt are set, strings are encoded as symbols using the extension
+// tags MsgpackSymbolDefineTag and MsgpackSymbolRefTag, so no extension should be
+// registered with those tags. Symbols are only decoded if AsSymbols is set (to any flags):
+// otherwise, those tags are read as any other extension.
 type MsgpackHandle struct {
 	BasicHandle
 
@@ -1024,46 +1236,133 @@ func (h *MsgpackHandle) newDecDriver(d *Decoder) decDriver {
 
 func (e *msgpackEncDriver) reset() {
 	e.w = e.e.w
+	clear(e.syms)
 }
 
 func (d *msgpackDecDriver) reset() {
 	d.r, d.br = d.d.r, d.d.bytes
 	d.bd, d.bdRead = 0, false
+	d.resetSymbols()
 }
 
 //--------------------------------------------------
 
 type msgpackSpecRpcCodec struct {
 	rpcCodec
+	o MsgpackSpecRpcOptions
+
+	wmu sync.Mutex // for writes, as notifications may be sent at any time
+
+	notifySeq uint64 // the Seq of the last notification read by a server
+
+	pd *Decoder // for the params of a request if MultiArgs, or the error of a response
+
+	rpcServerErrors // errors read which were not strings
+}
+
+// msgpackSpecRpcNotifySeq is the first Seq given to a notification read by a server,
+// outside the range of the uint32 msgid of a request, so its response is not written.
+const msgpackSpecRpcNotifySeq = 1 << 32
+
+// MsgpackSpecRpcNotifier is implemented by the rpc.ServerCodec and rpc.ClientCodec
+// returned by MsgpackSpecRpc, for sending notifications.
+type MsgpackSpecRpcNotifier interface {
+	// Notify sends a notification for the method to the peer, which sends no response.
+	//
+	// As with a request, params is sent as an array of 1 element,
+	// unless it is a MsgpackSpecRpcMultiArgs.
+	Notify(method string, params interface{}) error
+}
+
+// MsgpackSpecRpcErrorReply is implemented by the reply of a method served by MsgpackSpecRpc,
+// to send a value other than a string (e.g. a struct with an error code) in the error slot
+// of the response.
+//
+// If MsgpackSpecRpcError returns a non-nil value, it is sent in place of the reply. The method
+// should return a nil error, as net/rpc only passes the message of a returned error to the codec.
+//
+// The value is encoded with the Handle as any other (e.g. as a map or registered extension).
+// If it is an error, it is encoded as an error, so it is written with its name
+// if its type is registered via RegisterType for the error interface.
+type MsgpackSpecRpcErrorReply interface {
+	MsgpackSpecRpcError() interface{}
+}
+
+// MsgpackSpecRpcServerErrors is implemented by the rpc.ClientCodec returned by MsgpackSpecRpc,
+// for getting the value sent in the error slot of a response, where it is not a string.
+type MsgpackSpecRpcServerErrors interface {
+	// Call calls the named method as client.Call does, where client uses this codec.
+	// If the call fails with an error received from the server which is not a string,
+	// that error is returned, instead of an rpc.ServerError holding its message.
+	//
+	// The value received is decoded into:
+	//   - an error of a type registered via RegisterType for the error interface, if any, or
+	//   - the value of a registered extension, if it is an error, or
+	//   - else a *MsgpackSpecRpcError.
+	//
+	// The error can then be examined via errors.As.
+	Call(client *rpc.Client, serviceMethod string, args, reply interface{}) error
+}
+
+// MsgpackSpecRpcError holds a value received in the error slot of a msgpack-rpc response
+// which is neither a string nor decoded as an error (see MsgpackSpecRpcServerErrors).
+type MsgpackSpecRpcError struct {
+	Value interface{}
+}
+
+func (e *MsgpackSpecRpcError) Error() string {
+	return fmt.Sprintf("msgpack-rpc error: %v", e.Value)
 }
 
 // /////////////// Spec RPC Codec ///////////////////
 func (c *msgpackSpecRpcCodec) WriteRequest(r *rpc.Request, body interface{}) error {
-	// WriteRequest can write to both a Go service, and other services that do
-	// not abide by the 1 argument rule of a Go service.
-	// We discriminate based on if the body is a MsgpackSpecRpcMultiArgs
-	var bodyArr []interface{}
-	if m, ok := body.(MsgpackSpecRpcMultiArgs); ok {
-		bodyArr = ([]interface{})(m)
-	} else {
-		bodyArr = []interface{}{body}
-	}
-	r2 := []interface{}{0, uint32(r.Seq), r.ServiceMethod, bodyArr}
-	return c.write(r2, nil, false)
+	r2 := []interface{}{0, uint32(r.Seq), r.ServiceMethod, msgpackSpecRpcParams(c.requestBody(r.Seq, body))}
+	return c.writeMsg(r2)
 }
 
 func (c *msgpackSpecRpcCodec) WriteResponse(r *rpc.Response, body interface{}) error {
+	if r.Seq >= msgpackSpecRpcNotifySeq {
+		// a notification has no response, even if it failed
+		return nil
+	}
 	var moe interface{}
 	if r.Error != "" {
 		moe = r.Error
+	} else if x, ok := body.(MsgpackSpecRpcErrorReply); ok {
+		moe = x.MsgpackSpecRpcError()
+		if e, ok := moe.(error); ok {
+			moe = &e
+		}
 	}
 	if moe != nil && body != nil {
 		body = nil
 	}
 	r2 := []interface{}{1, uint32(r.Seq), moe, body}
+	return c.writeMsg(r2)
+}
+
+func (c *msgpackSpecRpcCodec) Notify(method string, params interface{}) error {
+	r2 := []interface{}{2, method, msgpackSpecRpcParams(params)}
+	return c.writeMsg(r2)
+}
+
+func (c *msgpackSpecRpcCodec) writeMsg(r2 []interface{}) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
 	return c.write(r2, nil, false)
 }
 
+// msgpackSpecRpcParams returns the params array for a request or notification.
+func msgpackSpecRpcParams(body interface{}) []interface{} {
+	// A request can be written to both a Go service, and other services that do
+	// not abide by the 1 argument rule of a Go service.
+	// We discriminate based on if the body is a MsgpackSpecRpcMultiArgs
+	if m, ok := body.(MsgpackSpecRpcMultiArgs); ok {
+		return ([]interface{})(m)
+	}
+	return []interface{}{body}
+}
+
 func (c *msgpackSpecRpcCodec) ReadResponseHeader(r *rpc.Response) error {
 	return c.parseCustomHeader(1, &r.Seq, &r.Error)
 }
@@ -1076,53 +1375,177 @@ func (c *msgpackSpecRpcCodec) ReadRequestBody(body interface{}) error {
 	if body == nil { // read and discard
 		return c.read(nil)
 	}
+	if c.o.MultiArgs {
+		return c.readMultiArgs(body)
+	}
 	bodyArr := []interface{}{body}
 	return c.read(&bodyArr)
 }
 
-func (c *msgpackSpecRpcCodec) parseCustomHeader(expectTypeByte byte, msgid *uint64, methodOrError *string) (err error) {
-	if cls := c.cls.load(); cls.closed {
-		return io.EOF
+// readMultiArgs reads the params array of a request into body, a pointer to the argument,
+// by position if a struct. The params are read in full first, so that the stream
+// is not left part way through them if they do not match the argument.
+func (c *msgpackSpecRpcCodec) readMultiArgs(body interface{}) (err error) {
+	var params Raw
+	if err = c.read(&params); err != nil {
+		return
+	}
+	if params == nil {
+		return fmt.Errorf("rpc: params must be an array, not %v", TokenNil)
+	}
+	if c.pd == nil {
+		c.pd = NewDecoderBytes(nil, c.h)
+	}
+	c.pd.ResetBytes(params)
+	t, err := c.pd.Token()
+	if err != nil {
+		return
+	}
+	if t.Kind != TokenArrayStart {
+		return fmt.Errorf("rpc: params must be an array, not %v", t.Kind)
 	}
+	rt := reflect.TypeOf(body).Elem()
+	byField := rt.Kind() == reflect.Struct && rt != timeTyp
+	want := 1
+	if byField {
+		want = len(basicHandle(c.h).getTypeInfo(rt2id(rt), rt).sfiSrc)
+	}
+	if t.Len != want {
+		return fmt.Errorf("rpc: wrong number of params for %v: expecting %d, got %d", rt, want, t.Len)
+	}
+	c.pd.ResetBytes(params)
+	if byField {
+		return c.pd.Decode(body)
+	}
+	bodyArr := []interface{}{body}
+	return c.pd.Decode(&bodyArr)
+}
 
+// parseCustomHeader reads the header of the next request or response.
+//
+// A notification read by a server is read as a request (whose response is not written),
+// and one read by a client is passed to OnNotify before reading on.
+func (c *msgpackSpecRpcCodec) parseCustomHeader(expectTypeByte byte, msgid *uint64, methodOrError *string) (err error) {
 	// We read the response header by hand
 	// so that the body can be decoded on its own from the stream at a later time.
 
 	const fia byte = 0x94 //four item array descriptor value
+	const tia byte = 0x93 //three item array descriptor value, of a notification
 	// Not sure why the panic of EOF is swallowed above.
 	// if bs1 := c.dec.r.readn1(); bs1 != fia {
 	// 	err = fmt.Errorf("Unexpected value for array descriptor: Expecting %v. Received %v", fia, bs1)
 	// 	return
 	// }
-	var ba [1]byte
-	var n int
 	for {
-		n, err = c.r.Read(ba[:])
-		if err != nil {
-			return
+		if cls := c.cls.load(); cls.closed {
+			return io.EOF
 		}
-		if n == 1 {
-			break
+		var ba [1]byte
+		var n int
+		for {
+			n, err = c.r.Read(ba[:])
+			if err != nil {
+				return
+			}
+			if n == 1 {
+				break
+			}
 		}
-	}
 
-	var b = ba[0]
-	if b != fia {
-		err = fmt.Errorf("not array - %s %x/%s", msgBadDesc, b, mpdesc(b))
-	} else {
-		err = c.read(&b)
-		if err == nil {
-			if b != expectTypeByte {
-				err = fmt.Errorf("%s - expecting %v but got %x/%s",
-					msgBadDesc, expectTypeByte, b, mpdesc(b))
-			} else {
-				err = c.read(msgid)
-				if err == nil {
-					err = c.read(methodOrError)
+		var b = ba[0]
+		if b == tia {
+			var method string
+			if err = c.read(&b); err == nil && b != 2 {
+				err = fmt.Errorf("%s - expecting 2 but got %x/%s", msgBadDesc, b, mpdesc(b))
+			}
+			if err == nil {
+				err = c.read(&method)
+			}
+			if err != nil {
+				return
+			}
+			if expectTypeByte == 0 {
+				c.notifySeq++
+				*msgid = msgpackSpecRpcNotifySeq + c.notifySeq
+				*methodOrError = method
+				return
+			}
+			var params Raw
+			if err = c.read(&params); err != nil {
+				return
+			}
+			if c.o.OnNotify != nil {
+				c.o.OnNotify(method, params)
+			}
+			continue
+		}
+		if b != fia {
+			err = fmt.Errorf("not array - %s %x/%s", msgBadDesc, b, mpdesc(b))
+		} else {
+			err = c.read(&b)
+			if err == nil {
+				if b != expectTypeByte {
+					err = fmt.Errorf("%s - expecting %v but got %x/%s",
+						msgBadDesc, expectTypeByte, b, mpdesc(b))
+				} else {
+					err = c.read(msgid)
+					if err == nil && expectTypeByte == 1 {
+						err = c.readError(*msgid, methodOrError)
+					} else if err == nil {
+						err = c.read(methodOrError)
+					}
 				}
 			}
 		}
+		return
+	}
+}
+
+// readError reads the error of the response with the given msgid into msg.
+// If it is not a string, it is decoded as for MsgpackSpecRpcServerErrors, and msg is its message.
+func (c *msgpackSpecRpcCodec) readError(msgid uint64, msg *string) (err error) {
+	var raw Raw
+	if err = c.read(&raw); err != nil || raw == nil { // raw is nil if the error is nil
+		*msg = ""
+		return
+	}
+	if c.pd == nil {
+		c.pd = NewDecoderBytes(nil, c.h)
+	}
+	var v interface{}
+	c.pd.ResetBytes(raw)
+	if err = c.pd.Decode(&v); err != nil {
+		return
 	}
+	switch x := v.(type) {
+	case nil:
+		*msg = ""
+		return
+	case string:
+		*msg = x
+		return
+	case []byte:
+		*msg = string(x)
+		return
+	}
+	var e error
+	if basicHandle(c.h).polyType(rt2id(errorTyp)) != nil {
+		c.pd.ResetBytes(raw)
+		if c.pd.Decode(&e) != nil {
+			e = nil // not a registered error, so use the value as is
+		}
+	}
+	if e == nil {
+		var ok bool
+		if e, ok = v.(error); !ok {
+			e = &MsgpackSpecRpcError{Value: v}
+		}
+	}
+	*msg = e.Error()
+	if *msg == "" {
+		*msg = "msgpack-rpc error"
+	}
+	c.addServerError(msgid, e)
 	return
 }
 
@@ -1135,15 +1558,47 @@ type msgpackSpecRpc struct{}
 // MsgpackSpecRpc implements Rpc using the communication protocol defined in
 // the msgpack spec at https://github.com/msgpack-rpc/msgpack-rpc/blob/master/spec.md .
 //
+// Its codecs implement MsgpackSpecRpcNotifier, for sending notifications.
+// A notification received by the server is dispatched as a request,
+// but no response is sent. A notification received by the client is discarded
+// (see MsgpackSpecRpcOptions for handling it).
+//
 // See GoRpc documentation, for information on buffering for better performance.
 var MsgpackSpecRpc msgpackSpecRpc
 
 func (x msgpackSpecRpc) ServerCodec(conn io.ReadWriteCloser, h Handle) rpc.ServerCodec {
-	return &msgpackSpecRpcCodec{newRPCCodec(conn, h)}
+	return MsgpackSpecRpcOptions{}.ServerCodec(conn, h)
 }
 
 func (x msgpackSpecRpc) ClientCodec(conn io.ReadWriteCloser, h Handle) rpc.ClientCodec {
-	return &msgpackSpecRpcCodec{newRPCCodec(conn, h)}
+	return MsgpackSpecRpcOptions{}.ClientCodec(conn, h)
+}
+
+// MsgpackSpecRpcOptions implements Rpc as MsgpackSpecRpc, with options.
+type MsgpackSpecRpcOptions struct {
+	// OnNotify is called by the client codec with each notification it receives
+	// from the server, with its params array as encoded. If nil, they are discarded.
+	//
+	// It is called from the goroutine reading responses, so must not block on a call.
+	OnNotify func(method string, params Raw)
+
+	// MultiArgs makes the server decode the params array of a request into the argument
+	// of the method by position, for clients which pass many params (e.g. not written in Go).
+	//
+	// If the argument is a struct, each param is decoded into a field, in the order of
+	// the fields (as when the struct is encoded with toarray). Else the argument is the one param.
+	// A request with the wrong number of params gets an error response.
+	//
+	// Without it, the params array must hold the argument as its one element.
+	MultiArgs bool
+}
+
+func (x MsgpackSpecRpcOptions) ServerCodec(conn io.ReadWriteCloser, h Handle) rpc.ServerCodec {
+	return &msgpackSpecRpcCodec{rpcCodec: newRPCCodec(conn, h), o: x}
+}
+
+func (x MsgpackSpecRpcOptions) ClientCodec(conn io.ReadWriteCloser, h Handle) rpc.ClientCodec {
+	return &msgpackSpecRpcCodec{rpcCodec: newRPCCodec(conn, h), o: x}
 }
 
 var _ decDriver = (*msgpackDecDriver)(nil)
@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Lookup finds the value at the given path within the next value in the stream,
+// and returns its encoded bytes. If there is no value at the path, it returns nil.
+//
+// A path is a sequence of map keys and array indices e.g. `Spec.Tasks[3].Name`.
+// A key is written as is, and separated from a previous segment by a '.'.
+// An index is written within '[' and ']', and also matches an integer map key.
+//
+// Only the values along the path are examined; all others are skipped
+// without being decoded. When decoding from a []byte, the returned bytes
+// are a view into it, and no allocation is made.
+//
+// Lookup leaves the stream positioned just after the value found,
+// so the Decoder must be reset (e.g. via ResetBytes) before it is used again.
+func (d *Decoder) Lookup(path string) (bs []byte, err error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	if recoverPanicToErr {
+		defer func() {
+			if x := recover(); x != nil {
+				panicValToErr(d, x, &d.err)
+				bs, err = nil, d.err
+			}
+		}()
+	}
+	if d.lookup(path) {
+		bs = d.nextValueBytes()
+	}
+	return
+}
+
+// LookupDecode is like Lookup, but decodes the value at the given path into v.
+//
+// It returns false if there is no value at the path, in which case v is not modified.
+func (d *Decoder) LookupDecode(path string, v interface{}) (found bool, err error) {
+	if d.err != nil {
+		return false, d.err
+	}
+	if recoverPanicToErr {
+		defer func() {
+			if x := recover(); x != nil {
+				panicValToErr(d, x, &d.err)
+				found, err = false, d.err
+			}
+		}()
+	}
+	if found = d.lookup(path); found {
+		d.mustDecode(v)
+	}
+	return
+}
+
+// lookup positions the stream at the value at the given path,
+// returning false if there is no such value.
+func (d *Decoder) lookup(path string) (found bool) {
+	d.resetSymbols()
+	var seg string
+	var index int
+	var isIndex bool
+	for i := 0; path != ""; i++ {
+		seg, index, isIndex, path = d.lookupPathNext(path, i == 0)
+		switch d.d.ContainerType() {
+		case valueTypeMap:
+			found = d.lookupMapKey(seg, index, isIndex)
+		case valueTypeArray:
+			found = isIndex && d.lookupArrayIndex(index)
+		default:
+			found = false
+		}
+		if !found {
+			return
+		}
+	}
+	return true
+}
+
+// lookupPathNext splits off the first segment of path,
+// which is a key, or an index if isIndex.
+func (d *Decoder) lookupPathNext(path string, first bool) (seg string, index int, isIndex bool, rest string) {
+	var err error
+	switch {
+	case path[0] == '[':
+		i := strings.IndexByte(path, ']')
+		if i < 0 {
+			d.errorf("lookup: invalid path: missing ']' in %q", path)
+		}
+		seg, rest, isIndex = path[1:i], path[i+1:], true
+		if index, err = strconv.Atoi(seg); err != nil || index < 0 {
+			d.errorf("lookup: invalid path: bad index %q", seg)
+		}
+		return
+	case path[0] == '.' && !first:
+		path = path[1:]
+	case first:
+	default:
+		d.errorf("lookup: invalid path: expected '.' or '[' at %q", path)
+	}
+	i := strings.IndexAny(path, ".[")
+	if i < 0 {
+		i = len(path)
+	}
+	if i == 0 {
+		d.errorf("lookup: invalid path: empty key at %q", path)
+	}
+	seg, rest = path[:i], path[i:]
+	return
+}
+
+// lookupMapKey positions the stream at the value for the given key of the
+// current map, returning false (having skipped the entire map) if not found.
+func (d *Decoder) lookupMapKey(key string, index int, isIndex bool) (found bool) {
+	dd := d.d
+	containerLen := dd.ReadMapStart()
+	hasLen := containerLen >= 0
+	for j := 0; (hasLen && j < containerLen) || !(hasLen || dd.CheckBreak()); j++ {
+		if d.esep {
+			dd.ReadMapElemKey()
+		}
+		switch dd.nextValueType() {
+		case valueTypeString, valueTypeBytes:
+			found = string(dd.DecodeStringAsBytes()) == key
+		case valueTypeMap, valueTypeArray:
+			d.swallow()
+		default:
+			n := d.naked()
+			dd.DecodeNaked()
+			switch n.v {
+			case valueTypeInt:
+				found = isIndex && n.i == int64(index)
+			case valueTypeUint:
+				found = isIndex && n.u == uint64(index)
+			case valueTypeString:
+				found = n.s == key // e.g. a msgpack symbol
+			case valueTypeExt:
+				if n.l == nil {
+					d.swallow() // the value follows in-band
+				}
+			}
+		}
+		if d.esep {
+			dd.ReadMapElemValue()
+		}
+		if found {
+			return
+		}
+		d.swallow()
+	}
+	dd.ReadMapEnd()
+	return
+}
+
+// lookupArrayIndex positions the stream at the given element of the current array,
+// returning false (having skipped the entire array) if not found.
+func (d *Decoder) lookupArrayIndex(index int) (found bool) {
+	dd := d.d
+	containerLen := dd.ReadArrayStart()
+	hasLen := containerLen >= 0
+	for j := 0; (hasLen && j < containerLen) || !(hasLen || dd.CheckBreak()); j++ {
+		if d.esep {
+			dd.ReadArrayElem()
+		}
+		if j == index {
+			return true
+		}
+		d.swallow()
+	}
+	dd.ReadArrayEnd()
+	return
+}
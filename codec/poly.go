@@ -0,0 +1,264 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// polyType is an interface registered via RegisterType, with its implementations.
+type polyType struct {
+	rt     reflect.Type            // the interface
+	byName map[string]reflect.Type // implementations, by name
+	byImpl map[uintptr]string      // names, by rtid of the implementation
+}
+
+type polyTypes []*polyType
+
+// RegisterType registers impl as an implementation of the interface intf,
+// identified in the stream by name.
+//
+// A value of type intf (e.g. a struct field, slice element or map value, or a *intf
+// passed to Encode) is then encoded with a discriminator giving the name of the
+// type it holds, and decoded into a new value of the registered type with that name.
+// Encoding a value of a type not registered for intf fails.
+//
+// The discriminator is configured by InterfaceTypeKey, and is the same for all formats.
+//
+// intf must be an interface type with methods, and the same name or impl may
+// not be registered twice for it. impl may be a pointer type.
+//
+// Register all types before using the Handle, as with extensions.
+func (o *polyTypes) RegisterType(intf reflect.Type, name string, impl reflect.Type) (err error) {
+	if intf == nil || intf.Kind() != reflect.Interface || intf.NumMethod() == 0 {
+		return fmt.Errorf("RegisterType: %v is not an interface type with methods", intf)
+	}
+	if impl == nil || !impl.Implements(intf) {
+		return fmt.Errorf("RegisterType: %v does not implement %v", impl, intf)
+	}
+	if name == "" {
+		return fmt.Errorf("RegisterType: empty name for %v", impl)
+	}
+	pt := o.polyType(rt2id(intf))
+	if pt == nil {
+		pt = &polyType{rt: intf, byName: make(map[string]reflect.Type), byImpl: make(map[uintptr]string)}
+		*o = append(*o, pt)
+	}
+	if t, ok := pt.byName[name]; ok {
+		return fmt.Errorf("RegisterType: name %q already registered for %v as %v", name, intf, t)
+	}
+	if n, ok := pt.byImpl[rt2id(impl)]; ok {
+		return fmt.Errorf("RegisterType: %v already registered for %v as %q", impl, intf, n)
+	}
+	pt.byName[name] = impl
+	pt.byImpl[rt2id(impl)] = name
+	return
+}
+
+// polyType returns the registration of the interface with the given rtid, if any.
+func (o polyTypes) polyType(rtid uintptr) *polyType {
+	for _, pt := range o {
+		if rt2id(pt.rt) == rtid {
+			return pt
+		}
+	}
+	return nil
+}
+
+// encodePoly encodes rv, the non-nil value of an interface registered via RegisterType,
+// with the name of its type.
+func (e *Encoder) encodePoly(pt *polyType, rv reflect.Value) {
+	name, ok := pt.byImpl[rt2id(rv.Type())]
+	if !ok {
+		e.errorf("type %v is not registered for interface %v", rv.Type(), pt.rt)
+	}
+	ee := e.e
+	key := e.h.InterfaceTypeKey
+	if key == "" {
+		ee.WriteMapStart(1)
+		ee.WriteMapElemKey()
+		e.encodeString(AsSymbolMapStringKeysFlag, name)
+		ee.WriteMapElemValue()
+		e.encodeValue(rv, nil, true)
+		ee.WriteMapEnd()
+		return
+	}
+
+	// Encode the value, with the key prepended to the map it is written as.
+	// This supports any type encoded as a map (e.g. a Selfer).
+	w := encDriverPoly{encDriver: ee, e: e, rt: rv.Type(), name: name}
+	e.e = &w
+	defer func() { e.e = ee }()
+	e.encodeValue(rv, nil, true)
+	if !w.done {
+		w.notMap()
+	}
+}
+
+// encDriverPoly is the encDriver while encodePoly encodes a value with InterfaceTypeKey.
+// It writes the key and name at the start of the map the value is written as.
+type encDriverPoly struct {
+	encDriver
+	e    *Encoder
+	rt   reflect.Type
+	name string
+	done bool
+}
+
+func (x *encDriverPoly) WriteMapStart(length int) {
+	if x.done {
+		x.encDriver.WriteMapStart(length)
+		return
+	}
+	x.done = true
+	x.e.e = x.encDriver // the rest of the value is written as is
+	if length >= 0 {
+		length++
+	}
+	x.encDriver.WriteMapStart(length)
+	x.encDriver.WriteMapElemKey()
+	x.e.encodeString(AsSymbolStructFieldNameFlag, x.e.h.InterfaceTypeKey)
+	x.encDriver.WriteMapElemValue()
+	x.e.encodeString(asSymbolValueFlag, x.name)
+}
+
+func (x *encDriverPoly) WriteArrayStart(length int) {
+	if !x.done {
+		x.notMap() // before any map within it is taken for the value
+	}
+	x.encDriver.WriteArrayStart(length)
+}
+
+func (x *encDriverPoly) notMap() {
+	x.e.errorf("type %v must be encoded as a map, for InterfaceTypeKey", x.rt)
+}
+
+// kInterfacePoly decodes into rv, an interface registered via RegisterType,
+// a new value of the type named in the stream.
+func (d *Decoder) kInterfacePoly(pt *polyType, rv reflect.Value) {
+	dd := d.d
+	if dd.TryDecodeAsNil() {
+		rv.Set(reflect.Zero(rv.Type()))
+		return
+	}
+	if d.h.InterfaceTypeKey != "" {
+		d.kInterfacePolyKey(pt, rv)
+		return
+	}
+	if vt := dd.ContainerType(); vt != valueTypeMap {
+		d.errorIs(ErrTypeMismatch, vt, "cannot decode %v from %v: expecting a map with one entry", pt.rt, vt)
+	}
+	n := dd.ReadMapStart()
+	if n == 0 || n > 1 || (n < 0 && dd.CheckBreak()) {
+		d.errorIs(ErrTypeMismatch, valueTypeMap, "cannot decode %v from a map of length %d: expecting one entry", pt.rt, n)
+	}
+	if d.esep {
+		dd.ReadMapElemKey()
+	}
+	rvn := d.polyValue(pt, dd.DecodeStringAsBytes())
+	if d.esep {
+		dd.ReadMapElemValue()
+	}
+	d.decodeValue(rvn, nil, true)
+	if n < 0 && !dd.CheckBreak() {
+		d.errorIs(ErrTypeMismatch, valueTypeMap, "cannot decode %v from a map of more than one entry", pt.rt)
+	}
+	dd.ReadMapEnd()
+	rv.Set(rvn)
+}
+
+// kInterfacePolyKey is kInterfacePoly when the name is the value of the InterfaceTypeKey
+// in the map, which may be anywhere in it.
+func (d *Decoder) kInterfacePolyKey(pt *polyType, rv reflect.Value) {
+	var nsyms uint
+	if d.ds != nil {
+		nsyms = d.ds.numSymbolsRead()
+	}
+	bs := d.nextValueBytes()
+	start := d.NumBytesRead() - len(bs)
+	expanded := d.ds != nil && d.ds.numSymbolsRead() != nsyms
+	if expanded {
+		// expand symbols, as they may have been defined earlier in the stream
+		bs = d.ds.appendSymbolsExpanded(nil, bs)
+	} else if !d.bytes {
+		bs = append([]byte(nil), bs...) // as a value may reference it e.g. if ZeroCopy
+	}
+	d2 := pooledDecoder(d.hh)
+	defer releaseDecoder(d.hh, d2)
+	d2.ResetBytes(bs)
+	dd := d2.d
+	if vt := dd.ContainerType(); vt != valueTypeMap {
+		d.errorIs(ErrTypeMismatch, vt, "cannot decode %v from %v: expecting a map", pt.rt, vt)
+	}
+	var rvn reflect.Value
+	var keyEnd int
+	n := dd.ReadMapStart()
+	for j := 0; (n >= 0 && j < n) || (n < 0 && !dd.CheckBreak()); j++ {
+		if d2.esep {
+			dd.ReadMapElemKey()
+		}
+		k := dd.DecodeStringAsBytes()
+		if d2.esep {
+			dd.ReadMapElemValue()
+		}
+		if string(k) == d.h.InterfaceTypeKey {
+			keyEnd = d2.NumBytesRead()
+			rvn = d.polyValue(pt, dd.DecodeStringAsBytes())
+			break
+		}
+		d2.swallow()
+	}
+	if !rvn.IsValid() {
+		d.errorIs(ErrTypeMismatch, valueTypeMap, "cannot decode %v from a map without the key %q", pt.rt, d.h.InterfaceTypeKey)
+	}
+	// decode the value from the whole map, ignoring the key where it was found
+	// (and only there, as it may also be a key of a value within), continuing the path of d.
+	d2.ResetBytes(bs)
+	d2.depthFrom(d)
+	d2.polyKeyEnd = keyEnd
+	err := d2.Decode(rvn.Addr().Interface())
+	d2.polyKeyEnd = 0
+	if err != nil {
+		var de *DecodeError
+		if !errors.As(err, &de) {
+			panic(err)
+		}
+		// the offset is within the value, unless its symbols were expanded
+		if expanded {
+			de.Offset = start
+		} else {
+			de.Offset += start
+		}
+		panic(decPolyErr{de})
+	}
+	rv.Set(rvn)
+}
+
+// decPolyErr is the error decoding the value of an interface via kInterfacePolyKey,
+// which already has the path to it, so is returned as is.
+type decPolyErr struct {
+	*DecodeError
+}
+
+// polyValue returns a new, settable value of the type registered with the name.
+// If that is a pointer type, it is a value of that pointer type.
+func (d *Decoder) polyValue(pt *polyType, name []byte) reflect.Value {
+	t, ok := pt.byName[string(name)]
+	if !ok {
+		d.errorf("no type registered as %q for interface %v", name, pt.rt)
+	}
+	rvn := reflect.New(t).Elem()
+	if t.Kind() == reflect.Pointer {
+		rvn.Set(reflect.New(t.Elem()))
+	}
+	return rvn
+}
+
+// atPolyKey returns whether the map key just read is the InterfaceTypeKey found by
+// kInterfacePolyKey, which is ignored when it matches no field of a struct.
+func (d *Decoder) atPolyKey() bool {
+	return d.polyKeyEnd != 0 && d.NumBytesRead() == d.polyKeyEnd
+}
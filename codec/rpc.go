@@ -8,6 +8,7 @@ import (
 	"errors"
 	"io"
 	"net/rpc"
+	"sync"
 )
 
 var errRpcJsonNeedsTermWhitespace = errors.New("rpc requires JsonHandle with TermWhitespace=true")
@@ -151,6 +152,62 @@ func (c *rpcCodec) ReadResponseBody(body interface{}) error {
 	return c.read(body)
 }
 
+// rpcServerErrors holds the calls made via Call by a client codec,
+// which are given the error read for their response if it carries more than a message.
+type rpcServerErrors struct {
+	emu   sync.Mutex
+	calls map[uint64]*rpcServerCall // by Seq
+}
+
+// rpcServerCall is passed as the args of a call made via Call,
+// so its request (and so its response) can be told by its Seq.
+type rpcServerCall struct {
+	args interface{}
+	seq  uint64
+	err  error
+}
+
+// requestBody returns the body to write for the request with the given Seq,
+// noting the call if it was made via Call.
+func (x *rpcServerErrors) requestBody(seq uint64, body interface{}) interface{} {
+	call, ok := body.(*rpcServerCall)
+	if !ok {
+		return body
+	}
+	x.emu.Lock()
+	if x.calls == nil {
+		x.calls = make(map[uint64]*rpcServerCall)
+	}
+	call.seq = seq
+	x.calls[seq] = call
+	x.emu.Unlock()
+	return call.args
+}
+
+// addServerError gives e to the call made via Call with the given Seq, if any.
+func (x *rpcServerErrors) addServerError(seq uint64, e error) {
+	x.emu.Lock()
+	if call, ok := x.calls[seq]; ok {
+		call.err = e
+	}
+	x.emu.Unlock()
+}
+
+func (x *rpcServerErrors) Call(client *rpc.Client, serviceMethod string, args, reply interface{}) error {
+	call := &rpcServerCall{args: args}
+	err := client.Call(serviceMethod, call, reply)
+	x.emu.Lock()
+	if x.calls[call.seq] == call {
+		delete(x.calls, call.seq)
+	}
+	e := call.err
+	x.emu.Unlock()
+	if _, ok := err.(rpc.ServerError); ok && e != nil {
+		return e
+	}
+	return err
+}
+
 // -------------------------------------
 
 type goRpcCodec struct {
@@ -0,0 +1,537 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"strconv"
+	"time"
+)
+
+// TokenKind identifies the kind of a Token.
+type TokenKind uint8
+
+const (
+	// TokenInvalid is the zero value of a TokenKind.
+	TokenInvalid TokenKind = iota
+	TokenNil
+	TokenBool
+	TokenInt
+	TokenUint
+	TokenFloat
+	// TokenNumber is only returned by PeekKind, for a number whose kind
+	// (int, uint or float) is only known once it is read e.g. in json.
+	TokenNumber
+	TokenString
+	TokenBytes
+	TokenExt
+	TokenTime
+	TokenMapStart
+	TokenArrayStart
+	// TokenEnd marks the end of the map or array most recently started.
+	TokenEnd
+)
+
+var tokenKindStrings = [...]string{
+	"Invalid",
+	"Nil",
+	"Bool",
+	"Int",
+	"Uint",
+	"Float",
+	"Number",
+	"String",
+	"Bytes",
+	"Ext",
+	"Time",
+	"MapStart",
+	"ArrayStart",
+	"End",
+}
+
+func (x TokenKind) String() string {
+	if int(x) < len(tokenKindStrings) {
+		return tokenKindStrings[x]
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+// Token is a single item of a stream, as returned by (*Decoder).Token.
+//
+// Only the fields pertinent to its Kind are set.
+type Token struct {
+	Kind TokenKind
+
+	// Len is the number of entries of a TokenMapStart or elements of a TokenArrayStart,
+	// or -1 if not known up front (e.g. json, or cbor indefinite-length containers).
+	Len int
+
+	Bool  bool
+	Int   int64
+	Uint  uint64
+	Float float64
+
+	// Bytes holds the contents of a TokenString, TokenBytes or TokenExt.
+	//
+	// It may share memory with the Decoder or its input []byte,
+	// and so is only valid until the next call on the Decoder.
+	//
+	// For an extension whose value is stored in-band (e.g. a cbor tag),
+	// Bytes is nil, and the value is read via the next call to Token or Decode.
+	Bytes []byte
+
+	// Tag is the extension tag of a TokenExt.
+	Tag uint64
+
+	Time time.Time
+}
+
+// decTokenContainer tracks a map or array started via (*Decoder).Token.
+type decTokenContainer struct {
+	l     int  // number of items (2 per map entry), or -1 if indefinite
+	j     int  // number of items started
+	isMap bool //
+	ready bool // separator for next item has been read, but the item has not
+	ended bool // all items have been read
+}
+
+// Token reads the next token from the stream.
+//
+// A map or array is returned as a TokenMapStart or TokenArrayStart,
+// followed by tokens for each of its keys and values or elements in turn,
+// and finally a TokenEnd.
+//
+// Token can be interleaved with Decode. Within a map or array started via Token,
+// Decode reads the next key, value or element in full. This allows a large stream
+// to be walked, only decoding the parts of interest into Go values.
+//
+// At the end of the input, Token returns io.EOF.
+func (d *Decoder) Token() (t Token, err error) {
+	if d.err != nil {
+		return t, d.err
+	}
+	if recoverPanicToErr {
+		defer func() {
+			if x := recover(); x != nil {
+				panicValToErr(d, x, &d.err)
+				err = d.err
+			}
+		}()
+	}
+	d.token(&t)
+	return
+}
+
+// PeekKind returns the kind of the next token, without consuming it.
+//
+// The kind is determined from the leading bytes of the next value only.
+// Consequently, a msgpack timestamp or symbol is reported as TokenExt
+// (but read as TokenTime or TokenString),
+// and a json number is reported as TokenNumber (unless PreferFloat is set).
+func (d *Decoder) PeekKind() (k TokenKind, err error) {
+	if d.err != nil {
+		return TokenInvalid, d.err
+	}
+	if recoverPanicToErr {
+		defer func() {
+			if x := recover(); x != nil {
+				panicValToErr(d, x, &d.err)
+				k, err = TokenInvalid, d.err
+			}
+		}()
+	}
+	if d.tokenNext() {
+		return TokenEnd, nil
+	}
+	switch d.d.nextValueType() {
+	case valueTypeUnset:
+		k = TokenNumber
+	case valueTypeNil:
+		k = TokenNil
+	case valueTypeInt:
+		k = TokenInt
+	case valueTypeUint:
+		k = TokenUint
+	case valueTypeFloat:
+		k = TokenFloat
+	case valueTypeBool:
+		k = TokenBool
+	case valueTypeString, valueTypeSymbol:
+		k = TokenString
+	case valueTypeBytes:
+		k = TokenBytes
+	case valueTypeMap:
+		k = TokenMapStart
+	case valueTypeArray:
+		k = TokenArrayStart
+	case valueTypeTime:
+		k = TokenTime
+	case valueTypeExt:
+		k = TokenExt
+	}
+	return
+}
+
+func (d *Decoder) token(t *Token) {
+	if d.tokenNext() {
+		d.tokenEnd()
+		t.Kind = TokenEnd
+		return
+	}
+	var c *decTokenContainer
+	if n := len(d.tok); n > 0 {
+		c = &d.tok[n-1]
+		c.ready = false
+	} else {
+		d.resetSymbols()
+	}
+	dd := d.d
+	switch dd.nextValueType() {
+	case valueTypeNil:
+		dd.TryDecodeAsNil()
+		t.Kind = TokenNil
+	case valueTypeMap:
+		t.Kind, t.Len = TokenMapStart, dd.ReadMapStart()
+		d.tokenPush(true, t.Len)
+	case valueTypeArray:
+		t.Kind, t.Len = TokenArrayStart, dd.ReadArrayStart()
+		d.tokenPush(false, t.Len)
+	case valueTypeString:
+		t.Kind, t.Bytes = TokenString, dd.DecodeStringAsBytes()
+	case valueTypeBytes:
+		t.Kind, t.Bytes = TokenBytes, dd.DecodeBytes(d.b[:], true)
+	default:
+		n := d.naked()
+		dd.DecodeNaked()
+		switch n.v {
+		case valueTypeNil:
+			t.Kind = TokenNil
+		case valueTypeBool:
+			t.Kind, t.Bool = TokenBool, n.b
+		case valueTypeInt:
+			t.Kind, t.Int = TokenInt, n.i
+		case valueTypeUint:
+			t.Kind, t.Uint = TokenUint, n.u
+		case valueTypeFloat:
+			t.Kind, t.Float = TokenFloat, n.f
+		case valueTypeString, valueTypeSymbol:
+			t.Kind, t.Bytes = TokenString, bytesView(n.s)
+		case valueTypeBytes:
+			t.Kind, t.Bytes = TokenBytes, n.l
+		case valueTypeTime:
+			t.Kind, t.Time = TokenTime, n.t
+		case valueTypeExt:
+			t.Kind, t.Tag, t.Bytes = TokenExt, n.u, n.l
+			if n.l == nil && c != nil {
+				// the value follows in-band, and is part of the same item
+				c.ready = true
+			}
+		default:
+			d.errorf("cannot read token: unsupported value type: %v", n.v)
+		}
+	}
+}
+
+// tokenCall calls fn, returning any error it panics with (as Token does).
+func (d *Decoder) tokenCall(fn func()) (err error) {
+	if d.err != nil {
+		return d.err
+	}
+	if recoverPanicToErr {
+		defer func() {
+			if x := recover(); x != nil {
+				panicValToErr(d, x, &d.err)
+				err = d.err
+			}
+		}()
+	}
+	fn()
+	return
+}
+
+// tokenNext positions the stream at the next item of the innermost container
+// started via Token, reading any separators. It returns true if there are no more items.
+func (d *Decoder) tokenNext() (end bool) {
+	n := len(d.tok)
+	if n == 0 {
+		return
+	}
+	c := &d.tok[n-1]
+	if c.ready {
+		return
+	}
+	if c.ended {
+		return true
+	}
+	if c.isMap && c.j%2 == 1 {
+		d.d.ReadMapElemValue()
+	} else if (c.l >= 0 && c.j == c.l) || (c.l < 0 && d.d.CheckBreak()) {
+		c.ended = true
+		return true
+	} else if c.isMap {
+		d.d.ReadMapElemKey()
+	} else {
+		d.d.ReadArrayElem()
+	}
+	c.j++
+	c.ready = true
+	return
+}
+
+// tokenElem positions the stream at the next item of the innermost container
+// started via Token, so it can be read by Decode.
+func (d *Decoder) tokenElem() {
+	if d.tokenNext() {
+		d.errorstr("cannot decode: no more items in the container started via Token")
+	}
+	d.tok[len(d.tok)-1].ready = false
+}
+
+func (d *Decoder) tokenPush(isMap bool, l int) {
+	d.depthIncr()
+	if isMap && l > 0 {
+		l *= 2
+	}
+	d.tok = append(d.tok, decTokenContainer{l: l, isMap: isMap})
+}
+
+func (d *Decoder) tokenEnd() {
+	n := len(d.tok) - 1
+	if d.tok[n].isMap {
+		d.d.ReadMapEnd()
+	} else {
+		d.d.ReadArrayEnd()
+	}
+	d.tok = d.tok[:n]
+	d.depthDecr()
+}
+
+// encTokenContainer tracks a map or array started via the Encoder's Write methods.
+type encTokenContainer struct {
+	l     int  // number of items declared (2 per map entry), or -1 if not declared
+	j     int  // number of items written
+	isMap bool //
+}
+
+// WriteMapStart starts a map with n entries, whose keys and values are
+// then written in turn (via the Write methods or Encode), followed by WriteEnd.
+//
+// The number of entries written is checked against n, and an error is returned
+// if too many are written, or if WriteEnd is called before all are written.
+//
+// n may be negative only if the format does not need the length up front
+// i.e. json, or cbor with IndefiniteLength set.
+func (e *Encoder) WriteMapStart(n int) error {
+	return e.tokenWrite(func() {
+		e.tokenStart(true, n)
+		e.e.WriteMapStart(n)
+	})
+}
+
+// WriteArrayStart starts an array with n elements, which are then
+// written in turn (via the Write methods or Encode), followed by WriteEnd.
+//
+// See WriteMapStart for how n is checked against the elements written.
+func (e *Encoder) WriteArrayStart(n int) error {
+	return e.tokenWrite(func() {
+		e.tokenStart(false, n)
+		e.e.WriteArrayStart(n)
+	})
+}
+
+// WriteEnd ends the map or array most recently started.
+func (e *Encoder) WriteEnd() error {
+	return e.tokenWrite(e.tokenEnd)
+}
+
+// WriteKey writes a string key of the map being written.
+//
+// It returns an error if a map value is expected instead.
+func (e *Encoder) WriteKey(k string) error {
+	return e.tokenWrite(func() {
+		e.tokenElem(true)
+		e.e.EncodeStringEnc(cUTF8, k)
+		e.tokenDone()
+	})
+}
+
+// WriteNil writes a nil value.
+func (e *Encoder) WriteNil() error {
+	return e.tokenWrite(func() {
+		e.tokenElem(false)
+		e.e.EncodeNil()
+		e.tokenDone()
+	})
+}
+
+// WriteBool writes a bool value.
+func (e *Encoder) WriteBool(v bool) error {
+	return e.tokenWrite(func() {
+		e.tokenElem(false)
+		e.e.EncodeBool(v)
+		e.tokenDone()
+	})
+}
+
+// WriteInt writes a signed integer value.
+func (e *Encoder) WriteInt(v int64) error {
+	return e.tokenWrite(func() {
+		e.tokenElem(false)
+		e.e.EncodeInt(v)
+		e.tokenDone()
+	})
+}
+
+// WriteUint writes an unsigned integer value.
+func (e *Encoder) WriteUint(v uint64) error {
+	return e.tokenWrite(func() {
+		e.tokenElem(false)
+		e.e.EncodeUint(v)
+		e.tokenDone()
+	})
+}
+
+// WriteFloat writes a float value.
+func (e *Encoder) WriteFloat(v float64) error {
+	return e.tokenWrite(func() {
+		e.tokenElem(false)
+		e.e.EncodeFloat64(v)
+		e.tokenDone()
+	})
+}
+
+// WriteString writes a string value.
+func (e *Encoder) WriteString(v string) error {
+	return e.tokenWrite(func() {
+		e.tokenElem(false)
+		e.e.EncodeStringEnc(cUTF8, v)
+		e.tokenDone()
+	})
+}
+
+// WriteBytes writes a []byte value.
+func (e *Encoder) WriteBytes(v []byte) error {
+	return e.tokenWrite(func() {
+		e.tokenElem(false)
+		e.e.EncodeStringBytesRaw(v)
+		e.tokenDone()
+	})
+}
+
+// WriteExt writes an extension value with the given tag and data.
+//
+// Formats without binary extensions write the data as the value of the extension
+// e.g. cbor writes the tag followed by the data as a byte string,
+// and json writes just the data (as a base64 string).
+func (e *Encoder) WriteExt(tag uint64, data []byte) error {
+	return e.tokenWrite(func() {
+		e.tokenElem(false)
+		e.e.EncodeRawExt(&RawExt{Tag: tag, Data: data, Value: data}, e)
+		e.tokenDone()
+	})
+}
+
+// tokenWrite runs fn, handling errors as Encode does.
+// Output is only flushed once a top-level value has been written (see tokenDone).
+func (e *Encoder) tokenWrite(fn func()) (err error) {
+	if e.err != nil {
+		return e.err
+	}
+	if recoverPanicToErr {
+		defer func() {
+			if x := recover(); x != nil {
+				panicValToErr(e, x, &e.err)
+				err = e.err
+			}
+		}()
+	}
+	fn()
+	return
+}
+
+// tokenElem is called before writing an item via the Write methods or Encode.
+// It writes any separator, after checking that the item is expected.
+func (e *Encoder) tokenElem(isKey bool) {
+	n := len(e.tok)
+	if n == 0 {
+		if e.wf != nil && e.wf.buf == nil {
+			e.wf.buf = e.wf.bytesBufPooler.get(e.wf.sz)
+		}
+		if isKey {
+			e.errorstr("cannot write key: no map was started")
+		}
+		return
+	}
+	c := &e.tok[n-1]
+	if c.l >= 0 && c.j == c.l {
+		if c.isMap {
+			e.errorf("cannot write item: map was started with %d entries, which were all written", c.l/2)
+		}
+		e.errorf("cannot write item: array was started with %d elements, which were all written", c.l)
+	}
+	if !c.isMap {
+		if isKey {
+			e.errorstr("cannot write key: an array element is expected")
+		}
+		e.e.WriteArrayElem()
+	} else if c.j%2 == 0 {
+		e.e.WriteMapElemKey()
+	} else {
+		if isKey {
+			e.errorstr("cannot write key: a map value is expected")
+		}
+		e.e.WriteMapElemValue()
+	}
+	c.j++
+}
+
+// tokenDone is called after writing an item via the Write methods.
+// It completes the encoding once a top-level value has been written.
+func (e *Encoder) tokenDone() {
+	if len(e.tok) != 0 {
+		return
+	}
+	e.e.atEndOfEncode()
+	e.w.end()
+	if e.wf != nil && !e.h.ExplicitRelease {
+		e.wf.release()
+	}
+}
+
+func (e *Encoder) tokenStart(isMap bool, l int) {
+	if l < 0 && !e.js {
+		if c, ok := e.e.(*cborEncDriver); !ok || !c.indefinite() {
+			e.errorf("cannot start container: length is required, but got %d", l)
+		}
+	}
+	e.tokenElem(false)
+	if l < 0 {
+		l = -1
+	} else if isMap {
+		l *= 2
+	}
+	e.tok = append(e.tok, encTokenContainer{l: l, isMap: isMap})
+}
+
+func (e *Encoder) tokenEnd() {
+	n := len(e.tok) - 1
+	if n < 0 {
+		e.errorstr("cannot write end: no map or array was started")
+	}
+	c := &e.tok[n]
+	if c.isMap {
+		if c.j%2 == 1 {
+			e.errorstr("cannot write end: a map value is expected")
+		} else if c.l >= 0 && c.j != c.l {
+			e.errorf("cannot write end: map was started with %d entries, but %d were written", c.l/2, c.j/2)
+		}
+		e.e.WriteMapEnd()
+	} else {
+		if c.l >= 0 && c.j != c.l {
+			e.errorf("cannot write end: array was started with %d elements, but %d were written", c.l, c.j)
+		}
+		e.e.WriteArrayEnd()
+	}
+	e.tok = e.tok[:n]
+	e.tokenDone()
+}
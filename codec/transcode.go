@@ -0,0 +1,512 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+// TranscodePolicy controls how a Transcoder writes a value
+// which json cannot represent natively.
+type TranscodePolicy uint8
+
+const (
+	// TranscodeNative writes the value in the closest form json supports:
+	//   - bytes as a base64 string (or via JsonHandle.RawBytesExt)
+	//   - time as an RFC 3339 string
+	//   - extensions as their data (or their value, for cbor tags), dropping the tag
+	//   - non-string map keys as written by the JsonHandle (honoring MapKeyAsString)
+	//
+	// Such values do not round-trip.
+	TranscodeNative TranscodePolicy = iota
+
+	// TranscodeTagged writes the value as a single-entry map,
+	// whose key identifies the kind of value:
+	//   - bytes as {"$bin": bytes}
+	//   - time as {"$time": "2006-01-02T15:04:05.999999999Z07:00"}
+	//   - extensions as {"$ext": [tag, data]}
+	//   - maps with non-string keys as {"$map": [key1, value1, key2, value2, ...]}
+	//
+	// These are recognized when transcoding from json, so values round-trip.
+	TranscodeTagged
+
+	// TranscodeFail fails the transcoding with an error.
+	TranscodeFail
+)
+
+// Transcoder converts values between formats e.g. from msgpack to json or the reverse.
+//
+// Values are read from a Decoder and written to an Encoder directly,
+// without being decoded into Go values (so no interface{} or reflection is involved).
+// Consequently, the distinction between signed integers, unsigned integers and floats
+// is preserved, subject to what the formats can represent.
+//
+// The policy fields control how values which json cannot represent natively
+// are written to json (and recognized when reading from json, if TranscodeTagged).
+// When neither side is json, all values are transcoded natively.
+//
+// Integers are written to json per the JsonHandle (honoring IntegerAsString).
+// A msgpack Decoder should be configured with RawToString or WriteExt,
+// so that msgpack strings are read as strings (not bytes).
+//
+// The zero value is ready to use. A Transcoder is not safe for concurrent use.
+type Transcoder struct {
+	// Bytes is the policy for binary values.
+	Bytes TranscodePolicy
+	// Ext is the policy for extensions (msgpack extensions or cbor tags).
+	Ext TranscodePolicy
+	// Time is the policy for time.Time values.
+	Time TranscodePolicy
+	// MapKeys is the policy for maps with keys which are not strings.
+	MapKeys TranscodePolicy
+
+	// stacks of Encoders and Decoders, reused across calls:
+	// enc buffers containers whose length is not known up front,
+	// and dec re-reads maps which have been checked for non-string keys.
+	enc  []*transcodeScratchEncoder
+	dec  []*Decoder
+	nenc int // number of enc in use
+	ndec int // number of dec in use
+}
+
+type transcodeScratchEncoder struct {
+	e *Encoder
+	b []byte
+}
+
+const (
+	transcodeTagBytes = "$bin"
+	transcodeTagTime  = "$time"
+	transcodeTagExt   = "$ext"
+	transcodeTagMap   = "$map"
+)
+
+// Transcode reads the next value from src and writes it to dst.
+//
+// It can also be used between Token/Write calls, to transcode
+// the next item of the container being read or written.
+func (t *Transcoder) Transcode(dst *Encoder, src *Decoder) (err error) {
+	if src.err != nil {
+		return src.err
+	}
+	if dst.err != nil {
+		return dst.err
+	}
+	if recoverPanicToErr {
+		defer func() {
+			if x := recover(); x != nil {
+				panicValToErr(src, x, &src.err)
+				err = src.err
+			}
+		}()
+	}
+	t.nenc, t.ndec = 0, 0
+	if len(src.tok) != 0 {
+		src.tokenElem()
+	}
+	dst.tokenElem(false)
+	t.transcode(dst, src)
+	dst.tokenDone()
+	return
+}
+
+func (t *Transcoder) transcode(dst *Encoder, src *Decoder) {
+	dd, ee := src.d, dst.e
+	switch dd.nextValueType() {
+	case valueTypeNil:
+		dd.TryDecodeAsNil()
+		ee.EncodeNil()
+	case valueTypeMap:
+		t.transcodeMap(dst, src, false)
+	case valueTypeArray:
+		t.transcodeArray(dst, src)
+	case valueTypeString:
+		ee.EncodeStringEnc(cUTF8, stringView(dd.DecodeStringAsBytes()))
+	case valueTypeBytes:
+		t.writeBytes(dst, src, dd.DecodeBytes(src.b[:], true))
+	default:
+		n := src.naked()
+		dd.DecodeNaked()
+		switch n.v {
+		case valueTypeNil:
+			ee.EncodeNil()
+		case valueTypeBool:
+			ee.EncodeBool(n.b)
+		case valueTypeInt:
+			ee.EncodeInt(n.i)
+		case valueTypeUint:
+			ee.EncodeUint(n.u)
+		case valueTypeFloat:
+			ee.EncodeFloat64(n.f)
+		case valueTypeString, valueTypeSymbol:
+			ee.EncodeStringEnc(cUTF8, n.s)
+		case valueTypeBytes:
+			t.writeBytes(dst, src, n.l)
+		case valueTypeTime:
+			t.writeTime(dst, src, n)
+		case valueTypeExt:
+			t.writeExt(dst, src, n.u, n.l)
+		default:
+			src.errorf("transcode: unsupported value type: %v", n.v)
+		}
+	}
+}
+
+func (t *Transcoder) writeBytes(dst *Encoder, src *Decoder, v []byte) {
+	if dst.js {
+		switch t.Bytes {
+		case TranscodeTagged:
+			t.tagStart(dst, transcodeTagBytes)
+			dst.e.EncodeStringBytesRaw(v)
+			dst.e.WriteMapEnd()
+			return
+		case TranscodeFail:
+			src.errorstr("transcode: cannot write bytes to json")
+		}
+	}
+	dst.e.EncodeStringBytesRaw(v)
+}
+
+func (t *Transcoder) writeTime(dst *Encoder, src *Decoder, n *decNaked) {
+	if dst.js {
+		switch t.Time {
+		case TranscodeTagged:
+			t.tagStart(dst, transcodeTagTime)
+			dst.e.EncodeTime(n.t)
+			dst.e.WriteMapEnd()
+			return
+		case TranscodeFail:
+			src.errorstr("transcode: cannot write time to json")
+		}
+	}
+	dst.e.EncodeTime(n.t)
+}
+
+// writeExt writes an extension. If data is nil, its value is stored in-band
+// i.e. it is the next value in src.
+func (t *Transcoder) writeExt(dst *Encoder, src *Decoder, tag uint64, data []byte) {
+	if dst.js {
+		switch t.Ext {
+		case TranscodeTagged:
+			t.tagStart(dst, transcodeTagExt)
+			dst.e.WriteArrayStart(2)
+			dst.e.WriteArrayElem()
+			dst.e.EncodeUint(tag)
+			dst.e.WriteArrayElem()
+			if data == nil {
+				t.transcode(dst, src)
+			} else {
+				dst.e.EncodeStringBytesRaw(data)
+			}
+			dst.e.WriteArrayEnd()
+			dst.e.WriteMapEnd()
+		case TranscodeFail:
+			src.errorf("transcode: cannot write extension with tag %d to json", tag)
+		default:
+			if data == nil {
+				t.transcode(dst, src)
+			} else {
+				dst.e.EncodeStringBytesRaw(data)
+			}
+		}
+		return
+	}
+	if c, ok := dst.e.(*cborEncDriver); ok {
+		c.encUint(tag, cborBaseTag)
+		if data == nil {
+			t.transcode(dst, src)
+		} else {
+			c.EncodeStringBytesRaw(data)
+		}
+		return
+	}
+	// extensions with data (e.g. msgpack) can only hold bytes.
+	if data == nil {
+		if src.d.nextValueType() != valueTypeBytes {
+			src.errorf("transcode: cannot write extension with tag %d: value is not bytes", tag)
+		}
+		data = src.d.DecodeBytes(src.b[:], true)
+	}
+	if tag > 0xff {
+		src.errorf("transcode: cannot write extension with tag %d: tag out of range", tag)
+	}
+	dst.e.EncodeRawExt(&RawExt{Tag: tag, Data: data}, dst)
+}
+
+// tagStart starts a single-entry map with the given key, to hold a tagged value.
+func (t *Transcoder) tagStart(dst *Encoder, key string) {
+	dst.e.WriteMapStart(1)
+	dst.e.WriteMapElemKey()
+	dst.e.EncodeStringEnc(cUTF8, key)
+	dst.e.WriteMapElemValue()
+}
+
+// untag transcodes the tagged value identified by key (the first key of the current map),
+// returning false if key does not identify a tagged value.
+func (t *Transcoder) untag(dst *Encoder, src *Decoder, key []byte) bool {
+	dd := src.d
+	switch {
+	case t.Bytes == TranscodeTagged && string(key) == transcodeTagBytes:
+		dd.ReadMapElemValue()
+		dst.e.EncodeStringBytesRaw(dd.DecodeBytes(src.b[:], true))
+	case t.Time == TranscodeTagged && string(key) == transcodeTagTime:
+		dd.ReadMapElemValue()
+		dst.e.EncodeTime(dd.DecodeTime())
+	case t.Ext == TranscodeTagged && string(key) == transcodeTagExt:
+		dd.ReadMapElemValue()
+		if dd.ReadArrayStart(); dd.CheckBreak() {
+			src.errorstr("transcode: invalid tagged extension: missing tag")
+		}
+		dd.ReadArrayElem()
+		tag := dd.DecodeUint64()
+		if dd.CheckBreak() {
+			src.errorstr("transcode: invalid tagged extension: missing data")
+		}
+		dd.ReadArrayElem()
+		if _, ok := dst.e.(*cborEncDriver); ok {
+			t.writeExt(dst, src, tag, nil)
+		} else {
+			t.writeExt(dst, src, tag, dd.DecodeBytes(src.b[:], true))
+		}
+		if !dd.CheckBreak() {
+			src.errorstr("transcode: invalid tagged extension: more than 2 elements")
+		}
+		dd.ReadArrayEnd()
+	case t.MapKeys == TranscodeTagged && string(key) == transcodeTagMap:
+		dd.ReadMapElemValue()
+		t.untagMap(dst, src)
+	default:
+		return false
+	}
+	if !dd.CheckBreak() {
+		src.errorf("transcode: invalid tagged value: %s has more than 1 entry", key)
+	}
+	return true
+}
+
+// untagMap transcodes the array of keys and values of a tagged map.
+func (t *Transcoder) untagMap(dst *Encoder, src *Decoder) {
+	dd := src.d
+	n := dd.ReadArrayStart()
+	src.depthIncr()
+	if n >= 0 {
+		if n%2 != 0 {
+			src.errorstr("transcode: invalid tagged map: odd number of keys and values")
+		}
+		n /= 2
+	}
+	w := t.scratchStart(dst, n < 0)
+	if w == dst {
+		w.e.WriteMapStart(n)
+	}
+	var j int
+	for ; (n >= 0 && j < 2*n) || !(n >= 0 || dd.CheckBreak()); j++ {
+		if src.esep {
+			dd.ReadArrayElem()
+		}
+		if w.esep {
+			if j%2 == 0 {
+				w.e.WriteMapElemKey()
+			} else {
+				w.e.WriteMapElemValue()
+			}
+		}
+		t.transcode(w, src)
+	}
+	dd.ReadArrayEnd()
+	src.depthDecr()
+	if j%2 != 0 {
+		src.errorstr("transcode: invalid tagged map: odd number of keys and values")
+	}
+	if w == dst {
+		w.e.WriteMapEnd()
+	} else {
+		dst.e.WriteMapStart(j / 2)
+		dst.w.writeb(t.scratchEnd())
+		dst.e.WriteMapEnd()
+	}
+}
+
+// transcodeMap transcodes a map. If keysChecked, then we already know
+// whether the map has non-string keys (and that it has not).
+func (t *Transcoder) transcodeMap(dst *Encoder, src *Decoder, keysChecked bool) {
+	if !keysChecked && t.MapKeys == TranscodeTagged && dst.js && !src.js {
+		t.transcodeMapKeysChecked(dst, src)
+		return
+	}
+	dd := src.d
+	n := dd.ReadMapStart()
+	src.depthIncr()
+	var j int
+	var key []byte
+	var sepRead, keyRead bool // separator and key of the first entry have been read
+	if src.js && !dst.js && (t.Bytes == TranscodeTagged || t.Time == TranscodeTagged ||
+		t.Ext == TranscodeTagged || t.MapKeys == TranscodeTagged) && !dd.CheckBreak() {
+		dd.ReadMapElemKey()
+		sepRead = true
+		if dd.nextValueType() == valueTypeString {
+			key, keyRead = dd.DecodeStringAsBytes(), true
+			if t.untag(dst, src, key) {
+				dd.ReadMapEnd()
+				src.depthDecr()
+				return
+			}
+		}
+	}
+	w := t.scratchStart(dst, n < 0)
+	if w == dst {
+		w.e.WriteMapStart(n)
+	}
+	for ; sepRead || (n >= 0 && j < n) || !(n >= 0 || dd.CheckBreak()); j++ {
+		if sepRead {
+			sepRead = false
+		} else if src.esep {
+			dd.ReadMapElemKey()
+		}
+		if w.esep {
+			w.e.WriteMapElemKey()
+		}
+		if keyRead {
+			w.e.EncodeStringEnc(cUTF8, stringView(key))
+			keyRead = false
+		} else {
+			t.transcodeKey(w, src)
+		}
+		if src.esep {
+			dd.ReadMapElemValue()
+		}
+		if w.esep {
+			w.e.WriteMapElemValue()
+		}
+		t.transcode(w, src)
+	}
+	dd.ReadMapEnd()
+	src.depthDecr()
+	if w == dst {
+		w.e.WriteMapEnd()
+	} else {
+		dst.e.WriteMapStart(j)
+		dst.w.writeb(t.scratchEnd())
+		dst.e.WriteMapEnd()
+	}
+}
+
+func (t *Transcoder) transcodeKey(dst *Encoder, src *Decoder) {
+	if dst.js && t.MapKeys == TranscodeFail {
+		if vt := src.d.nextValueType(); vt != valueTypeString {
+			src.errorf("transcode: cannot write map key of type %v to json", vt)
+		}
+	}
+	t.transcode(dst, src)
+}
+
+// transcodeMapKeysChecked transcodes a map to json, having first checked whether it has
+// any non-string keys, in which case it is written tagged.
+//
+// As the map is read twice, it is first read in full from src,
+// and then re-read from a Decoder over its bytes.
+func (t *Transcoder) transcodeMapKeysChecked(dst *Encoder, src *Decoder) {
+	bs := src.nextValueBytes()
+	if t.ndec == len(t.dec) {
+		t.dec = append(t.dec, nil)
+	}
+	d := t.dec[t.ndec]
+	if d == nil || d.hh != src.hh {
+		d = NewDecoderBytes(bs, src.hh)
+		t.dec[t.ndec] = d
+	} else {
+		d.ResetBytes(bs)
+	}
+	t.ndec++
+	defer func() { t.ndec-- }()
+	d.depthFrom(src)
+	var tagged bool
+	dd := d.d
+	n := dd.ReadMapStart()
+	for j := 0; !tagged && ((n >= 0 && j < n) || !(n >= 0 || dd.CheckBreak())); j++ {
+		tagged = dd.nextValueType() != valueTypeString
+		d.swallow()
+		d.swallow()
+	}
+	d.ResetBytes(bs)
+	d.depthFrom(src)
+	if !tagged {
+		t.transcodeMap(dst, d, true)
+		return
+	}
+	t.tagStart(dst, transcodeTagMap)
+	n = dd.ReadMapStart()
+	d.depthIncr()
+	if n >= 0 {
+		n *= 2
+	}
+	dst.e.WriteArrayStart(n)
+	for j := 0; (n >= 0 && j < n) || !(n >= 0 || dd.CheckBreak()); j++ {
+		dst.e.WriteArrayElem()
+		t.transcode(dst, d)
+	}
+	dd.ReadMapEnd()
+	d.depthDecr()
+	dst.e.WriteArrayEnd()
+	dst.e.WriteMapEnd()
+}
+
+func (t *Transcoder) transcodeArray(dst *Encoder, src *Decoder) {
+	dd := src.d
+	n := dd.ReadArrayStart()
+	src.depthIncr()
+	w := t.scratchStart(dst, n < 0)
+	if w == dst {
+		w.e.WriteArrayStart(n)
+	}
+	var j int
+	for ; (n >= 0 && j < n) || !(n >= 0 || dd.CheckBreak()); j++ {
+		if src.esep {
+			dd.ReadArrayElem()
+		}
+		if w.esep {
+			w.e.WriteArrayElem()
+		}
+		t.transcode(w, src)
+	}
+	dd.ReadArrayEnd()
+	src.depthDecr()
+	if w == dst {
+		w.e.WriteArrayEnd()
+	} else {
+		dst.e.WriteArrayStart(j)
+		dst.w.writeb(t.scratchEnd())
+		dst.e.WriteArrayEnd()
+	}
+}
+
+// scratchStart returns the Encoder to write the contents of a container to.
+//
+// If its length is not known and dst needs it up front, this is a scratch Encoder
+// (whose output is retrieved via scratchEnd). Else it is dst, and the caller
+// must start the container on it.
+func (t *Transcoder) scratchStart(dst *Encoder, unknownLen bool) *Encoder {
+	if !unknownLen || dst.js {
+		return dst
+	}
+	if c, ok := dst.e.(*cborEncDriver); ok && c.indefinite() {
+		return dst
+	}
+	if t.nenc == len(t.enc) {
+		t.enc = append(t.enc, new(transcodeScratchEncoder))
+	}
+	s := t.enc[t.nenc]
+	t.nenc++
+	if s.e == nil || s.e.hh != dst.hh {
+		s.e = NewEncoderBytes(&s.b, dst.hh)
+	} else {
+		s.e.ResetBytes(&s.b)
+	}
+	return s.e
+}
+
+// scratchEnd returns the output of the innermost scratch Encoder,
+// releasing it for reuse. The output is valid until the next scratchStart.
+func (t *Transcoder) scratchEnd() []byte {
+	t.nenc--
+	s := t.enc[t.nenc]
+	s.e.w.end()
+	return s.b
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import "reflect"
+
+var unknownFieldsTyp = reflect.TypeOf(UnknownFields{})
+
+// UnknownFields holds the fields of an encoded struct which match no field of the
+// struct, as the raw encoded bytes of each key and value.
+//
+// A struct with an exported field of type UnknownFields captures those fields into it
+// when decoded from a map, and writes them back after its other fields when encoded
+// to a map. The field itself is never encoded or decoded as a field.
+//
+// This allows a struct written by a newer version of a program, which has more fields,
+// to be relayed by an older version without losing those fields or changing how they
+// are encoded (e.g. an unsigned integer becoming a signed one).
+//
+// When encoding in the same format as they were decoded from, the fields are written
+// back byte-for-byte. Otherwise, they are converted as by a Transcoder.
+//
+// This is supported both via reflection and by codecgen, for a field of the struct
+// itself (not of an embedded struct). When a struct also implements MissingFielder,
+// UnknownFields takes precedence.
+type UnknownFields struct {
+	h    Handle // the Handle the fields were decoded with
+	b    []byte // the raw keys and values, in turn
+	ends []int  // the end offset in b of each key and value
+}
+
+// Len returns the number of unknown fields.
+func (x *UnknownFields) Len() int {
+	return len(x.ends) / 2
+}
+
+// Reset removes all the unknown fields.
+func (x *UnknownFields) Reset() {
+	x.h = nil
+	x.b = x.b[:0]
+	x.ends = x.ends[:0]
+}
+
+// field returns the raw key and value of the i'th unknown field.
+func (x *UnknownFields) field(i int) (key, value []byte) {
+	var start int
+	if i > 0 {
+		start = x.ends[2*i-1]
+	}
+	mid, end := x.ends[2*i], x.ends[2*i+1]
+	return x.b[start:mid], x.b[mid:end]
+}
+
+// append appends bs to the raw bytes, as the next key or value.
+func (x *UnknownFields) append(bs []byte) {
+	x.b = append(x.b, bs...)
+	x.ends = append(x.ends, len(x.b))
+}
+
+// unknownFieldKeyStart starts capturing the raw bytes of the next struct field key,
+// in case it matches no field.
+func (d *Decoder) unknownFieldKeyStart() {
+	d.d.uncacheRead()
+	if d.ds != nil {
+		d.ufsyms = d.ds.numSymbolsRead()
+	}
+	d.r.track()
+}
+
+// unknownFieldKeyEnd returns the raw bytes of the struct field key read since
+// unknownFieldKeyStart. They are only valid until the next value is read.
+func (d *Decoder) unknownFieldKeyEnd() []byte {
+	d.d.uncacheRead()
+	return d.r.stopTrack()
+}
+
+// unknownField captures the field with the given raw key, whose value is next in the stream.
+func (d *Decoder) unknownField(x *UnknownFields, key []byte) {
+	if d.atPolyKey() {
+		// the key is written by the encoder of the interface holding the struct
+		d.swallow()
+		return
+	}
+	if len(x.ends) == 0 {
+		x.h = d.hh
+	}
+	d.appendUnknown(x, key)
+	if d.ds != nil {
+		d.ufsyms = d.ds.numSymbolsRead()
+	}
+	d.appendUnknown(x, d.nextValueBytes())
+}
+
+// appendUnknown appends the raw bytes of a key or value to x,
+// replacing any symbols read in it, as they are only valid within the current value.
+func (d *Decoder) appendUnknown(x *UnknownFields, bs []byte) {
+	if d.ds != nil && d.ds.numSymbolsRead() != d.ufsyms {
+		x.b = d.ds.appendSymbolsExpanded(x.b, bs)
+		x.ends = append(x.ends, len(x.b))
+		return
+	}
+	x.append(bs)
+}
+
+// encUnknownFields writes the unknown fields as entries of the current map.
+func (e *Encoder) encUnknownFields(x *UnknownFields) {
+	if x.Len() == 0 {
+		return
+	}
+	ee := e.e
+	if x.h.Name() == e.hh.Name() {
+		for i, n := 0, x.Len(); i < n; i++ {
+			k, v := x.field(i)
+			ee.WriteMapElemKey()
+			e.asis(k)
+			ee.WriteMapElemValue()
+			e.asis(v)
+		}
+		return
+	}
+	var t Transcoder
+	d := NewDecoderBytes(nil, x.h)
+	for i, n := 0, x.Len(); i < n; i++ {
+		k, v := x.field(i)
+		ee.WriteMapElemKey()
+		d.ResetBytes(k)
+		t.transcodeKey(e, d)
+		ee.WriteMapElemValue()
+		d.ResetBytes(v)
+		t.transcode(e, d)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2013, 2025
+// SPDX-License-Identifier: MIT
+
+package codec
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"unicode/utf8"
+)
+
+// MsgpackValidateOptions configures MsgpackValidate and MsgpackValidateBytes.
+//
+// The zero value checks that the input is a single well-formed msgpack value,
+// nested at most 1024 levels deep, with no limits on lengths.
+type MsgpackValidateOptions struct {
+	// MaxDepth is the maximum nesting depth of arrays and maps.
+	// If zero, it defaults to 1024.
+	MaxDepth int
+
+	// MaxContainerLen is the maximum number of elements of an array,
+	// or of entries of a map. If zero, there is no limit.
+	MaxContainerLen int
+
+	// MaxBytesLen is the maximum length of a str, bin or ext value.
+	// If zero, there is no limit.
+	MaxBytesLen int
+
+	// ValidateUTF8 checks that str values are valid UTF-8.
+	ValidateUTF8 bool
+
+	// Multiple allows a stream of values. Otherwise, the input must hold
+	// a single value, and any bytes following it are a violation.
+	Multiple bool
+}
+
+// MsgpackValidateError describes the first violation of the msgpack spec
+// (or of the MsgpackValidateOptions) found in the input.
+type MsgpackValidateError struct {
+	// Offset is the byte offset of the violation within the input.
+	Offset int64
+	// Reason describes the violation.
+	Reason string
+}
+
+func (e *MsgpackValidateError) Error() string {
+	return fmt.Sprintf("msgpack: offset %d: %s", e.Offset, e.Reason)
+}
+
+// MsgpackValidate checks that the msgpack input read from r is well-formed,
+// without decoding it into Go values (and allocating little memory, whatever the input).
+// It is meant to reject malformed or hostile input cheaply, before it is decoded.
+//
+// It reports violations including:
+//   - the reserved descriptor 0xc1
+//   - values, containers, strings, binary or extensions truncated by the end of the input
+//   - containers nested deeper, or lengths larger, than the configured limits
+//   - invalid UTF-8 in str values (if ValidateUTF8)
+//   - timestamp extensions (-1) whose data is malformed
+//   - bytes following the value (unless Multiple)
+//
+// The first violation is returned as a *MsgpackValidateError.
+// An error reading from r is returned as is.
+func MsgpackValidate(r io.Reader, opts *MsgpackValidateOptions) error {
+	br, ok := r.(*bufio.Reader)
+	if !ok {
+		br = bufio.NewReader(r)
+	}
+	z := msgpackValidator{r: br}
+	return z.run(opts)
+}
+
+// MsgpackValidateBytes is like MsgpackValidate, but checks the msgpack input in b.
+//
+// As the length of the input is known, a container which declares
+// more elements than the bytes which remain is reported without being walked.
+func MsgpackValidateBytes(b []byte, opts *MsgpackValidateOptions) error {
+	z := msgpackValidator{b: b}
+	return z.run(opts)
+}
+
+type msgpackValidator struct {
+	opts MsgpackValidateOptions
+	b    []byte        // input, if validating bytes
+	r    *bufio.Reader // input, if validating a reader
+	off  int64         // offset of the next byte
+	rerr error         // error reading from r, other than io.EOF
+	err  error
+}
+
+func (z *msgpackValidator) run(opts *MsgpackValidateOptions) error {
+	if opts != nil {
+		z.opts = *opts
+	}
+	if z.opts.MaxDepth <= 0 {
+		z.opts.MaxDepth = decDefMaxDepth
+	}
+	for n := 0; z.err == nil; n++ {
+		if len(z.peek(1)) == 0 {
+			if n == 0 && !z.opts.Multiple {
+				z.fail(z.off, "unexpected end of input: expected a value")
+			} else if z.rerr != nil {
+				z.err = z.rerr
+			}
+			break
+		}
+		if n > 0 && !z.opts.Multiple {
+			z.fail(z.off, "unexpected trailing bytes after value")
+			break
+		}
+		z.value(0)
+	}
+	return z.err
+}
+
+// value validates the value at the current offset, nested at the given depth.
+// On failure, it sets z.err.
+func (z *msgpackValidator) value(depth int) {
+	start := z.off
+	bd := z.peek(1)[0]
+	name, hl, n := msgpackDumpFormat(bd)
+	if name == "" {
+		z.fail(start, fmt.Sprintf("reserved descriptor 0x%02x", bd))
+		return
+	}
+	h := z.peek(1 + hl)
+	if len(h) < 1+hl {
+		z.fail(start, fmt.Sprintf("truncated %s: need %d header bytes, have %d", name, hl, len(h)-1))
+		return
+	}
+	h = h[1:]
+	desc := mpdesc(bd)
+	switch desc {
+	case "map", "array", "string|bytes", "bytes", "ext":
+	default:
+		z.discard(1 + hl)
+		return
+	}
+	ln, tag := msgpackDumpLen(bd, h, n)
+	z.discard(1 + hl)
+	if desc == "map" || desc == "array" {
+		z.container(start, depth, name, desc == "map", ln)
+		return
+	}
+	if z.opts.MaxBytesLen > 0 && ln > uint64(z.opts.MaxBytesLen) {
+		z.fail(start, fmt.Sprintf("%s length %d exceeds the limit of %d", name, ln, z.opts.MaxBytesLen))
+		return
+	}
+	if z.r == nil && ln > uint64(len(z.b)-int(z.off)) {
+		z.fail(start, fmt.Sprintf("truncated %s: need %d bytes, have %d", name, ln, len(z.b)-int(z.off)))
+		return
+	}
+	switch {
+	case desc == "ext" && tag == mpTimeExtTag:
+		z.timestamp(start, name, ln)
+	case desc == "string|bytes" && z.opts.ValidateUTF8:
+		z.utf8(start, name, ln)
+	default:
+		// discard in steps, as ln may not fit in an int
+		for have := uint64(0); have < ln; {
+			k := int(min(ln-have, math.MaxInt32))
+			m := z.discard(k)
+			if have += uint64(m); m < k {
+				z.fail(start, fmt.Sprintf("truncated %s: need %d bytes, have %d", name, ln, have))
+				return
+			}
+		}
+	}
+}
+
+// container validates the n elements (or entries, if isMap) of an array or map.
+func (z *msgpackValidator) container(start int64, depth int, name string, isMap bool, n uint64) {
+	if depth >= z.opts.MaxDepth {
+		z.fail(start, fmt.Sprintf("maximum depth of %d exceeded", z.opts.MaxDepth))
+		return
+	}
+	if z.opts.MaxContainerLen > 0 && n > uint64(z.opts.MaxContainerLen) {
+		z.fail(start, fmt.Sprintf("%s length %d exceeds the limit of %d", name, n, z.opts.MaxContainerLen))
+		return
+	}
+	if isMap {
+		n *= 2
+	}
+	// each element takes at least 1 byte
+	if z.r == nil && n > uint64(len(z.b)-int(z.off)) {
+		z.fail(start, fmt.Sprintf("truncated %s: %d items declared, but only %d bytes remain", name, n, len(z.b)-int(z.off)))
+		return
+	}
+	for j := uint64(0); j < n && z.err == nil; j++ {
+		if len(z.peek(1)) == 0 {
+			z.fail(z.off, fmt.Sprintf("truncated %s at offset %d: have %d of %d items", name, start, j, n))
+			return
+		}
+		z.value(depth + 1)
+	}
+}
+
+// timestamp validates the n bytes of data of a timestamp extension.
+func (z *msgpackValidator) timestamp(start int64, name string, n uint64) {
+	if n != 4 && n != 8 && n != 12 {
+		z.fail(start, fmt.Sprintf("malformed timestamp: %s data has length %d, not 4, 8 or 12", name, n))
+		return
+	}
+	v := z.peek(int(n))
+	if len(v) < int(n) {
+		z.fail(start, fmt.Sprintf("truncated %s: need %d bytes, have %d", name, n, len(v)))
+		return
+	}
+	var nsec uint64
+	switch n {
+	case 8:
+		nsec = bigen.Uint64(v) >> 34
+	case 12:
+		nsec = uint64(bigen.Uint32(v))
+	}
+	z.discard(int(n))
+	if nsec > 999999999 {
+		z.fail(start, fmt.Sprintf("malformed timestamp: nanoseconds %d exceed 999999999", nsec))
+	}
+}
+
+// utf8 validates that the n bytes of a str are valid UTF-8,
+// reading them in chunks (of the bufio.Reader's size) if reading from an io.Reader.
+func (z *msgpackValidator) utf8(start int64, name string, n uint64) {
+	for have := uint64(0); have < n; {
+		k := n - have
+		if z.r != nil && k > uint64(z.r.Size()) {
+			k = uint64(z.r.Size())
+		}
+		// k is within the input remaining, or the bufio.Reader's size, so fits in an int
+		v := z.peek(int(k))
+		if uint64(len(v)) < k {
+			z.fail(start, fmt.Sprintf("truncated %s: need %d bytes, have %d", name, n, have+uint64(len(v))))
+			return
+		}
+		// leave a rune split across chunks to the next chunk
+		if have+k < n {
+			for i := len(v) - 1; i >= 0 && i >= len(v)-utf8.UTFMax; i-- {
+				if utf8.RuneStart(v[i]) {
+					if !utf8.FullRune(v[i:]) {
+						v = v[:i]
+					}
+					break
+				}
+			}
+		}
+		if !utf8.Valid(v) {
+			z.fail(start, fmt.Sprintf("invalid UTF-8 in %s", name))
+			return
+		}
+		have += uint64(z.discard(len(v)))
+	}
+}
+
+// peek returns up to the next n bytes of input, without consuming them.
+// It returns fewer than n bytes only at the end of the input
+// (or on a read error, which is recorded).
+func (z *msgpackValidator) peek(n int) []byte {
+	if z.r == nil {
+		if rem := len(z.b) - int(z.off); n > rem {
+			n = rem
+		}
+		return z.b[z.off : int(z.off)+n]
+	}
+	v, err := z.r.Peek(n)
+	if err != nil && err != io.EOF && z.rerr == nil {
+		z.rerr = err
+	}
+	return v
+}
+
+// discard consumes up to the next n bytes of input, returning the number consumed.
+func (z *msgpackValidator) discard(n int) int {
+	if z.r == nil {
+		if rem := len(z.b) - int(z.off); n > rem {
+			n = rem
+		}
+	} else {
+		var err error
+		if n, err = z.r.Discard(n); err != nil && err != io.EOF && z.rerr == nil {
+			z.rerr = err
+		}
+	}
+	z.off += int64(n)
+	return n
+}
+
+// fail records the violation at offset i, unless a read error explains it.
+func (z *msgpackValidator) fail(i int64, reason string) {
+	if z.err != nil {
+		return
+	}
+	if z.rerr != nil {
+		z.err = z.rerr
+		return
+	}
+	z.err = &MsgpackValidateError{Offset: i, Reason: reason}
+}
@@ -33,9 +33,9 @@ var (
 type codecSelfer19780 struct{}
 
 func init() {
-	if GenVersion != 10 {
+	if GenVersion != 11 {
 		_, file, _, _ := runtime.Caller(0)
-		panic("codecgen version mismatch: current: 10, need " + strconv.FormatInt(int64(GenVersion), 10) + ". Re-generate file: " + file)
+		panic("codecgen version mismatch: current: 11, need " + strconv.FormatInt(int64(GenVersion), 10) + ". Re-generate file: " + file)
 	}
 	if false {
 		var _ byte = 0 // reference the types, but skip this branch at build/run time
@@ -219,66 +219,70 @@ func (x *stringUint64T) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(2)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapStart(2)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(2)
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapStart(2)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"S\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `S`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"S\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						r.EncodeSymbol(`S`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.U))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"U\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.U))
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `U`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"U\"")
+					} else {
+						r.EncodeSymbol(`U`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.U))
+					}
 				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					r.EncodeUint(uint64(x.U))
+					r.WriteMapEnd()
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
@@ -416,395 +420,399 @@ func (x *AnonInTestStruc) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(15)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapStart(15)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AS\"")
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(15)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AS`)
+					r.WriteMapStart(15)
 				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI16))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI16`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI16))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.AUi64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.AUi64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.ASslice == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AS\"")
 					} else {
-						h.encSlicestring(([]string)(x.ASslice), e)
+						r.EncodeSymbol(`AS`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"ASslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `ASslice`)
-				}
-				r.WriteMapElemValue()
-				if x.ASslice == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSlicestring(([]string)(x.ASslice), e)
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AI64slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSliceint64(([]int64)(x.AI64slice), e)
+						r.EncodeInt(int64(x.AI64))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AI64slice == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI64\"")
 					} else {
-						h.encSliceint64(([]int64)(x.AI64slice), e)
+						r.EncodeSymbol(`AI64`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AUi64slice == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						r.EncodeInt(int64(x.AI64))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AUi64slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						r.EncodeInt(int64(x.AI16))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AF64slice == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI16\"")
 					} else {
-						h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						r.EncodeSymbol(`AI16`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AF64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AF64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AF64slice == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						r.EncodeInt(int64(x.AI16))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AF32slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						r.EncodeUint(uint64(x.AUi64))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AF32slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AF32slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AF32slice == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AUi64\"")
 					} else {
-						h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						r.EncodeSymbol(`AUi64`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AMSU16 == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						r.EncodeUint(uint64(x.AUi64))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16`)
-				}
-				r.WriteMapElemValue()
-				if x.AMSU16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.ASslice == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.ASslice), e)
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy34 := &x.AI64arr0
-				if false {
-				} else {
-					h.encArray0int64((*[0]int64)(yy34), e)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64arr0\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI64arr0`)
-				}
-				r.WriteMapElemValue()
-				yy36 := &x.AI64arr0
-				if false {
-				} else {
-					h.encArray0int64((*[0]int64)(yy36), e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.A164slice0 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"ASslice\"")
 					} else {
-						h.encSliceint64(([]int64)(x.A164slice0), e)
+						r.EncodeSymbol(`ASslice`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"A164slice0\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `A164slice0`)
-				}
-				r.WriteMapElemValue()
-				if x.A164slice0 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if x.ASslice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSliceint64(([]int64)(x.A164slice0), e)
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.ASslice), e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AUi64sliceN == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AI64slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.AI64slice), e)
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64sliceN\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64sliceN`)
-				}
-				r.WriteMapElemValue()
-				if x.AUi64sliceN == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI64slice\"")
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						r.EncodeSymbol(`AI64slice`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AMSU16N == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if x.AI64slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.AI64slice), e)
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16N\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16N`)
-				}
-				r.WriteMapElemValue()
-				if x.AMSU16N == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AUi64slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AMSU16E == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AUi64slice\"")
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						r.EncodeSymbol(`AUi64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AUi64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AF64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AF64slice\"")
+					} else {
+						r.EncodeSymbol(`AF64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AF64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AF32slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AF32slice\"")
+					} else {
+						r.EncodeSymbol(`AF32slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AF32slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AMSU16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AMSU16\"")
+					} else {
+						r.EncodeSymbol(`AMSU16`)
+					}
+					r.WriteMapElemValue()
+					if x.AMSU16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy34 := &x.AI64arr0
+					if false {
+					} else {
+						h.encArray0int64((*[0]int64)(yy34), e)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16E\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16E`)
-				}
-				r.WriteMapElemValue()
-				if x.AMSU16E == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI64arr0\"")
+					} else {
+						r.EncodeSymbol(`AI64arr0`)
+					}
+					r.WriteMapElemValue()
+					yy36 := &x.AI64arr0
 					if false {
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						h.encArray0int64((*[0]int64)(yy36), e)
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.A164slice0 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.A164slice0), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A164slice0\"")
+					} else {
+						r.EncodeSymbol(`A164slice0`)
+					}
+					r.WriteMapElemValue()
+					if x.A164slice0 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.A164slice0), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AUi64sliceN == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AUi64sliceN\"")
+					} else {
+						r.EncodeSymbol(`AUi64sliceN`)
+					}
+					r.WriteMapElemValue()
+					if x.AUi64sliceN == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AMSU16N == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AMSU16N\"")
+					} else {
+						r.EncodeSymbol(`AMSU16N`)
+					}
+					r.WriteMapElemValue()
+					if x.AMSU16N == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AMSU16E == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AMSU16E\"")
+					} else {
+						r.EncodeSymbol(`AMSU16E`)
+					}
+					r.WriteMapElemValue()
+					if x.AMSU16E == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
@@ -1294,412 +1302,416 @@ func (x *testSimpleFields) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(17)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapStart(17)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(17)
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapStart(17)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"S\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `S`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"S\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						r.EncodeSymbol(`S`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I64))
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I64`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I64\"")
+					} else {
+						r.EncodeSymbol(`I64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I64))
+					}
 				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I8))
+					}
 				} else {
-					r.EncodeInt(int64(x.I64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I8`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui8))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui8`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui8))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeFloat64(float64(x.F64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"F64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `F64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeFloat64(float64(x.F64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeFloat32(float32(x.F32))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"F32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `F32`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeFloat32(float32(x.F32))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"B\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `B`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Sslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I8\"")
 					} else {
-						h.encSlicestring(([]string)(x.Sslice), e)
+						r.EncodeSymbol(`I8`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Sslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Sslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Sslice == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSlicestring(([]string)(x.Sslice), e)
+						r.EncodeInt(int64(x.I8))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.I16slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSliceint16(([]int16)(x.I16slice), e)
+						r.EncodeUint(uint64(x.Ui64))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I16slice`)
-				}
-				r.WriteMapElemValue()
-				if x.I16slice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui64\"")
+					} else {
+						r.EncodeSymbol(`Ui64`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSliceint16(([]int16)(x.I16slice), e)
+						r.EncodeUint(uint64(x.Ui64))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Ui64slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						r.EncodeUint(uint64(x.Ui8))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.Ui64slice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui8\"")
+					} else {
+						r.EncodeSymbol(`Ui8`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						r.EncodeUint(uint64(x.Ui8))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Ui8slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						r.EncodeFloat64(float64(x.F64))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui8slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui8slice`)
-				}
-				r.WriteMapElemValue()
-				if x.Ui8slice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"F64\"")
+					} else {
+						r.EncodeSymbol(`F64`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						r.EncodeFloat64(float64(x.F64))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Bslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicebool(([]bool)(x.Bslice), e)
+						r.EncodeFloat32(float32(x.F32))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Bslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Bslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Bslice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"F32\"")
+					} else {
+						r.EncodeSymbol(`F32`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSlicebool(([]bool)(x.Bslice), e)
+						r.EncodeFloat32(float32(x.F32))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Iptrslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						r.EncodeBool(bool(x.B))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Iptrslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Iptrslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Iptrslice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
+					} else {
+						r.EncodeSymbol(`B`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						r.EncodeBool(bool(x.B))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.WrapSliceInt64 == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceInt64.CodecEncodeSelf(e)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"WrapSliceInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `WrapSliceInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.WrapSliceInt64 == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceInt64.CodecEncodeSelf(e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.WrapSliceString == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceString.CodecEncodeSelf(e)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"WrapSliceString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `WrapSliceString`)
-				}
-				r.WriteMapElemValue()
-				if x.WrapSliceString == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Sslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.Sslice), e)
+						}
+					}
 				} else {
-					x.WrapSliceString.CodecEncodeSelf(e)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Sslice\"")
+					} else {
+						r.EncodeSymbol(`Sslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Sslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.Sslice), e)
+						}
+					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Msi64 == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.I16slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint16(([]int16)(x.I16slice), e)
+						}
+					}
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I16slice\"")
+					} else {
+						r.EncodeSymbol(`I16slice`)
+					}
+					r.WriteMapElemValue()
+					if x.I16slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						if false {
+						} else {
+							h.encSliceint16(([]int16)(x.I16slice), e)
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Msi64\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Ui64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Msi64`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui64slice\"")
+					} else {
+						r.EncodeSymbol(`Ui64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.Ui64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if x.Msi64 == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Ui8slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						}
+					}
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui8slice\"")
+					} else {
+						r.EncodeSymbol(`Ui8slice`)
+					}
+					r.WriteMapElemValue()
+					if x.Ui8slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
-		}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Bslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicebool(([]bool)(x.Bslice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Bslice\"")
+					} else {
+						r.EncodeSymbol(`Bslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Bslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicebool(([]bool)(x.Bslice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Iptrslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Iptrslice\"")
+					} else {
+						r.EncodeSymbol(`Iptrslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Iptrslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.WrapSliceInt64 == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceInt64.CodecEncodeSelf(e)
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"WrapSliceInt64\"")
+					} else {
+						r.EncodeSymbol(`WrapSliceInt64`)
+					}
+					r.WriteMapElemValue()
+					if x.WrapSliceInt64 == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceInt64.CodecEncodeSelf(e)
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.WrapSliceString == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceString.CodecEncodeSelf(e)
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"WrapSliceString\"")
+					} else {
+						r.EncodeSymbol(`WrapSliceString`)
+					}
+					r.WriteMapElemValue()
+					if x.WrapSliceString == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceString.CodecEncodeSelf(e)
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Msi64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Msi64\"")
+					} else {
+						r.EncodeSymbol(`Msi64`)
+					}
+					r.WriteMapElemValue()
+					if x.Msi64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
+		}
 	}
 }
 
@@ -2208,1171 +2220,3783 @@ func (x *TestStrucCommon) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(49)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapStart(49)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(49)
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapStart(49)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"S\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `S`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"S\"")
+					} else {
+						r.EncodeSymbol(`S`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I64))
+					}
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I64\"")
+					} else {
+						r.EncodeSymbol(`I64`)
+					}
+					r.WriteMapElemValue()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						r.EncodeInt(int64(x.I64))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I32))
+					}
 				} else {
-					r.EncodeInt(int64(x.I64))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I32\"")
+					} else {
+						r.EncodeSymbol(`I32`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I32))
+					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I16))
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I64`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I16\"")
+					} else {
+						r.EncodeSymbol(`I16`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I16))
+					}
 				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I8))
+					}
 				} else {
-					r.EncodeInt(int64(x.I64))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I8\"")
+					} else {
+						r.EncodeSymbol(`I8`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I8))
+					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I64n))
+					}
 				} else {
-					r.EncodeInt(int64(x.I32))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I64n\"")
+					} else {
+						r.EncodeSymbol(`I64n`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I64n))
+					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I32\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I32n))
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I32`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I32n\"")
+					} else {
+						r.EncodeSymbol(`I32n`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I32n))
+					}
 				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I16n))
+					}
 				} else {
-					r.EncodeInt(int64(x.I32))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I16n\"")
+					} else {
+						r.EncodeSymbol(`I16n`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I16n))
+					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I16))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I16`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I16))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I8`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I64n))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64n\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I64n`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I64n))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I32n))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I32n\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I32n`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I32n))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I16n))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16n\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I16n`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I16n))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8n))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I8n\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I8n`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8n))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui32))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui32`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui32))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui16))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui16`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui16))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui8))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui8`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui8))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeFloat64(float64(x.F64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"F64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `F64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeFloat64(float64(x.F64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeFloat32(float32(x.F32))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"F32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `F32`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeFloat32(float32(x.F32))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"B\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `B`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.By))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"By\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `By`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.By))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Sslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicestring(([]string)(x.Sslice), e)
+						r.EncodeInt(int64(x.I8n))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Sslice\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Sslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Sslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I8n\"")
 					} else {
-						h.encSlicestring(([]string)(x.Sslice), e)
+						r.EncodeSymbol(`I8n`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.I64slice == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSliceint64(([]int64)(x.I64slice), e)
+						r.EncodeInt(int64(x.I8n))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.I64slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSliceint64(([]int64)(x.I64slice), e)
+						r.EncodeUint(uint64(x.Ui64))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.I16slice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui64\"")
+					} else {
+						r.EncodeSymbol(`Ui64`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSliceint16(([]int16)(x.I16slice), e)
+						r.EncodeUint(uint64(x.Ui64))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I16slice`)
-				}
-				r.WriteMapElemValue()
-				if x.I16slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSliceint16(([]int16)(x.I16slice), e)
+						r.EncodeUint(uint64(x.Ui32))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Ui64slice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui32\"")
+					} else {
+						r.EncodeSymbol(`Ui32`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						r.EncodeUint(uint64(x.Ui32))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.Ui64slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						r.EncodeUint(uint64(x.Ui16))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Ui8slice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui16\"")
+					} else {
+						r.EncodeSymbol(`Ui16`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						r.EncodeUint(uint64(x.Ui16))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui8slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui8slice`)
-				}
-				r.WriteMapElemValue()
-				if x.Ui8slice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						r.EncodeUint(uint64(x.Ui8))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Bslice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui8\"")
+					} else {
+						r.EncodeSymbol(`Ui8`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSlicebool(([]bool)(x.Bslice), e)
+						r.EncodeUint(uint64(x.Ui8))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Bslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Bslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Bslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicebool(([]bool)(x.Bslice), e)
+						r.EncodeFloat64(float64(x.F64))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Byslice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"F64\"")
+					} else {
+						r.EncodeSymbol(`F64`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Byslice))
+						r.EncodeFloat64(float64(x.F64))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Byslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Byslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Byslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Byslice))
+						r.EncodeFloat32(float32(x.F32))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Iptrslice == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"F32\"")
+					} else {
+						r.EncodeSymbol(`F32`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						r.EncodeFloat32(float32(x.F32))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Iptrslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Iptrslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Iptrslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						r.EncodeBool(bool(x.B))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.WrapSliceInt64 == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceInt64.CodecEncodeSelf(e)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"WrapSliceInt64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `WrapSliceInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.WrapSliceInt64 == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceInt64.CodecEncodeSelf(e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.WrapSliceString == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceString.CodecEncodeSelf(e)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"WrapSliceString\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `WrapSliceString`)
-				}
-				r.WriteMapElemValue()
-				if x.WrapSliceString == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceString.CodecEncodeSelf(e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Msi64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
 					} else {
-						h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						r.EncodeSymbol(`B`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Msi64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Msi64`)
-				}
-				r.WriteMapElemValue()
-				if x.Msi64 == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						r.EncodeBool(bool(x.B))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy88 := &x.Simplef
-				yy88.CodecEncodeSelf(e)
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Simplef\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Simplef`)
-				}
-				r.WriteMapElemValue()
-				yy90 := &x.Simplef
-				yy90.CodecEncodeSelf(e)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.SstrUi64T == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+						r.EncodeUint(uint64(x.By))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"SstrUi64T\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `SstrUi64T`)
-				}
-				r.WriteMapElemValue()
-				if x.SstrUi64T == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"By\"")
 					} else {
-						h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+						r.EncodeSymbol(`By`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+					r.WriteMapElemValue()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						r.EncodeUint(uint64(x.By))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AS\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AS`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Sslice == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.Sslice), e)
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI16))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI16`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI16))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.AUi64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64`)
-				}
-				r.WriteMapElemValue()
-				if false {
 				} else {
-					r.EncodeUint(uint64(x.AUi64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.ASslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Sslice\"")
 					} else {
-						h.encSlicestring(([]string)(x.ASslice), e)
+						r.EncodeSymbol(`Sslice`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"ASslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `ASslice`)
-				}
-				r.WriteMapElemValue()
-				if x.ASslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if x.Sslice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSlicestring(([]string)(x.ASslice), e)
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.Sslice), e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AI64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.I64slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSliceint64(([]int64)(x.AI64slice), e)
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.I64slice), e)
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64slice\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AI64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I64slice\"")
 					} else {
-						h.encSliceint64(([]int64)(x.AI64slice), e)
+						r.EncodeSymbol(`I64slice`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AUi64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if x.I64slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.I64slice), e)
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AUi64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.I16slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						if false {
+						} else {
+							h.encSliceint16(([]int16)(x.I16slice), e)
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AF64slice == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I16slice\"")
 					} else {
-						h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						r.EncodeSymbol(`I16slice`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AF64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AF64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AF64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if x.I16slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						if false {
+						} else {
+							h.encSliceint16(([]int16)(x.I16slice), e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AF32slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Ui64slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AF32slice\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AF32slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AF32slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui64slice\"")
 					} else {
-						h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						r.EncodeSymbol(`Ui64slice`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AMSU16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if x.Ui64slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16`)
-				}
-				r.WriteMapElemValue()
-				if x.AMSU16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Ui8slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy126 := &x.AI64arr0
-				if false {
-				} else {
-					h.encArray0int64((*[0]int64)(yy126), e)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64arr0\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI64arr0`)
-				}
-				r.WriteMapElemValue()
-				yy128 := &x.AI64arr0
-				if false {
-				} else {
-					h.encArray0int64((*[0]int64)(yy128), e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.A164slice0 == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui8slice\"")
 					} else {
-						h.encSliceint64(([]int64)(x.A164slice0), e)
+						r.EncodeSymbol(`Ui8slice`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"A164slice0\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `A164slice0`)
-				}
-				r.WriteMapElemValue()
-				if x.A164slice0 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if x.Ui8slice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSliceint64(([]int64)(x.A164slice0), e)
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AUi64sliceN == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Bslice == nil {
+						r.EncodeNil()
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						if false {
+						} else {
+							h.encSlicebool(([]bool)(x.Bslice), e)
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64sliceN\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64sliceN`)
-				}
-				r.WriteMapElemValue()
-				if x.AUi64sliceN == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Bslice\"")
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						r.EncodeSymbol(`Bslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Bslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicebool(([]bool)(x.Bslice), e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AMSU16N == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Byslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Byslice))
+						}
+					}
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Byslice\"")
+					} else {
+						r.EncodeSymbol(`Byslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Byslice == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Byslice))
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16N\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Iptrslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16N`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Iptrslice\"")
+					} else {
+						r.EncodeSymbol(`Iptrslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Iptrslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if x.AMSU16N == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.WrapSliceInt64 == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceInt64.CodecEncodeSelf(e)
+					}
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"WrapSliceInt64\"")
+					} else {
+						r.EncodeSymbol(`WrapSliceInt64`)
+					}
+					r.WriteMapElemValue()
+					if x.WrapSliceInt64 == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						x.WrapSliceInt64.CodecEncodeSelf(e)
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AMSU16E == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.WrapSliceString == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceString.CodecEncodeSelf(e)
+					}
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"WrapSliceString\"")
+					} else {
+						r.EncodeSymbol(`WrapSliceString`)
+					}
+					r.WriteMapElemValue()
+					if x.WrapSliceString == nil {
+						r.EncodeNil()
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						x.WrapSliceString.CodecEncodeSelf(e)
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16E\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Msi64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16E`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Msi64\"")
+					} else {
+						r.EncodeSymbol(`Msi64`)
+					}
+					r.WriteMapElemValue()
+					if x.Msi64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if x.AMSU16E == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy88 := &x.Simplef
+					yy88.CodecEncodeSelf(e)
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Simplef\"")
 					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						r.EncodeSymbol(`Simplef`)
 					}
+					r.WriteMapElemValue()
+					yy90 := &x.Simplef
+					yy90.CodecEncodeSelf(e)
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy143 := &x.NotAnon
-				yy143.CodecEncodeSelf(e)
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"NotAnon\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.SstrUi64T == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `NotAnon`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"SstrUi64T\"")
+					} else {
+						r.EncodeSymbol(`SstrUi64T`)
+					}
+					r.WriteMapElemValue()
+					if x.SstrUi64T == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				yy145 := &x.NotAnon
-				yy145.CodecEncodeSelf(e)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Nmap == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encMapstringbool((map[string]bool)(x.Nmap), e)
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nmap\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Nmap`)
-				}
-				r.WriteMapElemValue()
-				if x.Nmap == nil {
-					r.EncodeNil()
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AS\"")
+					} else {
+						r.EncodeSymbol(`AS`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encMapstringbool((map[string]bool)(x.Nmap), e)
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Nslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Nslice))
+						r.EncodeInt(int64(x.AI64))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nslice\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Nslice`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI64\"")
+					} else {
+						r.EncodeSymbol(`AI64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.AI64))
+					}
 				}
-				r.WriteMapElemValue()
-				if x.Nslice == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.AI16))
+					}
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI16\"")
+					} else {
+						r.EncodeSymbol(`AI16`)
+					}
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Nslice))
+						r.EncodeInt(int64(x.AI16))
 					}
 				}
-			}
-			var yyn153 bool
-			if x.Nint64 == nil {
-				yyn153 = true
-				goto LABEL153
-			}
-		LABEL153:
-			if yyr2 || yy2arr2 {
-				if yyn153 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.AUi64))
+					}
 				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AUi64\"")
+					} else {
+						r.EncodeSymbol(`AUi64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.AUi64))
+					}
+				}
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.Nint64 == nil {
+					if x.ASslice == nil {
 						r.EncodeNil()
 					} else {
-						yy154 := *x.Nint64
 						if false {
 						} else {
-							r.EncodeInt(int64(yy154))
+							h.encSlicestring(([]string)(x.ASslice), e)
 						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nint64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Nint64`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"ASslice\"")
+					} else {
+						r.EncodeSymbol(`ASslice`)
+					}
+					r.WriteMapElemValue()
+					if x.ASslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.ASslice), e)
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if yyn153 {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AI64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.AI64slice), e)
+						}
+					}
 				} else {
-					if x.Nint64 == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI64slice\"")
+					} else {
+						r.EncodeSymbol(`AI64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AI64slice == nil {
 						r.EncodeNil()
 					} else {
-						yy156 := *x.Nint64
 						if false {
 						} else {
-							r.EncodeInt(int64(yy156))
+							h.encSliceint64(([]int64)(x.AI64slice), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
-		}
-	}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AUi64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AUi64slice\"")
+					} else {
+						r.EncodeSymbol(`AUi64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AUi64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AF64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AF64slice\"")
+					} else {
+						r.EncodeSymbol(`AF64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AF64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AF32slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AF32slice\"")
+					} else {
+						r.EncodeSymbol(`AF32slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AF32slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AMSU16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AMSU16\"")
+					} else {
+						r.EncodeSymbol(`AMSU16`)
+					}
+					r.WriteMapElemValue()
+					if x.AMSU16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy126 := &x.AI64arr0
+					if false {
+					} else {
+						h.encArray0int64((*[0]int64)(yy126), e)
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI64arr0\"")
+					} else {
+						r.EncodeSymbol(`AI64arr0`)
+					}
+					r.WriteMapElemValue()
+					yy128 := &x.AI64arr0
+					if false {
+					} else {
+						h.encArray0int64((*[0]int64)(yy128), e)
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.A164slice0 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.A164slice0), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A164slice0\"")
+					} else {
+						r.EncodeSymbol(`A164slice0`)
+					}
+					r.WriteMapElemValue()
+					if x.A164slice0 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.A164slice0), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AUi64sliceN == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AUi64sliceN\"")
+					} else {
+						r.EncodeSymbol(`AUi64sliceN`)
+					}
+					r.WriteMapElemValue()
+					if x.AUi64sliceN == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AMSU16N == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AMSU16N\"")
+					} else {
+						r.EncodeSymbol(`AMSU16N`)
+					}
+					r.WriteMapElemValue()
+					if x.AMSU16N == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AMSU16E == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AMSU16E\"")
+					} else {
+						r.EncodeSymbol(`AMSU16E`)
+					}
+					r.WriteMapElemValue()
+					if x.AMSU16E == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy143 := &x.NotAnon
+					yy143.CodecEncodeSelf(e)
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"NotAnon\"")
+					} else {
+						r.EncodeSymbol(`NotAnon`)
+					}
+					r.WriteMapElemValue()
+					yy145 := &x.NotAnon
+					yy145.CodecEncodeSelf(e)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Nmap == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringbool((map[string]bool)(x.Nmap), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Nmap\"")
+					} else {
+						r.EncodeSymbol(`Nmap`)
+					}
+					r.WriteMapElemValue()
+					if x.Nmap == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringbool((map[string]bool)(x.Nmap), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Nslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Nslice))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Nslice\"")
+					} else {
+						r.EncodeSymbol(`Nslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Nslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Nslice))
+						}
+					}
+				}
+				var yyn153 bool
+				if x.Nint64 == nil {
+					yyn153 = true
+					goto LABEL153
+				}
+			LABEL153:
+				if yyr2 || yy2arr2 {
+					if yyn153 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.Nint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy154 := *x.Nint64
+							if false {
+							} else {
+								r.EncodeInt(int64(yy154))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Nint64\"")
+					} else {
+						r.EncodeSymbol(`Nint64`)
+					}
+					r.WriteMapElemValue()
+					if yyn153 {
+						r.EncodeNil()
+					} else {
+						if x.Nint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy156 := *x.Nint64
+							if false {
+							} else {
+								r.EncodeInt(int64(yy156))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
+		}
+	}
+}
+
+func (x *TestStrucCommon) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
+			} else {
+				x.codecDecodeSelfFromMap(yyl2, d)
+			}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl2, d)
+			}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
+	}
+}
+
+func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "S":
+			if r.TryDecodeAsNil() {
+				x.S = ""
+			} else {
+				x.S = (string)(r.DecodeString())
+			}
+		case "I64":
+			if r.TryDecodeAsNil() {
+				x.I64 = 0
+			} else {
+				x.I64 = (int64)(r.DecodeInt64())
+			}
+		case "I32":
+			if r.TryDecodeAsNil() {
+				x.I32 = 0
+			} else {
+				x.I32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
+			}
+		case "I16":
+			if r.TryDecodeAsNil() {
+				x.I16 = 0
+			} else {
+				x.I16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+			}
+		case "I8":
+			if r.TryDecodeAsNil() {
+				x.I8 = 0
+			} else {
+				x.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
+			}
+		case "I64n":
+			if r.TryDecodeAsNil() {
+				x.I64n = 0
+			} else {
+				x.I64n = (int64)(r.DecodeInt64())
+			}
+		case "I32n":
+			if r.TryDecodeAsNil() {
+				x.I32n = 0
+			} else {
+				x.I32n = (int32)(z.C.IntV(r.DecodeInt64(), 32))
+			}
+		case "I16n":
+			if r.TryDecodeAsNil() {
+				x.I16n = 0
+			} else {
+				x.I16n = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+			}
+		case "I8n":
+			if r.TryDecodeAsNil() {
+				x.I8n = 0
+			} else {
+				x.I8n = (int8)(z.C.IntV(r.DecodeInt64(), 8))
+			}
+		case "Ui64":
+			if r.TryDecodeAsNil() {
+				x.Ui64 = 0
+			} else {
+				x.Ui64 = (uint64)(r.DecodeUint64())
+			}
+		case "Ui32":
+			if r.TryDecodeAsNil() {
+				x.Ui32 = 0
+			} else {
+				x.Ui32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+			}
+		case "Ui16":
+			if r.TryDecodeAsNil() {
+				x.Ui16 = 0
+			} else {
+				x.Ui16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
+			}
+		case "Ui8":
+			if r.TryDecodeAsNil() {
+				x.Ui8 = 0
+			} else {
+				x.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+			}
+		case "F64":
+			if r.TryDecodeAsNil() {
+				x.F64 = 0
+			} else {
+				x.F64 = (float64)(r.DecodeFloat64())
+			}
+		case "F32":
+			if r.TryDecodeAsNil() {
+				x.F32 = 0
+			} else {
+				x.F32 = (float32)(r.DecodeFloat32As64())
+			}
+		case "B":
+			if r.TryDecodeAsNil() {
+				x.B = false
+			} else {
+				x.B = (bool)(r.DecodeBool())
+			}
+		case "By":
+			if r.TryDecodeAsNil() {
+				x.By = 0
+			} else {
+				x.By = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+			}
+		case "Sslice":
+			if r.TryDecodeAsNil() {
+				x.Sslice = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicestring((*[]string)(&x.Sslice), d)
+				}
+			}
+		case "I64slice":
+			if r.TryDecodeAsNil() {
+				x.I64slice = nil
+			} else {
+				if false {
+				} else {
+					h.decSliceint64((*[]int64)(&x.I64slice), d)
+				}
+			}
+		case "I16slice":
+			if r.TryDecodeAsNil() {
+				x.I16slice = nil
+			} else {
+				if false {
+				} else {
+					h.decSliceint16((*[]int16)(&x.I16slice), d)
+				}
+			}
+		case "Ui64slice":
+			if r.TryDecodeAsNil() {
+				x.Ui64slice = nil
+			} else {
+				if false {
+				} else {
+					h.decSliceuint64((*[]uint64)(&x.Ui64slice), d)
+				}
+			}
+		case "Ui8slice":
+			if r.TryDecodeAsNil() {
+				x.Ui8slice = nil
+			} else {
+				if false {
+				} else {
+					x.Ui8slice = r.DecodeBytes(([]byte)(x.Ui8slice), false)
+				}
+			}
+		case "Bslice":
+			if r.TryDecodeAsNil() {
+				x.Bslice = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicebool((*[]bool)(&x.Bslice), d)
+				}
+			}
+		case "Byslice":
+			if r.TryDecodeAsNil() {
+				x.Byslice = nil
+			} else {
+				if false {
+				} else {
+					x.Byslice = r.DecodeBytes(([]byte)(x.Byslice), false)
+				}
+			}
+		case "Iptrslice":
+			if r.TryDecodeAsNil() {
+				x.Iptrslice = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicePtrtoint64((*[]*int64)(&x.Iptrslice), d)
+				}
+			}
+		case "WrapSliceInt64":
+			if r.TryDecodeAsNil() {
+				x.WrapSliceInt64 = nil
+			} else {
+				x.WrapSliceInt64.CodecDecodeSelf(d)
+			}
+		case "WrapSliceString":
+			if r.TryDecodeAsNil() {
+				x.WrapSliceString = nil
+			} else {
+				x.WrapSliceString.CodecDecodeSelf(d)
+			}
+		case "Msi64":
+			if r.TryDecodeAsNil() {
+				x.Msi64 = nil
+			} else {
+				if false {
+				} else {
+					h.decMapstringint64((*map[string]int64)(&x.Msi64), d)
+				}
+			}
+		case "Simplef":
+			if r.TryDecodeAsNil() {
+				x.Simplef = testSimpleFields{}
+			} else {
+				x.Simplef.CodecDecodeSelf(d)
+			}
+		case "SstrUi64T":
+			if r.TryDecodeAsNil() {
+				x.SstrUi64T = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicestringUint64T((*[]stringUint64T)(&x.SstrUi64T), d)
+				}
+			}
+		case "AS":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AS = ""
+			} else {
+				x.AS = (string)(r.DecodeString())
+			}
+		case "AI64":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AI64 = 0
+			} else {
+				x.AI64 = (int64)(r.DecodeInt64())
+			}
+		case "AI16":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AI16 = 0
+			} else {
+				x.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+			}
+		case "AUi64":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AUi64 = 0
+			} else {
+				x.AUi64 = (uint64)(r.DecodeUint64())
+			}
+		case "ASslice":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.ASslice = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicestring((*[]string)(&x.ASslice), d)
+				}
+			}
+		case "AI64slice":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AI64slice = nil
+			} else {
+				if false {
+				} else {
+					h.decSliceint64((*[]int64)(&x.AI64slice), d)
+				}
+			}
+		case "AUi64slice":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AUi64slice = nil
+			} else {
+				if false {
+				} else {
+					h.decSliceuint64((*[]uint64)(&x.AUi64slice), d)
+				}
+			}
+		case "AF64slice":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AF64slice = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicefloat64((*[]float64)(&x.AF64slice), d)
+				}
+			}
+		case "AF32slice":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AF32slice = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicefloat32((*[]float32)(&x.AF32slice), d)
+				}
+			}
+		case "AMSU16":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AMSU16 = nil
+			} else {
+				if false {
+				} else {
+					h.decMapstringuint16((*map[string]uint16)(&x.AMSU16), d)
+				}
+			}
+		case "AI64arr0":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AI64arr0 = [0]int64{}
+			} else {
+				if false {
+				} else {
+					h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
+				}
+			}
+		case "A164slice0":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.A164slice0 = nil
+			} else {
+				if false {
+				} else {
+					h.decSliceint64((*[]int64)(&x.A164slice0), d)
+				}
+			}
+		case "AUi64sliceN":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AUi64sliceN = nil
+			} else {
+				if false {
+				} else {
+					h.decSliceuint64((*[]uint64)(&x.AUi64sliceN), d)
+				}
+			}
+		case "AMSU16N":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AMSU16N = nil
+			} else {
+				if false {
+				} else {
+					h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
+				}
+			}
+		case "AMSU16E":
+			if r.TryDecodeAsNil() {
+				x.AnonInTestStruc.AMSU16E = nil
+			} else {
+				if false {
+				} else {
+					h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
+				}
+			}
+		case "NotAnon":
+			if r.TryDecodeAsNil() {
+				x.NotAnon = AnonInTestStruc{}
+			} else {
+				x.NotAnon.CodecDecodeSelf(d)
+			}
+		case "Nmap":
+			if r.TryDecodeAsNil() {
+				x.Nmap = nil
+			} else {
+				if false {
+				} else {
+					h.decMapstringbool((*map[string]bool)(&x.Nmap), d)
+				}
+			}
+		case "Nslice":
+			if r.TryDecodeAsNil() {
+				x.Nslice = nil
+			} else {
+				if false {
+				} else {
+					x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
+				}
+			}
+		case "Nint64":
+			if r.TryDecodeAsNil() {
+				if true && x.Nint64 != nil {
+					x.Nint64 = nil
+				}
+			} else {
+				if x.Nint64 == nil {
+					x.Nint64 = new(int64)
+				}
+
+				if false {
+				} else {
+					*x.Nint64 = (int64)(r.DecodeInt64())
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *TestStrucCommon) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj77 int
+	var yyb77 bool
+	var yyhl77 bool = l >= 0
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.S = ""
+	} else {
+		x.S = (string)(r.DecodeString())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I64 = 0
+	} else {
+		x.I64 = (int64)(r.DecodeInt64())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I32 = 0
+	} else {
+		x.I32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I16 = 0
+	} else {
+		x.I16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I8 = 0
+	} else {
+		x.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I64n = 0
+	} else {
+		x.I64n = (int64)(r.DecodeInt64())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I32n = 0
+	} else {
+		x.I32n = (int32)(z.C.IntV(r.DecodeInt64(), 32))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I16n = 0
+	} else {
+		x.I16n = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I8n = 0
+	} else {
+		x.I8n = (int8)(z.C.IntV(r.DecodeInt64(), 8))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Ui64 = 0
+	} else {
+		x.Ui64 = (uint64)(r.DecodeUint64())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Ui32 = 0
+	} else {
+		x.Ui32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Ui16 = 0
+	} else {
+		x.Ui16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Ui8 = 0
+	} else {
+		x.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.F64 = 0
+	} else {
+		x.F64 = (float64)(r.DecodeFloat64())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.F32 = 0
+	} else {
+		x.F32 = (float32)(r.DecodeFloat32As64())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.B = false
+	} else {
+		x.B = (bool)(r.DecodeBool())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.By = 0
+	} else {
+		x.By = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Sslice = nil
+	} else {
+		if false {
+		} else {
+			h.decSlicestring((*[]string)(&x.Sslice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I64slice = nil
+	} else {
+		if false {
+		} else {
+			h.decSliceint64((*[]int64)(&x.I64slice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I16slice = nil
+	} else {
+		if false {
+		} else {
+			h.decSliceint16((*[]int16)(&x.I16slice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Ui64slice = nil
+	} else {
+		if false {
+		} else {
+			h.decSliceuint64((*[]uint64)(&x.Ui64slice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Ui8slice = nil
+	} else {
+		if false {
+		} else {
+			x.Ui8slice = r.DecodeBytes(([]byte)(x.Ui8slice), false)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Bslice = nil
+	} else {
+		if false {
+		} else {
+			h.decSlicebool((*[]bool)(&x.Bslice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Byslice = nil
+	} else {
+		if false {
+		} else {
+			x.Byslice = r.DecodeBytes(([]byte)(x.Byslice), false)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Iptrslice = nil
+	} else {
+		if false {
+		} else {
+			h.decSlicePtrtoint64((*[]*int64)(&x.Iptrslice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.WrapSliceInt64 = nil
+	} else {
+		x.WrapSliceInt64.CodecDecodeSelf(d)
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.WrapSliceString = nil
+	} else {
+		x.WrapSliceString.CodecDecodeSelf(d)
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Msi64 = nil
+	} else {
+		if false {
+		} else {
+			h.decMapstringint64((*map[string]int64)(&x.Msi64), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Simplef = testSimpleFields{}
+	} else {
+		x.Simplef.CodecDecodeSelf(d)
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.SstrUi64T = nil
+	} else {
+		if false {
+		} else {
+			h.decSlicestringUint64T((*[]stringUint64T)(&x.SstrUi64T), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AS = ""
+	} else {
+		x.AS = (string)(r.DecodeString())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AI64 = 0
+	} else {
+		x.AI64 = (int64)(r.DecodeInt64())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AI16 = 0
+	} else {
+		x.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AUi64 = 0
+	} else {
+		x.AUi64 = (uint64)(r.DecodeUint64())
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.ASslice = nil
+	} else {
+		if false {
+		} else {
+			h.decSlicestring((*[]string)(&x.ASslice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AI64slice = nil
+	} else {
+		if false {
+		} else {
+			h.decSliceint64((*[]int64)(&x.AI64slice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AUi64slice = nil
+	} else {
+		if false {
+		} else {
+			h.decSliceuint64((*[]uint64)(&x.AUi64slice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AF64slice = nil
+	} else {
+		if false {
+		} else {
+			h.decSlicefloat64((*[]float64)(&x.AF64slice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AF32slice = nil
+	} else {
+		if false {
+		} else {
+			h.decSlicefloat32((*[]float32)(&x.AF32slice), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AMSU16 = nil
+	} else {
+		if false {
+		} else {
+			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AI64arr0 = [0]int64{}
+	} else {
+		if false {
+		} else {
+			h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.A164slice0 = nil
+	} else {
+		if false {
+		} else {
+			h.decSliceint64((*[]int64)(&x.A164slice0), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AUi64sliceN = nil
+	} else {
+		if false {
+		} else {
+			h.decSliceuint64((*[]uint64)(&x.AUi64sliceN), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AMSU16N = nil
+	} else {
+		if false {
+		} else {
+			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.AnonInTestStruc.AMSU16E = nil
+	} else {
+		if false {
+		} else {
+			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.NotAnon = AnonInTestStruc{}
+	} else {
+		x.NotAnon.CodecDecodeSelf(d)
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Nmap = nil
+	} else {
+		if false {
+		} else {
+			h.decMapstringbool((*map[string]bool)(&x.Nmap), d)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Nslice = nil
+	} else {
+		if false {
+		} else {
+			x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
+		}
+	}
+	yyj77++
+	if yyhl77 {
+		yyb77 = yyj77 > l
+	} else {
+		yyb77 = r.CheckBreak()
+	}
+	if yyb77 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		if true && x.Nint64 != nil {
+			x.Nint64 = nil
+		}
+	} else {
+		if x.Nint64 == nil {
+			x.Nint64 = new(int64)
+		}
+
+		if false {
+		} else {
+			*x.Nint64 = (int64)(r.DecodeInt64())
+		}
+	}
+	for {
+		yyj77++
+		if yyhl77 {
+			yyb77 = yyj77 > l
+		} else {
+			yyb77 = r.CheckBreak()
+		}
+		if yyb77 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj77-1, "")
+	}
+	r.ReadArrayEnd()
+}
+
+func (x *TestStruc) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(53)
+				} else {
+					r.WriteMapStart(53)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"S\"")
+					} else {
+						r.EncodeSymbol(`S`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I64))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I64\"")
+					} else {
+						r.EncodeSymbol(`I64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I64))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I32))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I32\"")
+					} else {
+						r.EncodeSymbol(`I32`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I32))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I16))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I16\"")
+					} else {
+						r.EncodeSymbol(`I16`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I16))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I8))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I8\"")
+					} else {
+						r.EncodeSymbol(`I8`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I8))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I64n))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I64n\"")
+					} else {
+						r.EncodeSymbol(`I64n`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I64n))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I32n))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I32n\"")
+					} else {
+						r.EncodeSymbol(`I32n`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I32n))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I16n))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I16n\"")
+					} else {
+						r.EncodeSymbol(`I16n`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I16n))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I8n))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I8n\"")
+					} else {
+						r.EncodeSymbol(`I8n`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I8n))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.Ui64))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui64\"")
+					} else {
+						r.EncodeSymbol(`Ui64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.Ui64))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.Ui32))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui32\"")
+					} else {
+						r.EncodeSymbol(`Ui32`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.Ui32))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.Ui16))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui16\"")
+					} else {
+						r.EncodeSymbol(`Ui16`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.Ui16))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.Ui8))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui8\"")
+					} else {
+						r.EncodeSymbol(`Ui8`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.Ui8))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeFloat64(float64(x.F64))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"F64\"")
+					} else {
+						r.EncodeSymbol(`F64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeFloat64(float64(x.F64))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeFloat32(float32(x.F32))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"F32\"")
+					} else {
+						r.EncodeSymbol(`F32`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeFloat32(float32(x.F32))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.B))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
+					} else {
+						r.EncodeSymbol(`B`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.B))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.By))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"By\"")
+					} else {
+						r.EncodeSymbol(`By`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.By))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Sslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.Sslice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Sslice\"")
+					} else {
+						r.EncodeSymbol(`Sslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Sslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.Sslice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.I64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.I64slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I64slice\"")
+					} else {
+						r.EncodeSymbol(`I64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.I64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.I64slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.I16slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint16(([]int16)(x.I16slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I16slice\"")
+					} else {
+						r.EncodeSymbol(`I16slice`)
+					}
+					r.WriteMapElemValue()
+					if x.I16slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint16(([]int16)(x.I16slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Ui64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui64slice\"")
+					} else {
+						r.EncodeSymbol(`Ui64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.Ui64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Ui8slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ui8slice\"")
+					} else {
+						r.EncodeSymbol(`Ui8slice`)
+					}
+					r.WriteMapElemValue()
+					if x.Ui8slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Bslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicebool(([]bool)(x.Bslice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Bslice\"")
+					} else {
+						r.EncodeSymbol(`Bslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Bslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicebool(([]bool)(x.Bslice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Byslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Byslice))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Byslice\"")
+					} else {
+						r.EncodeSymbol(`Byslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Byslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Byslice))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Iptrslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Iptrslice\"")
+					} else {
+						r.EncodeSymbol(`Iptrslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Iptrslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.WrapSliceInt64 == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceInt64.CodecEncodeSelf(e)
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"WrapSliceInt64\"")
+					} else {
+						r.EncodeSymbol(`WrapSliceInt64`)
+					}
+					r.WriteMapElemValue()
+					if x.WrapSliceInt64 == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceInt64.CodecEncodeSelf(e)
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.WrapSliceString == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceString.CodecEncodeSelf(e)
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"WrapSliceString\"")
+					} else {
+						r.EncodeSymbol(`WrapSliceString`)
+					}
+					r.WriteMapElemValue()
+					if x.WrapSliceString == nil {
+						r.EncodeNil()
+					} else {
+						x.WrapSliceString.CodecEncodeSelf(e)
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Msi64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Msi64\"")
+					} else {
+						r.EncodeSymbol(`Msi64`)
+					}
+					r.WriteMapElemValue()
+					if x.Msi64 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy88 := &x.Simplef
+					yy88.CodecEncodeSelf(e)
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Simplef\"")
+					} else {
+						r.EncodeSymbol(`Simplef`)
+					}
+					r.WriteMapElemValue()
+					yy90 := &x.Simplef
+					yy90.CodecEncodeSelf(e)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.SstrUi64T == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"SstrUi64T\"")
+					} else {
+						r.EncodeSymbol(`SstrUi64T`)
+					}
+					r.WriteMapElemValue()
+					if x.SstrUi64T == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AS\"")
+					} else {
+						r.EncodeSymbol(`AS`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.AI64))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI64\"")
+					} else {
+						r.EncodeSymbol(`AI64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.AI64))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.AI16))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI16\"")
+					} else {
+						r.EncodeSymbol(`AI16`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.AI16))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.AUi64))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AUi64\"")
+					} else {
+						r.EncodeSymbol(`AUi64`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.AUi64))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.ASslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.ASslice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"ASslice\"")
+					} else {
+						r.EncodeSymbol(`ASslice`)
+					}
+					r.WriteMapElemValue()
+					if x.ASslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.ASslice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AI64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.AI64slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI64slice\"")
+					} else {
+						r.EncodeSymbol(`AI64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AI64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.AI64slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AUi64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AUi64slice\"")
+					} else {
+						r.EncodeSymbol(`AUi64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AUi64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AF64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AF64slice\"")
+					} else {
+						r.EncodeSymbol(`AF64slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AF64slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AF32slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AF32slice\"")
+					} else {
+						r.EncodeSymbol(`AF32slice`)
+					}
+					r.WriteMapElemValue()
+					if x.AF32slice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefloat32(([]float32)(x.AF32slice), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AMSU16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AMSU16\"")
+					} else {
+						r.EncodeSymbol(`AMSU16`)
+					}
+					r.WriteMapElemValue()
+					if x.AMSU16 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy126 := &x.AI64arr0
+					if false {
+					} else {
+						h.encArray0int64((*[0]int64)(yy126), e)
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AI64arr0\"")
+					} else {
+						r.EncodeSymbol(`AI64arr0`)
+					}
+					r.WriteMapElemValue()
+					yy128 := &x.AI64arr0
+					if false {
+					} else {
+						h.encArray0int64((*[0]int64)(yy128), e)
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.A164slice0 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.A164slice0), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A164slice0\"")
+					} else {
+						r.EncodeSymbol(`A164slice0`)
+					}
+					r.WriteMapElemValue()
+					if x.A164slice0 == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint64(([]int64)(x.A164slice0), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AUi64sliceN == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AUi64sliceN\"")
+					} else {
+						r.EncodeSymbol(`AUi64sliceN`)
+					}
+					r.WriteMapElemValue()
+					if x.AUi64sliceN == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AMSU16N == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AMSU16N\"")
+					} else {
+						r.EncodeSymbol(`AMSU16N`)
+					}
+					r.WriteMapElemValue()
+					if x.AMSU16N == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.AMSU16E == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"AMSU16E\"")
+					} else {
+						r.EncodeSymbol(`AMSU16E`)
+					}
+					r.WriteMapElemValue()
+					if x.AMSU16E == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					yy143 := &x.NotAnon
+					yy143.CodecEncodeSelf(e)
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"NotAnon\"")
+					} else {
+						r.EncodeSymbol(`NotAnon`)
+					}
+					r.WriteMapElemValue()
+					yy145 := &x.NotAnon
+					yy145.CodecEncodeSelf(e)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Nmap == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringbool((map[string]bool)(x.Nmap), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Nmap\"")
+					} else {
+						r.EncodeSymbol(`Nmap`)
+					}
+					r.WriteMapElemValue()
+					if x.Nmap == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringbool((map[string]bool)(x.Nmap), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Nslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Nslice))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Nslice\"")
+					} else {
+						r.EncodeSymbol(`Nslice`)
+					}
+					r.WriteMapElemValue()
+					if x.Nslice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							r.EncodeStringBytesRaw([]byte(x.Nslice))
+						}
+					}
+				}
+				var yyn153 bool
+				if x.TestStrucCommon.Nint64 == nil {
+					yyn153 = true
+					goto LABEL153
+				}
+			LABEL153:
+				if yyr2 || yy2arr2 {
+					if yyn153 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.Nint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy154 := *x.Nint64
+							if false {
+							} else {
+								r.EncodeInt(int64(yy154))
+							}
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Nint64\"")
+					} else {
+						r.EncodeSymbol(`Nint64`)
+					}
+					r.WriteMapElemValue()
+					if yyn153 {
+						r.EncodeNil()
+					} else {
+						if x.Nint64 == nil {
+							r.EncodeNil()
+						} else {
+							yy156 := *x.Nint64
+							if false {
+							} else {
+								r.EncodeInt(int64(yy156))
+							}
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Mtsptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringPtrtoTestStruc((map[string]*TestStruc)(x.Mtsptr), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Mtsptr\"")
+					} else {
+						r.EncodeSymbol(`Mtsptr`)
+					}
+					r.WriteMapElemValue()
+					if x.Mtsptr == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringPtrtoTestStruc((map[string]*TestStruc)(x.Mtsptr), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Mts == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringTestStruc((map[string]TestStruc)(x.Mts), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Mts\"")
+					} else {
+						r.EncodeSymbol(`Mts`)
+					}
+					r.WriteMapElemValue()
+					if x.Mts == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringTestStruc((map[string]TestStruc)(x.Mts), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Its == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicePtrtoTestStruc(([]*TestStruc)(x.Its), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Its\"")
+					} else {
+						r.EncodeSymbol(`Its`)
+					}
+					r.WriteMapElemValue()
+					if x.Its == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicePtrtoTestStruc(([]*TestStruc)(x.Its), e)
+						}
+					}
+				}
+				var yyn167 bool
+				if x.Nteststruc == nil {
+					yyn167 = true
+					goto LABEL167
+				}
+			LABEL167:
+				if yyr2 || yy2arr2 {
+					if yyn167 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.Nteststruc == nil {
+							r.EncodeNil()
+						} else {
+							x.Nteststruc.CodecEncodeSelf(e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Nteststruc\"")
+					} else {
+						r.EncodeSymbol(`Nteststruc`)
+					}
+					r.WriteMapElemValue()
+					if yyn167 {
+						r.EncodeNil()
+					} else {
+						if x.Nteststruc == nil {
+							r.EncodeNil()
+						} else {
+							x.Nteststruc.CodecEncodeSelf(e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
+		}
+	}
 }
 
-func (x *TestStrucCommon) CodecDecodeSelf(d *Decoder) {
+func (x *TestStruc) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -3401,7 +6025,7 @@ func (x *TestStrucCommon) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
-func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
+func (x *TestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -3422,109 +6046,109 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 		switch yys3 {
 		case "S":
 			if r.TryDecodeAsNil() {
-				x.S = ""
+				x.TestStrucCommon.S = ""
 			} else {
 				x.S = (string)(r.DecodeString())
 			}
 		case "I64":
 			if r.TryDecodeAsNil() {
-				x.I64 = 0
+				x.TestStrucCommon.I64 = 0
 			} else {
 				x.I64 = (int64)(r.DecodeInt64())
 			}
 		case "I32":
 			if r.TryDecodeAsNil() {
-				x.I32 = 0
+				x.TestStrucCommon.I32 = 0
 			} else {
 				x.I32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
 			}
 		case "I16":
 			if r.TryDecodeAsNil() {
-				x.I16 = 0
+				x.TestStrucCommon.I16 = 0
 			} else {
 				x.I16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
 			}
 		case "I8":
 			if r.TryDecodeAsNil() {
-				x.I8 = 0
+				x.TestStrucCommon.I8 = 0
 			} else {
 				x.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
 			}
 		case "I64n":
 			if r.TryDecodeAsNil() {
-				x.I64n = 0
+				x.TestStrucCommon.I64n = 0
 			} else {
 				x.I64n = (int64)(r.DecodeInt64())
 			}
 		case "I32n":
 			if r.TryDecodeAsNil() {
-				x.I32n = 0
+				x.TestStrucCommon.I32n = 0
 			} else {
 				x.I32n = (int32)(z.C.IntV(r.DecodeInt64(), 32))
 			}
 		case "I16n":
 			if r.TryDecodeAsNil() {
-				x.I16n = 0
+				x.TestStrucCommon.I16n = 0
 			} else {
 				x.I16n = (int16)(z.C.IntV(r.DecodeInt64(), 16))
 			}
 		case "I8n":
 			if r.TryDecodeAsNil() {
-				x.I8n = 0
+				x.TestStrucCommon.I8n = 0
 			} else {
 				x.I8n = (int8)(z.C.IntV(r.DecodeInt64(), 8))
 			}
 		case "Ui64":
 			if r.TryDecodeAsNil() {
-				x.Ui64 = 0
+				x.TestStrucCommon.Ui64 = 0
 			} else {
 				x.Ui64 = (uint64)(r.DecodeUint64())
 			}
 		case "Ui32":
 			if r.TryDecodeAsNil() {
-				x.Ui32 = 0
+				x.TestStrucCommon.Ui32 = 0
 			} else {
 				x.Ui32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
 			}
 		case "Ui16":
 			if r.TryDecodeAsNil() {
-				x.Ui16 = 0
+				x.TestStrucCommon.Ui16 = 0
 			} else {
 				x.Ui16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
 			}
 		case "Ui8":
 			if r.TryDecodeAsNil() {
-				x.Ui8 = 0
+				x.TestStrucCommon.Ui8 = 0
 			} else {
 				x.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
 			}
 		case "F64":
 			if r.TryDecodeAsNil() {
-				x.F64 = 0
+				x.TestStrucCommon.F64 = 0
 			} else {
 				x.F64 = (float64)(r.DecodeFloat64())
 			}
 		case "F32":
 			if r.TryDecodeAsNil() {
-				x.F32 = 0
+				x.TestStrucCommon.F32 = 0
 			} else {
 				x.F32 = (float32)(r.DecodeFloat32As64())
 			}
 		case "B":
 			if r.TryDecodeAsNil() {
-				x.B = false
+				x.TestStrucCommon.B = false
 			} else {
 				x.B = (bool)(r.DecodeBool())
 			}
 		case "By":
 			if r.TryDecodeAsNil() {
-				x.By = 0
+				x.TestStrucCommon.By = 0
 			} else {
 				x.By = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
 			}
 		case "Sslice":
 			if r.TryDecodeAsNil() {
-				x.Sslice = nil
+				x.TestStrucCommon.Sslice = nil
 			} else {
 				if false {
 				} else {
@@ -3533,7 +6157,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "I64slice":
 			if r.TryDecodeAsNil() {
-				x.I64slice = nil
+				x.TestStrucCommon.I64slice = nil
 			} else {
 				if false {
 				} else {
@@ -3542,7 +6166,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "I16slice":
 			if r.TryDecodeAsNil() {
-				x.I16slice = nil
+				x.TestStrucCommon.I16slice = nil
 			} else {
 				if false {
 				} else {
@@ -3551,7 +6175,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "Ui64slice":
 			if r.TryDecodeAsNil() {
-				x.Ui64slice = nil
+				x.TestStrucCommon.Ui64slice = nil
 			} else {
 				if false {
 				} else {
@@ -3560,7 +6184,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "Ui8slice":
 			if r.TryDecodeAsNil() {
-				x.Ui8slice = nil
+				x.TestStrucCommon.Ui8slice = nil
 			} else {
 				if false {
 				} else {
@@ -3569,7 +6193,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "Bslice":
 			if r.TryDecodeAsNil() {
-				x.Bslice = nil
+				x.TestStrucCommon.Bslice = nil
 			} else {
 				if false {
 				} else {
@@ -3578,7 +6202,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "Byslice":
 			if r.TryDecodeAsNil() {
-				x.Byslice = nil
+				x.TestStrucCommon.Byslice = nil
 			} else {
 				if false {
 				} else {
@@ -3587,7 +6211,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "Iptrslice":
 			if r.TryDecodeAsNil() {
-				x.Iptrslice = nil
+				x.TestStrucCommon.Iptrslice = nil
 			} else {
 				if false {
 				} else {
@@ -3596,19 +6220,19 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "WrapSliceInt64":
 			if r.TryDecodeAsNil() {
-				x.WrapSliceInt64 = nil
+				x.TestStrucCommon.WrapSliceInt64 = nil
 			} else {
 				x.WrapSliceInt64.CodecDecodeSelf(d)
 			}
 		case "WrapSliceString":
 			if r.TryDecodeAsNil() {
-				x.WrapSliceString = nil
+				x.TestStrucCommon.WrapSliceString = nil
 			} else {
 				x.WrapSliceString.CodecDecodeSelf(d)
 			}
 		case "Msi64":
 			if r.TryDecodeAsNil() {
-				x.Msi64 = nil
+				x.TestStrucCommon.Msi64 = nil
 			} else {
 				if false {
 				} else {
@@ -3617,13 +6241,13 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "Simplef":
 			if r.TryDecodeAsNil() {
-				x.Simplef = testSimpleFields{}
+				x.TestStrucCommon.Simplef = testSimpleFields{}
 			} else {
 				x.Simplef.CodecDecodeSelf(d)
 			}
 		case "SstrUi64T":
 			if r.TryDecodeAsNil() {
-				x.SstrUi64T = nil
+				x.TestStrucCommon.SstrUi64T = nil
 			} else {
 				if false {
 				} else {
@@ -3632,31 +6256,31 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AS":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AS = ""
+				x.TestStrucCommon.AnonInTestStruc.AS = ""
 			} else {
 				x.AS = (string)(r.DecodeString())
 			}
 		case "AI64":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AI64 = 0
+				x.TestStrucCommon.AnonInTestStruc.AI64 = 0
 			} else {
 				x.AI64 = (int64)(r.DecodeInt64())
 			}
 		case "AI16":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AI16 = 0
+				x.TestStrucCommon.AnonInTestStruc.AI16 = 0
 			} else {
 				x.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
 			}
 		case "AUi64":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AUi64 = 0
+				x.TestStrucCommon.AnonInTestStruc.AUi64 = 0
 			} else {
 				x.AUi64 = (uint64)(r.DecodeUint64())
 			}
 		case "ASslice":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.ASslice = nil
+				x.TestStrucCommon.AnonInTestStruc.ASslice = nil
 			} else {
 				if false {
 				} else {
@@ -3665,7 +6289,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AI64slice":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AI64slice = nil
+				x.TestStrucCommon.AnonInTestStruc.AI64slice = nil
 			} else {
 				if false {
 				} else {
@@ -3674,7 +6298,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AUi64slice":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AUi64slice = nil
+				x.TestStrucCommon.AnonInTestStruc.AUi64slice = nil
 			} else {
 				if false {
 				} else {
@@ -3683,7 +6307,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AF64slice":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AF64slice = nil
+				x.TestStrucCommon.AnonInTestStruc.AF64slice = nil
 			} else {
 				if false {
 				} else {
@@ -3692,7 +6316,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AF32slice":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AF32slice = nil
+				x.TestStrucCommon.AnonInTestStruc.AF32slice = nil
 			} else {
 				if false {
 				} else {
@@ -3701,7 +6325,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AMSU16":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AMSU16 = nil
+				x.TestStrucCommon.AnonInTestStruc.AMSU16 = nil
 			} else {
 				if false {
 				} else {
@@ -3710,7 +6334,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AI64arr0":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AI64arr0 = [0]int64{}
+				x.TestStrucCommon.AnonInTestStruc.AI64arr0 = [0]int64{}
 			} else {
 				if false {
 				} else {
@@ -3719,7 +6343,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "A164slice0":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.A164slice0 = nil
+				x.TestStrucCommon.AnonInTestStruc.A164slice0 = nil
 			} else {
 				if false {
 				} else {
@@ -3728,7 +6352,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AUi64sliceN":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AUi64sliceN = nil
+				x.TestStrucCommon.AnonInTestStruc.AUi64sliceN = nil
 			} else {
 				if false {
 				} else {
@@ -3737,7 +6361,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AMSU16N":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AMSU16N = nil
+				x.TestStrucCommon.AnonInTestStruc.AMSU16N = nil
 			} else {
 				if false {
 				} else {
@@ -3746,7 +6370,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "AMSU16E":
 			if r.TryDecodeAsNil() {
-				x.AnonInTestStruc.AMSU16E = nil
+				x.TestStrucCommon.AnonInTestStruc.AMSU16E = nil
 			} else {
 				if false {
 				} else {
@@ -3755,13 +6379,13 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "NotAnon":
 			if r.TryDecodeAsNil() {
-				x.NotAnon = AnonInTestStruc{}
+				x.TestStrucCommon.NotAnon = AnonInTestStruc{}
 			} else {
 				x.NotAnon.CodecDecodeSelf(d)
 			}
 		case "Nmap":
 			if r.TryDecodeAsNil() {
-				x.Nmap = nil
+				x.TestStrucCommon.Nmap = nil
 			} else {
 				if false {
 				} else {
@@ -3770,7 +6394,7 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "Nslice":
 			if r.TryDecodeAsNil() {
-				x.Nslice = nil
+				x.TestStrucCommon.Nslice = nil
 			} else {
 				if false {
 				} else {
@@ -3779,12 +6403,12 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 			}
 		case "Nint64":
 			if r.TryDecodeAsNil() {
-				if true && x.Nint64 != nil {
-					x.Nint64 = nil
+				if true && x.TestStrucCommon.Nint64 != nil {
+					x.TestStrucCommon.Nint64 = nil
 				}
 			} else {
-				if x.Nint64 == nil {
-					x.Nint64 = new(int64)
+				if x.TestStrucCommon.Nint64 == nil {
+					x.TestStrucCommon.Nint64 = new(int64)
 				}
 
 				if false {
@@ -3792,6 +6416,45 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 					*x.Nint64 = (int64)(r.DecodeInt64())
 				}
 			}
+		case "Mtsptr":
+			if r.TryDecodeAsNil() {
+				x.Mtsptr = nil
+			} else {
+				if false {
+				} else {
+					h.decMapstringPtrtoTestStruc((*map[string]*TestStruc)(&x.Mtsptr), d)
+				}
+			}
+		case "Mts":
+			if r.TryDecodeAsNil() {
+				x.Mts = nil
+			} else {
+				if false {
+				} else {
+					h.decMapstringTestStruc((*map[string]TestStruc)(&x.Mts), d)
+				}
+			}
+		case "Its":
+			if r.TryDecodeAsNil() {
+				x.Its = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicePtrtoTestStruc((*[]*TestStruc)(&x.Its), d)
+				}
+			}
+		case "Nteststruc":
+			if r.TryDecodeAsNil() {
+				if true && x.Nteststruc != nil {
+					x.Nteststruc = nil
+				}
+			} else {
+				if x.Nteststruc == nil {
+					x.Nteststruc = new(TestStruc)
+				}
+
+				x.Nteststruc.CodecDecodeSelf(d)
+			}
 		default:
 			z.DecStructFieldNotFound(-1, yys3)
 		} // end switch yys3
@@ -3799,2184 +6462,1785 @@ func (x *TestStrucCommon) codecDecodeSelfFromMap(l int, d *Decoder) {
 	r.ReadMapEnd()
 }
 
-func (x *TestStrucCommon) codecDecodeSelfFromArray(l int, d *Decoder) {
+func (x *TestStruc) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj77 int
-	var yyb77 bool
-	var yyhl77 bool = l >= 0
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	var yyj84 int
+	var yyb84 bool
+	var yyhl84 bool = l >= 0
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.S = ""
+		x.TestStrucCommon.S = ""
 	} else {
 		x.S = (string)(r.DecodeString())
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I64 = 0
+		x.TestStrucCommon.I64 = 0
 	} else {
 		x.I64 = (int64)(r.DecodeInt64())
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I32 = 0
+		x.TestStrucCommon.I32 = 0
 	} else {
 		x.I32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I16 = 0
+		x.TestStrucCommon.I16 = 0
 	} else {
 		x.I16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I8 = 0
+		x.TestStrucCommon.I8 = 0
 	} else {
 		x.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I64n = 0
+		x.TestStrucCommon.I64n = 0
 	} else {
 		x.I64n = (int64)(r.DecodeInt64())
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I32n = 0
+		x.TestStrucCommon.I32n = 0
 	} else {
 		x.I32n = (int32)(z.C.IntV(r.DecodeInt64(), 32))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I16n = 0
+		x.TestStrucCommon.I16n = 0
 	} else {
 		x.I16n = (int16)(z.C.IntV(r.DecodeInt64(), 16))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I8n = 0
+		x.TestStrucCommon.I8n = 0
 	} else {
 		x.I8n = (int8)(z.C.IntV(r.DecodeInt64(), 8))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ui64 = 0
+		x.TestStrucCommon.Ui64 = 0
 	} else {
 		x.Ui64 = (uint64)(r.DecodeUint64())
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ui32 = 0
+		x.TestStrucCommon.Ui32 = 0
 	} else {
 		x.Ui32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ui16 = 0
+		x.TestStrucCommon.Ui16 = 0
 	} else {
 		x.Ui16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ui8 = 0
+		x.TestStrucCommon.Ui8 = 0
 	} else {
 		x.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.F64 = 0
+		x.TestStrucCommon.F64 = 0
 	} else {
 		x.F64 = (float64)(r.DecodeFloat64())
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.F32 = 0
+		x.TestStrucCommon.F32 = 0
 	} else {
 		x.F32 = (float32)(r.DecodeFloat32As64())
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.B = false
+		x.TestStrucCommon.B = false
 	} else {
 		x.B = (bool)(r.DecodeBool())
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.By = 0
+		x.TestStrucCommon.By = 0
 	} else {
 		x.By = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Sslice = nil
+		x.TestStrucCommon.Sslice = nil
 	} else {
 		if false {
 		} else {
 			h.decSlicestring((*[]string)(&x.Sslice), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I64slice = nil
+		x.TestStrucCommon.I64slice = nil
 	} else {
 		if false {
 		} else {
 			h.decSliceint64((*[]int64)(&x.I64slice), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I16slice = nil
+		x.TestStrucCommon.I16slice = nil
 	} else {
 		if false {
 		} else {
 			h.decSliceint16((*[]int16)(&x.I16slice), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ui64slice = nil
+		x.TestStrucCommon.Ui64slice = nil
 	} else {
 		if false {
 		} else {
 			h.decSliceuint64((*[]uint64)(&x.Ui64slice), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ui8slice = nil
+		x.TestStrucCommon.Ui8slice = nil
 	} else {
 		if false {
 		} else {
 			x.Ui8slice = r.DecodeBytes(([]byte)(x.Ui8slice), false)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Bslice = nil
+		x.TestStrucCommon.Bslice = nil
 	} else {
 		if false {
 		} else {
 			h.decSlicebool((*[]bool)(&x.Bslice), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Byslice = nil
+		x.TestStrucCommon.Byslice = nil
 	} else {
 		if false {
 		} else {
 			x.Byslice = r.DecodeBytes(([]byte)(x.Byslice), false)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Iptrslice = nil
+		x.TestStrucCommon.Iptrslice = nil
 	} else {
 		if false {
 		} else {
 			h.decSlicePtrtoint64((*[]*int64)(&x.Iptrslice), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.WrapSliceInt64 = nil
+		x.TestStrucCommon.WrapSliceInt64 = nil
 	} else {
 		x.WrapSliceInt64.CodecDecodeSelf(d)
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.WrapSliceString = nil
+		x.TestStrucCommon.WrapSliceString = nil
 	} else {
 		x.WrapSliceString.CodecDecodeSelf(d)
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Msi64 = nil
+		x.TestStrucCommon.Msi64 = nil
 	} else {
 		if false {
 		} else {
 			h.decMapstringint64((*map[string]int64)(&x.Msi64), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Simplef = testSimpleFields{}
+		x.TestStrucCommon.Simplef = testSimpleFields{}
 	} else {
 		x.Simplef.CodecDecodeSelf(d)
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.SstrUi64T = nil
+		x.TestStrucCommon.SstrUi64T = nil
 	} else {
 		if false {
 		} else {
 			h.decSlicestringUint64T((*[]stringUint64T)(&x.SstrUi64T), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
+	} else {
+		yyb84 = r.CheckBreak()
+	}
+	if yyb84 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.AnonInTestStruc.AS = ""
+	} else {
+		x.AS = (string)(r.DecodeString())
+	}
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
+	} else {
+		yyb84 = r.CheckBreak()
+	}
+	if yyb84 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.AnonInTestStruc.AI64 = 0
+	} else {
+		x.AI64 = (int64)(r.DecodeInt64())
+	}
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
+	} else {
+		yyb84 = r.CheckBreak()
+	}
+	if yyb84 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.AnonInTestStruc.AI16 = 0
+	} else {
+		x.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+	}
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
+	} else {
+		yyb84 = r.CheckBreak()
+	}
+	if yyb84 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.AnonInTestStruc.AUi64 = 0
+	} else {
+		x.AUi64 = (uint64)(r.DecodeUint64())
+	}
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AS = ""
+		x.TestStrucCommon.AnonInTestStruc.ASslice = nil
 	} else {
-		x.AS = (string)(r.DecodeString())
+		if false {
+		} else {
+			h.decSlicestring((*[]string)(&x.ASslice), d)
+		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AI64 = 0
+		x.TestStrucCommon.AnonInTestStruc.AI64slice = nil
 	} else {
-		x.AI64 = (int64)(r.DecodeInt64())
+		if false {
+		} else {
+			h.decSliceint64((*[]int64)(&x.AI64slice), d)
+		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AI16 = 0
+		x.TestStrucCommon.AnonInTestStruc.AUi64slice = nil
 	} else {
-		x.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+		if false {
+		} else {
+			h.decSliceuint64((*[]uint64)(&x.AUi64slice), d)
+		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AUi64 = 0
+		x.TestStrucCommon.AnonInTestStruc.AF64slice = nil
 	} else {
-		x.AUi64 = (uint64)(r.DecodeUint64())
+		if false {
+		} else {
+			h.decSlicefloat64((*[]float64)(&x.AF64slice), d)
+		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.ASslice = nil
+		x.TestStrucCommon.AnonInTestStruc.AF32slice = nil
 	} else {
 		if false {
 		} else {
-			h.decSlicestring((*[]string)(&x.ASslice), d)
+			h.decSlicefloat32((*[]float32)(&x.AF32slice), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AI64slice = nil
+		x.TestStrucCommon.AnonInTestStruc.AMSU16 = nil
 	} else {
 		if false {
 		} else {
-			h.decSliceint64((*[]int64)(&x.AI64slice), d)
+			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AUi64slice = nil
+		x.TestStrucCommon.AnonInTestStruc.AI64arr0 = [0]int64{}
 	} else {
 		if false {
 		} else {
-			h.decSliceuint64((*[]uint64)(&x.AUi64slice), d)
+			h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AF64slice = nil
+		x.TestStrucCommon.AnonInTestStruc.A164slice0 = nil
 	} else {
 		if false {
 		} else {
-			h.decSlicefloat64((*[]float64)(&x.AF64slice), d)
+			h.decSliceint64((*[]int64)(&x.A164slice0), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AF32slice = nil
+		x.TestStrucCommon.AnonInTestStruc.AUi64sliceN = nil
 	} else {
 		if false {
 		} else {
-			h.decSlicefloat32((*[]float32)(&x.AF32slice), d)
+			h.decSliceuint64((*[]uint64)(&x.AUi64sliceN), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AMSU16 = nil
+		x.TestStrucCommon.AnonInTestStruc.AMSU16N = nil
 	} else {
 		if false {
 		} else {
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16), d)
+			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AI64arr0 = [0]int64{}
+		x.TestStrucCommon.AnonInTestStruc.AMSU16E = nil
 	} else {
 		if false {
 		} else {
-			h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
+			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.A164slice0 = nil
+		x.TestStrucCommon.NotAnon = AnonInTestStruc{}
 	} else {
-		if false {
-		} else {
-			h.decSliceint64((*[]int64)(&x.A164slice0), d)
-		}
+		x.NotAnon.CodecDecodeSelf(d)
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AUi64sliceN = nil
+		x.TestStrucCommon.Nmap = nil
 	} else {
 		if false {
 		} else {
-			h.decSliceuint64((*[]uint64)(&x.AUi64sliceN), d)
+			h.decMapstringbool((*map[string]bool)(&x.Nmap), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AMSU16N = nil
+		x.TestStrucCommon.Nslice = nil
 	} else {
 		if false {
 		} else {
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
+			x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.AnonInTestStruc.AMSU16E = nil
+		if true && x.TestStrucCommon.Nint64 != nil {
+			x.TestStrucCommon.Nint64 = nil
+		}
 	} else {
+		if x.TestStrucCommon.Nint64 == nil {
+			x.TestStrucCommon.Nint64 = new(int64)
+		}
+
 		if false {
 		} else {
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
+			*x.Nint64 = (int64)(r.DecodeInt64())
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.NotAnon = AnonInTestStruc{}
+		x.Mtsptr = nil
 	} else {
-		x.NotAnon.CodecDecodeSelf(d)
+		if false {
+		} else {
+			h.decMapstringPtrtoTestStruc((*map[string]*TestStruc)(&x.Mtsptr), d)
+		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Nmap = nil
+		x.Mts = nil
 	} else {
 		if false {
 		} else {
-			h.decMapstringbool((*map[string]bool)(&x.Nmap), d)
+			h.decMapstringTestStruc((*map[string]TestStruc)(&x.Mts), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Nslice = nil
+		x.Its = nil
 	} else {
 		if false {
 		} else {
-			x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
+			h.decSlicePtrtoTestStruc((*[]*TestStruc)(&x.Its), d)
 		}
 	}
-	yyj77++
-	if yyhl77 {
-		yyb77 = yyj77 > l
+	yyj84++
+	if yyhl84 {
+		yyb84 = yyj84 > l
 	} else {
-		yyb77 = r.CheckBreak()
+		yyb84 = r.CheckBreak()
 	}
-	if yyb77 {
+	if yyb84 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		if true && x.Nint64 != nil {
-			x.Nint64 = nil
+		if true && x.Nteststruc != nil {
+			x.Nteststruc = nil
 		}
 	} else {
-		if x.Nint64 == nil {
-			x.Nint64 = new(int64)
+		if x.Nteststruc == nil {
+			x.Nteststruc = new(TestStruc)
 		}
 
-		if false {
-		} else {
-			*x.Nint64 = (int64)(r.DecodeInt64())
-		}
+		x.Nteststruc.CodecDecodeSelf(d)
 	}
 	for {
-		yyj77++
-		if yyhl77 {
-			yyb77 = yyj77 > l
+		yyj84++
+		if yyhl84 {
+			yyb84 = yyj84 > l
 		} else {
-			yyb77 = r.CheckBreak()
+			yyb84 = r.CheckBreak()
 		}
-		if yyb77 {
+		if yyb84 {
 			break
 		}
 		r.ReadArrayElem()
-		z.DecStructFieldNotFound(yyj77-1, "")
+		z.DecStructFieldNotFound(yyj84-1, "")
 	}
 	r.ReadArrayEnd()
 }
 
-func (x *TestStruc) CodecEncodeSelf(e *Encoder) {
+func (x Bbool) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	if x == nil {
-		r.EncodeNil()
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.EncExtension(x, yyxt1)
 	} else {
-		if false {
-		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-			z.EncExtension(x, yyxt1)
-		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(53)
-			} else {
-				r.WriteMapStart(53)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"S\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `S`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I32))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I32`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I32))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I16))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I16`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I16))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I8`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I64n))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64n\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I64n`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I64n))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I32n))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I32n\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I32n`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I32n))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I16n))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16n\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I16n`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I16n))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8n))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I8n\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I8n`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I8n))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui32))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui32`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui32))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui16))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui16`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui16))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui8))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui8\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui8`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.Ui8))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeFloat64(float64(x.F64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"F64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `F64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeFloat64(float64(x.F64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeFloat32(float32(x.F32))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"F32\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `F32`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeFloat32(float32(x.F32))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"B\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `B`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.By))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"By\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `By`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.By))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Sslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSlicestring(([]string)(x.Sslice), e)
-					}
-				}
+		r.EncodeBool(bool(x))
+	}
+}
+
+func (x *Bbool) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		*x = (Bbool)(r.DecodeBool())
+	}
+}
+
+func (x *Aarray) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			h.encAarray((*Aarray)(x), e)
+		}
+	}
+}
+
+func (x *Aarray) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		h.decAarray((*Aarray)(x), d)
+	}
+}
+
+func (x Sstring) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.EncExtension(x, yyxt1)
+	} else {
+		if z.EncBasicHandle().StringToRaw {
+			r.EncodeStringBytesRaw(z.BytesView(string(x)))
+		} else {
+			r.EncodeStringEnc(codecSelferCcUTF819780, string(x))
+		}
+	}
+}
+
+func (x *Sstring) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		*x = (Sstring)(r.DecodeString())
+	}
+}
+
+func (x *Sstructsmall) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Sslice\"")
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(1)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Sslice`)
+					r.WriteMapStart(1)
 				}
-				r.WriteMapElemValue()
-				if x.Sslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicestring(([]string)(x.Sslice), e)
+						r.EncodeInt(int64(x.A))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.I64slice == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A\"")
 					} else {
-						h.encSliceint64(([]int64)(x.I64slice), e)
+						r.EncodeSymbol(`A`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.I64slice == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encSliceint64(([]int64)(x.I64slice), e)
+						r.EncodeInt(int64(x.A))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.I16slice == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					if false {
-					} else {
-						h.encSliceint16(([]int16)(x.I16slice), e)
-					}
+					r.WriteMapEnd()
 				}
+			} // end if z.EncStructReflect()
+		}
+	}
+}
+
+func (x *Sstructsmall) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I16slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I16slice`)
-				}
-				r.WriteMapElemValue()
-				if x.I16slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceint16(([]int16)(x.I16slice), e)
-					}
-				}
+				x.codecDecodeSelfFromMap(yyl2, d)
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Ui64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceuint64(([]uint64)(x.Ui64slice), e)
-					}
-				}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.Ui64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceuint64(([]uint64)(x.Ui64slice), e)
-					}
-				}
+				x.codecDecodeSelfFromArray(yyl2, d)
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Ui8slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						r.EncodeStringBytesRaw([]byte(x.Ui8slice))
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ui8slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ui8slice`)
-				}
-				r.WriteMapElemValue()
-				if x.Ui8slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						r.EncodeStringBytesRaw([]byte(x.Ui8slice))
-					}
-				}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
+	}
+}
+
+func (x *Sstructsmall) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Bslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSlicebool(([]bool)(x.Bslice), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Bslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Bslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Bslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSlicebool(([]bool)(x.Bslice), e)
-					}
-				}
+		} else {
+			if r.CheckBreak() {
+				break
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Byslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						r.EncodeStringBytesRaw([]byte(x.Byslice))
-					}
-				}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "A":
+			if r.TryDecodeAsNil() {
+				x.A = 0
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Byslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Byslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Byslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						r.EncodeStringBytesRaw([]byte(x.Byslice))
-					}
-				}
+				x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Iptrslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
-					}
-				}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *Sstructsmall) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj5 int
+	var yyb5 bool
+	var yyhl5 bool = l >= 0
+	yyj5++
+	if yyhl5 {
+		yyb5 = yyj5 > l
+	} else {
+		yyb5 = r.CheckBreak()
+	}
+	if yyb5 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.A = 0
+	} else {
+		x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+	}
+	for {
+		yyj5++
+		if yyhl5 {
+			yyb5 = yyj5 > l
+		} else {
+			yyb5 = r.CheckBreak()
+		}
+		if yyb5 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj5-1, "")
+	}
+	r.ReadArrayEnd()
+}
+
+func (x *Sstructbig) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Iptrslice\"")
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(5)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Iptrslice`)
+					r.WriteMapStart(5)
 				}
-				r.WriteMapElemValue()
-				if x.Iptrslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+						r.EncodeInt(int64(x.A))
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.WrapSliceInt64 == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceInt64.CodecEncodeSelf(e)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"WrapSliceInt64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `WrapSliceInt64`)
-				}
-				r.WriteMapElemValue()
-				if x.WrapSliceInt64 == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceInt64.CodecEncodeSelf(e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.WrapSliceString == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceString.CodecEncodeSelf(e)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"WrapSliceString\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `WrapSliceString`)
-				}
-				r.WriteMapElemValue()
-				if x.WrapSliceString == nil {
-					r.EncodeNil()
-				} else {
-					x.WrapSliceString.CodecEncodeSelf(e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Msi64 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A\"")
 					} else {
-						h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						r.EncodeSymbol(`A`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Msi64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Msi64`)
-				}
-				r.WriteMapElemValue()
-				if x.Msi64 == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encMapstringint64((map[string]int64)(x.Msi64), e)
+						r.EncodeInt(int64(x.A))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy88 := &x.Simplef
-				yy88.CodecEncodeSelf(e)
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Simplef\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Simplef`)
-				}
-				r.WriteMapElemValue()
-				yy90 := &x.Simplef
-				yy90.CodecEncodeSelf(e)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.SstrUi64T == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+						r.EncodeBool(bool(x.B))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"SstrUi64T\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `SstrUi64T`)
-				}
-				r.WriteMapElemValue()
-				if x.SstrUi64T == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
 					} else {
-						h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+						r.EncodeSymbol(`B`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+					r.WriteMapElemValue()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						r.EncodeBool(bool(x.B))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AS\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AS`)
+				var yyn9 bool
+				if x.Ssmallptr == nil {
+					yyn9 = true
+					goto LABEL9
 				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+			LABEL9:
+				if yyr2 || yy2arr2 {
+					if yyn9 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+						r.WriteArrayElem()
+						if x.Ssmallptr == nil {
+							r.EncodeNil()
+						} else {
+							x.Ssmallptr.CodecEncodeSelf(e)
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI16))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI16`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.AI16))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.AUi64))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeUint(uint64(x.AUi64))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.ASslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ssmallptr\"")
 					} else {
-						h.encSlicestring(([]string)(x.ASslice), e)
+						r.EncodeSymbol(`Ssmallptr`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"ASslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `ASslice`)
-				}
-				r.WriteMapElemValue()
-				if x.ASslice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn9 {
+						r.EncodeNil()
 					} else {
-						h.encSlicestring(([]string)(x.ASslice), e)
+						if x.Ssmallptr == nil {
+							r.EncodeNil()
+						} else {
+							x.Ssmallptr.CodecEncodeSelf(e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AI64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				var yyn12 bool
+				if x.Ssmall == nil {
+					yyn12 = true
+					goto LABEL12
+				}
+			LABEL12:
+				if yyr2 || yy2arr2 {
+					if yyn12 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encSliceint64(([]int64)(x.AI64slice), e)
+						r.WriteArrayElem()
+						if x.Ssmall == nil {
+							r.EncodeNil()
+						} else {
+							x.Ssmall.CodecEncodeSelf(e)
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AI64slice == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ssmall\"")
 					} else {
-						h.encSliceint64(([]int64)(x.AI64slice), e)
+						r.EncodeSymbol(`Ssmall`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AUi64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn12 {
+						r.EncodeNil()
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						if x.Ssmall == nil {
+							r.EncodeNil()
+						} else {
+							x.Ssmall.CodecEncodeSelf(e)
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64slice`)
+				var yyn15 bool
+				if x.Sptr == nil {
+					yyn15 = true
+					goto LABEL15
 				}
-				r.WriteMapElemValue()
-				if x.AUi64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+			LABEL15:
+				if yyr2 || yy2arr2 {
+					if yyn15 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+						r.WriteArrayElem()
+						if x.Sptr == nil {
+							r.EncodeNil()
+						} else {
+							x.Sptr.CodecEncodeSelf(e)
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AF64slice == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Sptr\"")
 					} else {
-						h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						r.EncodeSymbol(`Sptr`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AF64slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AF64slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AF64slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn15 {
+						r.EncodeNil()
 					} else {
-						h.encSlicefloat64(([]float64)(x.AF64slice), e)
+						if x.Sptr == nil {
+							r.EncodeNil()
+						} else {
+							x.Sptr.CodecEncodeSelf(e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AF32slice == nil {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					if false {
-					} else {
-						h.encSlicefloat32(([]float32)(x.AF32slice), e)
-					}
+					r.WriteMapEnd()
 				}
+			} // end if z.EncStructReflect()
+		}
+	}
+}
+
+func (x *Sstructbig) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AF32slice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AF32slice`)
-				}
-				r.WriteMapElemValue()
-				if x.AF32slice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSlicefloat32(([]float32)(x.AF32slice), e)
-					}
-				}
+				x.codecDecodeSelfFromMap(yyl2, d)
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AMSU16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
-					}
-				}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16`)
-				}
-				r.WriteMapElemValue()
-				if x.AMSU16 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
-					}
-				}
+				x.codecDecodeSelfFromArray(yyl2, d)
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy126 := &x.AI64arr0
-				if false {
-				} else {
-					h.encArray0int64((*[0]int64)(yy126), e)
-				}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
+	}
+}
+
+func (x *Sstructbig) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "A":
+			if r.TryDecodeAsNil() {
+				x.A = 0
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AI64arr0\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AI64arr0`)
-				}
-				r.WriteMapElemValue()
-				yy128 := &x.AI64arr0
-				if false {
-				} else {
-					h.encArray0int64((*[0]int64)(yy128), e)
-				}
+				x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.A164slice0 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceint64(([]int64)(x.A164slice0), e)
-					}
-				}
+		case "B":
+			if r.TryDecodeAsNil() {
+				x.B = false
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"A164slice0\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `A164slice0`)
-				}
-				r.WriteMapElemValue()
-				if x.A164slice0 == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceint64(([]int64)(x.A164slice0), e)
-					}
-				}
+				x.B = (bool)(r.DecodeBool())
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AUi64sliceN == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
-					}
+		case "Ssmallptr":
+			if r.TryDecodeAsNil() {
+				if true && x.Ssmallptr != nil {
+					x.Ssmallptr = nil
 				}
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AUi64sliceN\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64sliceN`)
-				}
-				r.WriteMapElemValue()
-				if x.AUi64sliceN == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
-					}
+				if x.Ssmallptr == nil {
+					x.Ssmallptr = new(Sstructsmall)
 				}
+
+				x.Ssmallptr.CodecDecodeSelf(d)
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AMSU16N == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
-					}
+		case "Ssmall":
+			if r.TryDecodeAsNil() {
+				if true && x.Ssmall != nil {
+					x.Ssmall = nil
 				}
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16N\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16N`)
-				}
-				r.WriteMapElemValue()
-				if x.AMSU16N == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
-					}
+				if x.Ssmall == nil {
+					x.Ssmall = new(Sstructsmall)
 				}
+
+				x.Ssmall.CodecDecodeSelf(d)
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.AMSU16E == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
-					}
+		case "Sptr":
+			if r.TryDecodeAsNil() {
+				if true && x.Sptr != nil {
+					x.Sptr = nil
 				}
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"AMSU16E\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16E`)
-				}
-				r.WriteMapElemValue()
-				if x.AMSU16E == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
-					}
+				if x.Sptr == nil {
+					x.Sptr = new(Sstructbig)
 				}
+
+				x.Sptr.CodecDecodeSelf(d)
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				yy143 := &x.NotAnon
-				yy143.CodecEncodeSelf(e)
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *Sstructbig) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj9 int
+	var yyb9 bool
+	var yyhl9 bool = l >= 0
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.A = 0
+	} else {
+		x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+	}
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.B = false
+	} else {
+		x.B = (bool)(r.DecodeBool())
+	}
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		if true && x.Ssmallptr != nil {
+			x.Ssmallptr = nil
+		}
+	} else {
+		if x.Ssmallptr == nil {
+			x.Ssmallptr = new(Sstructsmall)
+		}
+
+		x.Ssmallptr.CodecDecodeSelf(d)
+	}
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		if true && x.Ssmall != nil {
+			x.Ssmall = nil
+		}
+	} else {
+		if x.Ssmall == nil {
+			x.Ssmall = new(Sstructsmall)
+		}
+
+		x.Ssmall.CodecDecodeSelf(d)
+	}
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		if true && x.Sptr != nil {
+			x.Sptr = nil
+		}
+	} else {
+		if x.Sptr == nil {
+			x.Sptr = new(Sstructbig)
+		}
+
+		x.Sptr.CodecDecodeSelf(d)
+	}
+	for {
+		yyj9++
+		if yyhl9 {
+			yyb9 = yyj9 > l
+		} else {
+			yyb9 = r.CheckBreak()
+		}
+		if yyb9 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj9-1, "")
+	}
+	r.ReadArrayEnd()
+}
+
+func (x *SstructbigMapBySlice) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"NotAnon\"")
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = true // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(5)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `NotAnon`)
+					r.WriteMapStart(5)
 				}
-				r.WriteMapElemValue()
-				yy145 := &x.NotAnon
-				yy145.CodecEncodeSelf(e)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Nmap == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encMapstringbool((map[string]bool)(x.Nmap), e)
+						r.EncodeInt(int64(x.A))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nmap\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Nmap`)
-				}
-				r.WriteMapElemValue()
-				if x.Nmap == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A\"")
 					} else {
-						h.encMapstringbool((map[string]bool)(x.Nmap), e)
+						r.EncodeSymbol(`A`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Nslice == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Nslice))
+						r.EncodeInt(int64(x.A))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nslice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Nslice`)
-				}
-				r.WriteMapElemValue()
-				if x.Nslice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						r.EncodeStringBytesRaw([]byte(x.Nslice))
+						r.EncodeBool(bool(x.B))
 					}
-				}
-			}
-			var yyn153 bool
-			if x.TestStrucCommon.Nint64 == nil {
-				yyn153 = true
-				goto LABEL153
-			}
-		LABEL153:
-			if yyr2 || yy2arr2 {
-				if yyn153 {
-					r.WriteArrayElem()
-					r.EncodeNil()
 				} else {
-					r.WriteArrayElem()
-					if x.Nint64 == nil {
-						r.EncodeNil()
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
 					} else {
-						yy154 := *x.Nint64
-						if false {
-						} else {
-							r.EncodeInt(int64(yy154))
-						}
+						r.EncodeSymbol(`B`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.B))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nint64\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Nint64`)
+				var yyn9 bool
+				if x.Ssmallptr == nil {
+					yyn9 = true
+					goto LABEL9
 				}
-				r.WriteMapElemValue()
-				if yyn153 {
-					r.EncodeNil()
-				} else {
-					if x.Nint64 == nil {
+			LABEL9:
+				if yyr2 || yy2arr2 {
+					if yyn9 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						yy156 := *x.Nint64
-						if false {
+						r.WriteArrayElem()
+						if x.Ssmallptr == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeInt(int64(yy156))
+							x.Ssmallptr.CodecEncodeSelf(e)
 						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Mtsptr == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encMapstringPtrtoTestStruc((map[string]*TestStruc)(x.Mtsptr), e)
-					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Mtsptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Mtsptr`)
-				}
-				r.WriteMapElemValue()
-				if x.Mtsptr == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ssmallptr\"")
 					} else {
-						h.encMapstringPtrtoTestStruc((map[string]*TestStruc)(x.Mtsptr), e)
+						r.EncodeSymbol(`Ssmallptr`)
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Mts == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn9 {
+						r.EncodeNil()
 					} else {
-						h.encMapstringTestStruc((map[string]TestStruc)(x.Mts), e)
+						if x.Ssmallptr == nil {
+							r.EncodeNil()
+						} else {
+							x.Ssmallptr.CodecEncodeSelf(e)
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Mts\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Mts`)
+				var yyn12 bool
+				if x.Ssmall == nil {
+					yyn12 = true
+					goto LABEL12
 				}
-				r.WriteMapElemValue()
-				if x.Mts == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+			LABEL12:
+				if yyr2 || yy2arr2 {
+					if yyn12 {
+						r.WriteArrayElem()
+						r.EncodeNil()
 					} else {
-						h.encMapstringTestStruc((map[string]TestStruc)(x.Mts), e)
+						r.WriteArrayElem()
+						if x.Ssmall == nil {
+							r.EncodeNil()
+						} else {
+							x.Ssmall.CodecEncodeSelf(e)
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Its == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ssmall\"")
 					} else {
-						h.encSlicePtrtoTestStruc(([]*TestStruc)(x.Its), e)
+						r.EncodeSymbol(`Ssmall`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Its\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Its`)
-				}
-				r.WriteMapElemValue()
-				if x.Its == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+					r.WriteMapElemValue()
+					if yyn12 {
+						r.EncodeNil()
 					} else {
-						h.encSlicePtrtoTestStruc(([]*TestStruc)(x.Its), e)
+						if x.Ssmall == nil {
+							r.EncodeNil()
+						} else {
+							x.Ssmall.CodecEncodeSelf(e)
+						}
 					}
 				}
-			}
-			var yyn167 bool
-			if x.Nteststruc == nil {
-				yyn167 = true
-				goto LABEL167
-			}
-		LABEL167:
-			if yyr2 || yy2arr2 {
-				if yyn167 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.Nteststruc == nil {
+				var yyn15 bool
+				if x.Sptr == nil {
+					yyn15 = true
+					goto LABEL15
+				}
+			LABEL15:
+				if yyr2 || yy2arr2 {
+					if yyn15 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						x.Nteststruc.CodecEncodeSelf(e)
+						r.WriteArrayElem()
+						if x.Sptr == nil {
+							r.EncodeNil()
+						} else {
+							x.Sptr.CodecEncodeSelf(e)
+						}
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nteststruc\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Nteststruc`)
-				}
-				r.WriteMapElemValue()
-				if yyn167 {
-					r.EncodeNil()
-				} else {
-					if x.Nteststruc == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Sptr\"")
+					} else {
+						r.EncodeSymbol(`Sptr`)
+					}
+					r.WriteMapElemValue()
+					if yyn15 {
 						r.EncodeNil()
 					} else {
-						x.Nteststruc.CodecEncodeSelf(e)
+						if x.Sptr == nil {
+							r.EncodeNil()
+						} else {
+							x.Sptr.CodecEncodeSelf(e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
 
-func (x *TestStruc) CodecDecodeSelf(d *Decoder) {
+func (x *SstructbigMapBySlice) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -6005,7 +8269,7 @@ func (x *TestStruc) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
-func (x *TestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
+func (x *SstructbigMapBySlice) codecDecodeSelfFromMap(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -6024,1469 +8288,3094 @@ func (x *TestStruc) codecDecodeSelfFromMap(l int, d *Decoder) {
 		yys3 := z.StringView(r.DecodeStringAsBytes())
 		r.ReadMapElemValue()
 		switch yys3 {
-		case "S":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.S = ""
-			} else {
-				x.S = (string)(r.DecodeString())
-			}
-		case "I64":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I64 = 0
-			} else {
-				x.I64 = (int64)(r.DecodeInt64())
-			}
-		case "I32":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I32 = 0
-			} else {
-				x.I32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-			}
-		case "I16":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I16 = 0
-			} else {
-				x.I16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-			}
-		case "I8":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I8 = 0
-			} else {
-				x.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
-			}
-		case "I64n":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I64n = 0
-			} else {
-				x.I64n = (int64)(r.DecodeInt64())
-			}
-		case "I32n":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I32n = 0
-			} else {
-				x.I32n = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-			}
-		case "I16n":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I16n = 0
-			} else {
-				x.I16n = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-			}
-		case "I8n":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I8n = 0
-			} else {
-				x.I8n = (int8)(z.C.IntV(r.DecodeInt64(), 8))
-			}
-		case "Ui64":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Ui64 = 0
-			} else {
-				x.Ui64 = (uint64)(r.DecodeUint64())
-			}
-		case "Ui32":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Ui32 = 0
-			} else {
-				x.Ui32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
-			}
-		case "Ui16":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Ui16 = 0
-			} else {
-				x.Ui16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
-			}
-		case "Ui8":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Ui8 = 0
-			} else {
-				x.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-			}
-		case "F64":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.F64 = 0
-			} else {
-				x.F64 = (float64)(r.DecodeFloat64())
-			}
-		case "F32":
+		case "A":
 			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.F32 = 0
+				x.A = 0
 			} else {
-				x.F32 = (float32)(r.DecodeFloat32As64())
+				x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
 		case "B":
 			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.B = false
+				x.B = false
 			} else {
 				x.B = (bool)(r.DecodeBool())
 			}
-		case "By":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.By = 0
-			} else {
-				x.By = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
-			}
-		case "Sslice":
+		case "Ssmallptr":
 			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Sslice = nil
-			} else {
-				if false {
-				} else {
-					h.decSlicestring((*[]string)(&x.Sslice), d)
+				if true && x.Ssmallptr != nil {
+					x.Ssmallptr = nil
 				}
-			}
-		case "I64slice":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I64slice = nil
 			} else {
-				if false {
-				} else {
-					h.decSliceint64((*[]int64)(&x.I64slice), d)
+				if x.Ssmallptr == nil {
+					x.Ssmallptr = new(Sstructsmall)
 				}
+
+				x.Ssmallptr.CodecDecodeSelf(d)
 			}
-		case "I16slice":
+		case "Ssmall":
 			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.I16slice = nil
-			} else {
-				if false {
-				} else {
-					h.decSliceint16((*[]int16)(&x.I16slice), d)
+				if true && x.Ssmall != nil {
+					x.Ssmall = nil
 				}
-			}
-		case "Ui64slice":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Ui64slice = nil
 			} else {
-				if false {
-				} else {
-					h.decSliceuint64((*[]uint64)(&x.Ui64slice), d)
+				if x.Ssmall == nil {
+					x.Ssmall = new(Sstructsmall)
 				}
+
+				x.Ssmall.CodecDecodeSelf(d)
 			}
-		case "Ui8slice":
+		case "Sptr":
 			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Ui8slice = nil
-			} else {
-				if false {
-				} else {
-					x.Ui8slice = r.DecodeBytes(([]byte)(x.Ui8slice), false)
+				if true && x.Sptr != nil {
+					x.Sptr = nil
 				}
-			}
-		case "Bslice":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Bslice = nil
 			} else {
-				if false {
-				} else {
-					h.decSlicebool((*[]bool)(&x.Bslice), d)
+				if x.Sptr == nil {
+					x.Sptr = new(Sstructbig)
 				}
+
+				x.Sptr.CodecDecodeSelf(d)
 			}
-		case "Byslice":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Byslice = nil
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *SstructbigMapBySlice) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj9 int
+	var yyb9 bool
+	var yyhl9 bool = l >= 0
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.A = 0
+	} else {
+		x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+	}
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.B = false
+	} else {
+		x.B = (bool)(r.DecodeBool())
+	}
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		if true && x.Ssmallptr != nil {
+			x.Ssmallptr = nil
+		}
+	} else {
+		if x.Ssmallptr == nil {
+			x.Ssmallptr = new(Sstructsmall)
+		}
+
+		x.Ssmallptr.CodecDecodeSelf(d)
+	}
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		if true && x.Ssmall != nil {
+			x.Ssmall = nil
+		}
+	} else {
+		if x.Ssmall == nil {
+			x.Ssmall = new(Sstructsmall)
+		}
+
+		x.Ssmall.CodecDecodeSelf(d)
+	}
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
+	} else {
+		yyb9 = r.CheckBreak()
+	}
+	if yyb9 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		if true && x.Sptr != nil {
+			x.Sptr = nil
+		}
+	} else {
+		if x.Sptr == nil {
+			x.Sptr = new(Sstructbig)
+		}
+
+		x.Sptr.CodecDecodeSelf(d)
+	}
+	for {
+		yyj9++
+		if yyhl9 {
+			yyb9 = yyj9 > l
+		} else {
+			yyb9 = r.CheckBreak()
+		}
+		if yyb9 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj9-1, "")
+	}
+	r.ReadArrayEnd()
+}
+
+func (x *tLowerFirstLetter) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(2)
 				} else {
-					x.Byslice = r.DecodeBytes(([]byte)(x.Byslice), false)
+					r.WriteMapStart(2)
 				}
-			}
-		case "Iptrslice":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Iptrslice = nil
-			} else {
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I))
+					}
 				} else {
-					h.decSlicePtrtoint64((*[]*int64)(&x.Iptrslice), d)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I\"")
+					} else {
+						r.EncodeSymbol(`I`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I))
+					}
 				}
-			}
-		case "WrapSliceInt64":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.WrapSliceInt64 = nil
-			} else {
-				x.WrapSliceInt64.CodecDecodeSelf(d)
-			}
-		case "WrapSliceString":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.WrapSliceString = nil
-			} else {
-				x.WrapSliceString.CodecDecodeSelf(d)
-			}
-		case "Msi64":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Msi64 = nil
-			} else {
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
 				} else {
-					h.decMapstringint64((*map[string]int64)(&x.Msi64), d)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"S\"")
+					} else {
+						r.EncodeSymbol(`S`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
 				}
-			}
-		case "Simplef":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Simplef = testSimpleFields{}
-			} else {
-				x.Simplef.CodecDecodeSelf(d)
-			}
-		case "SstrUi64T":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.SstrUi64T = nil
-			} else {
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					h.decSlicestringUint64T((*[]stringUint64T)(&x.SstrUi64T), d)
+					r.WriteMapEnd()
 				}
-			}
-		case "AS":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AS = ""
-			} else {
-				x.AS = (string)(r.DecodeString())
-			}
-		case "AI64":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AI64 = 0
-			} else {
-				x.AI64 = (int64)(r.DecodeInt64())
-			}
-		case "AI16":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AI16 = 0
+			} // end if z.EncStructReflect()
+		}
+	}
+}
+
+func (x *tLowerFirstLetter) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
 			} else {
-				x.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+				x.codecDecodeSelfFromMap(yyl2, d)
 			}
-		case "AUi64":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AUi64 = 0
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
 			} else {
-				x.AUi64 = (uint64)(r.DecodeUint64())
+				x.codecDecodeSelfFromArray(yyl2, d)
 			}
-		case "ASslice":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.ASslice = nil
-			} else {
-				if false {
-				} else {
-					h.decSlicestring((*[]string)(&x.ASslice), d)
-				}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
+	}
+}
+
+func (x *tLowerFirstLetter) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
 			}
-		case "AI64slice":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AI64slice = nil
-			} else {
-				if false {
-				} else {
-					h.decSliceint64((*[]int64)(&x.AI64slice), d)
-				}
+		} else {
+			if r.CheckBreak() {
+				break
 			}
-		case "AUi64slice":
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "I":
 			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AUi64slice = nil
+				x.I = 0
 			} else {
-				if false {
-				} else {
-					h.decSliceuint64((*[]uint64)(&x.AUi64slice), d)
-				}
+				x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
-		case "AF64slice":
+		case "S":
 			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AF64slice = nil
+				x.S = ""
 			} else {
-				if false {
-				} else {
-					h.decSlicefloat64((*[]float64)(&x.AF64slice), d)
-				}
+				x.S = (string)(r.DecodeString())
 			}
-		case "AF32slice":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AF32slice = nil
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *tLowerFirstLetter) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyj6++
+	if yyhl6 {
+		yyb6 = yyj6 > l
+	} else {
+		yyb6 = r.CheckBreak()
+	}
+	if yyb6 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I = 0
+	} else {
+		x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+	}
+	yyj6++
+	if yyhl6 {
+		yyb6 = yyj6 > l
+	} else {
+		yyb6 = r.CheckBreak()
+	}
+	if yyb6 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.S = ""
+	} else {
+		x.S = (string)(r.DecodeString())
+	}
+	for {
+		yyj6++
+		if yyhl6 {
+			yyb6 = yyj6 > l
+		} else {
+			yyb6 = r.CheckBreak()
+		}
+		if yyb6 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
+	}
+	r.ReadArrayEnd()
+}
+
+func (x wrapInt64) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.EncExtension(x, yyxt1)
+	} else {
+		r.EncodeInt(int64(x))
+	}
+}
+
+func (x *wrapInt64) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		*x = (wrapInt64)(r.DecodeInt64())
+	}
+}
+
+func (x wrapUint8) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.EncExtension(x, yyxt1)
+	} else {
+		r.EncodeUint(uint64(x))
+	}
+}
+
+func (x *wrapUint8) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		*x = (wrapUint8)(z.C.UintV(r.DecodeUint64(), 8))
+	}
+}
+
+func (x wrapBytes) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			h.encwrapBytes((wrapBytes)(x), e)
+		}
+	}
+}
+
+func (x *wrapBytes) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		h.decwrapBytes((*wrapBytes)(x), d)
+	}
+}
+
+func (x *AnonInTestStrucIntf) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(5)
 				} else {
-					h.decSlicefloat32((*[]float32)(&x.AF32slice), d)
+					r.WriteMapStart(5)
 				}
-			}
-		case "AMSU16":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AMSU16 = nil
-			} else {
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Islice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceInterface(([]interface{})(x.Islice), e)
+						}
+					}
 				} else {
-					h.decMapstringuint16((*map[string]uint16)(&x.AMSU16), d)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Islice\"")
+					} else {
+						r.EncodeSymbol(`Islice`)
+					}
+					r.WriteMapElemValue()
+					if x.Islice == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceInterface(([]interface{})(x.Islice), e)
+						}
+					}
 				}
-			}
-		case "AI64arr0":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AI64arr0 = [0]int64{}
-			} else {
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Ms == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringInterface((map[string]interface{})(x.Ms), e)
+						}
+					}
 				} else {
-					h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Ms\"")
+					} else {
+						r.EncodeSymbol(`Ms`)
+					}
+					r.WriteMapElemValue()
+					if x.Ms == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringInterface((map[string]interface{})(x.Ms), e)
+						}
+					}
 				}
-			}
-		case "A164slice0":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.A164slice0 = nil
-			} else {
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Nintf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							z.EncFallback(x.Nintf)
+						}
+					}
 				} else {
-					h.decSliceint64((*[]int64)(&x.A164slice0), d)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Nintf\"")
+					} else {
+						r.EncodeSymbol(`Nintf`)
+					}
+					r.WriteMapElemValue()
+					if x.Nintf == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							z.EncFallback(x.Nintf)
+						}
+					}
 				}
-			}
-		case "AUi64sliceN":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AUi64sliceN = nil
-			} else {
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else if !z.EncBasicHandle().TimeNotBuiltin {
+						r.EncodeTime(x.T)
+					} else if yyxt13 := z.Extension(z.I2Rtid(x.T)); yyxt13 != nil {
+						z.EncExtension(x.T, yyxt13)
+					} else if z.EncBinary() {
+						z.EncBinaryMarshal(x.T)
+					} else if !z.EncBinary() && z.IsJSONHandle() {
+						z.EncJSONMarshal(x.T)
+					} else {
+						z.EncFallback(x.T)
+					}
 				} else {
-					h.decSliceuint64((*[]uint64)(&x.AUi64sliceN), d)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"T\"")
+					} else {
+						r.EncodeSymbol(`T`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else if !z.EncBasicHandle().TimeNotBuiltin {
+						r.EncodeTime(x.T)
+					} else if yyxt14 := z.Extension(z.I2Rtid(x.T)); yyxt14 != nil {
+						z.EncExtension(x.T, yyxt14)
+					} else if z.EncBinary() {
+						z.EncBinaryMarshal(x.T)
+					} else if !z.EncBinary() && z.IsJSONHandle() {
+						z.EncJSONMarshal(x.T)
+					} else {
+						z.EncFallback(x.T)
+					}
 				}
-			}
-		case "AMSU16N":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AMSU16N = nil
-			} else {
-				if false {
+				var yyn15 bool
+				if x.Tptr == nil {
+					yyn15 = true
+					goto LABEL15
+				}
+			LABEL15:
+				if yyr2 || yy2arr2 {
+					if yyn15 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if x.Tptr == nil {
+							r.EncodeNil()
+						} else {
+							yy16 := *x.Tptr
+							if false {
+							} else if !z.EncBasicHandle().TimeNotBuiltin {
+								r.EncodeTime(yy16)
+							} else if yyxt17 := z.Extension(z.I2Rtid(yy16)); yyxt17 != nil {
+								z.EncExtension(yy16, yyxt17)
+							} else if z.EncBinary() {
+								z.EncBinaryMarshal(yy16)
+							} else if !z.EncBinary() && z.IsJSONHandle() {
+								z.EncJSONMarshal(yy16)
+							} else {
+								z.EncFallback(yy16)
+							}
+						}
+					}
 				} else {
-					h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Tptr\"")
+					} else {
+						r.EncodeSymbol(`Tptr`)
+					}
+					r.WriteMapElemValue()
+					if yyn15 {
+						r.EncodeNil()
+					} else {
+						if x.Tptr == nil {
+							r.EncodeNil()
+						} else {
+							yy18 := *x.Tptr
+							if false {
+							} else if !z.EncBasicHandle().TimeNotBuiltin {
+								r.EncodeTime(yy18)
+							} else if yyxt19 := z.Extension(z.I2Rtid(yy18)); yyxt19 != nil {
+								z.EncExtension(yy18, yyxt19)
+							} else if z.EncBinary() {
+								z.EncBinaryMarshal(yy18)
+							} else if !z.EncBinary() && z.IsJSONHandle() {
+								z.EncJSONMarshal(yy18)
+							} else {
+								z.EncFallback(yy18)
+							}
+						}
+					}
 				}
-			}
-		case "AMSU16E":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.AnonInTestStruc.AMSU16E = nil
-			} else {
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
+					r.WriteMapEnd()
 				}
-			}
-		case "NotAnon":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.NotAnon = AnonInTestStruc{}
+			} // end if z.EncStructReflect()
+		}
+	}
+}
+
+func (x *AnonInTestStrucIntf) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
 			} else {
-				x.NotAnon.CodecDecodeSelf(d)
+				x.codecDecodeSelfFromMap(yyl2, d)
 			}
-		case "Nmap":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Nmap = nil
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
 			} else {
-				if false {
-				} else {
-					h.decMapstringbool((*map[string]bool)(&x.Nmap), d)
-				}
+				x.codecDecodeSelfFromArray(yyl2, d)
 			}
-		case "Nslice":
-			if r.TryDecodeAsNil() {
-				x.TestStrucCommon.Nslice = nil
-			} else {
-				if false {
-				} else {
-					x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
-				}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
+	}
+}
+
+func (x *AnonInTestStrucIntf) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
 			}
-		case "Nint64":
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "Islice":
 			if r.TryDecodeAsNil() {
-				if true && x.TestStrucCommon.Nint64 != nil {
-					x.TestStrucCommon.Nint64 = nil
-				}
+				x.Islice = nil
 			} else {
-				if x.TestStrucCommon.Nint64 == nil {
-					x.TestStrucCommon.Nint64 = new(int64)
-				}
-
 				if false {
 				} else {
-					*x.Nint64 = (int64)(r.DecodeInt64())
+					h.decSliceInterface((*[]interface{})(&x.Islice), d)
 				}
 			}
-		case "Mtsptr":
+		case "Ms":
 			if r.TryDecodeAsNil() {
-				x.Mtsptr = nil
+				x.Ms = nil
 			} else {
 				if false {
 				} else {
-					h.decMapstringPtrtoTestStruc((*map[string]*TestStruc)(&x.Mtsptr), d)
+					h.decMapstringInterface((*map[string]interface{})(&x.Ms), d)
 				}
 			}
-		case "Mts":
+		case "Nintf":
 			if r.TryDecodeAsNil() {
-				x.Mts = nil
+				x.Nintf = nil
 			} else {
 				if false {
 				} else {
-					h.decMapstringTestStruc((*map[string]TestStruc)(&x.Mts), d)
+					z.DecFallback(&x.Nintf, true)
 				}
 			}
-		case "Its":
+		case "T":
 			if r.TryDecodeAsNil() {
-				x.Its = nil
+				x.T = time.Time{}
 			} else {
 				if false {
+				} else if !z.DecBasicHandle().TimeNotBuiltin {
+					x.T = r.DecodeTime()
+				} else if yyxt11 := z.Extension(z.I2Rtid(x.T)); yyxt11 != nil {
+					z.DecExtension(x.T, yyxt11)
+				} else if z.DecBinary() {
+					z.DecBinaryUnmarshal(&x.T)
+				} else if !z.DecBinary() && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(&x.T)
 				} else {
-					h.decSlicePtrtoTestStruc((*[]*TestStruc)(&x.Its), d)
+					z.DecFallback(&x.T, false)
 				}
 			}
-		case "Nteststruc":
+		case "Tptr":
 			if r.TryDecodeAsNil() {
-				if true && x.Nteststruc != nil {
-					x.Nteststruc = nil
+				if true && x.Tptr != nil {
+					x.Tptr = nil
 				}
 			} else {
-				if x.Nteststruc == nil {
-					x.Nteststruc = new(TestStruc)
+				if x.Tptr == nil {
+					x.Tptr = new(time.Time)
 				}
 
-				x.Nteststruc.CodecDecodeSelf(d)
+				if false {
+				} else if !z.DecBasicHandle().TimeNotBuiltin {
+					*x.Tptr = r.DecodeTime()
+				} else if yyxt13 := z.Extension(z.I2Rtid(x.Tptr)); yyxt13 != nil {
+					z.DecExtension(x.Tptr, yyxt13)
+				} else if z.DecBinary() {
+					z.DecBinaryUnmarshal(x.Tptr)
+				} else if !z.DecBinary() && z.IsJSONHandle() {
+					z.DecJSONUnmarshal(x.Tptr)
+				} else {
+					z.DecFallback(x.Tptr, false)
+				}
 			}
 		default:
 			z.DecStructFieldNotFound(-1, yys3)
 		} // end switch yys3
 	} // end for yyj3
 	r.ReadMapEnd()
-}
-
-func (x *TestStruc) codecDecodeSelfFromArray(l int, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-	var yyj84 int
-	var yyb84 bool
-	var yyhl84 bool = l >= 0
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.S = ""
-	} else {
-		x.S = (string)(r.DecodeString())
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I64 = 0
-	} else {
-		x.I64 = (int64)(r.DecodeInt64())
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I32 = 0
-	} else {
-		x.I32 = (int32)(z.C.IntV(r.DecodeInt64(), 32))
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+}
+
+func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj14 int
+	var yyb14 bool
+	var yyhl14 bool = l >= 0
+	yyj14++
+	if yyhl14 {
+		yyb14 = yyj14 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb14 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb14 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I16 = 0
+		x.Islice = nil
 	} else {
-		x.I16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+		if false {
+		} else {
+			h.decSliceInterface((*[]interface{})(&x.Islice), d)
+		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj14++
+	if yyhl14 {
+		yyb14 = yyj14 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb14 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb14 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I8 = 0
+		x.Ms = nil
 	} else {
-		x.I8 = (int8)(z.C.IntV(r.DecodeInt64(), 8))
+		if false {
+		} else {
+			h.decMapstringInterface((*map[string]interface{})(&x.Ms), d)
+		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj14++
+	if yyhl14 {
+		yyb14 = yyj14 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb14 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb14 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I64n = 0
+		x.Nintf = nil
 	} else {
-		x.I64n = (int64)(r.DecodeInt64())
+		if false {
+		} else {
+			z.DecFallback(&x.Nintf, true)
+		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj14++
+	if yyhl14 {
+		yyb14 = yyj14 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb14 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb14 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I32n = 0
+		x.T = time.Time{}
 	} else {
-		x.I32n = (int32)(z.C.IntV(r.DecodeInt64(), 32))
+		if false {
+		} else if !z.DecBasicHandle().TimeNotBuiltin {
+			x.T = r.DecodeTime()
+		} else if yyxt22 := z.Extension(z.I2Rtid(x.T)); yyxt22 != nil {
+			z.DecExtension(x.T, yyxt22)
+		} else if z.DecBinary() {
+			z.DecBinaryUnmarshal(&x.T)
+		} else if !z.DecBinary() && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(&x.T)
+		} else {
+			z.DecFallback(&x.T, false)
+		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj14++
+	if yyhl14 {
+		yyb14 = yyj14 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb14 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb14 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I16n = 0
-	} else {
-		x.I16n = (int16)(z.C.IntV(r.DecodeInt64(), 16))
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+		if true && x.Tptr != nil {
+			x.Tptr = nil
+		}
 	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
+		if x.Tptr == nil {
+			x.Tptr = new(time.Time)
+		}
+
+		if false {
+		} else if !z.DecBasicHandle().TimeNotBuiltin {
+			*x.Tptr = r.DecodeTime()
+		} else if yyxt24 := z.Extension(z.I2Rtid(x.Tptr)); yyxt24 != nil {
+			z.DecExtension(x.Tptr, yyxt24)
+		} else if z.DecBinary() {
+			z.DecBinaryUnmarshal(x.Tptr)
+		} else if !z.DecBinary() && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(x.Tptr)
+		} else {
+			z.DecFallback(x.Tptr, false)
+		}
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I8n = 0
-	} else {
-		x.I8n = (int8)(z.C.IntV(r.DecodeInt64(), 8))
+	for {
+		yyj14++
+		if yyhl14 {
+			yyb14 = yyj14 > l
+		} else {
+			yyb14 = r.CheckBreak()
+		}
+		if yyb14 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj14-1, "")
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	r.ReadArrayEnd()
+}
+
+func (x *missingFielderT1) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
 	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(2)
+				} else {
+					r.WriteMapStart(2)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"S\"")
+					} else {
+						r.EncodeSymbol(`S`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.B))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
+					} else {
+						r.EncodeSymbol(`B`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.B))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
+		}
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Ui64 = 0
+}
+
+func (x *missingFielderT1) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
 	} else {
-		x.Ui64 = (uint64)(r.DecodeUint64())
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
+			} else {
+				x.codecDecodeSelfFromMap(yyl2, d)
+			}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl2, d)
+			}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+}
+
+func (x *missingFielderT1) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "S":
+			if r.TryDecodeAsNil() {
+				x.S = ""
+			} else {
+				x.S = (string)(r.DecodeString())
+			}
+		case "B":
+			if r.TryDecodeAsNil() {
+				x.B = false
+			} else {
+				x.B = (bool)(r.DecodeBool())
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *missingFielderT1) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyj6++
+	if yyhl6 {
+		yyb6 = yyj6 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb6 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb6 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Ui32 = 0
+		x.S = ""
 	} else {
-		x.Ui32 = (uint32)(z.C.UintV(r.DecodeUint64(), 32))
+		x.S = (string)(r.DecodeString())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj6++
+	if yyhl6 {
+		yyb6 = yyj6 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb6 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb6 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Ui16 = 0
-	} else {
-		x.Ui16 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+		x.B = false
 	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
+		x.B = (bool)(r.DecodeBool())
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Ui8 = 0
-	} else {
-		x.Ui8 = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+	for {
+		yyj6++
+		if yyhl6 {
+			yyb6 = yyj6 > l
+		} else {
+			yyb6 = r.CheckBreak()
+		}
+		if yyb6 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	r.ReadArrayEnd()
+}
+
+func (x *missingFielderT2) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
 	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(4)
+				} else {
+					r.WriteMapStart(4)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"S\"")
+					} else {
+						r.EncodeSymbol(`S`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.B))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
+					} else {
+						r.EncodeSymbol(`B`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.B))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeFloat64(float64(x.F))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"F\"")
+					} else {
+						r.EncodeSymbol(`F`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeFloat64(float64(x.F))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I\"")
+					} else {
+						r.EncodeSymbol(`I`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
+		}
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.F64 = 0
+}
+
+func (x *missingFielderT2) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
 	} else {
-		x.F64 = (float64)(r.DecodeFloat64())
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
+			} else {
+				x.codecDecodeSelfFromMap(yyl2, d)
+			}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl2, d)
+			}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+}
+
+func (x *missingFielderT2) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "S":
+			if r.TryDecodeAsNil() {
+				x.S = ""
+			} else {
+				x.S = (string)(r.DecodeString())
+			}
+		case "B":
+			if r.TryDecodeAsNil() {
+				x.B = false
+			} else {
+				x.B = (bool)(r.DecodeBool())
+			}
+		case "F":
+			if r.TryDecodeAsNil() {
+				x.F = 0
+			} else {
+				x.F = (float64)(r.DecodeFloat64())
+			}
+		case "I":
+			if r.TryDecodeAsNil() {
+				x.I = 0
+			} else {
+				x.I = (int64)(r.DecodeInt64())
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *missingFielderT2) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj8 int
+	var yyb8 bool
+	var yyhl8 bool = l >= 0
+	yyj8++
+	if yyhl8 {
+		yyb8 = yyj8 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb8 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb8 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.F32 = 0
+		x.S = ""
 	} else {
-		x.F32 = (float32)(r.DecodeFloat32As64())
+		x.S = (string)(r.DecodeString())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj8++
+	if yyhl8 {
+		yyb8 = yyj8 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb8 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb8 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.B = false
+		x.B = false
 	} else {
 		x.B = (bool)(r.DecodeBool())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj8++
+	if yyhl8 {
+		yyb8 = yyj8 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb8 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb8 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.By = 0
+		x.F = 0
 	} else {
-		x.By = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+		x.F = (float64)(r.DecodeFloat64())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj8++
+	if yyhl8 {
+		yyb8 = yyj8 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb8 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb8 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Sslice = nil
-	} else {
-		if false {
-		} else {
-			h.decSlicestring((*[]string)(&x.Sslice), d)
-		}
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+		x.I = 0
 	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
+		x.I = (int64)(r.DecodeInt64())
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I64slice = nil
-	} else {
-		if false {
+	for {
+		yyj8++
+		if yyhl8 {
+			yyb8 = yyj8 > l
 		} else {
-			h.decSliceint64((*[]int64)(&x.I64slice), d)
+			yyb8 = r.CheckBreak()
 		}
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.I16slice = nil
-	} else {
-		if false {
-		} else {
-			h.decSliceint16((*[]int16)(&x.I16slice), d)
+		if yyb8 {
+			break
 		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj8-1, "")
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Ui64slice = nil
+	r.ReadArrayEnd()
+}
+
+func (x *unknownFieldsT1) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
 	} else {
 		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
 		} else {
-			h.decSliceuint64((*[]uint64)(&x.Ui64slice), d)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(1)
+				} else {
+					r.WriteMapStart(1 + x.Unknown.Len())
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A\"")
+					} else {
+						r.EncodeSymbol(`A`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					z.EncUnknownFields(&x.Unknown)
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Ui8slice = nil
+}
+
+func (x *unknownFieldsT1) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
 	} else {
-		if false {
+		x.Unknown.Reset()
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
+			} else {
+				x.codecDecodeSelfFromMap(yyl2, d)
+			}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl2, d)
+			}
 		} else {
-			x.Ui8slice = r.DecodeBytes(([]byte)(x.Ui8slice), false)
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Bslice = nil
-	} else {
-		if false {
+}
+
+func (x *unknownFieldsT1) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
 		} else {
-			h.decSlicebool((*[]bool)(&x.Bslice), d)
+			if r.CheckBreak() {
+				break
+			}
 		}
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+		r.ReadMapElemKey()
+		z.DecUnknownFieldKeyStart()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		yys3uk := z.DecUnknownFieldKeyEnd()
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "A":
+			if r.TryDecodeAsNil() {
+				x.A = ""
+			} else {
+				x.A = (string)(r.DecodeString())
+			}
+		default:
+			z.DecUnknownField(&x.Unknown, yys3uk)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *unknownFieldsT1) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj5 int
+	var yyb5 bool
+	var yyhl5 bool = l >= 0
+	yyj5++
+	if yyhl5 {
+		yyb5 = yyj5 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb5 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb5 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Byslice = nil
+		x.A = ""
 	} else {
-		if false {
+		x.A = (string)(r.DecodeString())
+	}
+	for {
+		yyj5++
+		if yyhl5 {
+			yyb5 = yyj5 > l
 		} else {
-			x.Byslice = r.DecodeBytes(([]byte)(x.Byslice), false)
+			yyb5 = r.CheckBreak()
 		}
+		if yyb5 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj5-1, "")
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Iptrslice = nil
+	r.ReadArrayEnd()
+}
+
+func (x *unknownFieldsT2) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
 	} else {
 		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
 		} else {
-			h.decSlicePtrtoint64((*[]*int64)(&x.Iptrslice), d)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(5)
+				} else {
+					r.WriteMapStart(5)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A\"")
+					} else {
+						r.EncodeSymbol(`A`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.U))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"U\"")
+					} else {
+						r.EncodeSymbol(`U`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.U))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeFloat32(float32(x.F))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"F\"")
+					} else {
+						r.EncodeSymbol(`F`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeFloat32(float32(x.F))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.L == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.L), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"L\"")
+					} else {
+						r.EncodeSymbol(`L`)
+					}
+					r.WriteMapElemValue()
+					if x.L == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.L), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.M == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.M), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"M\"")
+					} else {
+						r.EncodeSymbol(`M`)
+					}
+					r.WriteMapElemValue()
+					if x.M == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringuint16((map[string]uint16)(x.M), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.WrapSliceInt64 = nil
+}
+
+func (x *unknownFieldsT2) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
 	} else {
-		x.WrapSliceInt64.CodecDecodeSelf(d)
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
+			} else {
+				x.codecDecodeSelfFromMap(yyl2, d)
+			}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl2, d)
+			}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+}
+
+func (x *unknownFieldsT2) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "A":
+			if r.TryDecodeAsNil() {
+				x.A = ""
+			} else {
+				x.A = (string)(r.DecodeString())
+			}
+		case "U":
+			if r.TryDecodeAsNil() {
+				x.U = 0
+			} else {
+				x.U = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+			}
+		case "F":
+			if r.TryDecodeAsNil() {
+				x.F = 0
+			} else {
+				x.F = (float32)(r.DecodeFloat32As64())
+			}
+		case "L":
+			if r.TryDecodeAsNil() {
+				x.L = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicestring((*[]string)(&x.L), d)
+				}
+			}
+		case "M":
+			if r.TryDecodeAsNil() {
+				x.M = nil
+			} else {
+				if false {
+				} else {
+					h.decMapstringuint16((*map[string]uint16)(&x.M), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *unknownFieldsT2) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj11 int
+	var yyb11 bool
+	var yyhl11 bool = l >= 0
+	yyj11++
+	if yyhl11 {
+		yyb11 = yyj11 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb11 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb11 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.WrapSliceString = nil
+		x.A = ""
 	} else {
-		x.WrapSliceString.CodecDecodeSelf(d)
+		x.A = (string)(r.DecodeString())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj11++
+	if yyhl11 {
+		yyb11 = yyj11 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb11 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb11 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Msi64 = nil
-	} else {
-		if false {
-		} else {
-			h.decMapstringint64((*map[string]int64)(&x.Msi64), d)
-		}
+		x.U = 0
+	} else {
+		x.U = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj11++
+	if yyhl11 {
+		yyb11 = yyj11 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb11 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb11 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Simplef = testSimpleFields{}
+		x.F = 0
 	} else {
-		x.Simplef.CodecDecodeSelf(d)
+		x.F = (float32)(r.DecodeFloat32As64())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj11++
+	if yyhl11 {
+		yyb11 = yyj11 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb11 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb11 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.SstrUi64T = nil
+		x.L = nil
 	} else {
 		if false {
 		} else {
-			h.decSlicestringUint64T((*[]stringUint64T)(&x.SstrUi64T), d)
+			h.decSlicestring((*[]string)(&x.L), d)
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj11++
+	if yyhl11 {
+		yyb11 = yyj11 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb11 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb11 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AS = ""
+		x.M = nil
 	} else {
-		x.AS = (string)(r.DecodeString())
+		if false {
+		} else {
+			h.decMapstringuint16((*map[string]uint16)(&x.M), d)
+		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	for {
+		yyj11++
+		if yyhl11 {
+			yyb11 = yyj11 > l
+		} else {
+			yyb11 = r.CheckBreak()
+		}
+		if yyb11 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj11-1, "")
+	}
+	r.ReadArrayEnd()
+}
+
+func (x *fieldAliasT) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
 	} else {
-		yyb84 = r.CheckBreak()
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				var yyq2 = [3]bool{     // should field at this index be written?
+					true,     // A
+					true,     // D
+					x.F != 0, // F
+				}
+				_ = yyq2
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(3)
+				} else {
+					var yynn2 int
+					for _, b := range yyq2 {
+						if b {
+							yynn2++
+						}
+					}
+					r.WriteMapStart(yynn2)
+					yynn2 = 0
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"a\"")
+					} else {
+						r.EncodeSymbol(`a`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.D))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"D\"")
+					} else {
+						r.EncodeSymbol(`D`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.D))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[2] {
+						if false {
+						} else {
+							r.EncodeInt(int64(x.F))
+						}
+					} else {
+						r.EncodeInt(0)
+					}
+				} else {
+					if yyq2[2] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"f\"")
+						} else {
+							r.EncodeSymbol(`f`)
+						}
+						r.WriteMapElemValue()
+						if false {
+						} else {
+							r.EncodeInt(int64(x.F))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
+		}
 	}
-	if yyb84 {
+}
+
+func (x *fieldAliasT) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
+			} else {
+				x.codecDecodeSelfFromMap(yyl2, d)
+			}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl2, d)
+			}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
+	}
+}
+
+func (x *fieldAliasT) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "a", "b", "c":
+			if r.TryDecodeAsNil() {
+				x.A = ""
+			} else {
+				x.A = (string)(r.DecodeString())
+			}
+		case "D", "E":
+			if r.TryDecodeAsNil() {
+				x.D = 0
+			} else {
+				x.D = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+			}
+		case "f":
+			if r.TryDecodeAsNil() {
+				x.F = 0
+			} else {
+				x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *fieldAliasT) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj7 int
+	var yyb7 bool
+	var yyhl7 bool = l >= 0
+	yyj7++
+	if yyhl7 {
+		yyb7 = yyj7 > l
+	} else {
+		yyb7 = r.CheckBreak()
+	}
+	if yyb7 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AI64 = 0
+		x.A = ""
 	} else {
-		x.AI64 = (int64)(r.DecodeInt64())
+		x.A = (string)(r.DecodeString())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj7++
+	if yyhl7 {
+		yyb7 = yyj7 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb7 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb7 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AI16 = 0
+		x.D = 0
 	} else {
-		x.AI16 = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+		x.D = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj7++
+	if yyhl7 {
+		yyb7 = yyj7 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb7 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb7 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AUi64 = 0
+		x.F = 0
 	} else {
-		x.AUi64 = (uint64)(r.DecodeUint64())
+		x.F = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
+	for {
+		yyj7++
+		if yyhl7 {
+			yyb7 = yyj7 > l
+		} else {
+			yyb7 = r.CheckBreak()
+		}
+		if yyb7 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj7-1, "")
 	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
+	r.ReadArrayEnd()
+}
+
+func (x *requiredFieldsT) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(4)
+				} else {
+					r.WriteMapStart(4)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"a\"")
+					} else {
+						r.EncodeSymbol(`a`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.B))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
+					} else {
+						r.EncodeSymbol(`B`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.B))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.C == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint(([]int)(x.C), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"c\"")
+					} else {
+						r.EncodeSymbol(`c`)
+					}
+					r.WriteMapElemValue()
+					if x.C == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSliceint(([]int)(x.C), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.D))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"D\"")
+					} else {
+						r.EncodeSymbol(`D`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.D))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
+		}
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.ASslice = nil
+}
+
+func (x *requiredFieldsT) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
 	} else {
-		if false {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			x.codecDecodeSelfFromMap(yyl2, d)
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			x.codecDecodeSelfFromArray(yyl2, d)
 		} else {
-			h.decSlicestring((*[]string)(&x.ASslice), d)
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+}
+
+func (x *requiredFieldsT) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyrq3 [3]bool // required fields seen
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "a":
+			yyrq3[1] = true
+			if r.TryDecodeAsNil() {
+				x.A = ""
+			} else {
+				x.A = (string)(r.DecodeString())
+			}
+		case "B", "b":
+			yyrq3[0] = true
+			if r.TryDecodeAsNil() {
+				x.B = 0
+			} else {
+				x.B = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+			}
+		case "c":
+			yyrq3[2] = true
+			if r.TryDecodeAsNil() {
+				x.C = nil
+			} else {
+				if false {
+				} else {
+					h.decSliceint((*[]int)(&x.C), d)
+				}
+			}
+		case "D":
+			if r.TryDecodeAsNil() {
+				x.D = 0
+			} else {
+				x.D = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+	z.DecRequiredFields(yyrq3[:], "B", "a", "c")
+}
+
+func (x *requiredFieldsT) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj9 int
+	var yyb9 bool
+	var yyhl9 bool = l >= 0
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb9 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb9 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AI64slice = nil
+		x.A = ""
 	} else {
-		if false {
-		} else {
-			h.decSliceint64((*[]int64)(&x.AI64slice), d)
-		}
+		x.A = (string)(r.DecodeString())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb9 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb9 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AUi64slice = nil
+		x.B = 0
 	} else {
-		if false {
-		} else {
-			h.decSliceuint64((*[]uint64)(&x.AUi64slice), d)
-		}
+		x.B = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb9 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb9 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AF64slice = nil
+		x.C = nil
 	} else {
 		if false {
 		} else {
-			h.decSlicefloat64((*[]float64)(&x.AF64slice), d)
+			h.decSliceint((*[]int)(&x.C), d)
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj9++
+	if yyhl9 {
+		yyb9 = yyj9 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb9 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb9 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AF32slice = nil
+		x.D = 0
 	} else {
-		if false {
+		x.D = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+	}
+	for {
+		yyj9++
+		if yyhl9 {
+			yyb9 = yyj9 > l
 		} else {
-			h.decSlicefloat32((*[]float32)(&x.AF32slice), d)
+			yyb9 = r.CheckBreak()
 		}
+		if yyb9 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj9-1, "")
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AMSU16 = nil
+	r.ReadArrayEnd()
+}
+
+func (x *requiredFieldsTT) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
 	} else {
 		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
 		} else {
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16), d)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(1)
+				} else {
+					r.WriteMapStart(1)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Items == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicerequiredFieldsT(([]requiredFieldsT)(x.Items), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Items\"")
+					} else {
+						r.EncodeSymbol(`Items`)
+					}
+					r.WriteMapElemValue()
+					if x.Items == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicerequiredFieldsT(([]requiredFieldsT)(x.Items), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AI64arr0 = [0]int64{}
+}
+
+func (x *requiredFieldsTT) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
 	} else {
-		if false {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
+			} else {
+				x.codecDecodeSelfFromMap(yyl2, d)
+			}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl2, d)
+			}
 		} else {
-			h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.A164slice0 = nil
-	} else {
-		if false {
+}
+
+func (x *requiredFieldsTT) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
 		} else {
-			h.decSliceint64((*[]int64)(&x.A164slice0), d)
+			if r.CheckBreak() {
+				break
+			}
 		}
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "Items":
+			if r.TryDecodeAsNil() {
+				x.Items = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicerequiredFieldsT((*[]requiredFieldsT)(&x.Items), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *requiredFieldsTT) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyj6++
+	if yyhl6 {
+		yyb6 = yyj6 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb6 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb6 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AUi64sliceN = nil
+		x.Items = nil
 	} else {
 		if false {
 		} else {
-			h.decSliceuint64((*[]uint64)(&x.AUi64sliceN), d)
+			h.decSlicerequiredFieldsT((*[]requiredFieldsT)(&x.Items), d)
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
+	for {
+		yyj6++
+		if yyhl6 {
+			yyb6 = yyj6 > l
+		} else {
+			yyb6 = r.CheckBreak()
+		}
+		if yyb6 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AMSU16N = nil
+	r.ReadArrayEnd()
+}
+
+func (x *defaulterT) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
 	} else {
 		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
 		} else {
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(7)
+				} else {
+					r.WriteMapStart(7)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"s\"")
+					} else {
+						r.EncodeSymbol(`s`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I\"")
+					} else {
+						r.EncodeSymbol(`I`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.U))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"U\"")
+					} else {
+						r.EncodeSymbol(`U`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeUint(uint64(x.U))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else if yyxt13 := z.Extension(z.I2Rtid(x.D)); yyxt13 != nil {
+						z.EncExtension(x.D, yyxt13)
+					} else {
+						r.EncodeInt(int64(x.D))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"D\"")
+					} else {
+						r.EncodeSymbol(`D`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else if yyxt14 := z.Extension(z.I2Rtid(x.D)); yyxt14 != nil {
+						z.EncExtension(x.D, yyxt14)
+					} else {
+						r.EncodeInt(int64(x.D))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.B))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
+					} else {
+						r.EncodeSymbol(`B`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeBool(bool(x.B))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeFloat64(float64(x.F))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"F\"")
+					} else {
+						r.EncodeSymbol(`F`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeFloat64(float64(x.F))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.L == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.L), e)
+						}
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"L\"")
+					} else {
+						r.EncodeSymbol(`L`)
+					}
+					r.WriteMapElemValue()
+					if x.L == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicestring(([]string)(x.L), e)
+						}
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+}
+
+func (x *defaulterT) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
 	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			x.codecDecodeSelfFromMap(yyl2, d)
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			x.codecDecodeSelfFromArray(yyl2, d)
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AMSU16E = nil
-	} else {
-		if false {
+}
+
+func (x *defaulterT) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yydf3 [7]bool // fields seen, for defaults
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
 		} else {
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
+			if r.CheckBreak() {
+				break
+			}
 		}
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "s":
+			yydf3[6] = true
+			if r.TryDecodeAsNil() {
+				x.S = ""
+			} else {
+				x.S = (string)(r.DecodeString())
+			}
+		case "I":
+			yydf3[3] = true
+			if r.TryDecodeAsNil() {
+				x.I = 0
+			} else {
+				x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+			}
+		case "U":
+			yydf3[5] = true
+			if r.TryDecodeAsNil() {
+				x.U = 0
+			} else {
+				x.U = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
+			}
+		case "D":
+			yydf3[1] = true
+			if r.TryDecodeAsNil() {
+				x.D = 0
+			} else {
+				if false {
+				} else if yyxt8 := z.Extension(z.I2Rtid(x.D)); yyxt8 != nil {
+					z.DecExtension(x.D, yyxt8)
+				} else {
+					x.D = (time.Duration)(r.DecodeInt64())
+				}
+			}
+		case "B":
+			yydf3[0] = true
+			if r.TryDecodeAsNil() {
+				x.B = false
+			} else {
+				x.B = (bool)(r.DecodeBool())
+			}
+		case "F":
+			yydf3[2] = true
+			if r.TryDecodeAsNil() {
+				x.F = 0
+			} else {
+				x.F = (float64)(r.DecodeFloat64())
+			}
+		case "L":
+			yydf3[4] = true
+			if r.TryDecodeAsNil() {
+				x.L = nil
+			} else {
+				if false {
+				} else {
+					h.decSlicestring((*[]string)(&x.L), d)
+				}
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+	z.DecStructDefaults(x, yydf3[:])
+}
+
+func (x *defaulterT) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj13 int
+	var yyb13 bool
+	var yyhl13 bool = l >= 0
+	yyj13++
+	if yyhl13 {
+		yyb13 = yyj13 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb13 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb13 {
 		r.ReadArrayEnd()
+		z.DecStructDefaultsFrom(x, 0)
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.NotAnon = AnonInTestStruc{}
+		x.S = ""
 	} else {
-		x.NotAnon.CodecDecodeSelf(d)
+		x.S = (string)(r.DecodeString())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj13++
+	if yyhl13 {
+		yyb13 = yyj13 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb13 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb13 {
 		r.ReadArrayEnd()
+		z.DecStructDefaultsFrom(x, 1)
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Nmap = nil
+		x.I = 0
 	} else {
-		if false {
-		} else {
-			h.decMapstringbool((*map[string]bool)(&x.Nmap), d)
-		}
+		x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj13++
+	if yyhl13 {
+		yyb13 = yyj13 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb13 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb13 {
 		r.ReadArrayEnd()
+		z.DecStructDefaultsFrom(x, 2)
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Nslice = nil
+		x.U = 0
 	} else {
-		if false {
-		} else {
-			x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
-		}
+		x.U = (uint8)(z.C.UintV(r.DecodeUint64(), 8))
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj13++
+	if yyhl13 {
+		yyb13 = yyj13 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb13 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb13 {
 		r.ReadArrayEnd()
+		z.DecStructDefaultsFrom(x, 3)
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		if true && x.TestStrucCommon.Nint64 != nil {
-			x.TestStrucCommon.Nint64 = nil
-		}
+		x.D = 0
 	} else {
-		if x.TestStrucCommon.Nint64 == nil {
-			x.TestStrucCommon.Nint64 = new(int64)
-		}
-
 		if false {
+		} else if yyxt18 := z.Extension(z.I2Rtid(x.D)); yyxt18 != nil {
+			z.DecExtension(x.D, yyxt18)
 		} else {
-			*x.Nint64 = (int64)(r.DecodeInt64())
+			x.D = (time.Duration)(r.DecodeInt64())
 		}
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj13++
+	if yyhl13 {
+		yyb13 = yyj13 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb13 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb13 {
 		r.ReadArrayEnd()
+		z.DecStructDefaultsFrom(x, 4)
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Mtsptr = nil
+		x.B = false
 	} else {
-		if false {
-		} else {
-			h.decMapstringPtrtoTestStruc((*map[string]*TestStruc)(&x.Mtsptr), d)
-		}
+		x.B = (bool)(r.DecodeBool())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj13++
+	if yyhl13 {
+		yyb13 = yyj13 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb13 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb13 {
 		r.ReadArrayEnd()
+		z.DecStructDefaultsFrom(x, 5)
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Mts = nil
+		x.F = 0
 	} else {
-		if false {
-		} else {
-			h.decMapstringTestStruc((*map[string]TestStruc)(&x.Mts), d)
-		}
+		x.F = (float64)(r.DecodeFloat64())
 	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
+	yyj13++
+	if yyhl13 {
+		yyb13 = yyj13 > l
 	} else {
-		yyb84 = r.CheckBreak()
+		yyb13 = r.CheckBreak()
 	}
-	if yyb84 {
+	if yyb13 {
 		r.ReadArrayEnd()
+		z.DecStructDefaultsFrom(x, 6)
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Its = nil
+		x.L = nil
 	} else {
 		if false {
 		} else {
-			h.decSlicePtrtoTestStruc((*[]*TestStruc)(&x.Its), d)
-		}
-	}
-	yyj84++
-	if yyhl84 {
-		yyb84 = yyj84 > l
-	} else {
-		yyb84 = r.CheckBreak()
-	}
-	if yyb84 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		if true && x.Nteststruc != nil {
-			x.Nteststruc = nil
+			h.decSlicestring((*[]string)(&x.L), d)
 		}
-	} else {
-		if x.Nteststruc == nil {
-			x.Nteststruc = new(TestStruc)
-		}
-
-		x.Nteststruc.CodecDecodeSelf(d)
 	}
 	for {
-		yyj84++
-		if yyhl84 {
-			yyb84 = yyj84 > l
-		} else {
-			yyb84 = r.CheckBreak()
-		}
-		if yyb84 {
-			break
-		}
-		r.ReadArrayElem()
-		z.DecStructFieldNotFound(yyj84-1, "")
-	}
-	r.ReadArrayEnd()
-}
-
-func (x Bbool) CodecEncodeSelf(e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelperEncoder(e)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.EncExtension(x, yyxt1)
-	} else {
-		r.EncodeBool(bool(x))
-	}
-}
-
-func (x *Bbool) CodecDecodeSelf(d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.DecExtension(x, yyxt1)
-	} else {
-		*x = (Bbool)(r.DecodeBool())
-	}
-}
-
-func (x *Aarray) CodecEncodeSelf(e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelperEncoder(e)
-	_, _, _ = h, z, r
-	if x == nil {
-		r.EncodeNil()
-	} else {
-		if false {
-		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-			z.EncExtension(x, yyxt1)
-		} else {
-			h.encAarray((*Aarray)(x), e)
-		}
-	}
-}
-
-func (x *Aarray) CodecDecodeSelf(d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.DecExtension(x, yyxt1)
-	} else {
-		h.decAarray((*Aarray)(x), d)
-	}
-}
-
-func (x Sstring) CodecEncodeSelf(e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelperEncoder(e)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.EncExtension(x, yyxt1)
-	} else {
-		if z.EncBasicHandle().StringToRaw {
-			r.EncodeStringBytesRaw(z.BytesView(string(x)))
+		yyj13++
+		if yyhl13 {
+			yyb13 = yyj13 > l
 		} else {
-			r.EncodeStringEnc(codecSelferCcUTF819780, string(x))
+			yyb13 = r.CheckBreak()
 		}
+		if yyb13 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj13-1, "")
 	}
+	r.ReadArrayEnd()
 }
 
-func (x *Sstring) CodecDecodeSelf(d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.DecExtension(x, yyxt1)
-	} else {
-		*x = (Sstring)(r.DecodeString())
-	}
-}
-
-func (x *Sstructsmall) CodecEncodeSelf(e *Encoder) {
+func (x *defaulterTagT) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -7497,44 +11386,67 @@ func (x *Sstructsmall) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(1)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapStart(1)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(2)
 				} else {
-					r.EncodeInt(int64(x.A))
+					r.WriteMapStart(2)
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"A\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.A))
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `A`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"A\"")
+					} else {
+						r.EncodeSymbol(`A`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.A))
+					}
 				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.B))
+					}
+				} else {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
+					} else {
+						r.EncodeSymbol(`B`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.B))
+					}
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					r.EncodeInt(int64(x.A))
+					r.WriteMapEnd()
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
 
-func (x *Sstructsmall) CodecDecodeSelf(d *Decoder) {
+func (x *defaulterTagT) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -7545,28 +11457,21 @@ func (x *Sstructsmall) CodecDecodeSelf(d *Decoder) {
 		yyct2 := r.ContainerType()
 		if yyct2 == codecSelferValueTypeMap19780 {
 			yyl2 := r.ReadMapStart()
-			if yyl2 == 0 {
-				r.ReadMapEnd()
-			} else {
-				x.codecDecodeSelfFromMap(yyl2, d)
-			}
+			x.codecDecodeSelfFromMap(yyl2, d)
 		} else if yyct2 == codecSelferValueTypeArray19780 {
 			yyl2 := r.ReadArrayStart()
-			if yyl2 == 0 {
-				r.ReadArrayEnd()
-			} else {
-				x.codecDecodeSelfFromArray(yyl2, d)
-			}
+			x.codecDecodeSelfFromArray(yyl2, d)
 		} else {
 			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
 		}
 	}
 }
 
-func (x *Sstructsmall) codecDecodeSelfFromMap(l int, d *Decoder) {
+func (x *defaulterTagT) codecDecodeSelfFromMap(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
+	var yydf3 [2]bool // fields seen, for defaults
 	var yyhl3 bool = l >= 0
 	for yyj3 := 0; ; yyj3++ {
 		if yyhl3 {
@@ -7583,33 +11488,43 @@ func (x *Sstructsmall) codecDecodeSelfFromMap(l int, d *Decoder) {
 		r.ReadMapElemValue()
 		switch yys3 {
 		case "A":
+			yydf3[0] = true
 			if r.TryDecodeAsNil() {
 				x.A = 0
 			} else {
 				x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
+		case "B":
+			yydf3[1] = true
+			if r.TryDecodeAsNil() {
+				x.B = 0
+			} else {
+				x.B = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+			}
 		default:
 			z.DecStructFieldNotFound(-1, yys3)
 		} // end switch yys3
 	} // end for yyj3
 	r.ReadMapEnd()
+	z.DecStructDefaults(x, yydf3[:])
 }
 
-func (x *Sstructsmall) codecDecodeSelfFromArray(l int, d *Decoder) {
+func (x *defaulterTagT) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj5 int
-	var yyb5 bool
-	var yyhl5 bool = l >= 0
-	yyj5++
-	if yyhl5 {
-		yyb5 = yyj5 > l
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyj6++
+	if yyhl6 {
+		yyb6 = yyj6 > l
 	} else {
-		yyb5 = r.CheckBreak()
+		yyb6 = r.CheckBreak()
 	}
-	if yyb5 {
+	if yyb6 {
 		r.ReadArrayEnd()
+		z.DecStructDefaultsFrom(x, 0)
 		return
 	}
 	r.ReadArrayElem()
@@ -7618,23 +11533,40 @@ func (x *Sstructsmall) codecDecodeSelfFromArray(l int, d *Decoder) {
 	} else {
 		x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
+	yyj6++
+	if yyhl6 {
+		yyb6 = yyj6 > l
+	} else {
+		yyb6 = r.CheckBreak()
+	}
+	if yyb6 {
+		r.ReadArrayEnd()
+		z.DecStructDefaultsFrom(x, 1)
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.B = 0
+	} else {
+		x.B = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+	}
 	for {
-		yyj5++
-		if yyhl5 {
-			yyb5 = yyj5 > l
+		yyj6++
+		if yyhl6 {
+			yyb6 = yyj6 > l
 		} else {
-			yyb5 = r.CheckBreak()
+			yyb6 = r.CheckBreak()
 		}
-		if yyb5 {
+		if yyb6 {
 			break
 		}
 		r.ReadArrayElem()
-		z.DecStructFieldNotFound(yyj5-1, "")
+		z.DecStructFieldNotFound(yyj6-1, "")
 	}
 	r.ReadArrayEnd()
 }
 
-func (x *Sstructbig) CodecEncodeSelf(e *Encoder) {
+func (x *defaulterOldT) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -7645,171 +11577,249 @@ func (x *Sstructbig) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(5)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapStart(5)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = true // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(2)
 				} else {
-					r.EncodeInt(int64(x.A))
+					r.WriteMapStart(2)
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"A\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `A`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"S\"")
+					} else {
+						r.EncodeSymbol(`S`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I))
+					}
 				} else {
-					r.EncodeInt(int64(x.A))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"I\"")
+					} else {
+						r.EncodeSymbol(`I`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.I))
+					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					r.EncodeBool(bool(x.B))
+					r.WriteMapEnd()
 				}
+			} // end if z.EncStructReflect()
+		}
+	}
+}
+
+func (x *defaulterOldT) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"B\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `B`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
+				x.codecDecodeSelfFromMap(yyl2, d)
 			}
-			var yyn9 bool
-			if x.Ssmallptr == nil {
-				yyn9 = true
-				goto LABEL9
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl2, d)
 			}
-		LABEL9:
-			if yyr2 || yy2arr2 {
-				if yyn9 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.Ssmallptr == nil {
-						r.EncodeNil()
-					} else {
-						x.Ssmallptr.CodecEncodeSelf(e)
-					}
-				}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
+	}
+}
+
+func (x *defaulterOldT) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "S":
+			if r.TryDecodeAsNil() {
+				x.S = ""
+			} else {
+				x.S = (string)(r.DecodeString())
+			}
+		case "I":
+			if r.TryDecodeAsNil() {
+				x.I = 0
+			} else {
+				x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+			}
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *defaulterOldT) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj6 int
+	var yyb6 bool
+	var yyhl6 bool = l >= 0
+	yyj6++
+	if yyhl6 {
+		yyb6 = yyj6 > l
+	} else {
+		yyb6 = r.CheckBreak()
+	}
+	if yyb6 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.S = ""
+	} else {
+		x.S = (string)(r.DecodeString())
+	}
+	yyj6++
+	if yyhl6 {
+		yyb6 = yyj6 > l
+	} else {
+		yyb6 = r.CheckBreak()
+	}
+	if yyb6 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.I = 0
+	} else {
+		x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+	}
+	for {
+		yyj6++
+		if yyhl6 {
+			yyb6 = yyj6 > l
+		} else {
+			yyb6 = r.CheckBreak()
+		}
+		if yyb6 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj6-1, "")
+	}
+	r.ReadArrayEnd()
+}
+
+func (x *fieldSetE) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ssmallptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ssmallptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn9 {
-					r.EncodeNil()
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(1)
 				} else {
-					if x.Ssmallptr == nil {
-						r.EncodeNil()
-					} else {
-						x.Ssmallptr.CodecEncodeSelf(e)
-					}
+					r.WriteMapStart(1)
 				}
-			}
-			var yyn12 bool
-			if x.Ssmall == nil {
-				yyn12 = true
-				goto LABEL12
-			}
-		LABEL12:
-			if yyr2 || yy2arr2 {
-				if yyn12 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if x.Ssmall == nil {
-						r.EncodeNil()
+					if false {
 					} else {
-						x.Ssmall.CodecEncodeSelf(e)
+						r.EncodeInt(int64(x.C))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ssmall\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ssmall`)
-				}
-				r.WriteMapElemValue()
-				if yyn12 {
-					r.EncodeNil()
 				} else {
-					if x.Ssmall == nil {
-						r.EncodeNil()
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"C\"")
 					} else {
-						x.Ssmall.CodecEncodeSelf(e)
+						r.EncodeSymbol(`C`)
 					}
-				}
-			}
-			var yyn15 bool
-			if x.Sptr == nil {
-				yyn15 = true
-				goto LABEL15
-			}
-		LABEL15:
-			if yyr2 || yy2arr2 {
-				if yyn15 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.Sptr == nil {
-						r.EncodeNil()
+					r.WriteMapElemValue()
+					if false {
 					} else {
-						x.Sptr.CodecEncodeSelf(e)
+						r.EncodeInt(int64(x.C))
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Sptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Sptr`)
-				}
-				r.WriteMapElemValue()
-				if yyn15 {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					if x.Sptr == nil {
-						r.EncodeNil()
-					} else {
-						x.Sptr.CodecEncodeSelf(e)
-					}
+					r.WriteMapEnd()
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
 
-func (x *Sstructbig) CodecDecodeSelf(d *Decoder) {
+func (x *fieldSetE) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -7838,7 +11848,7 @@ func (x *Sstructbig) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
-func (x *Sstructbig) codecDecodeSelfFromMap(l int, d *Decoder) {
+func (x *fieldSetE) codecDecodeSelfFromMap(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -7857,53 +11867,11 @@ func (x *Sstructbig) codecDecodeSelfFromMap(l int, d *Decoder) {
 		yys3 := z.StringView(r.DecodeStringAsBytes())
 		r.ReadMapElemValue()
 		switch yys3 {
-		case "A":
-			if r.TryDecodeAsNil() {
-				x.A = 0
-			} else {
-				x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-			}
-		case "B":
-			if r.TryDecodeAsNil() {
-				x.B = false
-			} else {
-				x.B = (bool)(r.DecodeBool())
-			}
-		case "Ssmallptr":
-			if r.TryDecodeAsNil() {
-				if true && x.Ssmallptr != nil {
-					x.Ssmallptr = nil
-				}
-			} else {
-				if x.Ssmallptr == nil {
-					x.Ssmallptr = new(Sstructsmall)
-				}
-
-				x.Ssmallptr.CodecDecodeSelf(d)
-			}
-		case "Ssmall":
-			if r.TryDecodeAsNil() {
-				if true && x.Ssmall != nil {
-					x.Ssmall = nil
-				}
-			} else {
-				if x.Ssmall == nil {
-					x.Ssmall = new(Sstructsmall)
-				}
-
-				x.Ssmall.CodecDecodeSelf(d)
-			}
-		case "Sptr":
+		case "C":
 			if r.TryDecodeAsNil() {
-				if true && x.Sptr != nil {
-					x.Sptr = nil
-				}
+				x.C = 0
 			} else {
-				if x.Sptr == nil {
-					x.Sptr = new(Sstructbig)
-				}
-
-				x.Sptr.CodecDecodeSelf(d)
+				x.C = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
 		default:
 			z.DecStructFieldNotFound(-1, yys3)
@@ -7912,128 +11880,46 @@ func (x *Sstructbig) codecDecodeSelfFromMap(l int, d *Decoder) {
 	r.ReadMapEnd()
 }
 
-func (x *Sstructbig) codecDecodeSelfFromArray(l int, d *Decoder) {
+func (x *fieldSetE) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj9 int
-	var yyb9 bool
-	var yyhl9 bool = l >= 0
-	yyj9++
-	if yyhl9 {
-		yyb9 = yyj9 > l
-	} else {
-		yyb9 = r.CheckBreak()
-	}
-	if yyb9 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.A = 0
-	} else {
-		x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	}
-	yyj9++
-	if yyhl9 {
-		yyb9 = yyj9 > l
-	} else {
-		yyb9 = r.CheckBreak()
-	}
-	if yyb9 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.B = false
-	} else {
-		x.B = (bool)(r.DecodeBool())
-	}
-	yyj9++
-	if yyhl9 {
-		yyb9 = yyj9 > l
-	} else {
-		yyb9 = r.CheckBreak()
-	}
-	if yyb9 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		if true && x.Ssmallptr != nil {
-			x.Ssmallptr = nil
-		}
-	} else {
-		if x.Ssmallptr == nil {
-			x.Ssmallptr = new(Sstructsmall)
-		}
-
-		x.Ssmallptr.CodecDecodeSelf(d)
-	}
-	yyj9++
-	if yyhl9 {
-		yyb9 = yyj9 > l
-	} else {
-		yyb9 = r.CheckBreak()
-	}
-	if yyb9 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		if true && x.Ssmall != nil {
-			x.Ssmall = nil
-		}
-	} else {
-		if x.Ssmall == nil {
-			x.Ssmall = new(Sstructsmall)
-		}
-
-		x.Ssmall.CodecDecodeSelf(d)
-	}
-	yyj9++
-	if yyhl9 {
-		yyb9 = yyj9 > l
+	var yyj5 int
+	var yyb5 bool
+	var yyhl5 bool = l >= 0
+	yyj5++
+	if yyhl5 {
+		yyb5 = yyj5 > l
 	} else {
-		yyb9 = r.CheckBreak()
+		yyb5 = r.CheckBreak()
 	}
-	if yyb9 {
+	if yyb5 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		if true && x.Sptr != nil {
-			x.Sptr = nil
-		}
+		x.C = 0
 	} else {
-		if x.Sptr == nil {
-			x.Sptr = new(Sstructbig)
-		}
-
-		x.Sptr.CodecDecodeSelf(d)
+		x.C = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
 	for {
-		yyj9++
-		if yyhl9 {
-			yyb9 = yyj9 > l
+		yyj5++
+		if yyhl5 {
+			yyb5 = yyj5 > l
 		} else {
-			yyb9 = r.CheckBreak()
+			yyb5 = r.CheckBreak()
 		}
-		if yyb9 {
+		if yyb5 {
 			break
 		}
 		r.ReadArrayElem()
-		z.DecStructFieldNotFound(yyj9-1, "")
+		z.DecStructFieldNotFound(yyj5-1, "")
 	}
 	r.ReadArrayEnd()
 }
 
-func (x *SstructbigMapBySlice) CodecEncodeSelf(e *Encoder) {
+func (x *fieldSetT) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -8044,171 +11930,138 @@ func (x *SstructbigMapBySlice) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = true // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(5)
-			} else {
-				r.WriteMapStart(5)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.A))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"A\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `A`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.A))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"B\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `B`)
-				}
-				r.WriteMapElemValue()
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(4)
 				} else {
-					r.EncodeBool(bool(x.B))
+					r.WriteMapStart(4)
 				}
-			}
-			var yyn9 bool
-			if x.Ssmallptr == nil {
-				yyn9 = true
-				goto LABEL9
-			}
-		LABEL9:
-			if yyr2 || yy2arr2 {
-				if yyn9 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.Ssmallptr == nil {
-						r.EncodeNil()
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"a\"")
+					} else {
+						r.EncodeSymbol(`a`)
+					}
+					r.WriteMapElemValue()
+					if false {
 					} else {
-						x.Ssmallptr.CodecEncodeSelf(e)
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.A)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.A))
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ssmallptr\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ssmallptr`)
+				var yyn6 bool
+				if x.B == nil {
+					yyn6 = true
+					goto LABEL6
 				}
-				r.WriteMapElemValue()
-				if yyn9 {
-					r.EncodeNil()
-				} else {
-					if x.Ssmallptr == nil {
+			LABEL6:
+				if yyr2 || yy2arr2 {
+					if yyn6 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						x.Ssmallptr.CodecEncodeSelf(e)
+						r.WriteArrayElem()
+						if x.B == nil {
+							r.EncodeNil()
+						} else {
+							yy7 := *x.B
+							if false {
+							} else {
+								r.EncodeInt(int64(yy7))
+							}
+						}
 					}
-				}
-			}
-			var yyn12 bool
-			if x.Ssmall == nil {
-				yyn12 = true
-				goto LABEL12
-			}
-		LABEL12:
-			if yyr2 || yy2arr2 {
-				if yyn12 {
-					r.WriteArrayElem()
-					r.EncodeNil()
 				} else {
-					r.WriteArrayElem()
-					if x.Ssmall == nil {
-						r.EncodeNil()
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"B\"")
 					} else {
-						x.Ssmall.CodecEncodeSelf(e)
+						r.EncodeSymbol(`B`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ssmall\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ssmall`)
-				}
-				r.WriteMapElemValue()
-				if yyn12 {
-					r.EncodeNil()
-				} else {
-					if x.Ssmall == nil {
+					r.WriteMapElemValue()
+					if yyn6 {
 						r.EncodeNil()
 					} else {
-						x.Ssmall.CodecEncodeSelf(e)
+						if x.B == nil {
+							r.EncodeNil()
+						} else {
+							yy9 := *x.B
+							if false {
+							} else {
+								r.EncodeInt(int64(yy9))
+							}
+						}
 					}
 				}
-			}
-			var yyn15 bool
-			if x.Sptr == nil {
-				yyn15 = true
-				goto LABEL15
-			}
-		LABEL15:
-			if yyr2 || yy2arr2 {
-				if yyn15 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if x.Sptr == nil {
-						r.EncodeNil()
+					if false {
 					} else {
-						x.Sptr.CodecEncodeSelf(e)
+						r.EncodeInt(int64(x.C))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Sptr\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Sptr`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"C\"")
+					} else {
+						r.EncodeSymbol(`C`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.C))
+					}
 				}
-				r.WriteMapElemValue()
-				if yyn15 {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.D))
+					}
 				} else {
-					if x.Sptr == nil {
-						r.EncodeNil()
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"D\"")
+					} else {
+						r.EncodeSymbol(`D`)
+					}
+					r.WriteMapElemValue()
+					if false {
 					} else {
-						x.Sptr.CodecEncodeSelf(e)
+						r.EncodeInt(int64(x.D))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
 
-func (x *SstructbigMapBySlice) CodecDecodeSelf(d *Decoder) {
+func (x *fieldSetT) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -8216,6 +12069,7 @@ func (x *SstructbigMapBySlice) CodecDecodeSelf(d *Decoder) {
 	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 		z.DecExtension(x, yyxt1)
 	} else {
+		x.FS.Reset()
 		yyct2 := r.ContainerType()
 		if yyct2 == codecSelferValueTypeMap19780 {
 			yyl2 := r.ReadMapStart()
@@ -8237,7 +12091,7 @@ func (x *SstructbigMapBySlice) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
-func (x *SstructbigMapBySlice) codecDecodeSelfFromMap(l int, d *Decoder) {
+func (x *fieldSetT) codecDecodeSelfFromMap(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -8256,53 +12110,42 @@ func (x *SstructbigMapBySlice) codecDecodeSelfFromMap(l int, d *Decoder) {
 		yys3 := z.StringView(r.DecodeStringAsBytes())
 		r.ReadMapElemValue()
 		switch yys3 {
-		case "A":
+		case "a", "aa":
+			x.FS.Add("A")
 			if r.TryDecodeAsNil() {
-				x.A = 0
+				x.A = ""
 			} else {
-				x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+				x.A = (string)(r.DecodeString())
 			}
 		case "B":
+			x.FS.Add("B")
 			if r.TryDecodeAsNil() {
-				x.B = false
-			} else {
-				x.B = (bool)(r.DecodeBool())
-			}
-		case "Ssmallptr":
-			if r.TryDecodeAsNil() {
-				if true && x.Ssmallptr != nil {
-					x.Ssmallptr = nil
+				if true && x.B != nil {
+					x.B = nil
 				}
 			} else {
-				if x.Ssmallptr == nil {
-					x.Ssmallptr = new(Sstructsmall)
+				if x.B == nil {
+					x.B = new(int)
 				}
 
-				x.Ssmallptr.CodecDecodeSelf(d)
+				if false {
+				} else {
+					*x.B = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+				}
 			}
-		case "Ssmall":
+		case "C":
+			x.FS.Add("C")
 			if r.TryDecodeAsNil() {
-				if true && x.Ssmall != nil {
-					x.Ssmall = nil
-				}
+				x.fieldSetE.C = 0
 			} else {
-				if x.Ssmall == nil {
-					x.Ssmall = new(Sstructsmall)
-				}
-
-				x.Ssmall.CodecDecodeSelf(d)
+				x.C = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
-		case "Sptr":
+		case "D":
+			x.FS.Add("D")
 			if r.TryDecodeAsNil() {
-				if true && x.Sptr != nil {
-					x.Sptr = nil
-				}
+				x.D = 0
 			} else {
-				if x.Sptr == nil {
-					x.Sptr = new(Sstructbig)
-				}
-
-				x.Sptr.CodecDecodeSelf(d)
+				x.D = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
 		default:
 			z.DecStructFieldNotFound(-1, yys3)
@@ -8311,7 +12154,7 @@ func (x *SstructbigMapBySlice) codecDecodeSelfFromMap(l int, d *Decoder) {
 	r.ReadMapEnd()
 }
 
-func (x *SstructbigMapBySlice) codecDecodeSelfFromArray(l int, d *Decoder) {
+func (x *fieldSetT) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -8329,26 +12172,11 @@ func (x *SstructbigMapBySlice) codecDecodeSelfFromArray(l int, d *Decoder) {
 		return
 	}
 	r.ReadArrayElem()
+	x.FS.Add("A")
 	if r.TryDecodeAsNil() {
-		x.A = 0
-	} else {
-		x.A = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
-	}
-	yyj9++
-	if yyhl9 {
-		yyb9 = yyj9 > l
-	} else {
-		yyb9 = r.CheckBreak()
-	}
-	if yyb9 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.B = false
+		x.A = ""
 	} else {
-		x.B = (bool)(r.DecodeBool())
+		x.A = (string)(r.DecodeString())
 	}
 	yyj9++
 	if yyhl9 {
@@ -8361,16 +12189,20 @@ func (x *SstructbigMapBySlice) codecDecodeSelfFromArray(l int, d *Decoder) {
 		return
 	}
 	r.ReadArrayElem()
+	x.FS.Add("B")
 	if r.TryDecodeAsNil() {
-		if true && x.Ssmallptr != nil {
-			x.Ssmallptr = nil
+		if true && x.B != nil {
+			x.B = nil
 		}
 	} else {
-		if x.Ssmallptr == nil {
-			x.Ssmallptr = new(Sstructsmall)
+		if x.B == nil {
+			x.B = new(int)
 		}
 
-		x.Ssmallptr.CodecDecodeSelf(d)
+		if false {
+		} else {
+			*x.B = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+		}
 	}
 	yyj9++
 	if yyhl9 {
@@ -8383,16 +12215,11 @@ func (x *SstructbigMapBySlice) codecDecodeSelfFromArray(l int, d *Decoder) {
 		return
 	}
 	r.ReadArrayElem()
+	x.FS.Add("C")
 	if r.TryDecodeAsNil() {
-		if true && x.Ssmall != nil {
-			x.Ssmall = nil
-		}
+		x.fieldSetE.C = 0
 	} else {
-		if x.Ssmall == nil {
-			x.Ssmall = new(Sstructsmall)
-		}
-
-		x.Ssmall.CodecDecodeSelf(d)
+		x.C = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
 	yyj9++
 	if yyhl9 {
@@ -8405,16 +12232,11 @@ func (x *SstructbigMapBySlice) codecDecodeSelfFromArray(l int, d *Decoder) {
 		return
 	}
 	r.ReadArrayElem()
+	x.FS.Add("D")
 	if r.TryDecodeAsNil() {
-		if true && x.Sptr != nil {
-			x.Sptr = nil
-		}
+		x.D = 0
 	} else {
-		if x.Sptr == nil {
-			x.Sptr = new(Sstructbig)
-		}
-
-		x.Sptr.CodecDecodeSelf(d)
+		x.D = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
 	for {
 		yyj9++
@@ -8432,7 +12254,7 @@ func (x *SstructbigMapBySlice) codecDecodeSelfFromArray(l int, d *Decoder) {
 	r.ReadArrayEnd()
 }
 
-func (x *tLowerFirstLetter) CodecEncodeSelf(e *Encoder) {
+func (x *fieldMaskTask) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -8443,71 +12265,110 @@ func (x *tLowerFirstLetter) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(2)
-			} else {
-				r.WriteMapStart(2)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I))
-				}
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I\"")
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(3)
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I`)
+					r.WriteMapStart(3)
 				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.Name)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.Name))
+						}
+					}
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Name\"")
+					} else {
+						r.EncodeSymbol(`Name`)
+					}
+					r.WriteMapElemValue()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.Name)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.Name))
+						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"S\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.Driver)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.Driver))
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `S`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"driver\"")
+					} else {
+						r.EncodeSymbol(`driver`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.Driver)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.Driver))
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Env == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringstring((map[string]string)(x.Env), e)
+						}
+					}
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Env\"")
+					} else {
+						r.EncodeSymbol(`Env`)
+					}
+					r.WriteMapElemValue()
+					if x.Env == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						if false {
+						} else {
+							h.encMapstringstring((map[string]string)(x.Env), e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
 
-func (x *tLowerFirstLetter) CodecDecodeSelf(d *Decoder) {
+func (x *fieldMaskTask) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -8536,7 +12397,7 @@ func (x *tLowerFirstLetter) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
-func (x *tLowerFirstLetter) codecDecodeSelfFromMap(l int, d *Decoder) {
+func (x *fieldMaskTask) codecDecodeSelfFromMap(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -8555,17 +12416,26 @@ func (x *tLowerFirstLetter) codecDecodeSelfFromMap(l int, d *Decoder) {
 		yys3 := z.StringView(r.DecodeStringAsBytes())
 		r.ReadMapElemValue()
 		switch yys3 {
-		case "I":
+		case "Name":
 			if r.TryDecodeAsNil() {
-				x.I = 0
+				x.Name = ""
 			} else {
-				x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+				x.Name = (string)(r.DecodeString())
 			}
-		case "S":
+		case "driver":
 			if r.TryDecodeAsNil() {
-				x.S = ""
+				x.Driver = ""
 			} else {
-				x.S = (string)(r.DecodeString())
+				x.Driver = (string)(r.DecodeString())
+			}
+		case "Env":
+			if r.TryDecodeAsNil() {
+				x.Env = nil
+			} else {
+				if false {
+				} else {
+					h.decMapstringstring((*map[string]string)(&x.Env), d)
+				}
 			}
 		default:
 			z.DecStructFieldNotFound(-1, yys3)
@@ -8574,138 +12444,81 @@ func (x *tLowerFirstLetter) codecDecodeSelfFromMap(l int, d *Decoder) {
 	r.ReadMapEnd()
 }
 
-func (x *tLowerFirstLetter) codecDecodeSelfFromArray(l int, d *Decoder) {
+func (x *fieldMaskTask) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
-	var yyj6 int
-	var yyb6 bool
-	var yyhl6 bool = l >= 0
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
+	var yyj8 int
+	var yyb8 bool
+	var yyhl8 bool = l >= 0
+	yyj8++
+	if yyhl8 {
+		yyb8 = yyj8 > l
 	} else {
-		yyb6 = r.CheckBreak()
+		yyb8 = r.CheckBreak()
+	}
+	if yyb8 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Name = ""
+	} else {
+		x.Name = (string)(r.DecodeString())
+	}
+	yyj8++
+	if yyhl8 {
+		yyb8 = yyj8 > l
+	} else {
+		yyb8 = r.CheckBreak()
 	}
-	if yyb6 {
+	if yyb8 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I = 0
+		x.Driver = ""
 	} else {
-		x.I = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+		x.Driver = (string)(r.DecodeString())
 	}
-	yyj6++
-	if yyhl6 {
-		yyb6 = yyj6 > l
+	yyj8++
+	if yyhl8 {
+		yyb8 = yyj8 > l
 	} else {
-		yyb6 = r.CheckBreak()
+		yyb8 = r.CheckBreak()
 	}
-	if yyb6 {
+	if yyb8 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.S = ""
+		x.Env = nil
 	} else {
-		x.S = (string)(r.DecodeString())
+		if false {
+		} else {
+			h.decMapstringstring((*map[string]string)(&x.Env), d)
+		}
 	}
 	for {
-		yyj6++
-		if yyhl6 {
-			yyb6 = yyj6 > l
+		yyj8++
+		if yyhl8 {
+			yyb8 = yyj8 > l
 		} else {
-			yyb6 = r.CheckBreak()
+			yyb8 = r.CheckBreak()
 		}
-		if yyb6 {
+		if yyb8 {
 			break
 		}
 		r.ReadArrayElem()
-		z.DecStructFieldNotFound(yyj6-1, "")
+		z.DecStructFieldNotFound(yyj8-1, "")
 	}
 	r.ReadArrayEnd()
 }
 
-func (x wrapInt64) CodecEncodeSelf(e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelperEncoder(e)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.EncExtension(x, yyxt1)
-	} else {
-		r.EncodeInt(int64(x))
-	}
-}
-
-func (x *wrapInt64) CodecDecodeSelf(d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.DecExtension(x, yyxt1)
-	} else {
-		*x = (wrapInt64)(r.DecodeInt64())
-	}
-}
-
-func (x wrapUint8) CodecEncodeSelf(e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelperEncoder(e)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.EncExtension(x, yyxt1)
-	} else {
-		r.EncodeUint(uint64(x))
-	}
-}
-
-func (x *wrapUint8) CodecDecodeSelf(d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.DecExtension(x, yyxt1)
-	} else {
-		*x = (wrapUint8)(z.C.UintV(r.DecodeUint64(), 8))
-	}
-}
-
-func (x wrapBytes) CodecEncodeSelf(e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelperEncoder(e)
-	_, _, _ = h, z, r
-	if x == nil {
-		r.EncodeNil()
-	} else {
-		if false {
-		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-			z.EncExtension(x, yyxt1)
-		} else {
-			h.encwrapBytes((wrapBytes)(x), e)
-		}
-	}
-}
-
-func (x *wrapBytes) CodecDecodeSelf(d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-	if false {
-	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
-		z.DecExtension(x, yyxt1)
-	} else {
-		h.decwrapBytes((*wrapBytes)(x), d)
-	}
-}
-
-func (x *AnonInTestStrucIntf) CodecEncodeSelf(e *Encoder) {
+func (x *fieldMaskSpec) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -8716,201 +12529,205 @@ func (x *AnonInTestStrucIntf) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(5)
-			} else {
-				r.WriteMapStart(5)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Islice == nil {
-					r.EncodeNil()
-				} else {
-					if false {
-					} else {
-						h.encSliceInterface(([]interface{})(x.Islice), e)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				var yyq2 = [6]bool{     // should field at this index be written?
+					true,         // Name
+					x.Count != 0, // Count
+					true,         // Tasks
+					true,         // ByName
+					true,         // Meta
+					true,         // Any
+				}
+				_ = yyq2
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(6)
+				} else {
+					var yynn2 int
+					for _, b := range yyq2 {
+						if b {
+							yynn2++
+						}
 					}
+					r.WriteMapStart(yynn2)
+					yynn2 = 0
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Islice\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Islice`)
-				}
-				r.WriteMapElemValue()
-				if x.Islice == nil {
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
 					if false {
 					} else {
-						h.encSliceInterface(([]interface{})(x.Islice), e)
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.Name)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.Name))
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Ms == nil {
-					r.EncodeNil()
 				} else {
-					if false {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Name\"")
 					} else {
-						h.encMapstringInterface((map[string]interface{})(x.Ms), e)
+						r.EncodeSymbol(`Name`)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Ms\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Ms`)
-				}
-				r.WriteMapElemValue()
-				if x.Ms == nil {
-					r.EncodeNil()
-				} else {
+					r.WriteMapElemValue()
 					if false {
 					} else {
-						h.encMapstringInterface((map[string]interface{})(x.Ms), e)
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.Name)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.Name))
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if x.Nintf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[1] {
+						if false {
+						} else {
+							r.EncodeInt(int64(x.Count))
+						}
 					} else {
-						z.EncFallback(x.Nintf)
+						r.EncodeInt(0)
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Nintf\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Nintf`)
+					if yyq2[1] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Count\"")
+						} else {
+							r.EncodeSymbol(`Count`)
+						}
+						r.WriteMapElemValue()
+						if false {
+						} else {
+							r.EncodeInt(int64(x.Count))
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if x.Nintf == nil {
-					r.EncodeNil()
-				} else {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Tasks == nil {
+						r.EncodeNil()
 					} else {
-						z.EncFallback(x.Nintf)
+						if false {
+						} else {
+							h.encSlicefieldMaskTask(([]fieldMaskTask)(x.Tasks), e)
+						}
 					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else if !z.EncBasicHandle().TimeNotBuiltin {
-					r.EncodeTime(x.T)
-				} else if yyxt13 := z.Extension(z.I2Rtid(x.T)); yyxt13 != nil {
-					z.EncExtension(x.T, yyxt13)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.T)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.T)
-				} else {
-					z.EncFallback(x.T)
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"T\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `T`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else if !z.EncBasicHandle().TimeNotBuiltin {
-					r.EncodeTime(x.T)
-				} else if yyxt14 := z.Extension(z.I2Rtid(x.T)); yyxt14 != nil {
-					z.EncExtension(x.T, yyxt14)
-				} else if z.EncBinary() {
-					z.EncBinaryMarshal(x.T)
-				} else if !z.EncBinary() && z.IsJSONHandle() {
-					z.EncJSONMarshal(x.T)
 				} else {
-					z.EncFallback(x.T)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Tasks\"")
+					} else {
+						r.EncodeSymbol(`Tasks`)
+					}
+					r.WriteMapElemValue()
+					if x.Tasks == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encSlicefieldMaskTask(([]fieldMaskTask)(x.Tasks), e)
+						}
+					}
 				}
-			}
-			var yyn15 bool
-			if x.Tptr == nil {
-				yyn15 = true
-				goto LABEL15
-			}
-		LABEL15:
-			if yyr2 || yy2arr2 {
-				if yyn15 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
+					if x.ByName == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringPtrtofieldMaskTask((map[string]*fieldMaskTask)(x.ByName), e)
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if x.Tptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"ByName\"")
+					} else {
+						r.EncodeSymbol(`ByName`)
+					}
+					r.WriteMapElemValue()
+					if x.ByName == nil {
 						r.EncodeNil()
 					} else {
-						yy16 := *x.Tptr
 						if false {
-						} else if !z.EncBasicHandle().TimeNotBuiltin {
-							r.EncodeTime(yy16)
-						} else if yyxt17 := z.Extension(z.I2Rtid(yy16)); yyxt17 != nil {
-							z.EncExtension(yy16, yyxt17)
-						} else if z.EncBinary() {
-							z.EncBinaryMarshal(yy16)
-						} else if !z.EncBinary() && z.IsJSONHandle() {
-							z.EncJSONMarshal(yy16)
 						} else {
-							z.EncFallback(yy16)
+							h.encMapstringPtrtofieldMaskTask((map[string]*fieldMaskTask)(x.ByName), e)
 						}
 					}
 				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"Tptr\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Meta == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringstring((map[string]string)(x.Meta), e)
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `Tptr`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Meta\"")
+					} else {
+						r.EncodeSymbol(`Meta`)
+					}
+					r.WriteMapElemValue()
+					if x.Meta == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							h.encMapstringstring((map[string]string)(x.Meta), e)
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if yyn15 {
-					r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Any == nil {
+						r.EncodeNil()
+					} else {
+						if false {
+						} else {
+							z.EncFallback(x.Any)
+						}
+					}
 				} else {
-					if x.Tptr == nil {
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Any\"")
+					} else {
+						r.EncodeSymbol(`Any`)
+					}
+					r.WriteMapElemValue()
+					if x.Any == nil {
 						r.EncodeNil()
 					} else {
-						yy18 := *x.Tptr
 						if false {
-						} else if !z.EncBasicHandle().TimeNotBuiltin {
-							r.EncodeTime(yy18)
-						} else if yyxt19 := z.Extension(z.I2Rtid(yy18)); yyxt19 != nil {
-							z.EncExtension(yy18, yyxt19)
-						} else if z.EncBinary() {
-							z.EncBinaryMarshal(yy18)
-						} else if !z.EncBinary() && z.IsJSONHandle() {
-							z.EncJSONMarshal(yy18)
 						} else {
-							z.EncFallback(yy18)
+							z.EncFallback(x.Any)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
 
-func (x *AnonInTestStrucIntf) CodecDecodeSelf(d *Decoder) {
+func (x *fieldMaskSpec) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -8939,7 +12756,7 @@ func (x *AnonInTestStrucIntf) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
-func (x *AnonInTestStrucIntf) codecDecodeSelfFromMap(l int, d *Decoder) {
+func (x *fieldMaskSpec) codecDecodeSelfFromMap(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -8958,71 +12775,52 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromMap(l int, d *Decoder) {
 		yys3 := z.StringView(r.DecodeStringAsBytes())
 		r.ReadMapElemValue()
 		switch yys3 {
-		case "Islice":
+		case "Name":
 			if r.TryDecodeAsNil() {
-				x.Islice = nil
+				x.Name = ""
 			} else {
-				if false {
-				} else {
-					h.decSliceInterface((*[]interface{})(&x.Islice), d)
-				}
+				x.Name = (string)(r.DecodeString())
 			}
-		case "Ms":
+		case "Count":
 			if r.TryDecodeAsNil() {
-				x.Ms = nil
+				x.Count = 0
+			} else {
+				x.Count = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+			}
+		case "Tasks":
+			if r.TryDecodeAsNil() {
+				x.Tasks = nil
 			} else {
 				if false {
 				} else {
-					h.decMapstringInterface((*map[string]interface{})(&x.Ms), d)
+					h.decSlicefieldMaskTask((*[]fieldMaskTask)(&x.Tasks), d)
 				}
 			}
-		case "Nintf":
+		case "ByName":
 			if r.TryDecodeAsNil() {
-				x.Nintf = nil
+				x.ByName = nil
 			} else {
 				if false {
 				} else {
-					z.DecFallback(&x.Nintf, true)
+					h.decMapstringPtrtofieldMaskTask((*map[string]*fieldMaskTask)(&x.ByName), d)
 				}
 			}
-		case "T":
+		case "Meta":
 			if r.TryDecodeAsNil() {
-				x.T = time.Time{}
+				x.Meta = nil
 			} else {
 				if false {
-				} else if !z.DecBasicHandle().TimeNotBuiltin {
-					x.T = r.DecodeTime()
-				} else if yyxt11 := z.Extension(z.I2Rtid(x.T)); yyxt11 != nil {
-					z.DecExtension(x.T, yyxt11)
-				} else if z.DecBinary() {
-					z.DecBinaryUnmarshal(&x.T)
-				} else if !z.DecBinary() && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(&x.T)
 				} else {
-					z.DecFallback(&x.T, false)
+					h.decMapstringstring((*map[string]string)(&x.Meta), d)
 				}
 			}
-		case "Tptr":
+		case "Any":
 			if r.TryDecodeAsNil() {
-				if true && x.Tptr != nil {
-					x.Tptr = nil
-				}
+				x.Any = nil
 			} else {
-				if x.Tptr == nil {
-					x.Tptr = new(time.Time)
-				}
-
 				if false {
-				} else if !z.DecBasicHandle().TimeNotBuiltin {
-					*x.Tptr = r.DecodeTime()
-				} else if yyxt13 := z.Extension(z.I2Rtid(x.Tptr)); yyxt13 != nil {
-					z.DecExtension(x.Tptr, yyxt13)
-				} else if z.DecBinary() {
-					z.DecBinaryUnmarshal(x.Tptr)
-				} else if !z.DecBinary() && z.IsJSONHandle() {
-					z.DecJSONUnmarshal(x.Tptr)
 				} else {
-					z.DecFallback(x.Tptr, false)
+					z.DecFallback(&x.Any, true)
 				}
 			}
 		default:
@@ -9032,7 +12830,7 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromMap(l int, d *Decoder) {
 	r.ReadMapEnd()
 }
 
-func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
+func (x *fieldMaskSpec) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -9051,12 +12849,9 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Islice = nil
+		x.Name = ""
 	} else {
-		if false {
-		} else {
-			h.decSliceInterface((*[]interface{})(&x.Islice), d)
-		}
+		x.Name = (string)(r.DecodeString())
 	}
 	yyj14++
 	if yyhl14 {
@@ -9070,11 +12865,27 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ms = nil
+		x.Count = 0
+	} else {
+		x.Count = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
+	}
+	yyj14++
+	if yyhl14 {
+		yyb14 = yyj14 > l
+	} else {
+		yyb14 = r.CheckBreak()
+	}
+	if yyb14 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Tasks = nil
 	} else {
 		if false {
 		} else {
-			h.decMapstringInterface((*map[string]interface{})(&x.Ms), d)
+			h.decSlicefieldMaskTask((*[]fieldMaskTask)(&x.Tasks), d)
 		}
 	}
 	yyj14++
@@ -9089,11 +12900,11 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Nintf = nil
+		x.ByName = nil
 	} else {
 		if false {
 		} else {
-			z.DecFallback(&x.Nintf, true)
+			h.decMapstringPtrtofieldMaskTask((*map[string]*fieldMaskTask)(&x.ByName), d)
 		}
 	}
 	yyj14++
@@ -9108,19 +12919,11 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.T = time.Time{}
+		x.Meta = nil
 	} else {
 		if false {
-		} else if !z.DecBasicHandle().TimeNotBuiltin {
-			x.T = r.DecodeTime()
-		} else if yyxt22 := z.Extension(z.I2Rtid(x.T)); yyxt22 != nil {
-			z.DecExtension(x.T, yyxt22)
-		} else if z.DecBinary() {
-			z.DecBinaryUnmarshal(&x.T)
-		} else if !z.DecBinary() && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(&x.T)
 		} else {
-			z.DecFallback(&x.T, false)
+			h.decMapstringstring((*map[string]string)(&x.Meta), d)
 		}
 	}
 	yyj14++
@@ -9135,25 +12938,11 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		if true && x.Tptr != nil {
-			x.Tptr = nil
-		}
+		x.Any = nil
 	} else {
-		if x.Tptr == nil {
-			x.Tptr = new(time.Time)
-		}
-
 		if false {
-		} else if !z.DecBasicHandle().TimeNotBuiltin {
-			*x.Tptr = r.DecodeTime()
-		} else if yyxt24 := z.Extension(z.I2Rtid(x.Tptr)); yyxt24 != nil {
-			z.DecExtension(x.Tptr, yyxt24)
-		} else if z.DecBinary() {
-			z.DecBinaryUnmarshal(x.Tptr)
-		} else if !z.DecBinary() && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(x.Tptr)
 		} else {
-			z.DecFallback(x.Tptr, false)
+			z.DecFallback(&x.Any, true)
 		}
 	}
 	for {
@@ -9172,7 +12961,7 @@ func (x *AnonInTestStrucIntf) codecDecodeSelfFromArray(l int, d *Decoder) {
 	r.ReadArrayEnd()
 }
 
-func (x *missingFielderT1) CodecEncodeSelf(e *Encoder) {
+func (x *testPolyCreated) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -9183,71 +12972,75 @@ func (x *missingFielderT1) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(2)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapStart(2)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(2)
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapStart(2)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						r.EncodeInt(int64(x.ID))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"S\"")
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `S`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"ID\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						r.EncodeSymbol(`ID`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.ID))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"B\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.Name)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.Name))
+						}
+					}
 				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `B`)
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"Name\"")
+					} else {
+						r.EncodeSymbol(`Name`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw(z.BytesView(string(x.Name)))
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.Name))
+						}
+					}
 				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					r.EncodeBool(bool(x.B))
+					r.WriteMapEnd()
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
 
-func (x *missingFielderT1) CodecDecodeSelf(d *Decoder) {
+func (x *testPolyCreated) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -9276,7 +13069,7 @@ func (x *missingFielderT1) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
-func (x *missingFielderT1) codecDecodeSelfFromMap(l int, d *Decoder) {
+func (x *testPolyCreated) codecDecodeSelfFromMap(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -9295,17 +13088,17 @@ func (x *missingFielderT1) codecDecodeSelfFromMap(l int, d *Decoder) {
 		yys3 := z.StringView(r.DecodeStringAsBytes())
 		r.ReadMapElemValue()
 		switch yys3 {
-		case "S":
+		case "ID":
 			if r.TryDecodeAsNil() {
-				x.S = ""
+				x.ID = 0
 			} else {
-				x.S = (string)(r.DecodeString())
+				x.ID = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
-		case "B":
+		case "Name":
 			if r.TryDecodeAsNil() {
-				x.B = false
+				x.Name = ""
 			} else {
-				x.B = (bool)(r.DecodeBool())
+				x.Name = (string)(r.DecodeString())
 			}
 		default:
 			z.DecStructFieldNotFound(-1, yys3)
@@ -9314,7 +13107,7 @@ func (x *missingFielderT1) codecDecodeSelfFromMap(l int, d *Decoder) {
 	r.ReadMapEnd()
 }
 
-func (x *missingFielderT1) codecDecodeSelfFromArray(l int, d *Decoder) {
+func (x *testPolyCreated) codecDecodeSelfFromArray(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -9333,9 +13126,9 @@ func (x *missingFielderT1) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.S = ""
+		x.ID = 0
 	} else {
-		x.S = (string)(r.DecodeString())
+		x.ID = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
 	yyj6++
 	if yyhl6 {
@@ -9349,9 +13142,9 @@ func (x *missingFielderT1) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.B = false
+		x.Name = ""
 	} else {
-		x.B = (bool)(r.DecodeBool())
+		x.Name = (string)(r.DecodeString())
 	}
 	for {
 		yyj6++
@@ -9369,7 +13162,7 @@ func (x *missingFielderT1) codecDecodeSelfFromArray(l int, d *Decoder) {
 	r.ReadArrayEnd()
 }
 
-func (x *missingFielderT2) CodecEncodeSelf(e *Encoder) {
+func (x *testPolyDeleted) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -9380,109 +13173,48 @@ func (x *missingFielderT2) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(4)
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				r.WriteMapStart(4)
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(1)
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapStart(1)
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if false {
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						r.EncodeInt(int64(x.ID))
 					}
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"S\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `S`)
-				}
-				r.WriteMapElemValue()
-				if false {
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+					r.WriteMapElemKey()
+					if z.IsJSONHandle() {
+						z.WriteStr("\"ID\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+						r.EncodeSymbol(`ID`)
+					}
+					r.WriteMapElemValue()
+					if false {
+					} else {
+						r.EncodeInt(int64(x.ID))
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"B\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `B`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeBool(bool(x.B))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeFloat64(float64(x.F))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"F\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `F`)
-				}
-				r.WriteMapElemValue()
-				if false {
-				} else {
-					r.EncodeFloat64(float64(x.F))
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if false {
-				} else {
-					r.EncodeInt(int64(x.I))
-				}
-			} else {
-				r.WriteMapElemKey()
-				if z.IsJSONHandle() {
-					z.WriteStr("\"I\"")
-				} else {
-					r.EncodeStringEnc(codecSelferCcUTF819780, `I`)
-				}
-				r.WriteMapElemValue()
-				if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					r.EncodeInt(int64(x.I))
+					r.WriteMapEnd()
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
 
-func (x *missingFielderT2) CodecDecodeSelf(d *Decoder) {
+func (x *testPolyDeleted) CodecDecodeSelf(d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -9511,7 +13243,7 @@ func (x *missingFielderT2) CodecDecodeSelf(d *Decoder) {
 	}
 }
 
-func (x *missingFielderT2) codecDecodeSelfFromMap(l int, d *Decoder) {
+func (x *testPolyDeleted) codecDecodeSelfFromMap(l int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -9530,125 +13262,87 @@ func (x *missingFielderT2) codecDecodeSelfFromMap(l int, d *Decoder) {
 		yys3 := z.StringView(r.DecodeStringAsBytes())
 		r.ReadMapElemValue()
 		switch yys3 {
-		case "S":
-			if r.TryDecodeAsNil() {
-				x.S = ""
-			} else {
-				x.S = (string)(r.DecodeString())
-			}
-		case "B":
-			if r.TryDecodeAsNil() {
-				x.B = false
-			} else {
-				x.B = (bool)(r.DecodeBool())
-			}
-		case "F":
-			if r.TryDecodeAsNil() {
-				x.F = 0
-			} else {
-				x.F = (float64)(r.DecodeFloat64())
-			}
-		case "I":
+		case "ID":
 			if r.TryDecodeAsNil() {
-				x.I = 0
+				x.ID = 0
 			} else {
-				x.I = (int64)(r.DecodeInt64())
+				x.ID = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 			}
 		default:
 			z.DecStructFieldNotFound(-1, yys3)
 		} // end switch yys3
 	} // end for yyj3
 	r.ReadMapEnd()
-}
-
-func (x *missingFielderT2) codecDecodeSelfFromArray(l int, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-	var yyj8 int
-	var yyb8 bool
-	var yyhl8 bool = l >= 0
-	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
-	} else {
-		yyb8 = r.CheckBreak()
-	}
-	if yyb8 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.S = ""
-	} else {
-		x.S = (string)(r.DecodeString())
-	}
-	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
-	} else {
-		yyb8 = r.CheckBreak()
-	}
-	if yyb8 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.B = false
-	} else {
-		x.B = (bool)(r.DecodeBool())
-	}
-	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
-	} else {
-		yyb8 = r.CheckBreak()
-	}
-	if yyb8 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.F = 0
-	} else {
-		x.F = (float64)(r.DecodeFloat64())
-	}
-	yyj8++
-	if yyhl8 {
-		yyb8 = yyj8 > l
+}
+
+func (x *testPolyDeleted) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj5 int
+	var yyb5 bool
+	var yyhl5 bool = l >= 0
+	yyj5++
+	if yyhl5 {
+		yyb5 = yyj5 > l
 	} else {
-		yyb8 = r.CheckBreak()
+		yyb5 = r.CheckBreak()
 	}
-	if yyb8 {
+	if yyb5 {
 		r.ReadArrayEnd()
 		return
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.I = 0
+		x.ID = 0
 	} else {
-		x.I = (int64)(r.DecodeInt64())
+		x.ID = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 	}
 	for {
-		yyj8++
-		if yyhl8 {
-			yyb8 = yyj8 > l
+		yyj5++
+		if yyhl5 {
+			yyb5 = yyj5 > l
 		} else {
-			yyb8 = r.CheckBreak()
+			yyb5 = r.CheckBreak()
 		}
-		if yyb8 {
+		if yyb5 {
 			break
 		}
 		r.ReadArrayElem()
-		z.DecStructFieldNotFound(yyj8-1, "")
+		z.DecStructFieldNotFound(yyj5-1, "")
 	}
 	r.ReadArrayEnd()
 }
 
-func (x *TestStrucFlex) CodecEncodeSelf(e *Encoder) {
+func (x testPolyNote) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.EncExtension(x, yyxt1)
+	} else {
+		if z.EncBasicHandle().StringToRaw {
+			r.EncodeStringBytesRaw(z.BytesView(string(x)))
+		} else {
+			r.EncodeStringEnc(codecSelferCcUTF819780, string(x))
+		}
+	}
+}
+
+func (x *testPolyNote) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		*x = (testPolyNote)(r.DecodeString())
+	}
+}
+
+func (x *testPolyLog) CodecEncodeSelf(e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -9659,2431 +13353,2778 @@ func (x *TestStrucFlex) CodecEncodeSelf(e *Encoder) {
 		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
 			z.EncExtension(x, yyxt1)
 		} else {
-			yysep2 := !z.EncBinary()
-			yy2arr2 := z.EncBasicHandle().StructToArray
-			_, _ = yysep2, yy2arr2
-			const yyr2 bool = false // struct tag has 'toArray'
-			var yyq2 = [73]bool{    // should field at this index be written?
-				x.S != "",                   // S
-				x.I64 != 0,                  // I64
-				x.I32 != 0,                  // I32
-				x.I16 != 0,                  // I16
-				x.I8 != 0,                   // I8
-				x.I64n != 0,                 // I64n
-				x.I32n != 0,                 // I32n
-				x.I16n != 0,                 // I16n
-				x.I8n != 0,                  // I8n
-				x.Ui64 != 0,                 // Ui64
-				x.Ui32 != 0,                 // Ui32
-				x.Ui16 != 0,                 // Ui16
-				x.Ui8 != 0,                  // Ui8
-				x.F64 != 0,                  // F64
-				x.F32 != 0,                  // F32
-				x.B,                         // B
-				x.By != 0,                   // By
-				len(x.Sslice) != 0,          // Sslice
-				len(x.I64slice) != 0,        // I64slice
-				len(x.I16slice) != 0,        // I16slice
-				len(x.Ui64slice) != 0,       // Ui64slice
-				len(x.Ui8slice) != 0,        // Ui8slice
-				len(x.Bslice) != 0,          // Bslice
-				len(x.Byslice) != 0,         // Byslice
-				len(x.Iptrslice) != 0,       // Iptrslice
-				len(x.WrapSliceInt64) != 0,  // WrapSliceInt64
-				len(x.WrapSliceString) != 0, // WrapSliceString
-				len(x.Msi64) != 0,           // Msi64
-				false || x.Simplef.S != "" || x.Simplef.I64 != 0 || x.Simplef.I8 != 0 || x.Simplef.Ui64 != 0 || x.Simplef.Ui8 != 0 || x.Simplef.F64 != 0 || x.Simplef.F32 != 0 || x.Simplef.B || len(x.Simplef.Sslice) != 0 || len(x.Simplef.I16slice) != 0 || len(x.Simplef.Ui64slice) != 0 || len(x.Simplef.Ui8slice) != 0 || len(x.Simplef.Bslice) != 0 || len(x.Simplef.Iptrslice) != 0 || len(x.Simplef.WrapSliceInt64) != 0 || len(x.Simplef.WrapSliceString) != 0 || len(x.Simplef.Msi64) != 0, // Simplef
-				len(x.SstrUi64T) != 0,   // SstrUi64T
-				x.AS != "",              // AS
-				x.AI64 != 0,             // AI64
-				x.AI16 != 0,             // AI16
-				x.AUi64 != 0,            // AUi64
-				len(x.ASslice) != 0,     // ASslice
-				len(x.AI64slice) != 0,   // AI64slice
-				len(x.AUi64slice) != 0,  // AUi64slice
-				len(x.AF64slice) != 0,   // AF64slice
-				len(x.AF32slice) != 0,   // AF32slice
-				len(x.AMSU16) != 0,      // AMSU16
-				len(x.AI64arr0) != 0,    // AI64arr0
-				len(x.A164slice0) != 0,  // A164slice0
-				len(x.AUi64sliceN) != 0, // AUi64sliceN
-				len(x.AMSU16N) != 0,     // AMSU16N
-				len(x.AMSU16E) != 0,     // AMSU16E
-				false || x.NotAnon.AS != "" || x.NotAnon.AI64 != 0 || x.NotAnon.AI16 != 0 || x.NotAnon.AUi64 != 0 || len(x.NotAnon.ASslice) != 0 || len(x.NotAnon.AI64slice) != 0 || len(x.NotAnon.AUi64slice) != 0 || len(x.NotAnon.AF64slice) != 0 || len(x.NotAnon.AF32slice) != 0 || len(x.NotAnon.AMSU16) != 0 || len(x.NotAnon.AI64arr0) != 0 || len(x.NotAnon.A164slice0) != 0 || len(x.NotAnon.AUi64sliceN) != 0 || len(x.NotAnon.AMSU16N) != 0 || len(x.NotAnon.AMSU16E) != 0, // NotAnon
-				len(x.Nmap) != 0,           // Nmap
-				len(x.Nslice) != 0,         // Nslice
-				x.Nint64 != nil,            // Nint64
-				len(x.Chstr) != 0,          // Chstr
-				len(x.Mis) != 0,            // Mis
-				len(x.Mbu64) != 0,          // Mbu64
-				len(x.Miwu64s) != 0,        // Miwu64s
-				len(x.Mfwss) != 0,          // Mfwss
-				len(x.Mf32wss) != 0,        // Mf32wss
-				len(x.Mui2wss) != 0,        // Mui2wss
-				len(x.Msu2wss) != 0,        // Msu2wss
-				x.Ci64 != 0,                // Ci64
-				len(x.Swrapbytes) != 0,     // Swrapbytes
-				len(x.Swrapuint8) != 0,     // Swrapuint8
-				len(x.ArrStrUi64T) != 0,    // ArrStrUi64T
-				len(x.Ui64array) != 0,      // Ui64array
-				len(x.Ui64slicearray) != 0, // Ui64slicearray
-				len(x.SintfAarray) != 0,    // SintfAarray
-				x.AnonInTestStrucIntf != nil && len(x.Islice) != 0, // Islice
-				x.AnonInTestStrucIntf != nil && len(x.Ms) != 0,     // Ms
-				x.AnonInTestStrucIntf != nil && x.Nintf != nil,     // Nintf
-				x.AnonInTestStrucIntf != nil && !(x.T.IsZero()),    // T
-				x.AnonInTestStrucIntf != nil && x.Tptr != nil,      // Tptr
-				len(x.Mtsptr) != 0,  // Mtsptr
-				len(x.Mts) != 0,     // Mts
-				len(x.Its) != 0,     // Its
-				x.Nteststruc != nil, // Nteststruc
-			}
-			_ = yyq2
-			if yyr2 || yy2arr2 {
-				r.WriteArrayStart(73)
-			} else {
-				var yynn2 int
-				for _, b := range yyq2 {
-					if b {
-						yynn2++
-					}
-				}
-				r.WriteMapStart(yynn2)
-				yynn2 = 0
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[0] {
-					if false {
-					} else {
-						if z.EncBasicHandle().StringToRaw {
-							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
-						} else {
-							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
-						}
-					}
-				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw([]byte{})
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, "")
-					}
-				}
-			} else {
-				if yyq2[0] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"S\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `S`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						if z.EncBasicHandle().StringToRaw {
-							r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
-						} else {
-							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
-						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[1] {
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I64))
-					}
-				} else {
-					r.EncodeInt(0)
-				}
-			} else {
-				if yyq2[1] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I64\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I64`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I64))
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[2] {
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I32))
-					}
-				} else {
-					r.EncodeInt(0)
-				}
-			} else {
-				if yyq2[2] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I32\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I32`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I32))
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[3] {
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I16))
-					}
-				} else {
-					r.EncodeInt(0)
-				}
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
 			} else {
-				if yyq2[3] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I16\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I16`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I16))
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[4] {
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I8))
-					}
-				} else {
-					r.EncodeInt(0)
-				}
-			} else {
-				if yyq2[4] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I8\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I8`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I8))
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[5] {
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I64n))
-					}
-				} else {
-					r.EncodeInt(0)
-				}
-			} else {
-				if yyq2[5] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I64n\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I64n`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I64n))
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[6] {
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I32n))
-					}
-				} else {
-					r.EncodeInt(0)
-				}
-			} else {
-				if yyq2[6] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I32n\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I32n`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I32n))
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[7] {
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I16n))
-					}
-				} else {
-					r.EncodeInt(0)
-				}
-			} else {
-				if yyq2[7] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I16n\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I16n`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I16n))
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[8] {
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I8n))
-					}
-				} else {
-					r.EncodeInt(0)
-				}
-			} else {
-				if yyq2[8] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I8n\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I8n`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.I8n))
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[9] {
-					if false {
-					} else {
-						r.EncodeUint(uint64(x.Ui64))
-					}
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(4)
 				} else {
-					r.EncodeUint(0)
-				}
-			} else {
-				if yyq2[9] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Ui64\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeUint(uint64(x.Ui64))
-					}
+					r.WriteMapStart(4)
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[10] {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Events == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeUint(uint64(x.Ui32))
+						if false {
+						} else {
+							h.encSlicetestPolyEvent(([]testPolyEvent)(x.Events), e)
+						}
 					}
 				} else {
-					r.EncodeUint(0)
-				}
-			} else {
-				if yyq2[10] {
 					r.WriteMapElemKey()
 					if z.IsJSONHandle() {
-						z.WriteStr("\"Ui32\"")
+						z.WriteStr("\"Events\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ui32`)
+						r.EncodeSymbol(`Events`)
 					}
 					r.WriteMapElemValue()
-					if false {
+					if x.Events == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeUint(uint64(x.Ui32))
+						if false {
+						} else {
+							h.encSlicetestPolyEvent(([]testPolyEvent)(x.Events), e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[11] {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.Last == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeUint(uint64(x.Ui16))
+						if false {
+						} else if yyxt7 := z.Extension(z.I2Rtid(x.Last)); yyxt7 != nil {
+							z.EncExtension(x.Last, yyxt7)
+						} else {
+							yyip7 := x.Last
+							z.EncFallback(&yyip7)
+						}
 					}
 				} else {
-					r.EncodeUint(0)
-				}
-			} else {
-				if yyq2[11] {
 					r.WriteMapElemKey()
 					if z.IsJSONHandle() {
-						z.WriteStr("\"Ui16\"")
+						z.WriteStr("\"Last\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ui16`)
+						r.EncodeSymbol(`Last`)
 					}
 					r.WriteMapElemValue()
-					if false {
+					if x.Last == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeUint(uint64(x.Ui16))
+						if false {
+						} else if yyxt8 := z.Extension(z.I2Rtid(x.Last)); yyxt8 != nil {
+							z.EncExtension(x.Last, yyxt8)
+						} else {
+							yyip8 := x.Last
+							z.EncFallback(&yyip8)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[12] {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.ByName == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeUint(uint64(x.Ui8))
+						if false {
+						} else {
+							h.encMapstringtestPolyEvent((map[string]testPolyEvent)(x.ByName), e)
+						}
 					}
 				} else {
-					r.EncodeUint(0)
-				}
-			} else {
-				if yyq2[12] {
 					r.WriteMapElemKey()
 					if z.IsJSONHandle() {
-						z.WriteStr("\"Ui8\"")
+						z.WriteStr("\"ByName\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ui8`)
+						r.EncodeSymbol(`ByName`)
 					}
 					r.WriteMapElemValue()
-					if false {
+					if x.ByName == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeUint(uint64(x.Ui8))
+						if false {
+						} else {
+							h.encMapstringtestPolyEvent((map[string]testPolyEvent)(x.ByName), e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[13] {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if x.None == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeFloat64(float64(x.F64))
+						if false {
+						} else if yyxt13 := z.Extension(z.I2Rtid(x.None)); yyxt13 != nil {
+							z.EncExtension(x.None, yyxt13)
+						} else {
+							yyip13 := x.None
+							z.EncFallback(&yyip13)
+						}
 					}
 				} else {
-					r.EncodeFloat64(0)
-				}
-			} else {
-				if yyq2[13] {
 					r.WriteMapElemKey()
 					if z.IsJSONHandle() {
-						z.WriteStr("\"F64\"")
+						z.WriteStr("\"None\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `F64`)
+						r.EncodeSymbol(`None`)
 					}
 					r.WriteMapElemValue()
-					if false {
+					if x.None == nil {
+						r.EncodeNil()
 					} else {
-						r.EncodeFloat64(float64(x.F64))
+						if false {
+						} else if yyxt14 := z.Extension(z.I2Rtid(x.None)); yyxt14 != nil {
+							z.EncExtension(x.None, yyxt14)
+						} else {
+							yyip14 := x.None
+							z.EncFallback(&yyip14)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[14] {
-					if false {
-					} else {
-						r.EncodeFloat32(float32(x.F32))
-					}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
 				} else {
-					r.EncodeFloat32(0)
+					r.WriteMapEnd()
 				}
+			} // end if z.EncStructReflect()
+		}
+	}
+}
+
+func (x *testPolyLog) CodecDecodeSelf(d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	if false {
+	} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+		z.DecExtension(x, yyxt1)
+	} else {
+		yyct2 := r.ContainerType()
+		if yyct2 == codecSelferValueTypeMap19780 {
+			yyl2 := r.ReadMapStart()
+			if yyl2 == 0 {
+				r.ReadMapEnd()
 			} else {
-				if yyq2[14] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"F32\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `F32`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeFloat32(float32(x.F32))
-					}
-				}
+				x.codecDecodeSelfFromMap(yyl2, d)
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[15] {
-					if false {
-					} else {
-						r.EncodeBool(bool(x.B))
-					}
+		} else if yyct2 == codecSelferValueTypeArray19780 {
+			yyl2 := r.ReadArrayStart()
+			if yyl2 == 0 {
+				r.ReadArrayEnd()
+			} else {
+				x.codecDecodeSelfFromArray(yyl2, d)
+			}
+		} else {
+			panic(errCodecSelferOnlyMapOrArrayEncodeToStruct19780)
+		}
+	}
+}
+
+func (x *testPolyLog) codecDecodeSelfFromMap(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyhl3 bool = l >= 0
+	for yyj3 := 0; ; yyj3++ {
+		if yyhl3 {
+			if yyj3 >= l {
+				break
+			}
+		} else {
+			if r.CheckBreak() {
+				break
+			}
+		}
+		r.ReadMapElemKey()
+		yys3 := z.StringView(r.DecodeStringAsBytes())
+		r.ReadMapElemValue()
+		switch yys3 {
+		case "Events":
+			if r.TryDecodeAsNil() {
+				x.Events = nil
+			} else {
+				if false {
 				} else {
-					r.EncodeBool(false)
+					h.decSlicetestPolyEvent((*[]testPolyEvent)(&x.Events), d)
 				}
+			}
+		case "Last":
+			if r.TryDecodeAsNil() {
+				x.Last = nil
 			} else {
-				if yyq2[15] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"B\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `B`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeBool(bool(x.B))
-					}
+				if false {
+				} else {
+					z.DecFallback(&x.Last, true)
 				}
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[16] {
-					if false {
-					} else {
-						r.EncodeUint(uint64(x.By))
-					}
+		case "ByName":
+			if r.TryDecodeAsNil() {
+				x.ByName = nil
+			} else {
+				if false {
 				} else {
-					r.EncodeUint(0)
+					h.decMapstringtestPolyEvent((*map[string]testPolyEvent)(&x.ByName), d)
 				}
+			}
+		case "None":
+			if r.TryDecodeAsNil() {
+				x.None = nil
 			} else {
-				if yyq2[16] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"By\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `By`)
-					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeUint(uint64(x.By))
-					}
+				if false {
+				} else {
+					z.DecFallback(&x.None, true)
 				}
 			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[17] {
-					if x.Sslice == nil {
-						r.EncodeNil()
+		default:
+			z.DecStructFieldNotFound(-1, yys3)
+		} // end switch yys3
+	} // end for yyj3
+	r.ReadMapEnd()
+}
+
+func (x *testPolyLog) codecDecodeSelfFromArray(l int, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	var yyj12 int
+	var yyb12 bool
+	var yyhl12 bool = l >= 0
+	yyj12++
+	if yyhl12 {
+		yyb12 = yyj12 > l
+	} else {
+		yyb12 = r.CheckBreak()
+	}
+	if yyb12 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Events = nil
+	} else {
+		if false {
+		} else {
+			h.decSlicetestPolyEvent((*[]testPolyEvent)(&x.Events), d)
+		}
+	}
+	yyj12++
+	if yyhl12 {
+		yyb12 = yyj12 > l
+	} else {
+		yyb12 = r.CheckBreak()
+	}
+	if yyb12 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.Last = nil
+	} else {
+		if false {
+		} else {
+			z.DecFallback(&x.Last, true)
+		}
+	}
+	yyj12++
+	if yyhl12 {
+		yyb12 = yyj12 > l
+	} else {
+		yyb12 = r.CheckBreak()
+	}
+	if yyb12 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.ByName = nil
+	} else {
+		if false {
+		} else {
+			h.decMapstringtestPolyEvent((*map[string]testPolyEvent)(&x.ByName), d)
+		}
+	}
+	yyj12++
+	if yyhl12 {
+		yyb12 = yyj12 > l
+	} else {
+		yyb12 = r.CheckBreak()
+	}
+	if yyb12 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.None = nil
+	} else {
+		if false {
+		} else {
+			z.DecFallback(&x.None, true)
+		}
+	}
+	for {
+		yyj12++
+		if yyhl12 {
+			yyb12 = yyj12 > l
+		} else {
+			yyb12 = r.CheckBreak()
+		}
+		if yyb12 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj12-1, "")
+	}
+	r.ReadArrayEnd()
+}
+
+func (x *TestStrucFlex) CodecEncodeSelf(e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	if x == nil {
+		r.EncodeNil()
+	} else {
+		if false {
+		} else if yyxt1 := z.Extension(z.I2Rtid(x)); yyxt1 != nil {
+			z.EncExtension(x, yyxt1)
+		} else {
+			if z.EncStructReflect() {
+				z.EncStructByReflection(x)
+			} else {
+				yysep2 := !z.EncBinary()
+				yy2arr2 := z.EncBasicHandle().StructToArray
+				_, _ = yysep2, yy2arr2
+				const yyr2 bool = false // struct tag has 'toArray'
+				var yyq2 = [73]bool{    // should field at this index be written?
+					x.S != "",                   // S
+					x.I64 != 0,                  // I64
+					x.I32 != 0,                  // I32
+					x.I16 != 0,                  // I16
+					x.I8 != 0,                   // I8
+					x.I64n != 0,                 // I64n
+					x.I32n != 0,                 // I32n
+					x.I16n != 0,                 // I16n
+					x.I8n != 0,                  // I8n
+					x.Ui64 != 0,                 // Ui64
+					x.Ui32 != 0,                 // Ui32
+					x.Ui16 != 0,                 // Ui16
+					x.Ui8 != 0,                  // Ui8
+					x.F64 != 0,                  // F64
+					x.F32 != 0,                  // F32
+					x.B,                         // B
+					x.By != 0,                   // By
+					len(x.Sslice) != 0,          // Sslice
+					len(x.I64slice) != 0,        // I64slice
+					len(x.I16slice) != 0,        // I16slice
+					len(x.Ui64slice) != 0,       // Ui64slice
+					len(x.Ui8slice) != 0,        // Ui8slice
+					len(x.Bslice) != 0,          // Bslice
+					len(x.Byslice) != 0,         // Byslice
+					len(x.Iptrslice) != 0,       // Iptrslice
+					len(x.WrapSliceInt64) != 0,  // WrapSliceInt64
+					len(x.WrapSliceString) != 0, // WrapSliceString
+					len(x.Msi64) != 0,           // Msi64
+					false || x.Simplef.S != "" || x.Simplef.I64 != 0 || x.Simplef.I8 != 0 || x.Simplef.Ui64 != 0 || x.Simplef.Ui8 != 0 || x.Simplef.F64 != 0 || x.Simplef.F32 != 0 || x.Simplef.B || len(x.Simplef.Sslice) != 0 || len(x.Simplef.I16slice) != 0 || len(x.Simplef.Ui64slice) != 0 || len(x.Simplef.Ui8slice) != 0 || len(x.Simplef.Bslice) != 0 || len(x.Simplef.Iptrslice) != 0 || len(x.Simplef.WrapSliceInt64) != 0 || len(x.Simplef.WrapSliceString) != 0 || len(x.Simplef.Msi64) != 0, // Simplef
+					len(x.SstrUi64T) != 0,   // SstrUi64T
+					x.AS != "",              // AS
+					x.AI64 != 0,             // AI64
+					x.AI16 != 0,             // AI16
+					x.AUi64 != 0,            // AUi64
+					len(x.ASslice) != 0,     // ASslice
+					len(x.AI64slice) != 0,   // AI64slice
+					len(x.AUi64slice) != 0,  // AUi64slice
+					len(x.AF64slice) != 0,   // AF64slice
+					len(x.AF32slice) != 0,   // AF32slice
+					len(x.AMSU16) != 0,      // AMSU16
+					len(x.AI64arr0) != 0,    // AI64arr0
+					len(x.A164slice0) != 0,  // A164slice0
+					len(x.AUi64sliceN) != 0, // AUi64sliceN
+					len(x.AMSU16N) != 0,     // AMSU16N
+					len(x.AMSU16E) != 0,     // AMSU16E
+					false || x.NotAnon.AS != "" || x.NotAnon.AI64 != 0 || x.NotAnon.AI16 != 0 || x.NotAnon.AUi64 != 0 || len(x.NotAnon.ASslice) != 0 || len(x.NotAnon.AI64slice) != 0 || len(x.NotAnon.AUi64slice) != 0 || len(x.NotAnon.AF64slice) != 0 || len(x.NotAnon.AF32slice) != 0 || len(x.NotAnon.AMSU16) != 0 || len(x.NotAnon.AI64arr0) != 0 || len(x.NotAnon.A164slice0) != 0 || len(x.NotAnon.AUi64sliceN) != 0 || len(x.NotAnon.AMSU16N) != 0 || len(x.NotAnon.AMSU16E) != 0, // NotAnon
+					len(x.Nmap) != 0,           // Nmap
+					len(x.Nslice) != 0,         // Nslice
+					x.Nint64 != nil,            // Nint64
+					len(x.Chstr) != 0,          // Chstr
+					len(x.Mis) != 0,            // Mis
+					len(x.Mbu64) != 0,          // Mbu64
+					len(x.Miwu64s) != 0,        // Miwu64s
+					len(x.Mfwss) != 0,          // Mfwss
+					len(x.Mf32wss) != 0,        // Mf32wss
+					len(x.Mui2wss) != 0,        // Mui2wss
+					len(x.Msu2wss) != 0,        // Msu2wss
+					x.Ci64 != 0,                // Ci64
+					len(x.Swrapbytes) != 0,     // Swrapbytes
+					len(x.Swrapuint8) != 0,     // Swrapuint8
+					len(x.ArrStrUi64T) != 0,    // ArrStrUi64T
+					len(x.Ui64array) != 0,      // Ui64array
+					len(x.Ui64slicearray) != 0, // Ui64slicearray
+					len(x.SintfAarray) != 0,    // SintfAarray
+					x.AnonInTestStrucIntf != nil && len(x.Islice) != 0, // Islice
+					x.AnonInTestStrucIntf != nil && len(x.Ms) != 0,     // Ms
+					x.AnonInTestStrucIntf != nil && x.Nintf != nil,     // Nintf
+					x.AnonInTestStrucIntf != nil && !(x.T.IsZero()),    // T
+					x.AnonInTestStrucIntf != nil && x.Tptr != nil,      // Tptr
+					len(x.Mtsptr) != 0,  // Mtsptr
+					len(x.Mts) != 0,     // Mts
+					len(x.Its) != 0,     // Its
+					x.Nteststruc != nil, // Nteststruc
+				}
+				_ = yyq2
+				if yyr2 || yy2arr2 {
+					r.WriteArrayStart(73)
+				} else {
+					var yynn2 int
+					for _, b := range yyq2 {
+						if b {
+							yynn2++
+						}
+					}
+					r.WriteMapStart(yynn2)
+					yynn2 = 0
+				}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[0] {
+						if false {
+						} else {
+							if z.EncBasicHandle().StringToRaw {
+								r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+							} else {
+								r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+							}
+						}
 					} else {
-						if false {
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw([]byte{})
 						} else {
-							h.encSlicestring(([]string)(x.Sslice), e)
+							r.EncodeStringEnc(codecSelferCcUTF819780, "")
 						}
 					}
 				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[17] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Sslice\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Sslice`)
-					}
-					r.WriteMapElemValue()
-					if x.Sslice == nil {
-						r.EncodeNil()
-					} else {
+					if yyq2[0] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"S\"")
+						} else {
+							r.EncodeSymbol(`S`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSlicestring(([]string)(x.Sslice), e)
+							if z.EncBasicHandle().StringToRaw {
+								r.EncodeStringBytesRaw(z.BytesView(string(x.S)))
+							} else {
+								r.EncodeStringEnc(codecSelferCcUTF819780, string(x.S))
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[18] {
-					if x.I64slice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[1] {
 						if false {
 						} else {
-							h.encSliceint64(([]int64)(x.I64slice), e)
+							r.EncodeInt(int64(x.I64))
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[18] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I64slice\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I64slice`)
+						r.EncodeInt(0)
 					}
-					r.WriteMapElemValue()
-					if x.I64slice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[1] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I64\"")
+						} else {
+							r.EncodeSymbol(`I64`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSliceint64(([]int64)(x.I64slice), e)
+							r.EncodeInt(int64(x.I64))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[19] {
-					if x.I16slice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[2] {
 						if false {
 						} else {
-							h.encSliceint16(([]int16)(x.I16slice), e)
+							r.EncodeInt(int64(x.I32))
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[19] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"I16slice\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `I16slice`)
+						r.EncodeInt(0)
 					}
-					r.WriteMapElemValue()
-					if x.I16slice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[2] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I32\"")
+						} else {
+							r.EncodeSymbol(`I32`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSliceint16(([]int16)(x.I16slice), e)
+							r.EncodeInt(int64(x.I32))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[20] {
-					if x.Ui64slice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[3] {
 						if false {
 						} else {
-							h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+							r.EncodeInt(int64(x.I16))
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[20] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Ui64slice\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64slice`)
+						r.EncodeInt(0)
 					}
-					r.WriteMapElemValue()
-					if x.Ui64slice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[3] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I16\"")
+						} else {
+							r.EncodeSymbol(`I16`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+							r.EncodeInt(int64(x.I16))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[21] {
-					if x.Ui8slice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[4] {
 						if false {
 						} else {
-							r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+							r.EncodeInt(int64(x.I8))
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[21] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Ui8slice\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ui8slice`)
+						r.EncodeInt(0)
 					}
-					r.WriteMapElemValue()
-					if x.Ui8slice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[4] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I8\"")
+						} else {
+							r.EncodeSymbol(`I8`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+							r.EncodeInt(int64(x.I8))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[22] {
-					if x.Bslice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[5] {
 						if false {
 						} else {
-							h.encSlicebool(([]bool)(x.Bslice), e)
+							r.EncodeInt(int64(x.I64n))
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[22] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Bslice\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Bslice`)
+						r.EncodeInt(0)
 					}
-					r.WriteMapElemValue()
-					if x.Bslice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[5] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I64n\"")
+						} else {
+							r.EncodeSymbol(`I64n`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSlicebool(([]bool)(x.Bslice), e)
+							r.EncodeInt(int64(x.I64n))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[23] {
-					if x.Byslice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[6] {
 						if false {
 						} else {
-							r.EncodeStringBytesRaw([]byte(x.Byslice))
+							r.EncodeInt(int64(x.I32n))
 						}
+					} else {
+						r.EncodeInt(0)
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[6] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I32n\"")
+						} else {
+							r.EncodeSymbol(`I32n`)
+						}
+						r.WriteMapElemValue()
+						if false {
+						} else {
+							r.EncodeInt(int64(x.I32n))
+						}
+					}
 				}
-			} else {
-				if yyq2[23] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Byslice\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[7] {
+						if false {
+						} else {
+							r.EncodeInt(int64(x.I16n))
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Byslice`)
+						r.EncodeInt(0)
 					}
-					r.WriteMapElemValue()
-					if x.Byslice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[7] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I16n\"")
+						} else {
+							r.EncodeSymbol(`I16n`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							r.EncodeStringBytesRaw([]byte(x.Byslice))
+							r.EncodeInt(int64(x.I16n))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[24] {
-					if x.Iptrslice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[8] {
 						if false {
 						} else {
-							h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+							r.EncodeInt(int64(x.I8n))
 						}
+					} else {
+						r.EncodeInt(0)
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[8] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I8n\"")
+						} else {
+							r.EncodeSymbol(`I8n`)
+						}
+						r.WriteMapElemValue()
+						if false {
+						} else {
+							r.EncodeInt(int64(x.I8n))
+						}
+					}
 				}
-			} else {
-				if yyq2[24] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Iptrslice\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[9] {
+						if false {
+						} else {
+							r.EncodeUint(uint64(x.Ui64))
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Iptrslice`)
+						r.EncodeUint(0)
 					}
-					r.WriteMapElemValue()
-					if x.Iptrslice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[9] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ui64\"")
+						} else {
+							r.EncodeSymbol(`Ui64`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+							r.EncodeUint(uint64(x.Ui64))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[25] {
-					if x.WrapSliceInt64 == nil {
-						r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[10] {
+						if false {
+						} else {
+							r.EncodeUint(uint64(x.Ui32))
+						}
 					} else {
-						x.WrapSliceInt64.CodecEncodeSelf(e)
+						r.EncodeUint(0)
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[10] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ui32\"")
+						} else {
+							r.EncodeSymbol(`Ui32`)
+						}
+						r.WriteMapElemValue()
+						if false {
+						} else {
+							r.EncodeUint(uint64(x.Ui32))
+						}
+					}
 				}
-			} else {
-				if yyq2[25] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"WrapSliceInt64\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[11] {
+						if false {
+						} else {
+							r.EncodeUint(uint64(x.Ui16))
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `WrapSliceInt64`)
+						r.EncodeUint(0)
 					}
-					r.WriteMapElemValue()
-					if x.WrapSliceInt64 == nil {
-						r.EncodeNil()
-					} else {
-						x.WrapSliceInt64.CodecEncodeSelf(e)
+				} else {
+					if yyq2[11] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ui16\"")
+						} else {
+							r.EncodeSymbol(`Ui16`)
+						}
+						r.WriteMapElemValue()
+						if false {
+						} else {
+							r.EncodeUint(uint64(x.Ui16))
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[26] {
-					if x.WrapSliceString == nil {
-						r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[12] {
+						if false {
+						} else {
+							r.EncodeUint(uint64(x.Ui8))
+						}
 					} else {
-						x.WrapSliceString.CodecEncodeSelf(e)
+						r.EncodeUint(0)
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[12] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ui8\"")
+						} else {
+							r.EncodeSymbol(`Ui8`)
+						}
+						r.WriteMapElemValue()
+						if false {
+						} else {
+							r.EncodeUint(uint64(x.Ui8))
+						}
+					}
 				}
-			} else {
-				if yyq2[26] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"WrapSliceString\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[13] {
+						if false {
+						} else {
+							r.EncodeFloat64(float64(x.F64))
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `WrapSliceString`)
+						r.EncodeFloat64(0)
 					}
-					r.WriteMapElemValue()
-					if x.WrapSliceString == nil {
-						r.EncodeNil()
-					} else {
-						x.WrapSliceString.CodecEncodeSelf(e)
+				} else {
+					if yyq2[13] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"F64\"")
+						} else {
+							r.EncodeSymbol(`F64`)
+						}
+						r.WriteMapElemValue()
+						if false {
+						} else {
+							r.EncodeFloat64(float64(x.F64))
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[27] {
-					if x.Msi64 == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[14] {
 						if false {
 						} else {
-							h.encMapstringint64((map[string]int64)(x.Msi64), e)
+							r.EncodeFloat32(float32(x.F32))
 						}
+					} else {
+						r.EncodeFloat32(0)
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[14] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"F32\"")
+						} else {
+							r.EncodeSymbol(`F32`)
+						}
+						r.WriteMapElemValue()
+						if false {
+						} else {
+							r.EncodeFloat32(float32(x.F32))
+						}
+					}
 				}
-			} else {
-				if yyq2[27] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Msi64\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[15] {
+						if false {
+						} else {
+							r.EncodeBool(bool(x.B))
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Msi64`)
+						r.EncodeBool(false)
 					}
-					r.WriteMapElemValue()
-					if x.Msi64 == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[15] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"B\"")
+						} else {
+							r.EncodeSymbol(`B`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encMapstringint64((map[string]int64)(x.Msi64), e)
+							r.EncodeBool(bool(x.B))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[28] {
-					yy88 := &x.Simplef
-					yy88.CodecEncodeSelf(e)
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[28] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Simplef\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[16] {
+						if false {
+						} else {
+							r.EncodeUint(uint64(x.By))
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Simplef`)
+						r.EncodeUint(0)
 					}
-					r.WriteMapElemValue()
-					yy90 := &x.Simplef
-					yy90.CodecEncodeSelf(e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[29] {
-					if x.SstrUi64T == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[16] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"By\"")
+						} else {
+							r.EncodeSymbol(`By`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+							r.EncodeUint(uint64(x.By))
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[29] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"SstrUi64T\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[17] {
+						if x.Sslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicestring(([]string)(x.Sslice), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `SstrUi64T`)
-					}
-					r.WriteMapElemValue()
-					if x.SstrUi64T == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[17] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Sslice\"")
 						} else {
-							h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+							r.EncodeSymbol(`Sslice`)
+						}
+						r.WriteMapElemValue()
+						if x.Sslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicestring(([]string)(x.Sslice), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[30] {
-					if false {
-					} else {
-						if z.EncBasicHandle().StringToRaw {
-							r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[18] {
+						if x.I64slice == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+							if false {
+							} else {
+								h.encSliceint64(([]int64)(x.I64slice), e)
+							}
 						}
+					} else {
+						r.EncodeNil()
 					}
 				} else {
-					if z.EncBasicHandle().StringToRaw {
-						r.EncodeStringBytesRaw([]byte{})
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, "")
+					if yyq2[18] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I64slice\"")
+						} else {
+							r.EncodeSymbol(`I64slice`)
+						}
+						r.WriteMapElemValue()
+						if x.I64slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceint64(([]int64)(x.I64slice), e)
+							}
+						}
 					}
 				}
-			} else {
-				if yyq2[30] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AS\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[19] {
+						if x.I16slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceint16(([]int16)(x.I16slice), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AS`)
+						r.EncodeNil()
 					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						if z.EncBasicHandle().StringToRaw {
-							r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+				} else {
+					if yyq2[19] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"I16slice\"")
 						} else {
-							r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+							r.EncodeSymbol(`I16slice`)
+						}
+						r.WriteMapElemValue()
+						if x.I16slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceint16(([]int16)(x.I16slice), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[31] {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[20] {
+						if x.Ui64slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+							}
+						}
 					} else {
-						r.EncodeInt(int64(x.AI64))
+						r.EncodeNil()
 					}
 				} else {
-					r.EncodeInt(0)
+					if yyq2[20] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ui64slice\"")
+						} else {
+							r.EncodeSymbol(`Ui64slice`)
+						}
+						r.WriteMapElemValue()
+						if x.Ui64slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceuint64(([]uint64)(x.Ui64slice), e)
+							}
+						}
+					}
 				}
-			} else {
-				if yyq2[31] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AI64\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[21] {
+						if x.Ui8slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AI64`)
+						r.EncodeNil()
 					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.AI64))
+				} else {
+					if yyq2[21] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ui8slice\"")
+						} else {
+							r.EncodeSymbol(`Ui8slice`)
+						}
+						r.WriteMapElemValue()
+						if x.Ui8slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								r.EncodeStringBytesRaw([]byte(x.Ui8slice))
+							}
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[32] {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[22] {
+						if x.Bslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicebool(([]bool)(x.Bslice), e)
+							}
+						}
 					} else {
-						r.EncodeInt(int64(x.AI16))
+						r.EncodeNil()
 					}
 				} else {
-					r.EncodeInt(0)
+					if yyq2[22] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Bslice\"")
+						} else {
+							r.EncodeSymbol(`Bslice`)
+						}
+						r.WriteMapElemValue()
+						if x.Bslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicebool(([]bool)(x.Bslice), e)
+							}
+						}
+					}
 				}
-			} else {
-				if yyq2[32] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AI16\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[23] {
+						if x.Byslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								r.EncodeStringBytesRaw([]byte(x.Byslice))
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AI16`)
+						r.EncodeNil()
 					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeInt(int64(x.AI16))
+				} else {
+					if yyq2[23] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Byslice\"")
+						} else {
+							r.EncodeSymbol(`Byslice`)
+						}
+						r.WriteMapElemValue()
+						if x.Byslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								r.EncodeStringBytesRaw([]byte(x.Byslice))
+							}
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[33] {
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[24] {
+						if x.Iptrslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+							}
+						}
 					} else {
-						r.EncodeUint(uint64(x.AUi64))
+						r.EncodeNil()
 					}
 				} else {
-					r.EncodeUint(0)
+					if yyq2[24] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Iptrslice\"")
+						} else {
+							r.EncodeSymbol(`Iptrslice`)
+						}
+						r.WriteMapElemValue()
+						if x.Iptrslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicePtrtoint64(([]*int64)(x.Iptrslice), e)
+							}
+						}
+					}
 				}
-			} else {
-				if yyq2[33] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AUi64\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[25] {
+						if x.WrapSliceInt64 == nil {
+							r.EncodeNil()
+						} else {
+							x.WrapSliceInt64.CodecEncodeSelf(e)
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64`)
+						r.EncodeNil()
 					}
-					r.WriteMapElemValue()
-					if false {
-					} else {
-						r.EncodeUint(uint64(x.AUi64))
+				} else {
+					if yyq2[25] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"WrapSliceInt64\"")
+						} else {
+							r.EncodeSymbol(`WrapSliceInt64`)
+						}
+						r.WriteMapElemValue()
+						if x.WrapSliceInt64 == nil {
+							r.EncodeNil()
+						} else {
+							x.WrapSliceInt64.CodecEncodeSelf(e)
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[34] {
-					if x.ASslice == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[26] {
+						if x.WrapSliceString == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicestring(([]string)(x.ASslice), e)
+							x.WrapSliceString.CodecEncodeSelf(e)
 						}
+					} else {
+						r.EncodeNil()
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[26] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"WrapSliceString\"")
+						} else {
+							r.EncodeSymbol(`WrapSliceString`)
+						}
+						r.WriteMapElemValue()
+						if x.WrapSliceString == nil {
+							r.EncodeNil()
+						} else {
+							x.WrapSliceString.CodecEncodeSelf(e)
+						}
+					}
 				}
-			} else {
-				if yyq2[34] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"ASslice\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[27] {
+						if x.Msi64 == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringint64((map[string]int64)(x.Msi64), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `ASslice`)
-					}
-					r.WriteMapElemValue()
-					if x.ASslice == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[27] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Msi64\"")
 						} else {
-							h.encSlicestring(([]string)(x.ASslice), e)
+							r.EncodeSymbol(`Msi64`)
+						}
+						r.WriteMapElemValue()
+						if x.Msi64 == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringint64((map[string]int64)(x.Msi64), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[35] {
-					if x.AI64slice == nil {
-						r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[28] {
+						yy88 := &x.Simplef
+						yy88.CodecEncodeSelf(e)
 					} else {
-						if false {
+						r.EncodeNil()
+					}
+				} else {
+					if yyq2[28] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Simplef\"")
 						} else {
-							h.encSliceint64(([]int64)(x.AI64slice), e)
+							r.EncodeSymbol(`Simplef`)
 						}
+						r.WriteMapElemValue()
+						yy90 := &x.Simplef
+						yy90.CodecEncodeSelf(e)
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[35] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AI64slice\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[29] {
+						if x.SstrUi64T == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AI64slice`)
-					}
-					r.WriteMapElemValue()
-					if x.AI64slice == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[29] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"SstrUi64T\"")
 						} else {
-							h.encSliceint64(([]int64)(x.AI64slice), e)
+							r.EncodeSymbol(`SstrUi64T`)
+						}
+						r.WriteMapElemValue()
+						if x.SstrUi64T == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicestringUint64T(([]stringUint64T)(x.SstrUi64T), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[36] {
-					if x.AUi64slice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[30] {
 						if false {
 						} else {
-							h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+							if z.EncBasicHandle().StringToRaw {
+								r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+							} else {
+								r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+							}
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[36] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AUi64slice\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64slice`)
+						if z.EncBasicHandle().StringToRaw {
+							r.EncodeStringBytesRaw([]byte{})
+						} else {
+							r.EncodeStringEnc(codecSelferCcUTF819780, "")
+						}
 					}
-					r.WriteMapElemValue()
-					if x.AUi64slice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[30] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AS\"")
+						} else {
+							r.EncodeSymbol(`AS`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+							if z.EncBasicHandle().StringToRaw {
+								r.EncodeStringBytesRaw(z.BytesView(string(x.AS)))
+							} else {
+								r.EncodeStringEnc(codecSelferCcUTF819780, string(x.AS))
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[37] {
-					if x.AF64slice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[31] {
 						if false {
 						} else {
-							h.encSlicefloat64(([]float64)(x.AF64slice), e)
+							r.EncodeInt(int64(x.AI64))
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[37] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AF64slice\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AF64slice`)
+						r.EncodeInt(0)
 					}
-					r.WriteMapElemValue()
-					if x.AF64slice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[31] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AI64\"")
+						} else {
+							r.EncodeSymbol(`AI64`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSlicefloat64(([]float64)(x.AF64slice), e)
+							r.EncodeInt(int64(x.AI64))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[38] {
-					if x.AF32slice == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[32] {
 						if false {
 						} else {
-							h.encSlicefloat32(([]float32)(x.AF32slice), e)
+							r.EncodeInt(int64(x.AI16))
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[38] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AF32slice\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AF32slice`)
+						r.EncodeInt(0)
 					}
-					r.WriteMapElemValue()
-					if x.AF32slice == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[32] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AI16\"")
+						} else {
+							r.EncodeSymbol(`AI16`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encSlicefloat32(([]float32)(x.AF32slice), e)
+							r.EncodeInt(int64(x.AI16))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[39] {
-					if x.AMSU16 == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[33] {
 						if false {
 						} else {
-							h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+							r.EncodeUint(uint64(x.AUi64))
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[39] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AMSU16\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16`)
+						r.EncodeUint(0)
 					}
-					r.WriteMapElemValue()
-					if x.AMSU16 == nil {
-						r.EncodeNil()
-					} else {
+				} else {
+					if yyq2[33] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AUi64\"")
+						} else {
+							r.EncodeSymbol(`AUi64`)
+						}
+						r.WriteMapElemValue()
 						if false {
 						} else {
-							h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+							r.EncodeUint(uint64(x.AUi64))
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[40] {
-					yy126 := &x.AI64arr0
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[34] {
+						if x.ASslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicestring(([]string)(x.ASslice), e)
+							}
+						}
 					} else {
-						h.encArray0int64((*[0]int64)(yy126), e)
+						r.EncodeNil()
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[34] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"ASslice\"")
+						} else {
+							r.EncodeSymbol(`ASslice`)
+						}
+						r.WriteMapElemValue()
+						if x.ASslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicestring(([]string)(x.ASslice), e)
+							}
+						}
+					}
 				}
-			} else {
-				if yyq2[40] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AI64arr0\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[35] {
+						if x.AI64slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceint64(([]int64)(x.AI64slice), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AI64arr0`)
+						r.EncodeNil()
 					}
-					r.WriteMapElemValue()
-					yy128 := &x.AI64arr0
-					if false {
-					} else {
-						h.encArray0int64((*[0]int64)(yy128), e)
+				} else {
+					if yyq2[35] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AI64slice\"")
+						} else {
+							r.EncodeSymbol(`AI64slice`)
+						}
+						r.WriteMapElemValue()
+						if x.AI64slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceint64(([]int64)(x.AI64slice), e)
+							}
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[41] {
-					if x.A164slice0 == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[36] {
+						if x.AUi64slice == nil {
+							r.EncodeNil()
 						} else {
-							h.encSliceint64(([]int64)(x.A164slice0), e)
+							if false {
+							} else {
+								h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+							}
 						}
+					} else {
+						r.EncodeNil()
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[36] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AUi64slice\"")
+						} else {
+							r.EncodeSymbol(`AUi64slice`)
+						}
+						r.WriteMapElemValue()
+						if x.AUi64slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceuint64(([]uint64)(x.AUi64slice), e)
+							}
+						}
+					}
 				}
-			} else {
-				if yyq2[41] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"A164slice0\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[37] {
+						if x.AF64slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicefloat64(([]float64)(x.AF64slice), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `A164slice0`)
-					}
-					r.WriteMapElemValue()
-					if x.A164slice0 == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[37] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AF64slice\"")
 						} else {
-							h.encSliceint64(([]int64)(x.A164slice0), e)
+							r.EncodeSymbol(`AF64slice`)
+						}
+						r.WriteMapElemValue()
+						if x.AF64slice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicefloat64(([]float64)(x.AF64slice), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[42] {
-					if x.AUi64sliceN == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[38] {
+						if x.AF32slice == nil {
+							r.EncodeNil()
 						} else {
-							h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+							if false {
+							} else {
+								h.encSlicefloat32(([]float32)(x.AF32slice), e)
+							}
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[42] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AUi64sliceN\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AUi64sliceN`)
-					}
-					r.WriteMapElemValue()
-					if x.AUi64sliceN == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[38] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AF32slice\"")
 						} else {
-							h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+							r.EncodeSymbol(`AF32slice`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[43] {
-					if x.AMSU16N == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if x.AF32slice == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+							if false {
+							} else {
+								h.encSlicefloat32(([]float32)(x.AF32slice), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[43] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AMSU16N\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[39] {
+						if x.AMSU16 == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16N`)
-					}
-					r.WriteMapElemValue()
-					if x.AMSU16N == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[39] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AMSU16\"")
 						} else {
-							h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+							r.EncodeSymbol(`AMSU16`)
+						}
+						r.WriteMapElemValue()
+						if x.AMSU16 == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringuint16((map[string]uint16)(x.AMSU16), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[44] {
-					if x.AMSU16E == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[40] {
+						yy126 := &x.AI64arr0
 						if false {
 						} else {
-							h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+							h.encArray0int64((*[0]int64)(yy126), e)
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[44] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"AMSU16E\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `AMSU16E`)
-					}
-					r.WriteMapElemValue()
-					if x.AMSU16E == nil {
 						r.EncodeNil()
-					} else {
+					}
+				} else {
+					if yyq2[40] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AI64arr0\"")
+						} else {
+							r.EncodeSymbol(`AI64arr0`)
+						}
+						r.WriteMapElemValue()
+						yy128 := &x.AI64arr0
 						if false {
 						} else {
-							h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
+							h.encArray0int64((*[0]int64)(yy128), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[45] {
-					yy143 := &x.NotAnon
-					yy143.CodecEncodeSelf(e)
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[45] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"NotAnon\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[41] {
+						if x.A164slice0 == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceint64(([]int64)(x.A164slice0), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `NotAnon`)
-					}
-					r.WriteMapElemValue()
-					yy145 := &x.NotAnon
-					yy145.CodecEncodeSelf(e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[46] {
-					if x.Nmap == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[41] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"A164slice0\"")
 						} else {
-							h.encMapstringbool((map[string]bool)(x.Nmap), e)
+							r.EncodeSymbol(`A164slice0`)
+						}
+						r.WriteMapElemValue()
+						if x.A164slice0 == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceint64(([]int64)(x.A164slice0), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[46] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Nmap\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[42] {
+						if x.AUi64sliceN == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Nmap`)
-					}
-					r.WriteMapElemValue()
-					if x.Nmap == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[42] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AUi64sliceN\"")
 						} else {
-							h.encMapstringbool((map[string]bool)(x.Nmap), e)
+							r.EncodeSymbol(`AUi64sliceN`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[47] {
-					if x.Nslice == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if x.AUi64sliceN == nil {
+							r.EncodeNil()
 						} else {
-							r.EncodeStringBytesRaw([]byte(x.Nslice))
+							if false {
+							} else {
+								h.encSliceuint64(([]uint64)(x.AUi64sliceN), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[47] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Nslice\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[43] {
+						if x.AMSU16N == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Nslice`)
-					}
-					r.WriteMapElemValue()
-					if x.Nslice == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[43] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AMSU16N\"")
 						} else {
-							r.EncodeStringBytesRaw([]byte(x.Nslice))
+							r.EncodeSymbol(`AMSU16N`)
+						}
+						r.WriteMapElemValue()
+						if x.AMSU16N == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringuint16((map[string]uint16)(x.AMSU16N), e)
+							}
 						}
 					}
 				}
-			}
-			var yyn153 bool
-			if x.TestStrucCommon.Nint64 == nil {
-				yyn153 = true
-				goto LABEL153
-			}
-		LABEL153:
-			if yyr2 || yy2arr2 {
-				if yyn153 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if yyq2[48] {
-						if x.Nint64 == nil {
+					if yyq2[44] {
+						if x.AMSU16E == nil {
 							r.EncodeNil()
 						} else {
-							yy154 := *x.Nint64
 							if false {
 							} else {
-								r.EncodeInt(int64(yy154))
+								h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
 							}
 						}
 					} else {
 						r.EncodeNil()
 					}
-				}
-			} else {
-				if yyq2[48] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Nint64\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Nint64`)
-					}
-					r.WriteMapElemValue()
-					if yyn153 {
-						r.EncodeNil()
-					} else {
-						if x.Nint64 == nil {
+				} else {
+					if yyq2[44] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"AMSU16E\"")
+						} else {
+							r.EncodeSymbol(`AMSU16E`)
+						}
+						r.WriteMapElemValue()
+						if x.AMSU16E == nil {
 							r.EncodeNil()
 						} else {
-							yy156 := *x.Nint64
 							if false {
 							} else {
-								r.EncodeInt(int64(yy156))
+								h.encMapstringuint16((map[string]uint16)(x.AMSU16E), e)
 							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[49] {
-					if x.Chstr == nil {
-						r.EncodeNil()
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[45] {
+						yy143 := &x.NotAnon
+						yy143.CodecEncodeSelf(e)
 					} else {
-						if false {
+						r.EncodeNil()
+					}
+				} else {
+					if yyq2[45] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"NotAnon\"")
 						} else {
-							h.encChanstring((chan string)(x.Chstr), e)
+							r.EncodeSymbol(`NotAnon`)
 						}
+						r.WriteMapElemValue()
+						yy145 := &x.NotAnon
+						yy145.CodecEncodeSelf(e)
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[49] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Chstr\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[46] {
+						if x.Nmap == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringbool((map[string]bool)(x.Nmap), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Chstr`)
-					}
-					r.WriteMapElemValue()
-					if x.Chstr == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[46] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Nmap\"")
 						} else {
-							h.encChanstring((chan string)(x.Chstr), e)
+							r.EncodeSymbol(`Nmap`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[50] {
-					if x.Mis == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if x.Nmap == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintstring((map[int]string)(x.Mis), e)
+							if false {
+							} else {
+								h.encMapstringbool((map[string]bool)(x.Nmap), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[50] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Mis\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[47] {
+						if x.Nslice == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								r.EncodeStringBytesRaw([]byte(x.Nslice))
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Mis`)
-					}
-					r.WriteMapElemValue()
-					if x.Mis == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[47] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Nslice\"")
+						} else {
+							r.EncodeSymbol(`Nslice`)
+						}
+						r.WriteMapElemValue()
+						if x.Nslice == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapintstring((map[int]string)(x.Mis), e)
+							if false {
+							} else {
+								r.EncodeStringBytesRaw([]byte(x.Nslice))
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[51] {
-					if x.Mbu64 == nil {
+				var yyn153 bool
+				if x.TestStrucCommon.Nint64 == nil {
+					yyn153 = true
+					goto LABEL153
+				}
+			LABEL153:
+				if yyr2 || yy2arr2 {
+					if yyn153 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						if false {
+						r.WriteArrayElem()
+						if yyq2[48] {
+							if x.Nint64 == nil {
+								r.EncodeNil()
+							} else {
+								yy154 := *x.Nint64
+								if false {
+								} else {
+									r.EncodeInt(int64(yy154))
+								}
+							}
 						} else {
-							h.encMapboolc3RydWN0IHt9((map[bool]struct{})(x.Mbu64), e)
+							r.EncodeNil()
 						}
 					}
 				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[51] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Mbu64\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Mbu64`)
-					}
-					r.WriteMapElemValue()
-					if x.Mbu64 == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+					if yyq2[48] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Nint64\"")
 						} else {
-							h.encMapboolc3RydWN0IHt9((map[bool]struct{})(x.Mbu64), e)
+							r.EncodeSymbol(`Nint64`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[52] {
-					if x.Miwu64s == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if yyn153 {
+							r.EncodeNil()
 						} else {
-							h.encMapintwrapUint64Slice((map[int]wrapUint64Slice)(x.Miwu64s), e)
+							if x.Nint64 == nil {
+								r.EncodeNil()
+							} else {
+								yy156 := *x.Nint64
+								if false {
+								} else {
+									r.EncodeInt(int64(yy156))
+								}
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[52] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Miwu64s\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[49] {
+						if x.Chstr == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encChanstring((chan string)(x.Chstr), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Miwu64s`)
-					}
-					r.WriteMapElemValue()
-					if x.Miwu64s == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[49] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Chstr\"")
 						} else {
-							h.encMapintwrapUint64Slice((map[int]wrapUint64Slice)(x.Miwu64s), e)
+							r.EncodeSymbol(`Chstr`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[53] {
-					if x.Mfwss == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if x.Chstr == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat64wrapStringSlice((map[float64]wrapStringSlice)(x.Mfwss), e)
+							if false {
+							} else {
+								h.encChanstring((chan string)(x.Chstr), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[53] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Mfwss\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[50] {
+						if x.Mis == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapintstring((map[int]string)(x.Mis), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Mfwss`)
-					}
-					r.WriteMapElemValue()
-					if x.Mfwss == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[50] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Mis\"")
 						} else {
-							h.encMapfloat64wrapStringSlice((map[float64]wrapStringSlice)(x.Mfwss), e)
+							r.EncodeSymbol(`Mis`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[54] {
-					if x.Mf32wss == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if x.Mis == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapfloat32wrapStringSlice((map[float32]wrapStringSlice)(x.Mf32wss), e)
+							if false {
+							} else {
+								h.encMapintstring((map[int]string)(x.Mis), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[54] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Mf32wss\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[51] {
+						if x.Mbu64 == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapboolONaHEdLDOQQHWfI((map[bool]struct{})(x.Mbu64), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Mf32wss`)
-					}
-					r.WriteMapElemValue()
-					if x.Mf32wss == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[51] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Mbu64\"")
 						} else {
-							h.encMapfloat32wrapStringSlice((map[float32]wrapStringSlice)(x.Mf32wss), e)
+							r.EncodeSymbol(`Mbu64`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[55] {
-					if x.Mui2wss == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if x.Mbu64 == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapuint64wrapStringSlice((map[uint64]wrapStringSlice)(x.Mui2wss), e)
+							if false {
+							} else {
+								h.encMapboolONaHEdLDOQQHWfI((map[bool]struct{})(x.Mbu64), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[55] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Mui2wss\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[52] {
+						if x.Miwu64s == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapintwrapUint64Slice((map[int]wrapUint64Slice)(x.Miwu64s), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Mui2wss`)
-					}
-					r.WriteMapElemValue()
-					if x.Mui2wss == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[52] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Miwu64s\"")
 						} else {
-							h.encMapuint64wrapStringSlice((map[uint64]wrapStringSlice)(x.Mui2wss), e)
+							r.EncodeSymbol(`Miwu64s`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[56] {
-					if x.Msu2wss == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if x.Miwu64s == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringUint64TwrapStringSlice((map[stringUint64T]wrapStringSlice)(x.Msu2wss), e)
+							if false {
+							} else {
+								h.encMapintwrapUint64Slice((map[int]wrapUint64Slice)(x.Miwu64s), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[56] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Msu2wss\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Msu2wss`)
-					}
-					r.WriteMapElemValue()
-					if x.Msu2wss == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[53] {
+						if x.Mfwss == nil {
+							r.EncodeNil()
 						} else {
-							h.encMapstringUint64TwrapStringSlice((map[stringUint64T]wrapStringSlice)(x.Msu2wss), e)
+							if false {
+							} else {
+								h.encMapfloat64wrapStringSlice((map[float64]wrapStringSlice)(x.Mfwss), e)
+							}
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[57] {
-					x.Ci64.CodecEncodeSelf(e)
-				} else {
-					r.EncodeInt(0)
-				}
-			} else {
-				if yyq2[57] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Ci64\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ci64`)
-					}
-					r.WriteMapElemValue()
-					x.Ci64.CodecEncodeSelf(e)
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[58] {
-					if x.Swrapbytes == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[53] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Mfwss\"")
+						} else {
+							r.EncodeSymbol(`Mfwss`)
+						}
+						r.WriteMapElemValue()
+						if x.Mfwss == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicewrapBytes(([]wrapBytes)(x.Swrapbytes), e)
+							if false {
+							} else {
+								h.encMapfloat64wrapStringSlice((map[float64]wrapStringSlice)(x.Mfwss), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[58] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Swrapbytes\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[54] {
+						if x.Mf32wss == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapfloat32wrapStringSlice((map[float32]wrapStringSlice)(x.Mf32wss), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Swrapbytes`)
-					}
-					r.WriteMapElemValue()
-					if x.Swrapbytes == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[54] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Mf32wss\"")
 						} else {
-							h.encSlicewrapBytes(([]wrapBytes)(x.Swrapbytes), e)
+							r.EncodeSymbol(`Mf32wss`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[59] {
-					if x.Swrapuint8 == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if x.Mf32wss == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicewrapUint8(([]wrapUint8)(x.Swrapuint8), e)
+							if false {
+							} else {
+								h.encMapfloat32wrapStringSlice((map[float32]wrapStringSlice)(x.Mf32wss), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[59] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Swrapuint8\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[55] {
+						if x.Mui2wss == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapuint64wrapStringSlice((map[uint64]wrapStringSlice)(x.Mui2wss), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Swrapuint8`)
-					}
-					r.WriteMapElemValue()
-					if x.Swrapuint8 == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[55] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Mui2wss\"")
+						} else {
+							r.EncodeSymbol(`Mui2wss`)
+						}
+						r.WriteMapElemValue()
+						if x.Mui2wss == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicewrapUint8(([]wrapUint8)(x.Swrapuint8), e)
+							if false {
+							} else {
+								h.encMapuint64wrapStringSlice((map[uint64]wrapStringSlice)(x.Mui2wss), e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[60] {
-					yy192 := &x.ArrStrUi64T
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[56] {
+						if x.Msu2wss == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringUint64TwrapStringSlice((map[stringUint64T]wrapStringSlice)(x.Msu2wss), e)
+							}
+						}
 					} else {
-						h.encArray4stringUint64T((*[4]stringUint64T)(yy192), e)
+						r.EncodeNil()
 					}
 				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[60] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"ArrStrUi64T\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `ArrStrUi64T`)
-					}
-					r.WriteMapElemValue()
-					yy194 := &x.ArrStrUi64T
-					if false {
-					} else {
-						h.encArray4stringUint64T((*[4]stringUint64T)(yy194), e)
+					if yyq2[56] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Msu2wss\"")
+						} else {
+							r.EncodeSymbol(`Msu2wss`)
+						}
+						r.WriteMapElemValue()
+						if x.Msu2wss == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringUint64TwrapStringSlice((map[stringUint64T]wrapStringSlice)(x.Msu2wss), e)
+							}
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[61] {
-					yy197 := &x.Ui64array
-					if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[57] {
+						x.Ci64.CodecEncodeSelf(e)
 					} else {
-						h.encArray4uint64((*[4]uint64)(yy197), e)
+						r.EncodeInt(0)
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[57] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ci64\"")
+						} else {
+							r.EncodeSymbol(`Ci64`)
+						}
+						r.WriteMapElemValue()
+						x.Ci64.CodecEncodeSelf(e)
+					}
 				}
-			} else {
-				if yyq2[61] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Ui64array\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[58] {
+						if x.Swrapbytes == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicewrapBytes(([]wrapBytes)(x.Swrapbytes), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64array`)
+						r.EncodeNil()
 					}
-					r.WriteMapElemValue()
-					yy199 := &x.Ui64array
-					if false {
-					} else {
-						h.encArray4uint64((*[4]uint64)(yy199), e)
+				} else {
+					if yyq2[58] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Swrapbytes\"")
+						} else {
+							r.EncodeSymbol(`Swrapbytes`)
+						}
+						r.WriteMapElemValue()
+						if x.Swrapbytes == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicewrapBytes(([]wrapBytes)(x.Swrapbytes), e)
+							}
+						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[62] {
-					if x.Ui64slicearray == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[59] {
+						if x.Swrapuint8 == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicePtrtoArray4uint64(([]*[4]uint64)(x.Ui64slicearray), e)
+							if false {
+							} else {
+								h.encSlicewrapUint8(([]wrapUint8)(x.Swrapuint8), e)
+							}
 						}
+					} else {
+						r.EncodeNil()
 					}
 				} else {
-					r.EncodeNil()
+					if yyq2[59] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Swrapuint8\"")
+						} else {
+							r.EncodeSymbol(`Swrapuint8`)
+						}
+						r.WriteMapElemValue()
+						if x.Swrapuint8 == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicewrapUint8(([]wrapUint8)(x.Swrapuint8), e)
+							}
+						}
+					}
 				}
-			} else {
-				if yyq2[62] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Ui64slicearray\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[60] {
+						yy192 := &x.ArrStrUi64T
+						if false {
+						} else {
+							h.encArray4stringUint64T((*[4]stringUint64T)(yy192), e)
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ui64slicearray`)
-					}
-					r.WriteMapElemValue()
-					if x.Ui64slicearray == nil {
 						r.EncodeNil()
-					} else {
+					}
+				} else {
+					if yyq2[60] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"ArrStrUi64T\"")
+						} else {
+							r.EncodeSymbol(`ArrStrUi64T`)
+						}
+						r.WriteMapElemValue()
+						yy194 := &x.ArrStrUi64T
 						if false {
 						} else {
-							h.encSlicePtrtoArray4uint64(([]*[4]uint64)(x.Ui64slicearray), e)
+							h.encArray4stringUint64T((*[4]stringUint64T)(yy194), e)
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[63] {
-					if x.SintfAarray == nil {
-						r.EncodeNil()
-					} else {
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[61] {
+						yy197 := &x.Ui64array
 						if false {
 						} else {
-							h.encSliceInterface(([]interface{})(x.SintfAarray), e)
+							h.encArray4uint64((*[4]uint64)(yy197), e)
 						}
-					}
-				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[63] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"SintfAarray\"")
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `SintfAarray`)
-					}
-					r.WriteMapElemValue()
-					if x.SintfAarray == nil {
 						r.EncodeNil()
-					} else {
+					}
+				} else {
+					if yyq2[61] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ui64array\"")
+						} else {
+							r.EncodeSymbol(`Ui64array`)
+						}
+						r.WriteMapElemValue()
+						yy199 := &x.Ui64array
 						if false {
 						} else {
-							h.encSliceInterface(([]interface{})(x.SintfAarray), e)
+							h.encArray4uint64((*[4]uint64)(yy199), e)
 						}
 					}
 				}
-			}
-			var yyn207 bool
-			if x.AnonInTestStrucIntf == nil {
-				yyn207 = true
-				goto LABEL207
-			}
-		LABEL207:
-			if yyr2 || yy2arr2 {
-				if yyn207 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if yyq2[64] {
-						if x.Islice == nil {
+					if yyq2[62] {
+						if x.Ui64slicearray == nil {
 							r.EncodeNil()
 						} else {
 							if false {
 							} else {
-								h.encSliceInterface(([]interface{})(x.Islice), e)
+								h.encSlicePtrtoArray4uint64(([]*[4]uint64)(x.Ui64slicearray), e)
 							}
 						}
 					} else {
 						r.EncodeNil()
 					}
-				}
-			} else {
-				if yyq2[64] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Islice\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Islice`)
-					}
-					r.WriteMapElemValue()
-					if yyn207 {
-						r.EncodeNil()
-					} else {
-						if x.Islice == nil {
+				} else {
+					if yyq2[62] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ui64slicearray\"")
+						} else {
+							r.EncodeSymbol(`Ui64slicearray`)
+						}
+						r.WriteMapElemValue()
+						if x.Ui64slicearray == nil {
 							r.EncodeNil()
 						} else {
 							if false {
 							} else {
-								h.encSliceInterface(([]interface{})(x.Islice), e)
+								h.encSlicePtrtoArray4uint64(([]*[4]uint64)(x.Ui64slicearray), e)
 							}
 						}
 					}
 				}
-			}
-			var yyn210 bool
-			if x.AnonInTestStrucIntf == nil {
-				yyn210 = true
-				goto LABEL210
-			}
-		LABEL210:
-			if yyr2 || yy2arr2 {
-				if yyn210 {
-					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					if yyq2[65] {
-						if x.Ms == nil {
+					if yyq2[63] {
+						if x.SintfAarray == nil {
 							r.EncodeNil()
 						} else {
 							if false {
 							} else {
-								h.encMapstringInterface((map[string]interface{})(x.Ms), e)
+								h.encSliceInterface(([]interface{})(x.SintfAarray), e)
 							}
 						}
 					} else {
 						r.EncodeNil()
 					}
-				}
-			} else {
-				if yyq2[65] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Ms\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Ms`)
-					}
-					r.WriteMapElemValue()
-					if yyn210 {
-						r.EncodeNil()
-					} else {
-						if x.Ms == nil {
+				} else {
+					if yyq2[63] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"SintfAarray\"")
+						} else {
+							r.EncodeSymbol(`SintfAarray`)
+						}
+						r.WriteMapElemValue()
+						if x.SintfAarray == nil {
 							r.EncodeNil()
 						} else {
 							if false {
 							} else {
-								h.encMapstringInterface((map[string]interface{})(x.Ms), e)
+								h.encSliceInterface(([]interface{})(x.SintfAarray), e)
 							}
 						}
 					}
 				}
-			}
-			var yyn213 bool
-			if x.AnonInTestStrucIntf == nil {
-				yyn213 = true
-				goto LABEL213
-			}
-		LABEL213:
-			if yyr2 || yy2arr2 {
-				if yyn213 {
-					r.WriteArrayElem()
-					r.EncodeNil()
+				var yyn207 bool
+				if x.AnonInTestStrucIntf == nil {
+					yyn207 = true
+					goto LABEL207
+				}
+			LABEL207:
+				if yyr2 || yy2arr2 {
+					if yyn207 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if yyq2[64] {
+							if x.Islice == nil {
+								r.EncodeNil()
+							} else {
+								if false {
+								} else {
+									h.encSliceInterface(([]interface{})(x.Islice), e)
+								}
+							}
+						} else {
+							r.EncodeNil()
+						}
+					}
 				} else {
-					r.WriteArrayElem()
-					if yyq2[66] {
-						if x.Nintf == nil {
+					if yyq2[64] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Islice\"")
+						} else {
+							r.EncodeSymbol(`Islice`)
+						}
+						r.WriteMapElemValue()
+						if yyn207 {
 							r.EncodeNil()
 						} else {
-							if false {
+							if x.Islice == nil {
+								r.EncodeNil()
 							} else {
-								z.EncFallback(x.Nintf)
+								if false {
+								} else {
+									h.encSliceInterface(([]interface{})(x.Islice), e)
+								}
 							}
 						}
-					} else {
-						r.EncodeNil()
 					}
 				}
-			} else {
-				if yyq2[66] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Nintf\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Nintf`)
-					}
-					r.WriteMapElemValue()
-					if yyn213 {
+				var yyn210 bool
+				if x.AnonInTestStrucIntf == nil {
+					yyn210 = true
+					goto LABEL210
+				}
+			LABEL210:
+				if yyr2 || yy2arr2 {
+					if yyn210 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						if x.Nintf == nil {
-							r.EncodeNil()
-						} else {
-							if false {
+						r.WriteArrayElem()
+						if yyq2[65] {
+							if x.Ms == nil {
+								r.EncodeNil()
 							} else {
-								z.EncFallback(x.Nintf)
+								if false {
+								} else {
+									h.encMapstringInterface((map[string]interface{})(x.Ms), e)
+								}
 							}
+						} else {
+							r.EncodeNil()
 						}
 					}
-				}
-			}
-			var yyn216 bool
-			if x.AnonInTestStrucIntf == nil {
-				yyn216 = true
-				goto LABEL216
-			}
-		LABEL216:
-			if yyr2 || yy2arr2 {
-				if yyn216 {
-					r.WriteArrayElem()
-					r.EncodeNil()
 				} else {
-					r.WriteArrayElem()
-					if yyq2[67] {
-						if false {
-						} else if !z.EncBasicHandle().TimeNotBuiltin {
-							r.EncodeTime(x.T)
-						} else if yyxt217 := z.Extension(z.I2Rtid(x.T)); yyxt217 != nil {
-							z.EncExtension(x.T, yyxt217)
-						} else if z.EncBinary() {
-							z.EncBinaryMarshal(x.T)
-						} else if !z.EncBinary() && z.IsJSONHandle() {
-							z.EncJSONMarshal(x.T)
+					if yyq2[65] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Ms\"")
 						} else {
-							z.EncFallback(x.T)
+							r.EncodeSymbol(`Ms`)
+						}
+						r.WriteMapElemValue()
+						if yyn210 {
+							r.EncodeNil()
+						} else {
+							if x.Ms == nil {
+								r.EncodeNil()
+							} else {
+								if false {
+								} else {
+									h.encMapstringInterface((map[string]interface{})(x.Ms), e)
+								}
+							}
 						}
-					} else {
-						r.EncodeNil()
 					}
 				}
-			} else {
-				if yyq2[67] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"T\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `T`)
-					}
-					r.WriteMapElemValue()
-					if yyn216 {
+				var yyn213 bool
+				if x.AnonInTestStrucIntf == nil {
+					yyn213 = true
+					goto LABEL213
+				}
+			LABEL213:
+				if yyr2 || yy2arr2 {
+					if yyn213 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						if false {
-						} else if !z.EncBasicHandle().TimeNotBuiltin {
-							r.EncodeTime(x.T)
-						} else if yyxt218 := z.Extension(z.I2Rtid(x.T)); yyxt218 != nil {
-							z.EncExtension(x.T, yyxt218)
-						} else if z.EncBinary() {
-							z.EncBinaryMarshal(x.T)
-						} else if !z.EncBinary() && z.IsJSONHandle() {
-							z.EncJSONMarshal(x.T)
+						r.WriteArrayElem()
+						if yyq2[66] {
+							if x.Nintf == nil {
+								r.EncodeNil()
+							} else {
+								if false {
+								} else {
+									z.EncFallback(x.Nintf)
+								}
+							}
 						} else {
-							z.EncFallback(x.T)
+							r.EncodeNil()
 						}
 					}
-				}
-			}
-			var yyn219 bool
-			if x.AnonInTestStrucIntf == nil {
-				yyn219 = true
-				goto LABEL219
-			}
-			if x.AnonInTestStrucIntf.Tptr == nil {
-				yyn219 = true
-				goto LABEL219
-			}
-		LABEL219:
-			if yyr2 || yy2arr2 {
-				if yyn219 {
-					r.WriteArrayElem()
-					r.EncodeNil()
 				} else {
-					r.WriteArrayElem()
-					if yyq2[68] {
-						if x.Tptr == nil {
+					if yyq2[66] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Nintf\"")
+						} else {
+							r.EncodeSymbol(`Nintf`)
+						}
+						r.WriteMapElemValue()
+						if yyn213 {
 							r.EncodeNil()
 						} else {
-							yy220 := *x.Tptr
+							if x.Nintf == nil {
+								r.EncodeNil()
+							} else {
+								if false {
+								} else {
+									z.EncFallback(x.Nintf)
+								}
+							}
+						}
+					}
+				}
+				var yyn216 bool
+				if x.AnonInTestStrucIntf == nil {
+					yyn216 = true
+					goto LABEL216
+				}
+			LABEL216:
+				if yyr2 || yy2arr2 {
+					if yyn216 {
+						r.WriteArrayElem()
+						r.EncodeNil()
+					} else {
+						r.WriteArrayElem()
+						if yyq2[67] {
 							if false {
 							} else if !z.EncBasicHandle().TimeNotBuiltin {
-								r.EncodeTime(yy220)
-							} else if yyxt221 := z.Extension(z.I2Rtid(yy220)); yyxt221 != nil {
-								z.EncExtension(yy220, yyxt221)
+								r.EncodeTime(x.T)
+							} else if yyxt217 := z.Extension(z.I2Rtid(x.T)); yyxt217 != nil {
+								z.EncExtension(x.T, yyxt217)
 							} else if z.EncBinary() {
-								z.EncBinaryMarshal(yy220)
+								z.EncBinaryMarshal(x.T)
 							} else if !z.EncBinary() && z.IsJSONHandle() {
-								z.EncJSONMarshal(yy220)
+								z.EncJSONMarshal(x.T)
 							} else {
-								z.EncFallback(yy220)
+								z.EncFallback(x.T)
 							}
+						} else {
+							r.EncodeNil()
 						}
-					} else {
-						r.EncodeNil()
-					}
-				}
-			} else {
-				if yyq2[68] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Tptr\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Tptr`)
 					}
-					r.WriteMapElemValue()
-					if yyn219 {
-						r.EncodeNil()
-					} else {
-						if x.Tptr == nil {
+				} else {
+					if yyq2[67] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"T\"")
+						} else {
+							r.EncodeSymbol(`T`)
+						}
+						r.WriteMapElemValue()
+						if yyn216 {
 							r.EncodeNil()
 						} else {
-							yy222 := *x.Tptr
 							if false {
 							} else if !z.EncBasicHandle().TimeNotBuiltin {
-								r.EncodeTime(yy222)
-							} else if yyxt223 := z.Extension(z.I2Rtid(yy222)); yyxt223 != nil {
-								z.EncExtension(yy222, yyxt223)
+								r.EncodeTime(x.T)
+							} else if yyxt218 := z.Extension(z.I2Rtid(x.T)); yyxt218 != nil {
+								z.EncExtension(x.T, yyxt218)
 							} else if z.EncBinary() {
-								z.EncBinaryMarshal(yy222)
+								z.EncBinaryMarshal(x.T)
 							} else if !z.EncBinary() && z.IsJSONHandle() {
-								z.EncJSONMarshal(yy222)
+								z.EncJSONMarshal(x.T)
 							} else {
-								z.EncFallback(yy222)
+								z.EncFallback(x.T)
 							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[69] {
-					if x.Mtsptr == nil {
+				var yyn219 bool
+				if x.AnonInTestStrucIntf == nil {
+					yyn219 = true
+					goto LABEL219
+				}
+				if x.AnonInTestStrucIntf.Tptr == nil {
+					yyn219 = true
+					goto LABEL219
+				}
+			LABEL219:
+				if yyr2 || yy2arr2 {
+					if yyn219 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						if false {
+						r.WriteArrayElem()
+						if yyq2[68] {
+							if x.Tptr == nil {
+								r.EncodeNil()
+							} else {
+								yy220 := *x.Tptr
+								if false {
+								} else if !z.EncBasicHandle().TimeNotBuiltin {
+									r.EncodeTime(yy220)
+								} else if yyxt221 := z.Extension(z.I2Rtid(yy220)); yyxt221 != nil {
+									z.EncExtension(yy220, yyxt221)
+								} else if z.EncBinary() {
+									z.EncBinaryMarshal(yy220)
+								} else if !z.EncBinary() && z.IsJSONHandle() {
+									z.EncJSONMarshal(yy220)
+								} else {
+									z.EncFallback(yy220)
+								}
+							}
 						} else {
-							h.encMapstringPtrtoTestStrucFlex((map[string]*TestStrucFlex)(x.Mtsptr), e)
+							r.EncodeNil()
 						}
 					}
 				} else {
-					r.EncodeNil()
-				}
-			} else {
-				if yyq2[69] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Mtsptr\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Mtsptr`)
-					}
-					r.WriteMapElemValue()
-					if x.Mtsptr == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+					if yyq2[68] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Tptr\"")
 						} else {
-							h.encMapstringPtrtoTestStrucFlex((map[string]*TestStrucFlex)(x.Mtsptr), e)
+							r.EncodeSymbol(`Tptr`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[70] {
-					if x.Mts == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if yyn219 {
+							r.EncodeNil()
 						} else {
-							h.encMapstringTestStrucFlex((map[string]TestStrucFlex)(x.Mts), e)
+							if x.Tptr == nil {
+								r.EncodeNil()
+							} else {
+								yy222 := *x.Tptr
+								if false {
+								} else if !z.EncBasicHandle().TimeNotBuiltin {
+									r.EncodeTime(yy222)
+								} else if yyxt223 := z.Extension(z.I2Rtid(yy222)); yyxt223 != nil {
+									z.EncExtension(yy222, yyxt223)
+								} else if z.EncBinary() {
+									z.EncBinaryMarshal(yy222)
+								} else if !z.EncBinary() && z.IsJSONHandle() {
+									z.EncJSONMarshal(yy222)
+								} else {
+									z.EncFallback(yy222)
+								}
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[70] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Mts\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[69] {
+						if x.Mtsptr == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringPtrtoTestStrucFlex((map[string]*TestStrucFlex)(x.Mtsptr), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Mts`)
-					}
-					r.WriteMapElemValue()
-					if x.Mts == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[69] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Mtsptr\"")
 						} else {
-							h.encMapstringTestStrucFlex((map[string]TestStrucFlex)(x.Mts), e)
+							r.EncodeSymbol(`Mtsptr`)
 						}
-					}
-				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayElem()
-				if yyq2[71] {
-					if x.Its == nil {
-						r.EncodeNil()
-					} else {
-						if false {
+						r.WriteMapElemValue()
+						if x.Mtsptr == nil {
+							r.EncodeNil()
 						} else {
-							h.encSlicePtrtoTestStrucFlex(([]*TestStrucFlex)(x.Its), e)
+							if false {
+							} else {
+								h.encMapstringPtrtoTestStrucFlex((map[string]*TestStrucFlex)(x.Mtsptr), e)
+							}
 						}
 					}
-				} else {
-					r.EncodeNil()
 				}
-			} else {
-				if yyq2[71] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Its\"")
+				if yyr2 || yy2arr2 {
+					r.WriteArrayElem()
+					if yyq2[70] {
+						if x.Mts == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringTestStrucFlex((map[string]TestStrucFlex)(x.Mts), e)
+							}
+						}
 					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Its`)
-					}
-					r.WriteMapElemValue()
-					if x.Its == nil {
 						r.EncodeNil()
-					} else {
-						if false {
+					}
+				} else {
+					if yyq2[70] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Mts\"")
 						} else {
-							h.encSlicePtrtoTestStrucFlex(([]*TestStrucFlex)(x.Its), e)
+							r.EncodeSymbol(`Mts`)
+						}
+						r.WriteMapElemValue()
+						if x.Mts == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encMapstringTestStrucFlex((map[string]TestStrucFlex)(x.Mts), e)
+							}
 						}
 					}
 				}
-			}
-			var yyn233 bool
-			if x.Nteststruc == nil {
-				yyn233 = true
-				goto LABEL233
-			}
-		LABEL233:
-			if yyr2 || yy2arr2 {
-				if yyn233 {
+				if yyr2 || yy2arr2 {
 					r.WriteArrayElem()
-					r.EncodeNil()
-				} else {
-					r.WriteArrayElem()
-					if yyq2[72] {
-						if x.Nteststruc == nil {
+					if yyq2[71] {
+						if x.Its == nil {
 							r.EncodeNil()
 						} else {
-							x.Nteststruc.CodecEncodeSelf(e)
+							if false {
+							} else {
+								h.encSlicePtrtoTestStrucFlex(([]*TestStrucFlex)(x.Its), e)
+							}
 						}
 					} else {
 						r.EncodeNil()
 					}
-				}
-			} else {
-				if yyq2[72] {
-					r.WriteMapElemKey()
-					if z.IsJSONHandle() {
-						z.WriteStr("\"Nteststruc\"")
-					} else {
-						r.EncodeStringEnc(codecSelferCcUTF819780, `Nteststruc`)
+				} else {
+					if yyq2[71] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Its\"")
+						} else {
+							r.EncodeSymbol(`Its`)
+						}
+						r.WriteMapElemValue()
+						if x.Its == nil {
+							r.EncodeNil()
+						} else {
+							if false {
+							} else {
+								h.encSlicePtrtoTestStrucFlex(([]*TestStrucFlex)(x.Its), e)
+							}
+						}
 					}
-					r.WriteMapElemValue()
+				}
+				var yyn233 bool
+				if x.Nteststruc == nil {
+					yyn233 = true
+					goto LABEL233
+				}
+			LABEL233:
+				if yyr2 || yy2arr2 {
 					if yyn233 {
+						r.WriteArrayElem()
 						r.EncodeNil()
 					} else {
-						if x.Nteststruc == nil {
+						r.WriteArrayElem()
+						if yyq2[72] {
+							if x.Nteststruc == nil {
+								r.EncodeNil()
+							} else {
+								x.Nteststruc.CodecEncodeSelf(e)
+							}
+						} else {
 							r.EncodeNil()
+						}
+					}
+				} else {
+					if yyq2[72] {
+						r.WriteMapElemKey()
+						if z.IsJSONHandle() {
+							z.WriteStr("\"Nteststruc\"")
 						} else {
-							x.Nteststruc.CodecEncodeSelf(e)
+							r.EncodeSymbol(`Nteststruc`)
+						}
+						r.WriteMapElemValue()
+						if yyn233 {
+							r.EncodeNil()
+						} else {
+							if x.Nteststruc == nil {
+								r.EncodeNil()
+							} else {
+								x.Nteststruc.CodecEncodeSelf(e)
+							}
 						}
 					}
 				}
-			}
-			if yyr2 || yy2arr2 {
-				r.WriteArrayEnd()
-			} else {
-				r.WriteMapEnd()
-			}
+				if yyr2 || yy2arr2 {
+					r.WriteArrayEnd()
+				} else {
+					r.WriteMapEnd()
+				}
+			} // end if z.EncStructReflect()
 		}
 	}
 }
@@ -12532,7 +16573,7 @@ func (x *TestStrucFlex) codecDecodeSelfFromMap(l int, d *Decoder) {
 			} else {
 				if false {
 				} else {
-					h.decMapboolc3RydWN0IHt9((*map[bool]struct{})(&x.Mbu64), d)
+					h.decMapboolONaHEdLDOQQHWfI((*map[bool]struct{})(&x.Mbu64), d)
 				}
 			}
 		case "Miwu64s":
@@ -13491,7 +17532,162 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	} else {
 		if false {
 		} else {
-			h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
+			h.decArray0int64((*[0]int64)(&x.AI64arr0), d)
+		}
+	}
+	yyj123++
+	if yyhl123 {
+		yyb123 = yyj123 > l
+	} else {
+		yyb123 = r.CheckBreak()
+	}
+	if yyb123 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.AnonInTestStruc.A164slice0 = nil
+	} else {
+		if false {
+		} else {
+			h.decSliceint64((*[]int64)(&x.A164slice0), d)
+		}
+	}
+	yyj123++
+	if yyhl123 {
+		yyb123 = yyj123 > l
+	} else {
+		yyb123 = r.CheckBreak()
+	}
+	if yyb123 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.AnonInTestStruc.AUi64sliceN = nil
+	} else {
+		if false {
+		} else {
+			h.decSliceuint64((*[]uint64)(&x.AUi64sliceN), d)
+		}
+	}
+	yyj123++
+	if yyhl123 {
+		yyb123 = yyj123 > l
+	} else {
+		yyb123 = r.CheckBreak()
+	}
+	if yyb123 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.AnonInTestStruc.AMSU16N = nil
+	} else {
+		if false {
+		} else {
+			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
+		}
+	}
+	yyj123++
+	if yyhl123 {
+		yyb123 = yyj123 > l
+	} else {
+		yyb123 = r.CheckBreak()
+	}
+	if yyb123 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.AnonInTestStruc.AMSU16E = nil
+	} else {
+		if false {
+		} else {
+			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
+		}
+	}
+	yyj123++
+	if yyhl123 {
+		yyb123 = yyj123 > l
+	} else {
+		yyb123 = r.CheckBreak()
+	}
+	if yyb123 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.NotAnon = AnonInTestStruc{}
+	} else {
+		x.NotAnon.CodecDecodeSelf(d)
+	}
+	yyj123++
+	if yyhl123 {
+		yyb123 = yyj123 > l
+	} else {
+		yyb123 = r.CheckBreak()
+	}
+	if yyb123 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.Nmap = nil
+	} else {
+		if false {
+		} else {
+			h.decMapstringbool((*map[string]bool)(&x.Nmap), d)
+		}
+	}
+	yyj123++
+	if yyhl123 {
+		yyb123 = yyj123 > l
+	} else {
+		yyb123 = r.CheckBreak()
+	}
+	if yyb123 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		x.TestStrucCommon.Nslice = nil
+	} else {
+		if false {
+		} else {
+			x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
+		}
+	}
+	yyj123++
+	if yyhl123 {
+		yyb123 = yyj123 > l
+	} else {
+		yyb123 = r.CheckBreak()
+	}
+	if yyb123 {
+		r.ReadArrayEnd()
+		return
+	}
+	r.ReadArrayElem()
+	if r.TryDecodeAsNil() {
+		if true && x.TestStrucCommon.Nint64 != nil {
+			x.TestStrucCommon.Nint64 = nil
+		}
+	} else {
+		if x.TestStrucCommon.Nint64 == nil {
+			x.TestStrucCommon.Nint64 = new(int64)
+		}
+
+		if false {
+		} else {
+			*x.Nint64 = (int64)(r.DecodeInt64())
 		}
 	}
 	yyj123++
@@ -13506,11 +17702,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.A164slice0 = nil
+		x.Chstr = nil
 	} else {
 		if false {
 		} else {
-			h.decSliceint64((*[]int64)(&x.A164slice0), d)
+			h.decChanstring((*chan string)(&x.Chstr), d)
 		}
 	}
 	yyj123++
@@ -13525,11 +17721,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AUi64sliceN = nil
+		x.Mis = nil
 	} else {
 		if false {
 		} else {
-			h.decSliceuint64((*[]uint64)(&x.AUi64sliceN), d)
+			h.decMapintstring((*map[int]string)(&x.Mis), d)
 		}
 	}
 	yyj123++
@@ -13544,11 +17740,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AMSU16N = nil
+		x.Mbu64 = nil
 	} else {
 		if false {
 		} else {
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16N), d)
+			h.decMapboolONaHEdLDOQQHWfI((*map[bool]struct{})(&x.Mbu64), d)
 		}
 	}
 	yyj123++
@@ -13563,11 +17759,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.AnonInTestStruc.AMSU16E = nil
+		x.Miwu64s = nil
 	} else {
 		if false {
 		} else {
-			h.decMapstringuint16((*map[string]uint16)(&x.AMSU16E), d)
+			h.decMapintwrapUint64Slice((*map[int]wrapUint64Slice)(&x.Miwu64s), d)
 		}
 	}
 	yyj123++
@@ -13582,9 +17778,12 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.NotAnon = AnonInTestStruc{}
+		x.Mfwss = nil
 	} else {
-		x.NotAnon.CodecDecodeSelf(d)
+		if false {
+		} else {
+			h.decMapfloat64wrapStringSlice((*map[float64]wrapStringSlice)(&x.Mfwss), d)
+		}
 	}
 	yyj123++
 	if yyhl123 {
@@ -13598,11 +17797,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Nmap = nil
+		x.Mf32wss = nil
 	} else {
 		if false {
 		} else {
-			h.decMapstringbool((*map[string]bool)(&x.Nmap), d)
+			h.decMapfloat32wrapStringSlice((*map[float32]wrapStringSlice)(&x.Mf32wss), d)
 		}
 	}
 	yyj123++
@@ -13617,11 +17816,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.TestStrucCommon.Nslice = nil
+		x.Mui2wss = nil
 	} else {
 		if false {
 		} else {
-			x.Nslice = r.DecodeBytes(([]byte)(x.Nslice), false)
+			h.decMapuint64wrapStringSlice((*map[uint64]wrapStringSlice)(&x.Mui2wss), d)
 		}
 	}
 	yyj123++
@@ -13636,17 +17835,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		if true && x.TestStrucCommon.Nint64 != nil {
-			x.TestStrucCommon.Nint64 = nil
-		}
+		x.Msu2wss = nil
 	} else {
-		if x.TestStrucCommon.Nint64 == nil {
-			x.TestStrucCommon.Nint64 = new(int64)
-		}
-
 		if false {
 		} else {
-			*x.Nint64 = (int64)(r.DecodeInt64())
+			h.decMapstringUint64TwrapStringSlice((*map[stringUint64T]wrapStringSlice)(&x.Msu2wss), d)
 		}
 	}
 	yyj123++
@@ -13661,12 +17854,9 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Chstr = nil
+		x.Ci64 = 0
 	} else {
-		if false {
-		} else {
-			h.decChanstring((*chan string)(&x.Chstr), d)
-		}
+		x.Ci64.CodecDecodeSelf(d)
 	}
 	yyj123++
 	if yyhl123 {
@@ -13680,11 +17870,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Mis = nil
+		x.Swrapbytes = nil
 	} else {
 		if false {
 		} else {
-			h.decMapintstring((*map[int]string)(&x.Mis), d)
+			h.decSlicewrapBytes((*[]wrapBytes)(&x.Swrapbytes), d)
 		}
 	}
 	yyj123++
@@ -13699,11 +17889,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Mbu64 = nil
+		x.Swrapuint8 = nil
 	} else {
 		if false {
 		} else {
-			h.decMapboolc3RydWN0IHt9((*map[bool]struct{})(&x.Mbu64), d)
+			h.decSlicewrapUint8((*[]wrapUint8)(&x.Swrapuint8), d)
 		}
 	}
 	yyj123++
@@ -13718,11 +17908,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Miwu64s = nil
+		x.ArrStrUi64T = [4]stringUint64T{}
 	} else {
 		if false {
 		} else {
-			h.decMapintwrapUint64Slice((*map[int]wrapUint64Slice)(&x.Miwu64s), d)
+			h.decArray4stringUint64T((*[4]stringUint64T)(&x.ArrStrUi64T), d)
 		}
 	}
 	yyj123++
@@ -13737,11 +17927,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Mfwss = nil
+		x.Ui64array = [4]uint64{}
 	} else {
 		if false {
 		} else {
-			h.decMapfloat64wrapStringSlice((*map[float64]wrapStringSlice)(&x.Mfwss), d)
+			h.decArray4uint64((*[4]uint64)(&x.Ui64array), d)
 		}
 	}
 	yyj123++
@@ -13756,11 +17946,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Mf32wss = nil
+		x.Ui64slicearray = nil
 	} else {
 		if false {
 		} else {
-			h.decMapfloat32wrapStringSlice((*map[float32]wrapStringSlice)(&x.Mf32wss), d)
+			h.decSlicePtrtoArray4uint64((*[]*[4]uint64)(&x.Ui64slicearray), d)
 		}
 	}
 	yyj123++
@@ -13775,11 +17965,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Mui2wss = nil
+		x.SintfAarray = nil
 	} else {
 		if false {
 		} else {
-			h.decMapuint64wrapStringSlice((*map[uint64]wrapStringSlice)(&x.Mui2wss), d)
+			h.decSliceInterface((*[]interface{})(&x.SintfAarray), d)
 		}
 	}
 	yyj123++
@@ -13794,11 +17984,17 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Msu2wss = nil
+		if true && x.AnonInTestStrucIntf != nil {
+			x.AnonInTestStrucIntf.Islice = nil
+		}
 	} else {
+		if x.AnonInTestStrucIntf == nil {
+			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
+		}
+
 		if false {
 		} else {
-			h.decMapstringUint64TwrapStringSlice((*map[stringUint64T]wrapStringSlice)(&x.Msu2wss), d)
+			h.decSliceInterface((*[]interface{})(&x.Islice), d)
 		}
 	}
 	yyj123++
@@ -13813,9 +18009,18 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ci64 = 0
+		if true && x.AnonInTestStrucIntf != nil {
+			x.AnonInTestStrucIntf.Ms = nil
+		}
 	} else {
-		x.Ci64.CodecDecodeSelf(d)
+		if x.AnonInTestStrucIntf == nil {
+			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
+		}
+
+		if false {
+		} else {
+			h.decMapstringInterface((*map[string]interface{})(&x.Ms), d)
+		}
 	}
 	yyj123++
 	if yyhl123 {
@@ -13829,11 +18034,17 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Swrapbytes = nil
+		if true && x.AnonInTestStrucIntf != nil {
+			x.AnonInTestStrucIntf.Nintf = nil
+		}
 	} else {
+		if x.AnonInTestStrucIntf == nil {
+			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
+		}
+
 		if false {
 		} else {
-			h.decSlicewrapBytes((*[]wrapBytes)(&x.Swrapbytes), d)
+			z.DecFallback(&x.Nintf, true)
 		}
 	}
 	yyj123++
@@ -13848,11 +18059,25 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Swrapuint8 = nil
+		if true && x.AnonInTestStrucIntf != nil {
+			x.AnonInTestStrucIntf.T = time.Time{}
+		}
 	} else {
+		if x.AnonInTestStrucIntf == nil {
+			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
+		}
+
 		if false {
+		} else if !z.DecBasicHandle().TimeNotBuiltin {
+			x.T = r.DecodeTime()
+		} else if yyxt233 := z.Extension(z.I2Rtid(x.T)); yyxt233 != nil {
+			z.DecExtension(x.T, yyxt233)
+		} else if z.DecBinary() {
+			z.DecBinaryUnmarshal(&x.T)
+		} else if !z.DecBinary() && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(&x.T)
 		} else {
-			h.decSlicewrapUint8((*[]wrapUint8)(&x.Swrapuint8), d)
+			z.DecFallback(&x.T, false)
 		}
 	}
 	yyj123++
@@ -13867,11 +18092,28 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.ArrStrUi64T = [4]stringUint64T{}
+		if true && x.AnonInTestStrucIntf != nil && x.AnonInTestStrucIntf.Tptr != nil {
+			x.AnonInTestStrucIntf.Tptr = nil
+		}
 	} else {
+		if x.AnonInTestStrucIntf == nil {
+			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
+		}
+		if x.AnonInTestStrucIntf.Tptr == nil {
+			x.AnonInTestStrucIntf.Tptr = new(time.Time)
+		}
+
 		if false {
+		} else if !z.DecBasicHandle().TimeNotBuiltin {
+			*x.Tptr = r.DecodeTime()
+		} else if yyxt235 := z.Extension(z.I2Rtid(x.Tptr)); yyxt235 != nil {
+			z.DecExtension(x.Tptr, yyxt235)
+		} else if z.DecBinary() {
+			z.DecBinaryUnmarshal(x.Tptr)
+		} else if !z.DecBinary() && z.IsJSONHandle() {
+			z.DecJSONUnmarshal(x.Tptr)
 		} else {
-			h.decArray4stringUint64T((*[4]stringUint64T)(&x.ArrStrUi64T), d)
+			z.DecFallback(x.Tptr, false)
 		}
 	}
 	yyj123++
@@ -13886,11 +18128,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ui64array = [4]uint64{}
+		x.Mtsptr = nil
 	} else {
 		if false {
 		} else {
-			h.decArray4uint64((*[4]uint64)(&x.Ui64array), d)
+			h.decMapstringPtrtoTestStrucFlex((*map[string]*TestStrucFlex)(&x.Mtsptr), d)
 		}
 	}
 	yyj123++
@@ -13905,11 +18147,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.Ui64slicearray = nil
+		x.Mts = nil
 	} else {
 		if false {
 		} else {
-			h.decSlicePtrtoArray4uint64((*[]*[4]uint64)(&x.Ui64slicearray), d)
+			h.decMapstringTestStrucFlex((*map[string]TestStrucFlex)(&x.Mts), d)
 		}
 	}
 	yyj123++
@@ -13924,11 +18166,11 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		x.SintfAarray = nil
+		x.Its = nil
 	} else {
 		if false {
 		} else {
-			h.decSliceInterface((*[]interface{})(&x.SintfAarray), d)
+			h.decSlicePtrtoTestStrucFlex((*[]*TestStrucFlex)(&x.Its), d)
 		}
 	}
 	yyj123++
@@ -13943,234 +18185,515 @@ func (x *TestStrucFlex) codecDecodeSelfFromArray(l int, d *Decoder) {
 	}
 	r.ReadArrayElem()
 	if r.TryDecodeAsNil() {
-		if true && x.AnonInTestStrucIntf != nil {
-			x.AnonInTestStrucIntf.Islice = nil
+		if true && x.Nteststruc != nil {
+			x.Nteststruc = nil
 		}
 	} else {
-		if x.AnonInTestStrucIntf == nil {
-			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
+		if x.Nteststruc == nil {
+			x.Nteststruc = new(TestStrucFlex)
+		}
+
+		x.Nteststruc.CodecDecodeSelf(d)
+	}
+	for {
+		yyj123++
+		if yyhl123 {
+			yyb123 = yyj123 > l
+		} else {
+			yyb123 = r.CheckBreak()
+		}
+		if yyb123 {
+			break
+		}
+		r.ReadArrayElem()
+		z.DecStructFieldNotFound(yyj123-1, "")
+	}
+	r.ReadArrayEnd()
+}
+
+func (x codecSelfer19780) encwrapSliceUint64(v wrapSliceUint64, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.WriteArrayStart(len(v))
+	for _, yyv1 := range v {
+		r.WriteArrayElem()
+		if false {
+		} else {
+			r.EncodeUint(uint64(yyv1))
+		}
+	}
+	r.WriteArrayEnd()
+}
+
+func (x codecSelfer19780) decwrapSliceUint64(v *wrapSliceUint64, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []uint64{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]uint64, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
 		}
+		var yyj1 int
+		// var yydn1 bool
+		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]uint64, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, 0)
+				yyc1 = true
+
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if r.TryDecodeAsNil() {
+					yyv1[yyj1] = 0
+				} else {
+					yyv1[yyj1] = (uint64)(r.DecodeUint64())
+				}
+
+			}
 
-		if false {
-		} else {
-			h.decSliceInterface((*[]interface{})(&x.Islice), d)
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = make([]uint64, 0)
+			yyc1 = true
 		}
 	}
-	yyj123++
-	if yyhl123 {
-		yyb123 = yyj123 > l
-	} else {
-		yyb123 = r.CheckBreak()
-	}
-	if yyb123 {
-		r.ReadArrayEnd()
-		return
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		if true && x.AnonInTestStrucIntf != nil {
-			x.AnonInTestStrucIntf.Ms = nil
-		}
-	} else {
-		if x.AnonInTestStrucIntf == nil {
-			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
-		}
+}
 
+func (x codecSelfer19780) encwrapSliceString(v wrapSliceString, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.WriteArrayStart(len(v))
+	for _, yyv1 := range v {
+		r.WriteArrayElem()
 		if false {
 		} else {
-			h.decMapstringInterface((*map[string]interface{})(&x.Ms), d)
+			if z.EncBasicHandle().StringToRaw {
+				r.EncodeStringBytesRaw(z.BytesView(string(yyv1)))
+			} else {
+				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyv1))
+			}
 		}
 	}
-	yyj123++
-	if yyhl123 {
-		yyb123 = yyj123 > l
-	} else {
-		yyb123 = r.CheckBreak()
-	}
-	if yyb123 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		if true && x.AnonInTestStrucIntf != nil {
-			x.AnonInTestStrucIntf.Nintf = nil
+	r.WriteArrayEnd()
+}
+
+func (x codecSelfer19780) decwrapSliceString(v *wrapSliceString, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []string{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
 		}
 	} else {
-		if x.AnonInTestStrucIntf == nil {
-			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]string, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
 		}
+		var yyj1 int
+		// var yydn1 bool
+		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]string, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
 
-		if false {
-		} else {
-			z.DecFallback(&x.Nintf, true)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, "")
+				yyc1 = true
+
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if r.TryDecodeAsNil() {
+					yyv1[yyj1] = ""
+				} else {
+					yyv1[yyj1] = (string)(r.DecodeString())
+				}
+
+			}
+
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = make([]string, 0)
+			yyc1 = true
 		}
 	}
-	yyj123++
-	if yyhl123 {
-		yyb123 = yyj123 > l
-	} else {
-		yyb123 = r.CheckBreak()
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
 	}
-	if yyb123 {
-		r.ReadArrayEnd()
-		return
+}
+
+func (x codecSelfer19780) encwrapUint64Slice(v wrapUint64Slice, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.WriteArrayStart(len(v))
+	for _, yyv1 := range v {
+		r.WriteArrayElem()
+		yyv1.CodecEncodeSelf(e)
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		if true && x.AnonInTestStrucIntf != nil {
-			x.AnonInTestStrucIntf.T = time.Time{}
+	r.WriteArrayEnd()
+}
+
+func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []wrapUint64{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
 		}
 	} else {
-		if x.AnonInTestStrucIntf == nil {
-			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]wrapUint64, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
 		}
+		var yyj1 int
+		// var yydn1 bool
+		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]wrapUint64, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
 
-		if false {
-		} else if !z.DecBasicHandle().TimeNotBuiltin {
-			x.T = r.DecodeTime()
-		} else if yyxt233 := z.Extension(z.I2Rtid(x.T)); yyxt233 != nil {
-			z.DecExtension(x.T, yyxt233)
-		} else if z.DecBinary() {
-			z.DecBinaryUnmarshal(&x.T)
-		} else if !z.DecBinary() && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(&x.T)
-		} else {
-			z.DecFallback(&x.T, false)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, 0)
+				yyc1 = true
+
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if r.TryDecodeAsNil() {
+					yyv1[yyj1] = 0
+				} else {
+					yyv1[yyj1].CodecDecodeSelf(d)
+				}
+
+			}
+
+		}
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = make([]wrapUint64, 0)
+			yyc1 = true
 		}
 	}
-	yyj123++
-	if yyhl123 {
-		yyb123 = yyj123 > l
-	} else {
-		yyb123 = r.CheckBreak()
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
 	}
-	if yyb123 {
-		r.ReadArrayEnd()
-		return
+}
+
+func (x codecSelfer19780) encwrapStringSlice(v wrapStringSlice, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.WriteArrayStart(len(v))
+	for _, yyv1 := range v {
+		r.WriteArrayElem()
+		yyv1.CodecEncodeSelf(e)
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		if true && x.AnonInTestStrucIntf != nil && x.AnonInTestStrucIntf.Tptr != nil {
-			x.AnonInTestStrucIntf.Tptr = nil
+	r.WriteArrayEnd()
+}
+
+func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []wrapString{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
 		}
 	} else {
-		if x.AnonInTestStrucIntf == nil {
-			x.AnonInTestStrucIntf = new(AnonInTestStrucIntf)
-		}
-		if x.AnonInTestStrucIntf.Tptr == nil {
-			x.AnonInTestStrucIntf.Tptr = new(time.Time)
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]wrapString, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
 		}
+		var yyj1 int
+		// var yydn1 bool
+		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]wrapString, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, "")
+				yyc1 = true
+
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if r.TryDecodeAsNil() {
+					yyv1[yyj1] = ""
+				} else {
+					yyv1[yyj1].CodecDecodeSelf(d)
+				}
+
+			}
 
-		if false {
-		} else if !z.DecBasicHandle().TimeNotBuiltin {
-			*x.Tptr = r.DecodeTime()
-		} else if yyxt235 := z.Extension(z.I2Rtid(x.Tptr)); yyxt235 != nil {
-			z.DecExtension(x.Tptr, yyxt235)
-		} else if z.DecBinary() {
-			z.DecBinaryUnmarshal(x.Tptr)
-		} else if !z.DecBinary() && z.IsJSONHandle() {
-			z.DecJSONUnmarshal(x.Tptr)
-		} else {
-			z.DecFallback(x.Tptr, false)
-		}
-	}
-	yyj123++
-	if yyhl123 {
-		yyb123 = yyj123 > l
-	} else {
-		yyb123 = r.CheckBreak()
-	}
-	if yyb123 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.Mtsptr = nil
-	} else {
-		if false {
-		} else {
-			h.decMapstringPtrtoTestStrucFlex((*map[string]*TestStrucFlex)(&x.Mtsptr), d)
 		}
-	}
-	yyj123++
-	if yyhl123 {
-		yyb123 = yyj123 > l
-	} else {
-		yyb123 = r.CheckBreak()
-	}
-	if yyb123 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.Mts = nil
-	} else {
-		if false {
-		} else {
-			h.decMapstringTestStrucFlex((*map[string]TestStrucFlex)(&x.Mts), d)
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = make([]wrapString, 0)
+			yyc1 = true
 		}
 	}
-	yyj123++
-	if yyhl123 {
-		yyb123 = yyj123 > l
-	} else {
-		yyb123 = r.CheckBreak()
-	}
-	if yyb123 {
-		r.ReadArrayEnd()
-		return
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
 	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		x.Its = nil
-	} else {
+}
+
+func (x codecSelfer19780) encSlicestring(v []string, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.WriteArrayStart(len(v))
+	for _, yyv1 := range v {
+		r.WriteArrayElem()
 		if false {
 		} else {
-			h.decSlicePtrtoTestStrucFlex((*[]*TestStrucFlex)(&x.Its), d)
+			if z.EncBasicHandle().StringToRaw {
+				r.EncodeStringBytesRaw(z.BytesView(string(yyv1)))
+			} else {
+				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyv1))
+			}
 		}
 	}
-	yyj123++
-	if yyhl123 {
-		yyb123 = yyj123 > l
-	} else {
-		yyb123 = r.CheckBreak()
-	}
-	if yyb123 {
-		r.ReadArrayEnd()
-		return
-	}
-	r.ReadArrayElem()
-	if r.TryDecodeAsNil() {
-		if true && x.Nteststruc != nil {
-			x.Nteststruc = nil
+	r.WriteArrayEnd()
+}
+
+func (x codecSelfer19780) decSlicestring(v *[]string, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []string{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
 		}
 	} else {
-		if x.Nteststruc == nil {
-			x.Nteststruc = new(TestStrucFlex)
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]string, yyrl1)
+				}
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
+			}
 		}
+		var yyj1 int
+		// var yydn1 bool
+		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				} else {
+					yyrl1 = 8
+				}
+				yyv1 = make([]string, yyrl1)
+				yyc1 = true
+			}
+			yyh1.ElemContainerState(yyj1)
+
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, "")
+				yyc1 = true
+
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if r.TryDecodeAsNil() {
+					yyv1[yyj1] = ""
+				} else {
+					yyv1[yyj1] = (string)(r.DecodeString())
+				}
+
+			}
 
-		x.Nteststruc.CodecDecodeSelf(d)
-	}
-	for {
-		yyj123++
-		if yyhl123 {
-			yyb123 = yyj123 > l
-		} else {
-			yyb123 = r.CheckBreak()
 		}
-		if yyb123 {
-			break
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = make([]string, 0)
+			yyc1 = true
 		}
-		r.ReadArrayElem()
-		z.DecStructFieldNotFound(yyj123-1, "")
 	}
-	r.ReadArrayEnd()
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
 }
 
-func (x codecSelfer19780) encwrapSliceUint64(v wrapSliceUint64, e *Encoder) {
+func (x codecSelfer19780) encSliceint64(v []int64, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -14179,13 +18702,13 @@ func (x codecSelfer19780) encwrapSliceUint64(v wrapSliceUint64, e *Encoder) {
 		r.WriteArrayElem()
 		if false {
 		} else {
-			r.EncodeUint(uint64(yyv1))
+			r.EncodeInt(int64(yyv1))
 		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decwrapSliceUint64(v *wrapSliceUint64, d *Decoder) {
+func (x codecSelfer19780) decSliceint64(v *[]int64, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -14196,7 +18719,7 @@ func (x codecSelfer19780) decwrapSliceUint64(v *wrapSliceUint64, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []uint64{}
+			yyv1 = []int64{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -14212,7 +18735,7 @@ func (x codecSelfer19780) decwrapSliceUint64(v *wrapSliceUint64, d *Decoder) {
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]uint64, yyrl1)
+					yyv1 = make([]int64, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -14229,7 +18752,7 @@ func (x codecSelfer19780) decwrapSliceUint64(v *wrapSliceUint64, d *Decoder) {
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]uint64, yyrl1)
+				yyv1 = make([]int64, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
@@ -14246,7 +18769,7 @@ func (x codecSelfer19780) decwrapSliceUint64(v *wrapSliceUint64, d *Decoder) {
 				if r.TryDecodeAsNil() {
 					yyv1[yyj1] = 0
 				} else {
-					yyv1[yyj1] = (uint64)(r.DecodeUint64())
+					yyv1[yyj1] = (int64)(r.DecodeInt64())
 				}
 
 			}
@@ -14256,7 +18779,7 @@ func (x codecSelfer19780) decwrapSliceUint64(v *wrapSliceUint64, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]uint64, 0)
+			yyv1 = make([]int64, 0)
 			yyc1 = true
 		}
 	}
@@ -14266,7 +18789,7 @@ func (x codecSelfer19780) decwrapSliceUint64(v *wrapSliceUint64, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encwrapSliceString(v wrapSliceString, e *Encoder) {
+func (x codecSelfer19780) encSliceuint64(v []uint64, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -14275,17 +18798,13 @@ func (x codecSelfer19780) encwrapSliceString(v wrapSliceString, e *Encoder) {
 		r.WriteArrayElem()
 		if false {
 		} else {
-			if z.EncBasicHandle().StringToRaw {
-				r.EncodeStringBytesRaw(z.BytesView(string(yyv1)))
-			} else {
-				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyv1))
-			}
+			r.EncodeUint(uint64(yyv1))
 		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decwrapSliceString(v *wrapSliceString, d *Decoder) {
+func (x codecSelfer19780) decSliceuint64(v *[]uint64, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -14296,7 +18815,7 @@ func (x codecSelfer19780) decwrapSliceString(v *wrapSliceString, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []string{}
+			yyv1 = []uint64{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -14308,11 +18827,11 @@ func (x codecSelfer19780) decwrapSliceString(v *wrapSliceString, d *Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]string, yyrl1)
+					yyv1 = make([]uint64, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -14325,18 +18844,18 @@ func (x codecSelfer19780) decwrapSliceString(v *wrapSliceString, d *Decoder) {
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]string, yyrl1)
+				yyv1 = make([]uint64, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, "")
+				yyv1 = append(yyv1, 0)
 				yyc1 = true
 
 			}
@@ -14344,9 +18863,9 @@ func (x codecSelfer19780) decwrapSliceString(v *wrapSliceString, d *Decoder) {
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = ""
+					yyv1[yyj1] = 0
 				} else {
-					yyv1[yyj1] = (string)(r.DecodeString())
+					yyv1[yyj1] = (uint64)(r.DecodeUint64())
 				}
 
 			}
@@ -14356,7 +18875,7 @@ func (x codecSelfer19780) decwrapSliceString(v *wrapSliceString, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]string, 0)
+			yyv1 = make([]uint64, 0)
 			yyc1 = true
 		}
 	}
@@ -14366,19 +18885,22 @@ func (x codecSelfer19780) decwrapSliceString(v *wrapSliceString, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encwrapUint64Slice(v wrapUint64Slice, e *Encoder) {
+func (x codecSelfer19780) encSlicefloat64(v []float64, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.WriteArrayStart(len(v))
 	for _, yyv1 := range v {
 		r.WriteArrayElem()
-		yyv1.CodecEncodeSelf(e)
+		if false {
+		} else {
+			r.EncodeFloat64(float64(yyv1))
+		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
+func (x codecSelfer19780) decSlicefloat64(v *[]float64, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -14389,7 +18911,7 @@ func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []wrapUint64{}
+			yyv1 = []float64{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -14405,7 +18927,7 @@ func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]wrapUint64, yyrl1)
+					yyv1 = make([]float64, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -14422,7 +18944,7 @@ func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]wrapUint64, yyrl1)
+				yyv1 = make([]float64, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
@@ -14439,7 +18961,7 @@ func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
 				if r.TryDecodeAsNil() {
 					yyv1[yyj1] = 0
 				} else {
-					yyv1[yyj1].CodecDecodeSelf(d)
+					yyv1[yyj1] = (float64)(r.DecodeFloat64())
 				}
 
 			}
@@ -14449,7 +18971,7 @@ func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]wrapUint64, 0)
+			yyv1 = make([]float64, 0)
 			yyc1 = true
 		}
 	}
@@ -14459,19 +18981,22 @@ func (x codecSelfer19780) decwrapUint64Slice(v *wrapUint64Slice, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encwrapStringSlice(v wrapStringSlice, e *Encoder) {
+func (x codecSelfer19780) encSlicefloat32(v []float32, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.WriteArrayStart(len(v))
 	for _, yyv1 := range v {
 		r.WriteArrayElem()
-		yyv1.CodecEncodeSelf(e)
+		if false {
+		} else {
+			r.EncodeFloat32(float32(yyv1))
+		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
+func (x codecSelfer19780) decSlicefloat32(v *[]float32, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -14482,7 +19007,7 @@ func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []wrapString{}
+			yyv1 = []float32{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -14494,11 +19019,11 @@ func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 4)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]wrapString, yyrl1)
+					yyv1 = make([]float32, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -14511,18 +19036,18 @@ func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 4)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]wrapString, yyrl1)
+				yyv1 = make([]float32, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, "")
+				yyv1 = append(yyv1, 0)
 				yyc1 = true
 
 			}
@@ -14530,9 +19055,9 @@ func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = ""
+					yyv1[yyj1] = 0
 				} else {
-					yyv1[yyj1].CodecDecodeSelf(d)
+					yyv1[yyj1] = (float32)(r.DecodeFloat32As64())
 				}
 
 			}
@@ -14542,7 +19067,7 @@ func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]wrapString, 0)
+			yyv1 = make([]float32, 0)
 			yyc1 = true
 		}
 	}
@@ -14552,7 +19077,143 @@ func (x codecSelfer19780) decwrapStringSlice(v *wrapStringSlice, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encSlicestring(v []string, e *Encoder) {
+func (x codecSelfer19780) encMapstringuint16(v map[string]uint16, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.WriteMapStart(len(v))
+	for yyk1, yyv1 := range v {
+		r.WriteMapElemKey()
+		if false {
+		} else {
+			if z.EncBasicHandle().StringToRaw {
+				r.EncodeStringBytesRaw(z.BytesView(string(yyk1)))
+			} else {
+				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyk1))
+			}
+		}
+		r.WriteMapElemValue()
+		if false {
+		} else {
+			r.EncodeUint(uint64(yyv1))
+		}
+	}
+	r.WriteMapEnd()
+}
+
+func (x codecSelfer19780) decMapstringuint16(v *map[string]uint16, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyl1 := r.ReadMapStart()
+	yybh1 := z.DecBasicHandle()
+	if yyv1 == nil {
+		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 18)
+		yyv1 = make(map[string]uint16, yyrl1)
+		*v = yyv1
+	}
+	var yymk1 string
+	var yymv1 uint16
+	var yymg1, yymdn1 bool
+	if yybh1.MapValueReset {
+	}
+	if yyl1 != 0 {
+		yyhl1 := yyl1 > 0
+		for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ {
+			r.ReadMapElemKey()
+			if r.TryDecodeAsNil() {
+				yymk1 = ""
+			} else {
+				yymk1 = (string)(r.DecodeString())
+			}
+
+			if yymg1 {
+				yymv1 = yyv1[yymk1]
+			}
+			r.ReadMapElemValue()
+			yymdn1 = false
+			if r.TryDecodeAsNil() {
+				yymdn1 = true
+			} else {
+				yymv1 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
+			}
+
+			if yymdn1 {
+				if yybh1.DeleteOnNilMapValue {
+					delete(yyv1, yymk1)
+				} else {
+					yyv1[yymk1] = 0
+				}
+			} else if yyv1 != nil {
+				yyv1[yymk1] = yymv1
+			}
+		}
+	} // else len==0: TODO: Should we clear map entries?
+	r.ReadMapEnd()
+}
+
+func (x codecSelfer19780) encArray0int64(v *[0]int64, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.WriteArrayStart(len(v))
+	for _, yyv1 := range v {
+		r.WriteArrayElem()
+		if false {
+		} else {
+			r.EncodeInt(int64(yyv1))
+		}
+	}
+	r.WriteArrayEnd()
+}
+
+func (x codecSelfer19780) decArray0int64(v *[0]int64, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	if yyl1 == 0 {
+
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+
+		var yyj1 int
+		// var yydn1 bool
+		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
+
+			yyh1.ElemContainerState(yyj1)
+
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				z.DecArrayCannotExpand(len(v), yyj1+1)
+				yydb1 = true
+
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if r.TryDecodeAsNil() {
+					yyv1[yyj1] = 0
+				} else {
+					yyv1[yyj1] = (int64)(r.DecodeInt64())
+				}
+
+			}
+
+		}
+
+	}
+	yyh1.End()
+
+}
+
+func (x codecSelfer19780) encSliceint16(v []int16, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -14561,17 +19222,13 @@ func (x codecSelfer19780) encSlicestring(v []string, e *Encoder) {
 		r.WriteArrayElem()
 		if false {
 		} else {
-			if z.EncBasicHandle().StringToRaw {
-				r.EncodeStringBytesRaw(z.BytesView(string(yyv1)))
-			} else {
-				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyv1))
-			}
+			r.EncodeInt(int64(yyv1))
 		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSlicestring(v *[]string, d *Decoder) {
+func (x codecSelfer19780) decSliceint16(v *[]int16, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -14582,7 +19239,7 @@ func (x codecSelfer19780) decSlicestring(v *[]string, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []string{}
+			yyv1 = []int16{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -14594,11 +19251,11 @@ func (x codecSelfer19780) decSlicestring(v *[]string, d *Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 2)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]string, yyrl1)
+					yyv1 = make([]int16, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -14611,18 +19268,18 @@ func (x codecSelfer19780) decSlicestring(v *[]string, d *Decoder) {
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 2)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]string, yyrl1)
+				yyv1 = make([]int16, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, "")
+				yyv1 = append(yyv1, 0)
 				yyc1 = true
 
 			}
@@ -14630,9 +19287,9 @@ func (x codecSelfer19780) decSlicestring(v *[]string, d *Decoder) {
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = ""
+					yyv1[yyj1] = 0
 				} else {
-					yyv1[yyj1] = (string)(r.DecodeString())
+					yyv1[yyj1] = (int16)(z.C.IntV(r.DecodeInt64(), 16))
 				}
 
 			}
@@ -14642,7 +19299,7 @@ func (x codecSelfer19780) decSlicestring(v *[]string, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]string, 0)
+			yyv1 = make([]int16, 0)
 			yyc1 = true
 		}
 	}
@@ -14652,7 +19309,7 @@ func (x codecSelfer19780) decSlicestring(v *[]string, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encSliceint64(v []int64, e *Encoder) {
+func (x codecSelfer19780) encSlicebool(v []bool, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -14661,13 +19318,13 @@ func (x codecSelfer19780) encSliceint64(v []int64, e *Encoder) {
 		r.WriteArrayElem()
 		if false {
 		} else {
-			r.EncodeInt(int64(yyv1))
+			r.EncodeBool(bool(yyv1))
 		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSliceint64(v *[]int64, d *Decoder) {
+func (x codecSelfer19780) decSlicebool(v *[]bool, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -14678,7 +19335,7 @@ func (x codecSelfer19780) decSliceint64(v *[]int64, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []int64{}
+			yyv1 = []bool{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -14690,11 +19347,11 @@ func (x codecSelfer19780) decSliceint64(v *[]int64, d *Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]int64, yyrl1)
+					yyv1 = make([]bool, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -14707,18 +19364,18 @@ func (x codecSelfer19780) decSliceint64(v *[]int64, d *Decoder) {
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]int64, yyrl1)
+				yyv1 = make([]bool, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, 0)
+				yyv1 = append(yyv1, false)
 				yyc1 = true
 
 			}
@@ -14726,9 +19383,9 @@ func (x codecSelfer19780) decSliceint64(v *[]int64, d *Decoder) {
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = 0
+					yyv1[yyj1] = false
 				} else {
-					yyv1[yyj1] = (int64)(r.DecodeInt64())
+					yyv1[yyj1] = (bool)(r.DecodeBool())
 				}
 
 			}
@@ -14738,7 +19395,7 @@ func (x codecSelfer19780) decSliceint64(v *[]int64, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]int64, 0)
+			yyv1 = make([]bool, 0)
 			yyc1 = true
 		}
 	}
@@ -14748,22 +19405,27 @@ func (x codecSelfer19780) decSliceint64(v *[]int64, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encSliceuint64(v []uint64, e *Encoder) {
+func (x codecSelfer19780) encSlicePtrtoint64(v []*int64, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.WriteArrayStart(len(v))
 	for _, yyv1 := range v {
 		r.WriteArrayElem()
-		if false {
+		if yyv1 == nil {
+			r.EncodeNil()
 		} else {
-			r.EncodeUint(uint64(yyv1))
+			yy2 := *yyv1
+			if false {
+			} else {
+				r.EncodeInt(int64(yy2))
+			}
 		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSliceuint64(v *[]uint64, d *Decoder) {
+func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -14774,7 +19436,7 @@ func (x codecSelfer19780) decSliceuint64(v *[]uint64, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []uint64{}
+			yyv1 = []*int64{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -14790,7 +19452,7 @@ func (x codecSelfer19780) decSliceuint64(v *[]uint64, d *Decoder) {
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]uint64, yyrl1)
+					yyv1 = make([]*int64, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -14807,14 +19469,14 @@ func (x codecSelfer19780) decSliceuint64(v *[]uint64, d *Decoder) {
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]uint64, yyrl1)
+				yyv1 = make([]*int64, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, 0)
+				yyv1 = append(yyv1, nil)
 				yyc1 = true
 
 			}
@@ -14822,9 +19484,15 @@ func (x codecSelfer19780) decSliceuint64(v *[]uint64, d *Decoder) {
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = 0
+					yyv1[yyj1] = nil
 				} else {
-					yyv1[yyj1] = (uint64)(r.DecodeUint64())
+					if yyv1[yyj1] == nil {
+						yyv1[yyj1] = new(int64)
+					}
+					if false {
+					} else {
+						*yyv1[yyj1] = (int64)(r.DecodeInt64())
+					}
 				}
 
 			}
@@ -14834,7 +19502,7 @@ func (x codecSelfer19780) decSliceuint64(v *[]uint64, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]uint64, 0)
+			yyv1 = make([]*int64, 0)
 			yyc1 = true
 		}
 	}
@@ -14844,22 +19512,97 @@ func (x codecSelfer19780) decSliceuint64(v *[]uint64, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encSlicefloat64(v []float64, e *Encoder) {
+func (x codecSelfer19780) encMapstringint64(v map[string]int64, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	r.WriteArrayStart(len(v))
-	for _, yyv1 := range v {
-		r.WriteArrayElem()
+	r.WriteMapStart(len(v))
+	for yyk1, yyv1 := range v {
+		r.WriteMapElemKey()
 		if false {
 		} else {
-			r.EncodeFloat64(float64(yyv1))
+			if z.EncBasicHandle().StringToRaw {
+				r.EncodeStringBytesRaw(z.BytesView(string(yyk1)))
+			} else {
+				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyk1))
+			}
+		}
+		r.WriteMapElemValue()
+		if false {
+		} else {
+			r.EncodeInt(int64(yyv1))
+		}
+	}
+	r.WriteMapEnd()
+}
+
+func (x codecSelfer19780) decMapstringint64(v *map[string]int64, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyl1 := r.ReadMapStart()
+	yybh1 := z.DecBasicHandle()
+	if yyv1 == nil {
+		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 24)
+		yyv1 = make(map[string]int64, yyrl1)
+		*v = yyv1
+	}
+	var yymk1 string
+	var yymv1 int64
+	var yymg1, yymdn1 bool
+	if yybh1.MapValueReset {
+	}
+	if yyl1 != 0 {
+		yyhl1 := yyl1 > 0
+		for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ {
+			r.ReadMapElemKey()
+			if r.TryDecodeAsNil() {
+				yymk1 = ""
+			} else {
+				yymk1 = (string)(r.DecodeString())
+			}
+
+			if yymg1 {
+				yymv1 = yyv1[yymk1]
+			}
+			r.ReadMapElemValue()
+			yymdn1 = false
+			if r.TryDecodeAsNil() {
+				yymdn1 = true
+			} else {
+				yymv1 = (int64)(r.DecodeInt64())
+			}
+
+			if yymdn1 {
+				if yybh1.DeleteOnNilMapValue {
+					delete(yyv1, yymk1)
+				} else {
+					yyv1[yymk1] = 0
+				}
+			} else if yyv1 != nil {
+				yyv1[yymk1] = yymv1
+			}
 		}
+	} // else len==0: TODO: Should we clear map entries?
+	r.ReadMapEnd()
+}
+
+func (x codecSelfer19780) encSlicestringUint64T(v []stringUint64T, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.WriteArrayStart(len(v))
+	for _, yyv1 := range v {
+		r.WriteArrayElem()
+		yy2 := &yyv1
+		yy2.CodecEncodeSelf(e)
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSlicefloat64(v *[]float64, d *Decoder) {
+func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -14870,7 +19613,7 @@ func (x codecSelfer19780) decSlicefloat64(v *[]float64, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []float64{}
+			yyv1 = []stringUint64T{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -14882,11 +19625,11 @@ func (x codecSelfer19780) decSlicefloat64(v *[]float64, d *Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 24)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]float64, yyrl1)
+					yyv1 = make([]stringUint64T, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -14899,18 +19642,18 @@ func (x codecSelfer19780) decSlicefloat64(v *[]float64, d *Decoder) {
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 24)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]float64, yyrl1)
+				yyv1 = make([]stringUint64T, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, 0)
+				yyv1 = append(yyv1, stringUint64T{})
 				yyc1 = true
 
 			}
@@ -14918,9 +19661,9 @@ func (x codecSelfer19780) decSlicefloat64(v *[]float64, d *Decoder) {
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = 0
+					yyv1[yyj1] = stringUint64T{}
 				} else {
-					yyv1[yyj1] = (float64)(r.DecodeFloat64())
+					yyv1[yyj1].CodecDecodeSelf(d)
 				}
 
 			}
@@ -14930,7 +19673,7 @@ func (x codecSelfer19780) decSlicefloat64(v *[]float64, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]float64, 0)
+			yyv1 = make([]stringUint64T, 0)
 			yyc1 = true
 		}
 	}
@@ -14940,103 +19683,84 @@ func (x codecSelfer19780) decSlicefloat64(v *[]float64, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encSlicefloat32(v []float32, e *Encoder) {
+func (x codecSelfer19780) encMapstringbool(v map[string]bool, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	r.WriteArrayStart(len(v))
-	for _, yyv1 := range v {
-		r.WriteArrayElem()
+	r.WriteMapStart(len(v))
+	for yyk1, yyv1 := range v {
+		r.WriteMapElemKey()
 		if false {
 		} else {
-			r.EncodeFloat32(float32(yyv1))
+			if z.EncBasicHandle().StringToRaw {
+				r.EncodeStringBytesRaw(z.BytesView(string(yyk1)))
+			} else {
+				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyk1))
+			}
+		}
+		r.WriteMapElemValue()
+		if false {
+		} else {
+			r.EncodeBool(bool(yyv1))
 		}
 	}
-	r.WriteArrayEnd()
+	r.WriteMapEnd()
 }
 
-func (x codecSelfer19780) decSlicefloat32(v *[]float32, d *Decoder) {
+func (x codecSelfer19780) decMapstringbool(v *map[string]bool, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
 	yyv1 := *v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	var yyc1 bool
-	_ = yyc1
-	if yyl1 == 0 {
-		if yyv1 == nil {
-			yyv1 = []float32{}
-			yyc1 = true
-		} else if len(yyv1) != 0 {
-			yyv1 = yyv1[:0]
-			yyc1 = true
-		}
-	} else {
-		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-		if yyhl1 {
-			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 4)
-				if yyrl1 <= cap(yyv1) {
-					yyv1 = yyv1[:yyrl1]
-				} else {
-					yyv1 = make([]float32, yyrl1)
-				}
-				yyc1 = true
-			} else if yyl1 != len(yyv1) {
-				yyv1 = yyv1[:yyl1]
-				yyc1 = true
-			}
-		}
-		var yyj1 int
-		// var yydn1 bool
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
-			if yyj1 == 0 && yyv1 == nil {
-				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 4)
-				} else {
-					yyrl1 = 8
-				}
-				yyv1 = make([]float32, yyrl1)
-				yyc1 = true
+	yyl1 := r.ReadMapStart()
+	yybh1 := z.DecBasicHandle()
+	if yyv1 == nil {
+		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 17)
+		yyv1 = make(map[string]bool, yyrl1)
+		*v = yyv1
+	}
+	var yymk1 string
+	var yymv1 bool
+	var yymg1, yymdn1 bool
+	if yybh1.MapValueReset {
+	}
+	if yyl1 != 0 {
+		yyhl1 := yyl1 > 0
+		for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ {
+			r.ReadMapElemKey()
+			if r.TryDecodeAsNil() {
+				yymk1 = ""
+			} else {
+				yymk1 = (string)(r.DecodeString())
 			}
-			yyh1.ElemContainerState(yyj1)
-
-			var yydb1 bool
-			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, 0)
-				yyc1 = true
 
+			if yymg1 {
+				yymv1 = yyv1[yymk1]
 			}
-			if yydb1 {
-				z.DecSwallow()
+			r.ReadMapElemValue()
+			yymdn1 = false
+			if r.TryDecodeAsNil() {
+				yymdn1 = true
 			} else {
-				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = 0
+				yymv1 = (bool)(r.DecodeBool())
+			}
+
+			if yymdn1 {
+				if yybh1.DeleteOnNilMapValue {
+					delete(yyv1, yymk1)
 				} else {
-					yyv1[yyj1] = (float32)(r.DecodeFloat32As64())
+					yyv1[yymk1] = false
 				}
-
+			} else if yyv1 != nil {
+				yyv1[yymk1] = yymv1
 			}
-
-		}
-		if yyj1 < len(yyv1) {
-			yyv1 = yyv1[:yyj1]
-			yyc1 = true
-		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]float32, 0)
-			yyc1 = true
 		}
-	}
-	yyh1.End()
-	if yyc1 {
-		*v = yyv1
-	}
+	} // else len==0: TODO: Should we clear map entries?
+	r.ReadMapEnd()
 }
 
-func (x codecSelfer19780) encMapstringuint16(v map[string]uint16, e *Encoder) {
+func (x codecSelfer19780) encMapstringPtrtoTestStruc(v map[string]*TestStruc, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -15052,15 +19776,16 @@ func (x codecSelfer19780) encMapstringuint16(v map[string]uint16, e *Encoder) {
 			}
 		}
 		r.WriteMapElemValue()
-		if false {
+		if yyv1 == nil {
+			r.EncodeNil()
 		} else {
-			r.EncodeUint(uint64(yyv1))
+			yyv1.CodecEncodeSelf(e)
 		}
 	}
 	r.WriteMapEnd()
 }
 
-func (x codecSelfer19780) decMapstringuint16(v *map[string]uint16, d *Decoder) {
+func (x codecSelfer19780) decMapstringPtrtoTestStruc(v *map[string]*TestStruc, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -15069,14 +19794,15 @@ func (x codecSelfer19780) decMapstringuint16(v *map[string]uint16, d *Decoder) {
 	yyl1 := r.ReadMapStart()
 	yybh1 := z.DecBasicHandle()
 	if yyv1 == nil {
-		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 18)
-		yyv1 = make(map[string]uint16, yyrl1)
+		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 24)
+		yyv1 = make(map[string]*TestStruc, yyrl1)
 		*v = yyv1
 	}
 	var yymk1 string
-	var yymv1 uint16
-	var yymg1, yymdn1 bool
+	var yymv1 *TestStruc
+	var yymg1, yymdn1, yyms1, yymok1 bool
 	if yybh1.MapValueReset {
+		yymg1 = true
 	}
 	if yyl1 != 0 {
 		yyhl1 := yyl1 > 0
@@ -15088,24 +19814,33 @@ func (x codecSelfer19780) decMapstringuint16(v *map[string]uint16, d *Decoder) {
 				yymk1 = (string)(r.DecodeString())
 			}
 
+			yyms1 = true
 			if yymg1 {
-				yymv1 = yyv1[yymk1]
+				yymv1, yymok1 = yyv1[yymk1]
+				if yymok1 {
+					yyms1 = false
+				}
+			} else {
+				yymv1 = nil
 			}
 			r.ReadMapElemValue()
 			yymdn1 = false
 			if r.TryDecodeAsNil() {
 				yymdn1 = true
 			} else {
-				yymv1 = (uint16)(z.C.UintV(r.DecodeUint64(), 16))
+				if yymv1 == nil {
+					yymv1 = new(TestStruc)
+				}
+				yymv1.CodecDecodeSelf(d)
 			}
 
 			if yymdn1 {
 				if yybh1.DeleteOnNilMapValue {
 					delete(yyv1, yymk1)
 				} else {
-					yyv1[yymk1] = 0
+					yyv1[yymk1] = nil
 				}
-			} else if yyv1 != nil {
+			} else if yyms1 && yyv1 != nil {
 				yyv1[yymk1] = yymv1
 			}
 		}
@@ -15113,81 +19848,101 @@ func (x codecSelfer19780) decMapstringuint16(v *map[string]uint16, d *Decoder) {
 	r.ReadMapEnd()
 }
 
-func (x codecSelfer19780) encArray0int64(v *[0]int64, e *Encoder) {
+func (x codecSelfer19780) encMapstringTestStruc(v map[string]TestStruc, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	r.WriteArrayStart(len(v))
-	for _, yyv1 := range v {
-		r.WriteArrayElem()
+	r.WriteMapStart(len(v))
+	for yyk1, yyv1 := range v {
+		r.WriteMapElemKey()
 		if false {
 		} else {
-			r.EncodeInt(int64(yyv1))
+			if z.EncBasicHandle().StringToRaw {
+				r.EncodeStringBytesRaw(z.BytesView(string(yyk1)))
+			} else {
+				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyk1))
+			}
 		}
+		r.WriteMapElemValue()
+		yy3 := &yyv1
+		yy3.CodecEncodeSelf(e)
 	}
-	r.WriteArrayEnd()
+	r.WriteMapEnd()
 }
 
-func (x codecSelfer19780) decArray0int64(v *[0]int64, d *Decoder) {
+func (x codecSelfer19780) decMapstringTestStruc(v *map[string]TestStruc, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1 := v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	if yyl1 == 0 {
-
-	} else {
+	yyv1 := *v
+	yyl1 := r.ReadMapStart()
+	yybh1 := z.DecBasicHandle()
+	if yyv1 == nil {
+		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 1184)
+		yyv1 = make(map[string]TestStruc, yyrl1)
+		*v = yyv1
+	}
+	var yymk1 string
+	var yymv1 TestStruc
+	var yymg1, yymdn1 bool
+	if yybh1.MapValueReset {
+		yymg1 = true
+	}
+	if yyl1 != 0 {
 		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-
-		var yyj1 int
-		// var yydn1 bool
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
-
-			yyh1.ElemContainerState(yyj1)
-
-			var yydb1 bool
-			if yyj1 >= len(yyv1) {
-				z.DecArrayCannotExpand(len(v), yyj1+1)
-				yydb1 = true
+		for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ {
+			r.ReadMapElemKey()
+			if r.TryDecodeAsNil() {
+				yymk1 = ""
+			} else {
+				yymk1 = (string)(r.DecodeString())
+			}
 
+			if yymg1 {
+				yymv1 = yyv1[yymk1]
+			} else {
+				yymv1 = TestStruc{}
 			}
-			if yydb1 {
-				z.DecSwallow()
+			r.ReadMapElemValue()
+			yymdn1 = false
+			if r.TryDecodeAsNil() {
+				yymdn1 = true
 			} else {
-				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = 0
+				yymv1.CodecDecodeSelf(d)
+			}
+
+			if yymdn1 {
+				if yybh1.DeleteOnNilMapValue {
+					delete(yyv1, yymk1)
 				} else {
-					yyv1[yyj1] = (int64)(r.DecodeInt64())
+					yyv1[yymk1] = TestStruc{}
 				}
-
+			} else if yyv1 != nil {
+				yyv1[yymk1] = yymv1
 			}
-
 		}
-
-	}
-	yyh1.End()
-
+	} // else len==0: TODO: Should we clear map entries?
+	r.ReadMapEnd()
 }
 
-func (x codecSelfer19780) encSliceint16(v []int16, e *Encoder) {
+func (x codecSelfer19780) encSlicePtrtoTestStruc(v []*TestStruc, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.WriteArrayStart(len(v))
 	for _, yyv1 := range v {
 		r.WriteArrayElem()
-		if false {
+		if yyv1 == nil {
+			r.EncodeNil()
 		} else {
-			r.EncodeInt(int64(yyv1))
+			yyv1.CodecEncodeSelf(e)
 		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSliceint16(v *[]int16, d *Decoder) {
+func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -15198,7 +19953,7 @@ func (x codecSelfer19780) decSliceint16(v *[]int16, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []int16{}
+			yyv1 = []*TestStruc{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -15210,11 +19965,11 @@ func (x codecSelfer19780) decSliceint16(v *[]int16, d *Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 2)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]int16, yyrl1)
+					yyv1 = make([]*TestStruc, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -15227,18 +19982,18 @@ func (x codecSelfer19780) decSliceint16(v *[]int16, d *Decoder) {
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 2)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]int16, yyrl1)
+				yyv1 = make([]*TestStruc, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, 0)
+				yyv1 = append(yyv1, nil)
 				yyc1 = true
 
 			}
@@ -15246,9 +20001,12 @@ func (x codecSelfer19780) decSliceint16(v *[]int16, d *Decoder) {
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = 0
+					yyv1[yyj1] = nil
 				} else {
-					yyv1[yyj1] = (int16)(z.C.IntV(r.DecodeInt64(), 16))
+					if yyv1[yyj1] == nil {
+						yyv1[yyj1] = new(TestStruc)
+					}
+					yyv1[yyj1].CodecDecodeSelf(d)
 				}
 
 			}
@@ -15258,7 +20016,7 @@ func (x codecSelfer19780) decSliceint16(v *[]int16, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]int16, 0)
+			yyv1 = make([]*TestStruc, 0)
 			yyc1 = true
 		}
 	}
@@ -15268,7 +20026,7 @@ func (x codecSelfer19780) decSliceint16(v *[]int16, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encSlicebool(v []bool, e *Encoder) {
+func (x codecSelfer19780) encAarray(v *Aarray, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -15277,94 +20035,75 @@ func (x codecSelfer19780) encSlicebool(v []bool, e *Encoder) {
 		r.WriteArrayElem()
 		if false {
 		} else {
-			r.EncodeBool(bool(yyv1))
+			if z.EncBasicHandle().StringToRaw {
+				r.EncodeStringBytesRaw(z.BytesView(string(yyv1)))
+			} else {
+				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyv1))
+			}
 		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSlicebool(v *[]bool, d *Decoder) {
+func (x codecSelfer19780) decAarray(v *Aarray, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
-
-	yyv1 := *v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	var yyc1 bool
-	_ = yyc1
-	if yyl1 == 0 {
-		if yyv1 == nil {
-			yyv1 = []bool{}
-			yyc1 = true
-		} else if len(yyv1) != 0 {
-			yyv1 = yyv1[:0]
-			yyc1 = true
-		}
-	} else {
-		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-		if yyhl1 {
-			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1)
-				if yyrl1 <= cap(yyv1) {
-					yyv1 = yyv1[:yyrl1]
-				} else {
-					yyv1 = make([]bool, yyrl1)
-				}
-				yyc1 = true
-			} else if yyl1 != len(yyv1) {
-				yyv1 = yyv1[:yyl1]
-				yyc1 = true
-			}
-		}
+
+	yyv1 := v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	if yyl1 == 0 {
+
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+
 		var yyj1 int
 		// var yydn1 bool
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
-			if yyj1 == 0 && yyv1 == nil {
-				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 1)
-				} else {
-					yyrl1 = 8
-				}
-				yyv1 = make([]bool, yyrl1)
-				yyc1 = true
-			}
+
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, false)
-				yyc1 = true
+				z.DecArrayCannotExpand(len(v), yyj1+1)
+				yydb1 = true
 
 			}
 			if yydb1 {
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = false
+					yyv1[yyj1] = ""
 				} else {
-					yyv1[yyj1] = (bool)(r.DecodeBool())
+					yyv1[yyj1] = (string)(r.DecodeString())
 				}
 
 			}
 
 		}
-		if yyj1 < len(yyv1) {
-			yyv1 = yyv1[:yyj1]
-			yyc1 = true
-		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]bool, 0)
-			yyc1 = true
-		}
+
 	}
 	yyh1.End()
-	if yyc1 {
-		*v = yyv1
-	}
+
 }
 
-func (x codecSelfer19780) encSlicePtrtoint64(v []*int64, e *Encoder) {
+func (x codecSelfer19780) encwrapBytes(v wrapBytes, e *Encoder) {
+	var h codecSelfer19780
+	z, r := GenHelperEncoder(e)
+	_, _, _ = h, z, r
+	r.EncodeStringBytesRaw([]byte(v))
+}
+
+func (x codecSelfer19780) decwrapBytes(v *wrapBytes, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+	*v = r.DecodeBytes(*((*[]byte)(v)), false)
+}
+
+func (x codecSelfer19780) encSliceInterface(v []interface{}, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -15374,17 +20113,16 @@ func (x codecSelfer19780) encSlicePtrtoint64(v []*int64, e *Encoder) {
 		if yyv1 == nil {
 			r.EncodeNil()
 		} else {
-			yy2 := *yyv1
 			if false {
 			} else {
-				r.EncodeInt(int64(yy2))
+				z.EncFallback(yyv1)
 			}
 		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
+func (x codecSelfer19780) decSliceInterface(v *[]interface{}, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -15395,7 +20133,7 @@ func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []*int64{}
+			yyv1 = []interface{}{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -15407,11 +20145,11 @@ func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]*int64, yyrl1)
+					yyv1 = make([]interface{}, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -15424,11 +20162,11 @@ func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 16)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]*int64, yyrl1)
+				yyv1 = make([]interface{}, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
@@ -15445,12 +20183,9 @@ func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
 				if r.TryDecodeAsNil() {
 					yyv1[yyj1] = nil
 				} else {
-					if yyv1[yyj1] == nil {
-						yyv1[yyj1] = new(int64)
-					}
 					if false {
 					} else {
-						*yyv1[yyj1] = (int64)(r.DecodeInt64())
+						z.DecFallback(&yyv1[yyj1], true)
 					}
 				}
 
@@ -15461,7 +20196,7 @@ func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]*int64, 0)
+			yyv1 = make([]interface{}, 0)
 			yyc1 = true
 		}
 	}
@@ -15471,7 +20206,7 @@ func (x codecSelfer19780) decSlicePtrtoint64(v *[]*int64, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encMapstringint64(v map[string]int64, e *Encoder) {
+func (x codecSelfer19780) encMapstringInterface(v map[string]interface{}, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -15487,15 +20222,19 @@ func (x codecSelfer19780) encMapstringint64(v map[string]int64, e *Encoder) {
 			}
 		}
 		r.WriteMapElemValue()
-		if false {
+		if yyv1 == nil {
+			r.EncodeNil()
 		} else {
-			r.EncodeInt(int64(yyv1))
+			if false {
+			} else {
+				z.EncFallback(yyv1)
+			}
 		}
 	}
 	r.WriteMapEnd()
 }
 
-func (x codecSelfer19780) decMapstringint64(v *map[string]int64, d *Decoder) {
+func (x codecSelfer19780) decMapstringInterface(v *map[string]interface{}, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -15504,14 +20243,17 @@ func (x codecSelfer19780) decMapstringint64(v *map[string]int64, d *Decoder) {
 	yyl1 := r.ReadMapStart()
 	yybh1 := z.DecBasicHandle()
 	if yyv1 == nil {
-		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 24)
-		yyv1 = make(map[string]int64, yyrl1)
+		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 32)
+		yyv1 = make(map[string]interface{}, yyrl1)
 		*v = yyv1
 	}
 	var yymk1 string
-	var yymv1 int64
+	var yymv1 interface{}
 	var yymg1, yymdn1 bool
 	if yybh1.MapValueReset {
+		if !yybh1.InterfaceReset {
+			yymg1 = true
+		}
 	}
 	if yyl1 != 0 {
 		yyhl1 := yyl1 > 0
@@ -15525,20 +20267,25 @@ func (x codecSelfer19780) decMapstringint64(v *map[string]int64, d *Decoder) {
 
 			if yymg1 {
 				yymv1 = yyv1[yymk1]
+			} else {
+				yymv1 = nil
 			}
 			r.ReadMapElemValue()
 			yymdn1 = false
 			if r.TryDecodeAsNil() {
 				yymdn1 = true
 			} else {
-				yymv1 = (int64)(r.DecodeInt64())
+				if false {
+				} else {
+					z.DecFallback(&yymv1, true)
+				}
 			}
 
 			if yymdn1 {
 				if yybh1.DeleteOnNilMapValue {
 					delete(yyv1, yymk1)
 				} else {
-					yyv1[yymk1] = 0
+					yyv1[yymk1] = nil
 				}
 			} else if yyv1 != nil {
 				yyv1[yymk1] = yymv1
@@ -15548,20 +20295,22 @@ func (x codecSelfer19780) decMapstringint64(v *map[string]int64, d *Decoder) {
 	r.ReadMapEnd()
 }
 
-func (x codecSelfer19780) encSlicestringUint64T(v []stringUint64T, e *Encoder) {
+func (x codecSelfer19780) encSliceint(v []int, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.WriteArrayStart(len(v))
 	for _, yyv1 := range v {
 		r.WriteArrayElem()
-		yy2 := &yyv1
-		yy2.CodecEncodeSelf(e)
+		if false {
+		} else {
+			r.EncodeInt(int64(yyv1))
+		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder) {
+func (x codecSelfer19780) decSliceint(v *[]int, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -15572,7 +20321,7 @@ func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder)
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []stringUint64T{}
+			yyv1 = []int{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -15584,11 +20333,11 @@ func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder)
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 24)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]stringUint64T, yyrl1)
+					yyv1 = make([]int, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -15601,18 +20350,18 @@ func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder)
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 24)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]stringUint64T, yyrl1)
+				yyv1 = make([]int, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, stringUint64T{})
+				yyv1 = append(yyv1, 0)
 				yyc1 = true
 
 			}
@@ -15620,9 +20369,9 @@ func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder)
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = stringUint64T{}
+					yyv1[yyj1] = 0
 				} else {
-					yyv1[yyj1].CodecDecodeSelf(d)
+					yyv1[yyj1] = (int)(z.C.IntV(r.DecodeInt64(), codecSelferBitsize19780))
 				}
 
 			}
@@ -15632,7 +20381,7 @@ func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder)
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]stringUint64T, 0)
+			yyv1 = make([]int, 0)
 			yyc1 = true
 		}
 	}
@@ -15642,172 +20391,101 @@ func (x codecSelfer19780) decSlicestringUint64T(v *[]stringUint64T, d *Decoder)
 	}
 }
 
-func (x codecSelfer19780) encMapstringbool(v map[string]bool, e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelperEncoder(e)
-	_, _, _ = h, z, r
-	r.WriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		r.WriteMapElemKey()
-		if false {
-		} else {
-			if z.EncBasicHandle().StringToRaw {
-				r.EncodeStringBytesRaw(z.BytesView(string(yyk1)))
-			} else {
-				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyk1))
-			}
-		}
-		r.WriteMapElemValue()
-		if false {
-		} else {
-			r.EncodeBool(bool(yyv1))
-		}
-	}
-	r.WriteMapEnd()
-}
-
-func (x codecSelfer19780) decMapstringbool(v *map[string]bool, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-
-	yyv1 := *v
-	yyl1 := r.ReadMapStart()
-	yybh1 := z.DecBasicHandle()
-	if yyv1 == nil {
-		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 17)
-		yyv1 = make(map[string]bool, yyrl1)
-		*v = yyv1
-	}
-	var yymk1 string
-	var yymv1 bool
-	var yymg1, yymdn1 bool
-	if yybh1.MapValueReset {
-	}
-	if yyl1 != 0 {
-		yyhl1 := yyl1 > 0
-		for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ {
-			r.ReadMapElemKey()
-			if r.TryDecodeAsNil() {
-				yymk1 = ""
-			} else {
-				yymk1 = (string)(r.DecodeString())
-			}
-
-			if yymg1 {
-				yymv1 = yyv1[yymk1]
-			}
-			r.ReadMapElemValue()
-			yymdn1 = false
-			if r.TryDecodeAsNil() {
-				yymdn1 = true
-			} else {
-				yymv1 = (bool)(r.DecodeBool())
-			}
-
-			if yymdn1 {
-				if yybh1.DeleteOnNilMapValue {
-					delete(yyv1, yymk1)
-				} else {
-					yyv1[yymk1] = false
-				}
-			} else if yyv1 != nil {
-				yyv1[yymk1] = yymv1
-			}
-		}
-	} // else len==0: TODO: Should we clear map entries?
-	r.ReadMapEnd()
-}
-
-func (x codecSelfer19780) encMapstringPtrtoTestStruc(v map[string]*TestStruc, e *Encoder) {
+func (x codecSelfer19780) encSlicerequiredFieldsT(v []requiredFieldsT, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	r.WriteMapStart(len(v))
-	for yyk1, yyv1 := range v {
-		r.WriteMapElemKey()
-		if false {
-		} else {
-			if z.EncBasicHandle().StringToRaw {
-				r.EncodeStringBytesRaw(z.BytesView(string(yyk1)))
-			} else {
-				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyk1))
-			}
-		}
-		r.WriteMapElemValue()
-		if yyv1 == nil {
-			r.EncodeNil()
-		} else {
-			yyv1.CodecEncodeSelf(e)
-		}
-	}
-	r.WriteMapEnd()
-}
-
-func (x codecSelfer19780) decMapstringPtrtoTestStruc(v *map[string]*TestStruc, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-
-	yyv1 := *v
-	yyl1 := r.ReadMapStart()
-	yybh1 := z.DecBasicHandle()
-	if yyv1 == nil {
-		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 24)
-		yyv1 = make(map[string]*TestStruc, yyrl1)
-		*v = yyv1
-	}
-	var yymk1 string
-	var yymv1 *TestStruc
-	var yymg1, yymdn1, yyms1, yymok1 bool
-	if yybh1.MapValueReset {
-		yymg1 = true
-	}
-	if yyl1 != 0 {
-		yyhl1 := yyl1 > 0
-		for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ {
-			r.ReadMapElemKey()
-			if r.TryDecodeAsNil() {
-				yymk1 = ""
-			} else {
-				yymk1 = (string)(r.DecodeString())
-			}
+	r.WriteArrayStart(len(v))
+	for _, yyv1 := range v {
+		r.WriteArrayElem()
+		yy2 := &yyv1
+		yy2.CodecEncodeSelf(e)
+	}
+	r.WriteArrayEnd()
+}
 
-			yyms1 = true
-			if yymg1 {
-				yymv1, yymok1 = yyv1[yymk1]
-				if yymok1 {
-					yyms1 = false
+func (x codecSelfer19780) decSlicerequiredFieldsT(v *[]requiredFieldsT, d *Decoder) {
+	var h codecSelfer19780
+	z, r := GenHelperDecoder(d)
+	_, _, _ = h, z, r
+
+	yyv1 := *v
+	yyh1, yyl1 := z.DecSliceHelperStart()
+	var yyc1 bool
+	_ = yyc1
+	if yyl1 == 0 {
+		if yyv1 == nil {
+			yyv1 = []requiredFieldsT{}
+			yyc1 = true
+		} else if len(yyv1) != 0 {
+			yyv1 = yyv1[:0]
+			yyc1 = true
+		}
+	} else {
+		yyhl1 := yyl1 > 0
+		var yyrl1 int
+		_ = yyrl1
+		if yyhl1 {
+			if yyl1 > cap(yyv1) {
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 56)
+				if yyrl1 <= cap(yyv1) {
+					yyv1 = yyv1[:yyrl1]
+				} else {
+					yyv1 = make([]requiredFieldsT, yyrl1)
 				}
-			} else {
-				yymv1 = nil
+				yyc1 = true
+			} else if yyl1 != len(yyv1) {
+				yyv1 = yyv1[:yyl1]
+				yyc1 = true
 			}
-			r.ReadMapElemValue()
-			yymdn1 = false
-			if r.TryDecodeAsNil() {
-				yymdn1 = true
-			} else {
-				if yymv1 == nil {
-					yymv1 = new(TestStruc)
+		}
+		var yyj1 int
+		// var yydn1 bool
+		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
+			if yyj1 == 0 && yyv1 == nil {
+				if yyhl1 {
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 56)
+				} else {
+					yyrl1 = 8
 				}
-				yymv1.CodecDecodeSelf(d)
+				yyv1 = make([]requiredFieldsT, yyrl1)
+				yyc1 = true
 			}
+			yyh1.ElemContainerState(yyj1)
 
-			if yymdn1 {
-				if yybh1.DeleteOnNilMapValue {
-					delete(yyv1, yymk1)
+			var yydb1 bool
+			if yyj1 >= len(yyv1) {
+				yyv1 = append(yyv1, requiredFieldsT{})
+				yyc1 = true
+
+			}
+			if yydb1 {
+				z.DecSwallow()
+			} else {
+				if r.TryDecodeAsNil() {
+					yyv1[yyj1] = requiredFieldsT{}
 				} else {
-					yyv1[yymk1] = nil
+					yyv1[yyj1].CodecDecodeSelf(d)
 				}
-			} else if yyms1 && yyv1 != nil {
-				yyv1[yymk1] = yymv1
+
 			}
+
 		}
-	} // else len==0: TODO: Should we clear map entries?
-	r.ReadMapEnd()
+		if yyj1 < len(yyv1) {
+			yyv1 = yyv1[:yyj1]
+			yyc1 = true
+		} else if yyj1 == 0 && yyv1 == nil {
+			yyv1 = make([]requiredFieldsT, 0)
+			yyc1 = true
+		}
+	}
+	yyh1.End()
+	if yyc1 {
+		*v = yyv1
+	}
 }
 
-func (x codecSelfer19780) encMapstringTestStruc(v map[string]TestStruc, e *Encoder) {
+func (x codecSelfer19780) encMapstringstring(v map[string]string, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -15823,13 +20501,19 @@ func (x codecSelfer19780) encMapstringTestStruc(v map[string]TestStruc, e *Encod
 			}
 		}
 		r.WriteMapElemValue()
-		yy3 := &yyv1
-		yy3.CodecEncodeSelf(e)
+		if false {
+		} else {
+			if z.EncBasicHandle().StringToRaw {
+				r.EncodeStringBytesRaw(z.BytesView(string(yyv1)))
+			} else {
+				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyv1))
+			}
+		}
 	}
 	r.WriteMapEnd()
 }
 
-func (x codecSelfer19780) decMapstringTestStruc(v *map[string]TestStruc, d *Decoder) {
+func (x codecSelfer19780) decMapstringstring(v *map[string]string, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -15838,15 +20522,14 @@ func (x codecSelfer19780) decMapstringTestStruc(v *map[string]TestStruc, d *Deco
 	yyl1 := r.ReadMapStart()
 	yybh1 := z.DecBasicHandle()
 	if yyv1 == nil {
-		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 1184)
-		yyv1 = make(map[string]TestStruc, yyrl1)
+		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 32)
+		yyv1 = make(map[string]string, yyrl1)
 		*v = yyv1
 	}
 	var yymk1 string
-	var yymv1 TestStruc
+	var yymv1 string
 	var yymg1, yymdn1 bool
 	if yybh1.MapValueReset {
-		yymg1 = true
 	}
 	if yyl1 != 0 {
 		yyhl1 := yyl1 > 0
@@ -15860,22 +20543,20 @@ func (x codecSelfer19780) decMapstringTestStruc(v *map[string]TestStruc, d *Deco
 
 			if yymg1 {
 				yymv1 = yyv1[yymk1]
-			} else {
-				yymv1 = TestStruc{}
 			}
 			r.ReadMapElemValue()
 			yymdn1 = false
 			if r.TryDecodeAsNil() {
 				yymdn1 = true
 			} else {
-				yymv1.CodecDecodeSelf(d)
+				yymv1 = (string)(r.DecodeString())
 			}
 
 			if yymdn1 {
 				if yybh1.DeleteOnNilMapValue {
 					delete(yyv1, yymk1)
 				} else {
-					yyv1[yymk1] = TestStruc{}
+					yyv1[yymk1] = ""
 				}
 			} else if yyv1 != nil {
 				yyv1[yymk1] = yymv1
@@ -15885,23 +20566,20 @@ func (x codecSelfer19780) decMapstringTestStruc(v *map[string]TestStruc, d *Deco
 	r.ReadMapEnd()
 }
 
-func (x codecSelfer19780) encSlicePtrtoTestStruc(v []*TestStruc, e *Encoder) {
+func (x codecSelfer19780) encSlicefieldMaskTask(v []fieldMaskTask, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
 	r.WriteArrayStart(len(v))
 	for _, yyv1 := range v {
 		r.WriteArrayElem()
-		if yyv1 == nil {
-			r.EncodeNil()
-		} else {
-			yyv1.CodecEncodeSelf(e)
-		}
+		yy2 := &yyv1
+		yy2.CodecEncodeSelf(e)
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
+func (x codecSelfer19780) decSlicefieldMaskTask(v *[]fieldMaskTask, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -15912,7 +20590,7 @@ func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []*TestStruc{}
+			yyv1 = []fieldMaskTask{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -15924,11 +20602,11 @@ func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 		_ = yyrl1
 		if yyhl1 {
 			if yyl1 > cap(yyv1) {
-				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+				yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 40)
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]*TestStruc, yyrl1)
+					yyv1 = make([]fieldMaskTask, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -15941,18 +20619,18 @@ func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
 			if yyj1 == 0 && yyv1 == nil {
 				if yyhl1 {
-					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 8)
+					yyrl1 = z.DecInferLen(yyl1, z.DecBasicHandle().MaxInitLen, 40)
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]*TestStruc, yyrl1)
+				yyv1 = make([]fieldMaskTask, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
 
 			var yydb1 bool
 			if yyj1 >= len(yyv1) {
-				yyv1 = append(yyv1, nil)
+				yyv1 = append(yyv1, fieldMaskTask{})
 				yyc1 = true
 
 			}
@@ -15960,11 +20638,8 @@ func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 				z.DecSwallow()
 			} else {
 				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = nil
+					yyv1[yyj1] = fieldMaskTask{}
 				} else {
-					if yyv1[yyj1] == nil {
-						yyv1[yyj1] = new(TestStruc)
-					}
 					yyv1[yyj1].CodecDecodeSelf(d)
 				}
 
@@ -15975,7 +20650,7 @@ func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]*TestStruc, 0)
+			yyv1 = make([]fieldMaskTask, 0)
 			yyc1 = true
 		}
 	}
@@ -15985,84 +20660,95 @@ func (x codecSelfer19780) decSlicePtrtoTestStruc(v *[]*TestStruc, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encAarray(v *Aarray, e *Encoder) {
+func (x codecSelfer19780) encMapstringPtrtofieldMaskTask(v map[string]*fieldMaskTask, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
-	r.WriteArrayStart(len(v))
-	for _, yyv1 := range v {
-		r.WriteArrayElem()
+	r.WriteMapStart(len(v))
+	for yyk1, yyv1 := range v {
+		r.WriteMapElemKey()
 		if false {
 		} else {
 			if z.EncBasicHandle().StringToRaw {
-				r.EncodeStringBytesRaw(z.BytesView(string(yyv1)))
+				r.EncodeStringBytesRaw(z.BytesView(string(yyk1)))
 			} else {
-				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyv1))
+				r.EncodeStringEnc(codecSelferCcUTF819780, string(yyk1))
 			}
 		}
+		r.WriteMapElemValue()
+		if yyv1 == nil {
+			r.EncodeNil()
+		} else {
+			yyv1.CodecEncodeSelf(e)
+		}
 	}
-	r.WriteArrayEnd()
+	r.WriteMapEnd()
 }
 
-func (x codecSelfer19780) decAarray(v *Aarray, d *Decoder) {
+func (x codecSelfer19780) decMapstringPtrtofieldMaskTask(v *map[string]*fieldMaskTask, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
 
-	yyv1 := v
-	yyh1, yyl1 := z.DecSliceHelperStart()
-	if yyl1 == 0 {
-
-	} else {
+	yyv1 := *v
+	yyl1 := r.ReadMapStart()
+	yybh1 := z.DecBasicHandle()
+	if yyv1 == nil {
+		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 24)
+		yyv1 = make(map[string]*fieldMaskTask, yyrl1)
+		*v = yyv1
+	}
+	var yymk1 string
+	var yymv1 *fieldMaskTask
+	var yymg1, yymdn1, yyms1, yymok1 bool
+	if yybh1.MapValueReset {
+		yymg1 = true
+	}
+	if yyl1 != 0 {
 		yyhl1 := yyl1 > 0
-		var yyrl1 int
-		_ = yyrl1
-
-		var yyj1 int
-		// var yydn1 bool
-		for yyj1 = 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ { // bounds-check-elimination
-
-			yyh1.ElemContainerState(yyj1)
-
-			var yydb1 bool
-			if yyj1 >= len(yyv1) {
-				z.DecArrayCannotExpand(len(v), yyj1+1)
-				yydb1 = true
+		for yyj1 := 0; (yyhl1 && yyj1 < yyl1) || !(yyhl1 || r.CheckBreak()); yyj1++ {
+			r.ReadMapElemKey()
+			if r.TryDecodeAsNil() {
+				yymk1 = ""
+			} else {
+				yymk1 = (string)(r.DecodeString())
+			}
 
+			yyms1 = true
+			if yymg1 {
+				yymv1, yymok1 = yyv1[yymk1]
+				if yymok1 {
+					yyms1 = false
+				}
+			} else {
+				yymv1 = nil
 			}
-			if yydb1 {
-				z.DecSwallow()
+			r.ReadMapElemValue()
+			yymdn1 = false
+			if r.TryDecodeAsNil() {
+				yymdn1 = true
 			} else {
-				if r.TryDecodeAsNil() {
-					yyv1[yyj1] = ""
-				} else {
-					yyv1[yyj1] = (string)(r.DecodeString())
+				if yymv1 == nil {
+					yymv1 = new(fieldMaskTask)
 				}
-
+				yymv1.CodecDecodeSelf(d)
 			}
 
+			if yymdn1 {
+				if yybh1.DeleteOnNilMapValue {
+					delete(yyv1, yymk1)
+				} else {
+					yyv1[yymk1] = nil
+				}
+			} else if yyms1 && yyv1 != nil {
+				yyv1[yymk1] = yymv1
+			}
 		}
-
-	}
-	yyh1.End()
-
-}
-
-func (x codecSelfer19780) encwrapBytes(v wrapBytes, e *Encoder) {
-	var h codecSelfer19780
-	z, r := GenHelperEncoder(e)
-	_, _, _ = h, z, r
-	r.EncodeStringBytesRaw([]byte(v))
-}
-
-func (x codecSelfer19780) decwrapBytes(v *wrapBytes, d *Decoder) {
-	var h codecSelfer19780
-	z, r := GenHelperDecoder(d)
-	_, _, _ = h, z, r
-	*v = r.DecodeBytes(*((*[]byte)(v)), false)
+	} // else len==0: TODO: Should we clear map entries?
+	r.ReadMapEnd()
 }
 
-func (x codecSelfer19780) encSliceInterface(v []interface{}, e *Encoder) {
+func (x codecSelfer19780) encSlicetestPolyEvent(v []testPolyEvent, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -16073,15 +20759,18 @@ func (x codecSelfer19780) encSliceInterface(v []interface{}, e *Encoder) {
 			r.EncodeNil()
 		} else {
 			if false {
+			} else if yyxt2 := z.Extension(z.I2Rtid(yyv1)); yyxt2 != nil {
+				z.EncExtension(yyv1, yyxt2)
 			} else {
-				z.EncFallback(yyv1)
+				yyip2 := yyv1
+				z.EncFallback(&yyip2)
 			}
 		}
 	}
 	r.WriteArrayEnd()
 }
 
-func (x codecSelfer19780) decSliceInterface(v *[]interface{}, d *Decoder) {
+func (x codecSelfer19780) decSlicetestPolyEvent(v *[]testPolyEvent, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -16092,7 +20781,7 @@ func (x codecSelfer19780) decSliceInterface(v *[]interface{}, d *Decoder) {
 	_ = yyc1
 	if yyl1 == 0 {
 		if yyv1 == nil {
-			yyv1 = []interface{}{}
+			yyv1 = []testPolyEvent{}
 			yyc1 = true
 		} else if len(yyv1) != 0 {
 			yyv1 = yyv1[:0]
@@ -16108,7 +20797,7 @@ func (x codecSelfer19780) decSliceInterface(v *[]interface{}, d *Decoder) {
 				if yyrl1 <= cap(yyv1) {
 					yyv1 = yyv1[:yyrl1]
 				} else {
-					yyv1 = make([]interface{}, yyrl1)
+					yyv1 = make([]testPolyEvent, yyrl1)
 				}
 				yyc1 = true
 			} else if yyl1 != len(yyv1) {
@@ -16125,7 +20814,7 @@ func (x codecSelfer19780) decSliceInterface(v *[]interface{}, d *Decoder) {
 				} else {
 					yyrl1 = 8
 				}
-				yyv1 = make([]interface{}, yyrl1)
+				yyv1 = make([]testPolyEvent, yyrl1)
 				yyc1 = true
 			}
 			yyh1.ElemContainerState(yyj1)
@@ -16155,7 +20844,7 @@ func (x codecSelfer19780) decSliceInterface(v *[]interface{}, d *Decoder) {
 			yyv1 = yyv1[:yyj1]
 			yyc1 = true
 		} else if yyj1 == 0 && yyv1 == nil {
-			yyv1 = make([]interface{}, 0)
+			yyv1 = make([]testPolyEvent, 0)
 			yyc1 = true
 		}
 	}
@@ -16165,7 +20854,7 @@ func (x codecSelfer19780) decSliceInterface(v *[]interface{}, d *Decoder) {
 	}
 }
 
-func (x codecSelfer19780) encMapstringInterface(v map[string]interface{}, e *Encoder) {
+func (x codecSelfer19780) encMapstringtestPolyEvent(v map[string]testPolyEvent, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -16185,15 +20874,18 @@ func (x codecSelfer19780) encMapstringInterface(v map[string]interface{}, e *Enc
 			r.EncodeNil()
 		} else {
 			if false {
+			} else if yyxt3 := z.Extension(z.I2Rtid(yyv1)); yyxt3 != nil {
+				z.EncExtension(yyv1, yyxt3)
 			} else {
-				z.EncFallback(yyv1)
+				yyip3 := yyv1
+				z.EncFallback(&yyip3)
 			}
 		}
 	}
 	r.WriteMapEnd()
 }
 
-func (x codecSelfer19780) decMapstringInterface(v *map[string]interface{}, d *Decoder) {
+func (x codecSelfer19780) decMapstringtestPolyEvent(v *map[string]testPolyEvent, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
@@ -16203,11 +20895,11 @@ func (x codecSelfer19780) decMapstringInterface(v *map[string]interface{}, d *De
 	yybh1 := z.DecBasicHandle()
 	if yyv1 == nil {
 		yyrl1 := z.DecInferLen(yyl1, yybh1.MaxInitLen, 32)
-		yyv1 = make(map[string]interface{}, yyrl1)
+		yyv1 = make(map[string]testPolyEvent, yyrl1)
 		*v = yyv1
 	}
 	var yymk1 string
-	var yymv1 interface{}
+	var yymv1 testPolyEvent
 	var yymg1, yymdn1 bool
 	if yybh1.MapValueReset {
 		if !yybh1.InterfaceReset {
@@ -16435,7 +21127,7 @@ func (x codecSelfer19780) decMapintstring(v *map[int]string, d *Decoder) {
 	r.ReadMapEnd()
 }
 
-func (x codecSelfer19780) encMapboolc3RydWN0IHt9(v map[bool]struct{}, e *Encoder) {
+func (x codecSelfer19780) encMapboolONaHEdLDOQQHWfI(v map[bool]struct{}, e *Encoder) {
 	var h codecSelfer19780
 	z, r := GenHelperEncoder(e)
 	_, _, _ = h, z, r
@@ -16456,7 +21148,7 @@ func (x codecSelfer19780) encMapboolc3RydWN0IHt9(v map[bool]struct{}, e *Encoder
 	r.WriteMapEnd()
 }
 
-func (x codecSelfer19780) decMapboolc3RydWN0IHt9(v *map[bool]struct{}, d *Decoder) {
+func (x codecSelfer19780) decMapboolONaHEdLDOQQHWfI(v *map[bool]struct{}, d *Decoder) {
 	var h codecSelfer19780
 	z, r := GenHelperDecoder(d)
 	_, _, _ = h, z, r
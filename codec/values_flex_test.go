@@ -98,6 +98,119 @@ type missingFielderT2 struct {
 	I int64
 }
 
+// unknownFieldsT1 is unknownFieldsT2 without the fields after A.
+type unknownFieldsT1 struct {
+	A       string
+	Unknown UnknownFields
+}
+
+type unknownFieldsT2 struct {
+	A string
+	U uint8
+	F float32
+	L []string
+	M map[string]uint16
+}
+
+type fieldAliasT struct {
+	A string `codec:"a,alias=b,alias=c"`
+	D int    `codec:",alias=a,alias=E"` // alias of another field's name is ignored
+	F int    `codec:"f,omitempty,alias=c"`
+}
+
+type requiredFieldsT struct {
+	A string `codec:"a,required"`
+	B int    `codec:",required,alias=b"`
+	C []int  `codec:"c,required"`
+	D int
+}
+
+type requiredFieldsTT struct {
+	Items []requiredFieldsT
+}
+
+type defaulterT struct {
+	S string        `codec:"s,default=seven"`
+	I int           `codec:",default=-8"`
+	U uint8         `codec:",default=0x10"`
+	D time.Duration `codec:",default=1m30s"`
+	B bool          `codec:",default=true"`
+	F float64
+	L []string
+}
+
+func (t *defaulterT) CodecDefaults() {
+	t.F = 9.5
+	t.L = []string{"nine"}
+	t.I++ // the default= values are already set
+}
+
+// defaulterTagT has default= options, but is not a Defaulter.
+type defaulterTagT struct {
+	A int `codec:",default=3"`
+	B int
+}
+
+// defaulterOldT is a defaulterT as written by an older version, with fewer fields.
+type defaulterOldT struct {
+	_struct struct{} `codec:",toarray"`
+	S       string
+	I       int
+}
+
+type fieldSetE struct {
+	C int
+}
+
+type fieldSetT struct {
+	A  string `codec:"a,alias=aa"`
+	B  *int
+	FS FieldSet
+	fieldSetE
+	D int
+}
+
+type fieldMaskTask struct {
+	Name   string
+	Driver string `codec:"driver"`
+	Env    map[string]string
+}
+
+type fieldMaskSpec struct {
+	Name   string
+	Count  int `codec:",omitempty"`
+	Tasks  []fieldMaskTask
+	ByName map[string]*fieldMaskTask
+	Meta   map[string]string
+	Any    interface{}
+}
+
+type testPolyEvent interface {
+	testPolyKind() string
+}
+
+type testPolyCreated struct {
+	ID   int
+	Name string
+}
+
+type testPolyDeleted struct {
+	ID int
+}
+
+type testPolyNote string
+
+func (testPolyCreated) testPolyKind() string  { return "created" }
+func (*testPolyDeleted) testPolyKind() string { return "deleted" }
+func (testPolyNote) testPolyKind() string     { return "note" }
+
+type testPolyLog struct {
+	Events []testPolyEvent
+	Last   testPolyEvent
+	ByName map[string]testPolyEvent
+	None   testPolyEvent
+}
+
 var testWRepeated512 wrapBytes
 var testStrucTime = time.Date(2012, 2, 2, 2, 2, 2, 2000, time.UTC).UTC()
 